@@ -1,8 +1,10 @@
 // Package beads provides a minimal public API for extending bd with custom orchestration.
 //
-// Most extensions should use direct SQL queries against bd's database.
-// This package exports only the essential types and functions needed for
-// Go-based extensions that want to use bd's storage layer programmatically.
+// Programs that embed beads should open a workspace with OpenStore, which
+// performs the same create, update, close, list, show and export operations
+// as the bd CLI and runs registered hooks after each change. Storage (from
+// Open, OpenFromConfig or Store.Storage) exposes the lower-level storage
+// layer for everything else.
 //
 // For detailed guidance on extending bd, see docs/EXTENDING.md.
 package beads
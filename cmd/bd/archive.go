@@ -0,0 +1,194 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var archiveCmd = &cobra.Command{
+	Use:     "archive",
+	GroupID: "maint",
+	Short:   "Move old closed issues into the archive table",
+	Long: `Move closed issues older than a threshold out of the active issues table.
+
+Archived issues are kept in the issues_archive table with their labels,
+dependencies, comments and events, so the active table (and every query
+against it) stays small. All matching issues move in one transaction and
+one Dolt commit.
+
+Skips: pinned issues and templates.
+
+Archived issues no longer appear in bd list, bd ready or bd show. Use
+'bd list --archived' to browse them and 'bd unarchive <id>' to bring one back.
+
+AUTO-ARCHIVE:
+With close.auto-archive-after set (e.g. 30d), closing an issue records when
+it becomes eligible for archiving; the issue stays visible until then.
+'bd archive --run' sweeps every issue whose time has come. Issues closed
+before the setting existed are only archived by --closed-before.
+
+EXAMPLES:
+  bd archive --closed-before 90d             # Archive issues closed 90+ days ago
+  bd archive --closed-before 12w --dry-run   # Preview without changing anything
+  bd archive --run                           # Archive issues past close.auto-archive-after`,
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("archive")
+
+		closedBefore, _ := cmd.Flags().GetString("closed-before")
+		run, _ := cmd.Flags().GetBool("run")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if run && closedBefore != "" {
+			FatalError("--run and --closed-before cannot be used together")
+		}
+		if !run && closedBefore == "" {
+			FatalErrorWithHint("--closed-before or --run is required", "e.g. bd archive --closed-before 90d")
+		}
+		var cutoff time.Time
+		if !run {
+			days, err := parseHumanDuration(closedBefore)
+			if err != nil {
+				FatalError("invalid --closed-before value %q: %v", closedBefore, err)
+			}
+			cutoff = time.Now().AddDate(0, 0, -days)
+		}
+
+		if store == nil {
+			if err := ensureStoreActive(); err != nil {
+				FatalError("%v", err)
+			}
+		}
+
+		if run {
+			runArchiveSweep(dryRun)
+			return
+		}
+
+		ids, err := store.ArchiveClosedIssues(rootCtx, cutoff, dryRun)
+		if err != nil {
+			FatalError("archiving issues: %v", err)
+		}
+		if !dryRun && len(ids) > 0 {
+			commandDidWrite.Store(true)
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"archived_count": len(ids),
+				"archived":       ids,
+				"closed_before":  cutoff.UTC().Format(time.RFC3339),
+				"dry_run":        dryRun,
+			})
+			return
+		}
+
+		if len(ids) == 0 {
+			fmt.Printf("No closed issues to archive (closed before %s)\n", cutoff.Format("2006-01-02"))
+			return
+		}
+		if dryRun {
+			fmt.Printf("Would archive %d closed issue(s) (closed before %s):\n", len(ids), cutoff.Format("2006-01-02"))
+			for _, id := range ids {
+				fmt.Printf("  %s\n", id)
+			}
+			return
+		}
+		fmt.Printf("%s Archived %d closed issue(s) (closed before %s)\n",
+			ui.RenderPass("✓"), len(ids), cutoff.Format("2006-01-02"))
+		fmt.Println("  Browse with 'bd list --archived'; bring one back with 'bd unarchive <id>'")
+	},
+}
+
+// runArchiveSweep archives closed issues whose close.auto-archive-after
+// eligibility time has passed and reports how many moved.
+func runArchiveSweep(dryRun bool) {
+	ids, err := store.ArchiveEligibleIssues(rootCtx, time.Now(), dryRun)
+	if err != nil {
+		FatalError("archiving issues: %v", err)
+	}
+	if !dryRun && len(ids) > 0 {
+		commandDidWrite.Store(true)
+	}
+
+	if jsonOutput {
+		outputJSON(map[string]interface{}{
+			"archived_count": len(ids),
+			"archived":       ids,
+			"dry_run":        dryRun,
+		})
+		return
+	}
+
+	if len(ids) == 0 {
+		fmt.Println("No closed issues are due for archiving")
+		if after, err := config.AutoArchiveAfter(); err == nil && after == 0 {
+			fmt.Println("  close.auto-archive-after is not set, so closing an issue never schedules it")
+		}
+		return
+	}
+	if dryRun {
+		fmt.Printf("Would archive %d closed issue(s) past their archive time:\n", len(ids))
+		for _, id := range ids {
+			fmt.Printf("  %s\n", id)
+		}
+		return
+	}
+	fmt.Printf("%s Archived %d closed issue(s) past their archive time\n", ui.RenderPass("✓"), len(ids))
+	fmt.Println("  Browse with 'bd list --archived'; bring one back with 'bd unarchive <id>'")
+}
+
+var unarchiveCmd = &cobra.Command{
+	Use:     "unarchive <issue-id>",
+	GroupID: "maint",
+	Short:   "Move an archived issue back into the active issues table",
+	Long: `Move an issue archived by 'bd archive' back into the active issues table.
+
+The issue comes back with everything that was archived with it: labels,
+dependencies, comments, events, its child counter and its compaction
+snapshots. The move is one transaction and one Dolt commit.
+
+To view the pre-compaction content of a compacted issue, use 'bd restore'.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("unarchive")
+		issueID := args[0]
+
+		if store == nil {
+			if err := ensureStoreActive(); err != nil {
+				FatalError("%v", err)
+			}
+		}
+
+		if err := store.RestoreArchivedIssue(rootCtx, issueID); err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				FatalErrorWithHint(fmt.Sprintf("issue '%s' is not archived", issueID), "browse archived issues with 'bd list --archived'")
+			}
+			FatalError("restoring %s from archive: %v", issueID, err)
+		}
+		commandDidWrite.Store(true)
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"id":       issueID,
+				"restored": true,
+				"source":   "archive",
+			})
+			return
+		}
+		fmt.Printf("%s Restored %s from the archive\n", ui.RenderPass("✓"), issueID)
+	},
+}
+
+func init() {
+	archiveCmd.Flags().String("closed-before", "", "Archive issues closed more than N ago (e.g., 90d, 12w, 90)")
+	archiveCmd.Flags().Bool("run", false, "Archive issues whose close.auto-archive-after time has passed")
+	archiveCmd.Flags().Bool("dry-run", false, "List issues that would be archived without moving them")
+	rootCmd.AddCommand(archiveCmd)
+	rootCmd.AddCommand(unarchiveCmd)
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/hooks"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/utils"
+)
+
+var assignCmd = &cobra.Command{
+	Use:     "assign <id...> <user>",
+	GroupID: "issues",
+	Short:   "Assign one or more issues to a user",
+	Long: `Assign issues to a user. The last argument is the assignee unless --me is
+given, in which case every argument is an issue ID and the assignee is the
+current actor (see 'bd --actor').
+
+An assignee of '@<group>' (see 'bd group') spreads the issues across the
+group's members: each issue goes to the member with the fewest issues that
+are not closed, counting the ones assigned by this command, with ties going
+to the name that sorts first.
+
+All issues are updated in a single Dolt commit.
+
+Examples:
+  bd assign bd-abc alice
+  bd assign bd-abc bd-def bob
+  bd assign bd-abc bd-def @backend
+  bd assign bd-abc --me`,
+	Args: cobra.MinimumNArgs(1),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return issueIDCompletion(cmd, args, toComplete)
+		}
+		if me, _ := cmd.Flags().GetBool("me"); me {
+			return issueIDCompletion(cmd, args, toComplete)
+		}
+		return assigneeCompletion(cmd, args, toComplete)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("assign")
+		me, _ := cmd.Flags().GetBool("me")
+
+		ids, assignee, err := parseAssignArgs(args, me, getActorWithGit())
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		setAssignee(ids, assignee)
+	},
+}
+
+var unassignCmd = &cobra.Command{
+	Use:     "unassign <id...>",
+	GroupID: "issues",
+	Short:   "Clear the assignee on one or more issues",
+	Long: `Clear the assignee on issues. All issues are updated in a single Dolt commit.
+
+Examples:
+  bd unassign bd-abc
+  bd unassign bd-abc bd-def`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("unassign")
+		setAssignee(args, "")
+	},
+}
+
+// parseAssignArgs splits `bd assign` arguments into issue IDs and assignee.
+// With --me every argument is an ID and the assignee is the resolved actor.
+func parseAssignArgs(args []string, me bool, currentActor string) ([]string, string, error) {
+	if me {
+		if currentActor == "" {
+			return nil, "", fmt.Errorf("--me: could not determine current actor (set --actor or BD_ACTOR)")
+		}
+		return args, currentActor, nil
+	}
+	if len(args) < 2 {
+		return nil, "", fmt.Errorf("usage: bd assign <id...> <user> (or --me)")
+	}
+	assignee := strings.TrimSpace(args[len(args)-1])
+	if assignee == "" {
+		return nil, "", fmt.Errorf("assignee cannot be empty (use 'bd unassign' to clear it)")
+	}
+	return args[:len(args)-1], assignee, nil
+}
+
+// setAssignee sets (or clears, when assignee is empty) the assignee on every
+// issue in a single transaction, so a multi-issue assign is one Dolt commit.
+func setAssignee(args []string, assignee string) {
+	ctx := rootCtx
+	if store == nil {
+		FatalErrorWithHint("database not initialized",
+			"run 'bd doctor' to diagnose, or 'bd init' to create a new database")
+	}
+
+	ids, err := utils.ResolvePartialIDs(ctx, store, args)
+	if err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+	for _, id := range ids {
+		issue, err := store.GetIssue(ctx, id)
+		if err != nil {
+			FatalErrorRespectJSON("getting %s: %v", id, err)
+		}
+		if err := validateIssueUpdatable(id, issue); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+	}
+
+	assignees := make([]string, len(ids))
+	for i := range assignees {
+		assignees[i] = assignee
+	}
+	if group, ok := assigneeGroupName(assignee); ok {
+		members, err := resolveAssigneeGroup(ctx, group)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		loads, err := groupMemberLoads(ctx, members)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		assignees = pickLeastLoaded(members, loads, len(ids))
+	}
+
+	if dryRun {
+		printDryRun(dryRunAssignEach(ctx, ids, assignees, assignee))
+		return
+	}
+
+	verb := "assign"
+	commitMsg := fmt.Sprintf("bd: assign %d issue(s) to %s", len(ids), assignee)
+	if assignee == "" {
+		verb = "unassign"
+		commitMsg = fmt.Sprintf("bd: unassign %d issue(s)", len(ids))
+	}
+	err = transact(ctx, store, commitMsg, func(tx storage.Transaction) error {
+		for i, id := range ids {
+			if err := tx.UpdateIssue(ctx, id, map[string]interface{}{"assignee": assignees[i]}, actor); err != nil {
+				return fmt.Errorf("%s %s: %w", verb, id, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+
+	updated := make([]*types.Issue, 0, len(ids))
+	for _, id := range ids {
+		issue, _ := store.GetIssue(ctx, id) // Best effort: nil issue handled below
+		if issue == nil {
+			continue
+		}
+		if hookRunner != nil {
+			hookRunner.Run(hooks.EventUpdate, issue)
+		}
+		updated = append(updated, issue)
+	}
+
+	if jsonOutput {
+		outputJSON(updated)
+		return
+	}
+	for _, issue := range updated {
+		if assignee == "" {
+			fmt.Printf("%s Unassigned %s\n", ui.RenderPass("✓"), formatFeedbackID(issue.ID, issue.Title))
+		} else {
+			fmt.Printf("%s Assigned %s to %s\n", ui.RenderPass("✓"), formatFeedbackID(issue.ID, issue.Title), issue.Assignee)
+		}
+	}
+}
+
+func init() {
+	assignCmd.Flags().Bool("me", false, "Assign to the current actor")
+	registerCommitMessageFlag(assignCmd, "Dolt commit message for this assign (default: generated)")
+	registerCommitMessageFlag(unassignCmd, "Dolt commit message for this unassign (default: generated)")
+	unassignCmd.ValidArgsFunction = issueIDCompletion
+	rootCmd.AddCommand(assignCmd)
+	rootCmd.AddCommand(unassignCmd)
+}
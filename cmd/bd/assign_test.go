@@ -0,0 +1,39 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseAssignArgs(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		me           bool
+		actor        string
+		wantIDs      []string
+		wantAssignee string
+		wantErr      bool
+	}{
+		{"single", []string{"bd-abc", "alice"}, false, "me", []string{"bd-abc"}, "alice", false},
+		{"multiple", []string{"bd-abc", "bd-def", "bob"}, false, "me", []string{"bd-abc", "bd-def"}, "bob", false},
+		{"me", []string{"bd-abc", "bd-def"}, true, "carol", []string{"bd-abc", "bd-def"}, "carol", false},
+		{"missing user", []string{"bd-abc"}, false, "me", nil, "", true},
+		{"blank user", []string{"bd-abc", " "}, false, "me", nil, "", true},
+		{"me without actor", []string{"bd-abc"}, true, "", nil, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, assignee, err := parseAssignArgs(tt.args, tt.me, tt.actor)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) || assignee != tt.wantAssignee {
+				t.Errorf("got (%v, %q), want (%v, %q)", ids, assignee, tt.wantIDs, tt.wantAssignee)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var attachCmd = &cobra.Command{
+	Use:     "attach",
+	GroupID: "issues",
+	Short:   "Reference files and URLs from issues",
+	Long: `Associate artifacts such as logs, screenshots or design documents with an
+issue without storing them in the database. Only the reference is kept:
+for local files, the path (relative to the repository root when the file is
+inside it), size and SHA-256; for URLs, just the URL.
+
+Attachments are included in 'bd export' and restored by 'bd import'.
+'bd doctor' warns about attached paths that no longer exist.
+
+Examples:
+  bd attach add bd-42 logs/crash.txt
+  bd attach add bd-42 https://example.com/design.pdf --name design
+  bd attach ls bd-42
+  bd attach rm bd-42 crash.txt`,
+}
+
+var attachAddCmd = &cobra.Command{
+	Use:   "add <issue-id> <path-or-url>",
+	Short: "Attach a file or URL to an issue",
+	Long: `Attach a file or URL to an issue. The name defaults to the file's base
+name; attaching a name the issue already has replaces it, which refreshes
+the recorded size and hash after a file changes.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("attach add")
+		name, _ := cmd.Flags().GetString("name")
+		issueID := resolveIssueArg(args[0])
+
+		a, err := newAttachment(args[1])
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		a.IssueID = issueID
+		a.CreatedBy = getActorWithGit()
+		if name != "" {
+			a.Name = name
+		}
+		if err := store.AddAttachment(rootCtx, a); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		commandDidWrite.Store(true)
+
+		if jsonOutput {
+			outputJSON(a)
+			return
+		}
+		fmt.Printf("%s Attached %s to %s\n", ui.RenderPass("✓"), a.Name, issueID)
+	},
+}
+
+var attachLsCmd = &cobra.Command{
+	Use:     "ls <issue-id>",
+	Aliases: []string{"list"},
+	Short:   "List the attachments of an issue",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		issueID := resolveIssueArg(args[0])
+		attachments, err := store.GetAttachments(rootCtx, issueID)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if jsonOutput {
+			if attachments == nil {
+				attachments = []*types.Attachment{}
+			}
+			outputJSON(attachments)
+			return
+		}
+		if len(attachments) == 0 {
+			fmt.Printf("%s has no attachments\n", issueID)
+			return
+		}
+		for _, line := range formatAttachmentLines(attachments) {
+			fmt.Println(line)
+		}
+	},
+}
+
+var attachRmCmd = &cobra.Command{
+	Use:     "rm <issue-id> <name>",
+	Aliases: []string{"remove"},
+	Short:   "Remove an attachment from an issue",
+	Long:    `Remove an attachment reference. The file itself is not touched.`,
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("attach rm")
+		issueID, name := resolveIssueArg(args[0]), args[1]
+		removed, err := store.RemoveAttachment(rootCtx, issueID, name)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if !removed {
+			FatalErrorRespectJSON("%s has no attachment named %q", issueID, name)
+		}
+		commandDidWrite.Store(true)
+		if jsonOutput {
+			outputJSON(map[string]interface{}{"issue_id": issueID, "removed": name})
+			return
+		}
+		fmt.Printf("%s Removed %s from %s\n", ui.RenderPass("✓"), name, issueID)
+	},
+}
+
+// formatAttachmentLines renders attachments as aligned name/location lines,
+// with size and a short hash for files.
+func formatAttachmentLines(attachments []*types.Attachment) []string {
+	width := 0
+	for _, a := range attachments {
+		width = max(width, len(a.Name))
+	}
+	lines := make([]string, 0, len(attachments))
+	for _, a := range attachments {
+		line := fmt.Sprintf("%-*s  %s", width, a.Name, a.Location)
+		if a.Size != nil {
+			line += "  " + ui.RenderMuted(fmt.Sprintf("%s sha256:%s", formatBytes(*a.Size), shortHash(a.SHA256)))
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// newAttachment builds the reference for a path or URL. Files are hashed
+// and stored relative to the repository root when they are inside it, so
+// the reference stays valid in other clones.
+func newAttachment(target string) (*types.Attachment, error) {
+	if u, err := url.Parse(target); err == nil && u.Scheme != "" && u.Host != "" {
+		name := path.Base(u.Path)
+		if name == "." || name == "/" {
+			name = u.Host
+		}
+		return &types.Attachment{Name: name, Location: target}, nil
+	}
+
+	abs, err := filepath.Abs(target)
+	if err != nil {
+		return nil, err
+	}
+	// The repository root has symlinks resolved (e.g. /tmp on macOS)
+	if resolved, err := filepath.EvalSymlinks(abs); err == nil {
+		abs = resolved
+	}
+	f, err := os.Open(abs) //nolint:gosec // G304: the user names the file to attach
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("%s is not a regular file", target)
+	}
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash %s: %w", target, err)
+	}
+
+	location := abs
+	if rc, err := beads.GetRepoContext(); err == nil {
+		location = attachmentLocation(rc.RepoRoot, abs)
+	}
+	return &types.Attachment{
+		Name:     filepath.Base(target),
+		Location: location,
+		Size:     &size,
+		SHA256:   hex.EncodeToString(h.Sum(nil)),
+	}, nil
+}
+
+// attachmentLocation returns abs relative to repoRoot, with forward slashes,
+// or abs unchanged when it is outside the repository.
+func attachmentLocation(repoRoot, abs string) string {
+	rel, err := filepath.Rel(repoRoot, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return abs
+	}
+	return filepath.ToSlash(rel)
+}
+
+func init() {
+	attachAddCmd.Flags().String("name", "", "Attachment name (default: the file's base name)")
+	attachCmd.AddCommand(attachAddCmd)
+	attachCmd.AddCommand(attachLsCmd)
+	attachCmd.AddCommand(attachRmCmd)
+	rootCmd.AddCommand(attachCmd)
+}
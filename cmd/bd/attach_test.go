@@ -0,0 +1,51 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAttachmentLocation(t *testing.T) {
+	root := filepath.Join(string(filepath.Separator), "repo")
+	tests := []struct{ abs, want string }{
+		{filepath.Join(root, "logs", "crash.txt"), "logs/crash.txt"},
+		{filepath.Join(root, "..", "elsewhere", "f.txt"), filepath.Join(string(filepath.Separator), "elsewhere", "f.txt")},
+		{filepath.Join(string(filepath.Separator), "repository", "f.txt"), filepath.Join(string(filepath.Separator), "repository", "f.txt")},
+	}
+	for _, tt := range tests {
+		if got := attachmentLocation(root, filepath.Clean(tt.abs)); got != tt.want {
+			t.Errorf("attachmentLocation(%q) = %q, want %q", tt.abs, got, tt.want)
+		}
+	}
+}
+
+func TestNewAttachment(t *testing.T) {
+	a, err := newAttachment("https://example.com/docs/design.pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Name != "design.pdf" || a.Location != "https://example.com/docs/design.pdf" || a.Size != nil {
+		t.Errorf("URL attachment = %+v", a)
+	}
+
+	path := filepath.Join(t.TempDir(), "log.txt")
+	if err := os.WriteFile(path, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	a, err = newAttachment(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const helloSHA = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	if a.Name != "log.txt" || a.Size == nil || *a.Size != 5 || a.SHA256 != helloSHA {
+		t.Errorf("file attachment = %+v", a)
+	}
+
+	if _, err := newAttachment(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected error for a missing file")
+	}
+	if _, err := newAttachment(t.TempDir()); err == nil {
+		t.Error("expected error for a directory")
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/debug"
 )
 
 var backupForce bool
@@ -59,7 +60,7 @@ exceed 20 minutes, cache grows unboundedly, and force-push is needed after recov
 			if err := gitBackup(rootCtx); err != nil {
 				return err
 			}
-			fmt.Println("Backup committed and pushed to git.")
+			debug.Noticef("Backup committed and pushed to git.\n")
 		}
 
 		return nil
@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"os"
 	"os/exec"
 	"strings"
@@ -96,7 +95,7 @@ func maybeAutoBackup(ctx context.Context) {
 	// Run the export (force=true since we already checked change detection above)
 	newState, err := runBackupExport(ctx, true)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: auto-backup failed: %v\n", err)
+		debug.Warnf("auto-backup failed: %v\n", err)
 		return
 	}
 
@@ -108,7 +107,7 @@ func maybeAutoBackup(ctx context.Context) {
 		if branch, err := currentGitBranch(); err == nil && !isDefaultBranch(branch) {
 			debug.Logf("backup: skipping git commit — on branch %q (not default)\n", branch)
 		} else if err := gitBackup(ctx); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: backup git push failed: %v\n", err)
+			debug.Warnf("backup git push failed: %v\n", err)
 		}
 	}
 }
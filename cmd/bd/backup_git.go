@@ -102,7 +102,7 @@ func gitBackup(ctx context.Context) error {
 	defer cancel()
 	if err := gitExecInDir(pushCtx, gitDir, "push"); err != nil {
 		debug.Logf("backup: git push failed (non-fatal): %v\n", err)
-		fmt.Fprintf(os.Stderr, "Warning: backup git push failed: %v\n", err)
+		debug.Warnf("backup git push failed: %v\n", err)
 		return nil // non-fatal
 	}
 
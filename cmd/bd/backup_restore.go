@@ -241,7 +241,7 @@ func restoreConfig(ctx context.Context, s *dolt.DoltStore, path string, dryRun b
 // Go struct types.
 //
 // The JSONL may contain denormalized data from `bd export` (labels, dependencies,
-// comment counts). These are extracted and inserted into their proper tables.
+// metadata, attachments, comment counts). These are extracted and inserted into their proper tables.
 func restoreIssues(ctx context.Context, s *dolt.DoltStore, path string, dryRun bool) (int, error) {
 	lines, err := readJSONLFile(path)
 	if err != nil {
@@ -301,6 +301,18 @@ func restoreIssues(ctx context.Context, s *dolt.DoltStore, path string, dryRun b
 			delete(row, "dependencies")
 		}
 
+		var meta map[string]interface{}
+		if v, ok := row["meta"]; ok {
+			meta, _ = v.(map[string]interface{})
+			delete(row, "meta")
+		}
+
+		var attachments []interface{}
+		if v, ok := row["attachments"]; ok {
+			attachments, _ = v.([]interface{})
+			delete(row, "attachments")
+		}
+
 		// Remove computed count fields that don't exist in the issues table.
 		delete(row, "dependency_count")
 		delete(row, "dependent_count")
@@ -324,6 +336,38 @@ func restoreIssues(ctx context.Context, s *dolt.DoltStore, path string, dryRun b
 			}
 		}
 
+		// Insert extracted metadata into the issue_meta table
+		for key, v := range meta {
+			if value, ok := v.(string); ok && dolt.ValidateMetaKey(key) == nil {
+				_, _ = db.ExecContext(ctx,
+					"INSERT IGNORE INTO issue_meta (issue_id, `key`, value) VALUES (?, ?, ?)",
+					issueID, key, value)
+			}
+		}
+
+		// Insert extracted attachment references into the attachments table
+		for _, a := range attachments {
+			att, ok := a.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := att["name"].(string)
+			location, _ := att["url_or_path"].(string)
+			if name == "" || location == "" {
+				continue
+			}
+			var size interface{}
+			if v, ok := att["size"].(float64); ok {
+				size = int64(v)
+			}
+			sha, _ := att["sha256"].(string)
+			createdBy, _ := att["created_by"].(string)
+			createdAtStr, _ := att["created_at"].(string)
+			_, _ = db.ExecContext(ctx,
+				"INSERT IGNORE INTO attachments (issue_id, name, url_or_path, size, sha256, created_by, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
+				issueID, name, location, size, sha, createdBy, parseTimeOrNow(createdAtStr))
+		}
+
 		// Insert extracted dependencies into the dependencies table
 		for _, d := range deps {
 			dep, ok := d.(map[string]interface{})
@@ -696,6 +696,40 @@ func TestCLI_Import(t *testing.T) {
 	}
 }
 
+func TestCLI_ImportJSONReportsDuplicates(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping slow CLI test in short mode")
+	}
+	tmpDir := setupCLITestDB(t)
+	importFile := filepath.Join(tmpDir, "dups.jsonl")
+	content := `{"id":"test-dup","title":"First","status":"open","priority":2,"issue_type":"task"}
+{"id":"test-dup","title":"Second","status":"open","priority":2,"issue_type":"task"}
+`
+	if err := os.WriteFile(importFile, []byte(content), 0o600); err != nil {
+		t.Fatalf("write JSONL: %v", err)
+	}
+
+	// --quiet drops the stderr warning but not the JSON record
+	out, stderr, err := runBDInProcessAllowError(t, tmpDir, "import", importFile, "--json", "--quiet")
+	if err != nil {
+		t.Fatalf("bd import failed: %v\nStderr: %s", err, stderr)
+	}
+	if strings.Contains(stderr, "appear more than once") {
+		t.Errorf("--quiet still printed the duplicate warning: %s", stderr)
+	}
+	var result importResult
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("parse JSON %q: %v", out, err)
+	}
+	if result.Imported != 1 || len(result.Duplicates) != 1 {
+		t.Fatalf("result = %+v, want 1 issue and 1 duplicate", result)
+	}
+	dup := result.Duplicates[0]
+	if dup.ID != "test-dup" || strings.Join(dup.Lines, " ") != "dups.jsonl:1 dups.jsonl:2" || dup.Winner != "dups.jsonl:2" {
+		t.Errorf("duplicate = %+v, want test-dup on lines 1 and 2 won by line 2", dup)
+	}
+}
+
 var testBD string
 
 func init() {
@@ -23,7 +23,15 @@ var closeCmd = &cobra.Command{
 	Long: `Close one or more issues.
 
 If no issue ID is provided, closes the last touched issue (from most recent
-create, update, show, or close operation).`,
+create, update, show, or close operation).
+
+For bulk closes that deserve review, --plan writes a plan and prints a token
+instead of closing anything; run 'bd apply <token>' to execute it atomically.
+
+--cascade also closes every child that is still open, children first, and
+lists them by status before closing. If any child is in_progress, blocked or
+in another state that is neither open nor closed, nothing is closed unless
+--yes confirms that ending that work is intended.`,
 	Args: cobra.MinimumNArgs(0),
 	Run: func(cmd *cobra.Command, args []string) {
 		CheckReadonly("close")
@@ -64,6 +72,8 @@ create, update, show, or close operation).`,
 		continueFlag, _ := cmd.Flags().GetBool("continue")
 		noAuto, _ := cmd.Flags().GetBool("no-auto")
 		suggestNext, _ := cmd.Flags().GetBool("suggest-next")
+		cascade, _ := cmd.Flags().GetBool("cascade")
+		yes, _ := cmd.Flags().GetBool("yes")
 
 		// Get session ID from flag or environment variable
 		session, _ := cmd.Flags().GetString("session")
@@ -99,12 +109,77 @@ create, update, show, or close operation).`,
 			}
 		}
 
+		// With --cascade, open descendants are closed first
+		closeIDs := resolvedIDs
+		if cascade {
+			if len(routedArgs) > 0 {
+				FatalErrorRespectJSON("--cascade does not support cross-rig IDs: %s", strings.Join(routedArgs, ", "))
+			}
+			if planMode, _ := cmd.Flags().GetBool("plan"); planMode {
+				FatalErrorRespectJSON("--cascade cannot be combined with --plan")
+			}
+			children, err := cascadeCloseChildren(ctx, store, resolvedIDs)
+			if err != nil {
+				FatalErrorRespectJSON("%v", err)
+			}
+			if len(children) > 0 {
+				if !jsonOutput {
+					renderCascadeReport(os.Stdout, resolvedIDs, children)
+				}
+				if atRisk := atRiskCascadeChildren(children); len(atRisk) > 0 && !yes && !dryRun {
+					FatalErrorRespectJSON("not closing: %d child issue(s) are neither open nor closed: %s (rerun with --yes to close them anyway)",
+						len(atRisk), describeIssueStatuses(atRisk))
+				}
+				closeIDs = make([]string, 0, len(children)+len(resolvedIDs))
+				for _, child := range children {
+					closeIDs = append(closeIDs, child.ID)
+				}
+				closeIDs = append(closeIDs, resolvedIDs...)
+			}
+		}
+
+		// Two-phase apply: record the intended closes instead of executing them
+		if planMode, _ := cmd.Flags().GetBool("plan"); planMode {
+			if len(routedArgs) > 0 {
+				FatalErrorRespectJSON("--plan does not support cross-rig IDs: %s", strings.Join(routedArgs, ", "))
+			}
+			var planned []*types.Issue
+			for _, id := range resolvedIDs {
+				issue, _ := store.GetIssue(ctx, id)
+				if issue == nil {
+					FatalErrorRespectJSON("issue %s not found", id)
+				}
+				if err := validateIssueClosable(id, issue, force); err != nil {
+					FatalErrorRespectJSON("%v", err)
+				}
+				planned = append(planned, issue)
+			}
+			p, err := newOpPlan(planOpClose, planned)
+			if err != nil {
+				FatalErrorRespectJSON("%v", err)
+			}
+			p.Reason = reason
+			p.Force = force
+			emitPlan(p, fmt.Sprintf("close %d issue(s) with reason %q", len(planned), reason))
+			return
+		}
+
+		if dryRun {
+			if len(routedArgs) > 0 {
+				FatalErrorRespectJSON("--dry-run does not support cross-rig IDs: %s", strings.Join(routedArgs, ", "))
+			}
+			printDryRun(dryRunClose(ctx, closeIDs, reason, force))
+			return
+		}
+
 		// Direct mode
 		closedIssues := []*types.Issue{}
 		closedCount := 0
+		lib := libStore(store)
+		lib.SetSession(session)
 
 		// Handle local IDs
-		for _, id := range resolvedIDs {
+		for _, id := range closeIDs {
 			// Get issue for checks (nil issue is handled by validateIssueClosable)
 			issue, _ := store.GetIssue(ctx, id)
 
@@ -143,7 +218,8 @@ create, update, show, or close operation).`,
 				}
 			}
 
-			if err := store.CloseIssue(ctx, id, reason, actor, session); err != nil {
+			closedIssue, err := lib.CloseIssue(ctx, id, reason)
+			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error closing %s: %v\n", id, err)
 				continue
 			}
@@ -153,16 +229,18 @@ create, update, show, or close operation).`,
 			// Auto-close parent molecule if all steps are now complete
 			autoCloseCompletedMolecule(ctx, store, id, actor, session)
 
-			// Run close hook (best effort: hook runs only if re-fetch succeeds)
-			closedIssue, _ := store.GetIssue(ctx, id)
-			if closedIssue != nil && hookRunner != nil {
+			// Run close hook
+			if hookRunner != nil {
 				hookRunner.Run(hooks.EventClose, closedIssue)
+				var oldStatus types.Status
+				if issue != nil {
+					oldStatus = issue.Status
+				}
+				hookRunner.FireWebhook(hooks.EventClose, closedIssue, oldStatus, actor)
 			}
 
 			if jsonOutput {
-				if closedIssue != nil {
-					closedIssues = append(closedIssues, closedIssue)
-				}
+				closedIssues = append(closedIssues, closedIssue)
 			} else {
 				fmt.Printf("%s Closed %s: %s\n", ui.RenderPass("✓"), formatFeedbackID(id, issueTitleOrEmpty(issue)), reason)
 			}
@@ -213,7 +291,10 @@ create, update, show, or close operation).`,
 				}
 			}
 
-			if err := result.Store.CloseIssue(ctx, result.ResolvedID, reason, actor, session); err != nil {
+			routedLib := libStore(result.Store)
+			routedLib.SetSession(session)
+			closedIssue, err := routedLib.CloseIssue(ctx, result.ResolvedID, reason)
+			if err != nil {
 				result.Close()
 				fmt.Fprintf(os.Stderr, "Error closing %s: %v\n", id, err)
 				continue
@@ -224,16 +305,14 @@ create, update, show, or close operation).`,
 			// Auto-close parent molecule if all steps are now complete
 			autoCloseCompletedMolecule(ctx, result.Store, result.ResolvedID, actor, session)
 
-			// Get updated issue for hook (best effort: hook runs only if re-fetch succeeds)
-			closedIssue, _ := result.Store.GetIssue(ctx, result.ResolvedID)
-			if closedIssue != nil && hookRunner != nil {
+			// Run close hook
+			if hookRunner != nil {
 				hookRunner.Run(hooks.EventClose, closedIssue)
+				hookRunner.FireWebhook(hooks.EventClose, closedIssue, result.Issue.Status, actor)
 			}
 
 			if jsonOutput {
-				if closedIssue != nil {
-					closedIssues = append(closedIssues, closedIssue)
-				}
+				closedIssues = append(closedIssues, closedIssue)
 			} else {
 				fmt.Printf("%s Closed %s: %s\n", ui.RenderPass("✓"), formatFeedbackID(result.ResolvedID, result.Issue.Title), reason)
 			}
@@ -283,7 +362,7 @@ create, update, show, or close operation).`,
 
 		// Exit non-zero if no issues were actually closed (close guard
 		// and other soft failures should surface as non-zero exit codes for scripting)
-		totalAttempted := len(resolvedIDs) + len(routedArgs)
+		totalAttempted := len(closeIDs) + len(routedArgs)
 		if totalAttempted > 0 && closedCount == 0 {
 			os.Exit(1)
 		}
@@ -291,17 +370,21 @@ create, update, show, or close operation).`,
 }
 
 func init() {
-	closeCmd.Flags().StringP("reason", "r", "", "Reason for closing")
+	closeCmd.Flags().StringP("reason", "r", "", "Reason for closing (stored as close_reason and used in the Dolt commit message)")
 	closeCmd.Flags().String("resolution", "", "Alias for --reason (Jira CLI convention)")
 	_ = closeCmd.Flags().MarkHidden("resolution") // Hidden alias for agent/CLI ergonomics
 	closeCmd.Flags().StringP("message", "m", "", "Alias for --reason (git commit convention)")
 	_ = closeCmd.Flags().MarkHidden("message") // Hidden alias for agent/CLI ergonomics
+	registerCommitMessageFlag(closeCmd, "Dolt commit message for this close (default: generated from the reason)")
 	closeCmd.Flags().String("comment", "", "Alias for --reason")
 	_ = closeCmd.Flags().MarkHidden("comment") // Hidden alias for agent/CLI ergonomics
 	closeCmd.Flags().BoolP("force", "f", false, "Force close pinned issues or unsatisfied gates")
 	closeCmd.Flags().Bool("continue", false, "Auto-advance to next step in molecule")
 	closeCmd.Flags().Bool("no-auto", false, "With --continue, show next step but don't claim it")
 	closeCmd.Flags().Bool("suggest-next", false, "Show newly unblocked issues after closing")
+	closeCmd.Flags().Bool("cascade", false, "Also close all open child issues (children first)")
+	closeCmd.Flags().BoolP("yes", "y", false, "With --cascade, close children that are in_progress, blocked or otherwise in flight")
+	closeCmd.Flags().Bool("plan", false, "Write a plan for review instead of closing (execute with bd apply <token>)")
 	closeCmd.Flags().String("session", "", "Claude Code session ID (or set CLAUDE_SESSION_ID env var)")
 	closeCmd.ValidArgsFunction = issueIDCompletion
 	rootCmd.AddCommand(closeCmd)
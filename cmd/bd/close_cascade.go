@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// cascadeCloseChildren returns the descendants of ids that are not closed
+// yet, deepest first so children close before their parents. Closed
+// children are skipped but still searched, since they may have open
+// children of their own.
+func cascadeCloseChildren(ctx context.Context, s *dolt.DoltStore, ids []string) ([]*types.Issue, error) {
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		seen[id] = true
+	}
+
+	// Breadth-first, so every child is found after its parent.
+	var found []*types.Issue
+	queue := append([]string(nil), ids...)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		children, err := childIssueIDs(ctx, s, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range children {
+			if seen[child] {
+				continue
+			}
+			seen[child] = true
+			queue = append(queue, child)
+			issue, err := s.GetIssue(ctx, child)
+			if err != nil {
+				return nil, fmt.Errorf("getting child %s: %w", child, err)
+			}
+			if issue.Status != types.StatusClosed {
+				found = append(found, issue)
+			}
+		}
+	}
+
+	for i, j := 0, len(found)-1; i < j; i, j = i+1, j-1 {
+		found[i], found[j] = found[j], found[i]
+	}
+	return found, nil
+}
+
+// atRiskCascadeChildren returns the children a cascade close should not end
+// without confirmation: anything neither open nor closed, such as work that
+// is in_progress, blocked or hooked.
+func atRiskCascadeChildren(children []*types.Issue) []*types.Issue {
+	var atRisk []*types.Issue
+	for _, issue := range children {
+		if issue.Status != types.StatusOpen && issue.Status != types.StatusClosed {
+			atRisk = append(atRisk, issue)
+		}
+	}
+	return atRisk
+}
+
+// renderCascadeReport lists the children a cascade close will close,
+// grouped by status with open first and at-risk statuses flagged.
+func renderCascadeReport(w io.Writer, parentIDs []string, children []*types.Issue) {
+	byStatus := make(map[types.Status][]string)
+	for _, issue := range children {
+		byStatus[issue.Status] = append(byStatus[issue.Status], issue.ID)
+	}
+	statuses := make([]types.Status, 0, len(byStatus))
+	for status := range byStatus {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if (statuses[i] == types.StatusOpen) != (statuses[j] == types.StatusOpen) {
+			return statuses[i] == types.StatusOpen
+		}
+		return statuses[i] < statuses[j]
+	})
+
+	_, _ = fmt.Fprintf(w, "Closing %s will also close %d child issue(s):\n", strings.Join(parentIDs, ", "), len(children))
+	for _, status := range statuses {
+		ids := byStatus[status]
+		sort.Strings(ids)
+		flag := ""
+		if status != types.StatusOpen {
+			flag = " (in flight)"
+		}
+		_, _ = fmt.Fprintf(w, "  %s (%d)%s: %s\n", status, len(ids), flag, strings.Join(ids, ", "))
+	}
+}
+
+// describeIssueStatuses formats issues as "id (status)" for error messages.
+func describeIssueStatuses(issues []*types.Issue) string {
+	parts := make([]string, len(issues))
+	for i, issue := range issues {
+		parts[i] = fmt.Sprintf("%s (%s)", issue.ID, issue.Status)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,91 @@
+//go:build cgo
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestCascadeCloseChildren(t *testing.T) {
+	tmpDir := t.TempDir()
+	s := newTestStore(t, filepath.Join(tmpDir, ".beads", "beads.db"))
+	ctx := context.Background()
+
+	for _, issue := range []*types.Issue{
+		{ID: "test-epic", Title: "Epic", IssueType: types.TypeEpic, Status: types.StatusOpen},
+		{ID: "test-epic.1", Title: "Done child", IssueType: types.TypeTask, Status: types.StatusClosed},
+		{ID: "test-epic.1.1", Title: "Open grandchild", IssueType: types.TypeTask, Status: types.StatusOpen},
+		{ID: "test-epic.2", Title: "Open child", IssueType: types.TypeTask, Status: types.StatusOpen},
+		{ID: "test-linked", Title: "Linked child", IssueType: types.TypeTask, Status: types.StatusOpen},
+	} {
+		issue.Priority = 2
+		if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+	dep := &types.Dependency{IssueID: "test-linked", DependsOnID: "test-epic", Type: types.DepParentChild}
+	if err := s.AddDependency(ctx, dep, "test"); err != nil {
+		t.Fatalf("AddDependency: %v", err)
+	}
+
+	children, err := cascadeCloseChildren(ctx, s, []string{"test-epic"})
+	if err != nil {
+		t.Fatalf("cascadeCloseChildren: %v", err)
+	}
+	pos := make(map[string]int, len(children))
+	for i, issue := range children {
+		pos[issue.ID] = i
+	}
+	if len(children) != 3 {
+		t.Fatalf("children = %v, want the three open descendants", pos)
+	}
+	if _, ok := pos["test-epic.1"]; ok {
+		t.Errorf("closed child test-epic.1 should not be closed again")
+	}
+	if _, ok := pos["test-epic.1.1"]; !ok {
+		t.Errorf("open grandchild under a closed child was missed")
+	}
+	if _, ok := pos["test-linked"]; !ok {
+		t.Errorf("parent-child dependent test-linked was missed")
+	}
+	if atRisk := atRiskCascadeChildren(children); len(atRisk) != 0 {
+		t.Errorf("open children reported at risk: %s", describeIssueStatuses(atRisk))
+	}
+}
+
+func TestAtRiskCascadeChildren(t *testing.T) {
+	for _, status := range []types.Status{
+		types.StatusInProgress,
+		types.StatusBlocked,
+		types.StatusDeferred,
+		types.StatusPinned,
+		types.StatusHooked,
+		types.Status("review"), // custom workflow status
+	} {
+		t.Run(string(status), func(t *testing.T) {
+			children := []*types.Issue{
+				{ID: "test-epic.1", Status: types.StatusOpen},
+				{ID: "test-epic.2", Status: status},
+			}
+			atRisk := atRiskCascadeChildren(children)
+			if len(atRisk) != 1 || atRisk[0].ID != "test-epic.2" {
+				t.Fatalf("atRisk = %s, want test-epic.2 (%s)", describeIssueStatuses(atRisk), status)
+			}
+
+			var out bytes.Buffer
+			renderCascadeReport(&out, []string{"test-epic"}, children)
+			report := out.String()
+			if !strings.Contains(report, "2 child issue(s)") ||
+				!strings.Contains(report, "  open (1): test-epic.1\n") ||
+				!strings.Contains(report, "  "+string(status)+" (1) (in flight): test-epic.2\n") {
+				t.Errorf("report = %q", report)
+			}
+		})
+	}
+}
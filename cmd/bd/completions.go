@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/beads"
@@ -20,30 +22,12 @@ func issueIDCompletion(cmd *cobra.Command, args []string, toComplete string) ([]
 		ctx = rootCtx
 	}
 
-	// Get database path - use same logic as in PersistentPreRun
-	currentDBPath := dbPath
-	if currentDBPath == "" {
-		// Try to find database path
-		foundDB := beads.FindDatabasePath()
-		if foundDB != "" {
-			currentDBPath = foundDB
-		} else {
-			// Default path
-			currentDBPath = filepath.Join(".beads", beads.CanonicalDatabaseName)
-		}
-	}
-
-	// Open database if store is not initialized
-	currentStore := store
-	if currentStore == nil {
-		var err error
-		currentStore, err = dolt.New(ctx, &dolt.Config{Path: currentDBPath, ReadOnly: true})
-		if err != nil {
-			// If we can't open database, return empty completion
-			return nil, cobra.ShellCompDirectiveNoFileComp
-		}
-		defer func() { _ = currentStore.Close() }()
+	currentStore, cleanup, err := openCompletionStore(ctx)
+	if err != nil {
+		// If we can't open database, return empty completion
+		return nil, cobra.ShellCompDirectiveNoFileComp
 	}
+	defer cleanup()
 
 	// Use SearchIssues with IDPrefix filter to efficiently query matching issues
 	filter := types.IssueFilter{
@@ -64,3 +48,64 @@ func issueIDCompletion(cmd *cobra.Command, args []string, toComplete string) ([]
 
 	return completions, cobra.ShellCompDirectiveNoFileComp
 }
+
+// assigneeCompletion completes user names from the assignees already present
+// in the database.
+func assigneeCompletion(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	ctx := context.Background()
+	if rootCtx != nil {
+		ctx = rootCtx
+	}
+
+	currentStore, cleanup, err := openCompletionStore(ctx)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	defer cleanup()
+
+	issues, err := currentStore.SearchIssues(ctx, "", types.IssueFilter{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	seen := make(map[string]bool)
+	var completions []string
+	for _, issue := range issues {
+		if issue.Assignee == "" || seen[issue.Assignee] || !strings.HasPrefix(issue.Assignee, toComplete) {
+			continue
+		}
+		seen[issue.Assignee] = true
+		completions = append(completions, issue.Assignee)
+	}
+	sort.Strings(completions)
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// openCompletionStore returns the active store, or opens the database
+// read-only when completion runs before PersistentPreRun has set one up.
+// The returned cleanup func must always be called.
+func openCompletionStore(ctx context.Context) (*dolt.DoltStore, func(), error) {
+	if store != nil {
+		return store, func() {}, nil
+	}
+
+	// Get database path - use same logic as in PersistentPreRun
+	currentDBPath := dbPath
+	if currentDBPath == "" {
+		// Try to find database path
+		foundDB := beads.FindDatabasePath()
+		if foundDB != "" {
+			currentDBPath = foundDB
+		} else {
+			// Default path
+			currentDBPath = filepath.Join(".beads", beads.CanonicalDatabaseName)
+		}
+	}
+
+	s, err := dolt.New(ctx, &dolt.Config{Path: currentDBPath, ReadOnly: true})
+	if err != nil {
+		return nil, func() {}, err
+	}
+	return s, func() { _ = s.Close() }, nil
+}
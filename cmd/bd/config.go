@@ -26,6 +26,12 @@ var configCmd = &cobra.Command{
 
 Configuration is stored per-project in the beads database and is version-control-friendly.
 
+Startup settings (actor, git.author, routing.*, ...) live in config.yaml files
+instead and resolve as: flags > BD_* env vars > config.local.yaml >
+$BEADS_DIR/config.yaml > .beads/config.yaml > ~/.config/bd/config.yaml >
+~/.beads/config.yaml > defaults. Use 'bd config get <key> --show-source' to
+see which layer a value came from.
+
 Common namespaces:
   - jira.*            Jira integration settings
   - linear.*          Linear integration settings
@@ -58,6 +64,7 @@ Examples:
   bd config set status.custom "awaiting_review,awaiting_testing"
   bd config set doctor.suppress.pending-migrations true
   bd config get jira.url
+  bd config get git.author --show-source
   bd config list
   bd config unset jira.url`,
 }
@@ -151,17 +158,31 @@ var configGetCmd = &cobra.Command{
 		// These are read from config.yaml via viper, not SQLite. (GH#536)
 		if config.IsYamlOnlyKey(key) {
 			value := config.GetYamlConfig(key)
+			resolved := resolveConfigValue(cmd, key)
+			if resolved.Source == config.SourceFlag {
+				value = fmt.Sprint(resolved.Value)
+			}
 
 			if jsonOutput {
-				outputJSON(map[string]interface{}{
+				result := map[string]interface{}{
 					"key":      key,
 					"value":    value,
 					"location": "config.yaml",
-				})
+					"source":   resolved.Source,
+				}
+				if resolved.File != "" {
+					result["scope"] = resolved.Scope
+					result["file"] = resolved.File
+				}
+				outputJSON(result)
 			} else {
-				if value == "" {
+				showSource, _ := cmd.Flags().GetBool("show-source")
+				switch {
+				case value == "" && resolved.Source == config.SourceDefault:
 					fmt.Printf("%s (not set in config.yaml)\n", key)
-				} else {
+				case showSource:
+					fmt.Printf("%s\t%s\n", value, describeConfigSource(resolved))
+				default:
 					fmt.Printf("%s\n", value)
 				}
 			}
@@ -287,24 +308,31 @@ func showConfigYAMLOverrides(dbConfig map[string]string) {
 	yamlKeys := []string{
 		"no-db", "json", "actor", "identity",
 		"routing.mode", "routing.default", "routing.maintainer", "routing.contributor",
-		"sync.mode", "sync.git-remote", "no-push", "no-git-ops",
+		"sync.mode", "sync.git-remote", "no-push", "no-git-ops", "default.remote", "push.remote", "push.branch",
 		"git.author", "git.no-gpg-sign",
-		"create.require-description",
+		"create.require-description", "create.parent-title-template",
+		"create.default-priority", "create.default-status", "create.default-labels",
+		"archive.resolve-references", "close.auto-archive-after",
 		"validation.on-create", "validation.on-sync",
-		"hierarchy.max-depth",
-		"dolt.idle-timeout",
+		"hierarchy.max-depth", "hierarchy.separator",
+		"dolt.idle-timeout", "dolt.squash-on-push",
 	}
 
 	var yamlOverrides []string
 	for _, key := range yamlKeys {
 		val := config.GetYamlConfig(key)
-		if val != "" && config.GetValueSource(key) == config.SourceConfigFile {
-			yamlOverrides = append(yamlOverrides, fmt.Sprintf("  %s = %s", key, val))
+		if val == "" {
+			// Lists don't convert to a string
+			val = strings.Join(config.GetStringSlice(key), ",")
+		}
+		resolved := config.Resolve(key)
+		if val != "" && resolved.Source != config.SourceDefault {
+			yamlOverrides = append(yamlOverrides, fmt.Sprintf("  %s = %s  (%s)", key, val, describeConfigSource(resolved)))
 		}
 	}
 
 	if len(yamlOverrides) > 0 {
-		fmt.Println("\nAlso set in config.yaml (not shown above):")
+		fmt.Println("\nAlso set in config files or the environment (not shown above):")
 		for _, line := range yamlOverrides {
 			fmt.Println(line)
 		}
@@ -350,10 +378,13 @@ var configUnsetCmd = &cobra.Command{
 
 var configValidateCmd = &cobra.Command{
 	Use:   "validate",
-	Short: "Validate sync-related configuration",
-	Long: `Validate sync-related configuration settings.
+	Short: "Validate config files and sync-related configuration",
+	Long: `Validate config files and sync-related configuration settings.
 
 Checks:
+  - Every key in the loaded config.yaml files is known, and its value has
+    the expected type (bool, int, duration, list, map or string). Unknown
+    keys are skipped with --ignore-unknown-config.
   - sync.mode is a valid value (dolt-native)
   - federation.sovereignty is valid (T1, T2, T3, T4, or empty)
   - federation.remote is set when sync.mode requires it
@@ -385,6 +416,9 @@ Examples:
 
 		// Combine results
 		allIssues := []string{}
+		for _, issue := range config.ValidateFiles(ignoreUnknownConfigKeys()) {
+			allIssues = append(allIssues, issue.String())
+		}
 		if doctorCheck.Detail != "" {
 			allIssues = append(allIssues, strings.Split(doctorCheck.Detail, "\n")...)
 		}
@@ -480,6 +514,51 @@ func isValidRemoteURL(url string) bool {
 	return gitSSHRemotePattern.MatchString(url)
 }
 
+// configFlags maps config keys to the root flags that override them.
+var configFlags = map[string]string{
+	"json":             "json",
+	"readonly":         "readonly",
+	"db":               "db",
+	"actor":            "actor",
+	"dolt.auto-commit": "dolt-auto-commit",
+}
+
+// resolveConfigValue resolves key like config.Resolve, but also reports an
+// explicitly set root flag as the winning source.
+func resolveConfigValue(cmd *cobra.Command, key string) config.ResolvedValue {
+	resolved := config.Resolve(key)
+	if name, ok := configFlags[resolved.Key]; ok {
+		if f := cmd.Root().PersistentFlags().Lookup(name); f != nil && f.Changed {
+			resolved.Value = f.Value.String()
+			resolved.Source = config.SourceFlag
+			resolved.Scope, resolved.File = "", ""
+		}
+	}
+	return resolved
+}
+
+// describeConfigSource renders where a resolved value came from, e.g.
+// "repo: /path/.beads/config.yaml" or "env BD_ACTOR".
+func describeConfigSource(r config.ResolvedValue) string {
+	switch r.Source {
+	case config.SourceFlag:
+		return "flag --" + configFlags[r.Key]
+	case config.SourceEnvVar:
+		envKey := "BD_" + strings.ToUpper(strings.ReplaceAll(strings.ReplaceAll(r.Key, "-", "_"), ".", "_"))
+		if _, ok := os.LookupEnv(envKey); !ok {
+			envKey = "BEADS_" + strings.TrimPrefix(envKey, "BD_")
+		}
+		return "env " + envKey
+	case config.SourceConfigFile:
+		if r.File != "" {
+			return r.Scope + ": " + r.File
+		}
+		return "config file"
+	default:
+		return "default"
+	}
+}
+
 // findBeadsRepoRoot walks up from the given path to find the repo root (containing .beads)
 func findBeadsRepoRoot(startPath string) string {
 	path := startPath
@@ -502,5 +581,6 @@ func init() {
 	configCmd.AddCommand(configListCmd)
 	configCmd.AddCommand(configUnsetCmd)
 	configCmd.AddCommand(configValidateCmd)
+	configGetCmd.Flags().Bool("show-source", false, "Also print where the value came from (flag, env, config file, default)")
 	rootCmd.AddCommand(configCmd)
 }
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+)
+
+// configRepairCommands still run when config files are invalid, so the
+// user can inspect and fix them.
+var configRepairCommands = []string{"config", "doctor", "help", "version", "completion", "__complete", "__completeNoDesc"}
+
+// checkConfigFiles validates the loaded config files and exits with every
+// offending key, its file and the expected type. --ignore-unknown-config
+// (or ignore-unknown-config: true) skips unknown keys.
+func checkConfigFiles(cmd *cobra.Command) {
+	for c := cmd; c != nil; c = c.Parent() {
+		for _, name := range configRepairCommands {
+			if c.Name() == name {
+				return
+			}
+		}
+	}
+	issues := config.ValidateFiles(ignoreUnknownConfigKeys())
+	if len(issues) == 0 {
+		return
+	}
+	FatalErrorWithHint(formatConfigIssues(issues),
+		"fix the keys above (see 'bd config validate'), or pass --ignore-unknown-config to skip unknown keys")
+}
+
+func ignoreUnknownConfigKeys() bool {
+	return ignoreUnknownConfig || config.GetBool("ignore-unknown-config")
+}
+
+func formatConfigIssues(issues []config.ConfigIssue) string {
+	lines := make([]string, 0, len(issues)+1)
+	lines = append(lines, fmt.Sprintf("invalid configuration (%d issue(s)):", len(issues)))
+	for _, issue := range issues {
+		lines = append(lines, "  "+issue.String())
+	}
+	return strings.Join(lines, "\n")
+}
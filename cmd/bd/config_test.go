@@ -455,3 +455,21 @@ func TestFindBeadsRepoRoot(t *testing.T) {
 		}
 	})
 }
+
+func TestDescribeConfigSource(t *testing.T) {
+	t.Setenv("BD_GIT_AUTHOR", "env-bot")
+	tests := []struct {
+		resolved config.ResolvedValue
+		want     string
+	}{
+		{config.ResolvedValue{Key: "dolt.auto-commit", Source: config.SourceFlag}, "flag --dolt-auto-commit"},
+		{config.ResolvedValue{Key: "git.author", Source: config.SourceEnvVar}, "env BD_GIT_AUTHOR"},
+		{config.ResolvedValue{Key: "git.author", Source: config.SourceConfigFile, Scope: config.ScopeRepo, File: "/p/.beads/config.yaml"}, "repo: /p/.beads/config.yaml"},
+		{config.ResolvedValue{Key: "git.author", Source: config.SourceDefault}, "default"},
+	}
+	for _, tt := range tests {
+		if got := describeConfigSource(tt.resolved); got != tt.want {
+			t.Errorf("describeConfigSource(%+v) = %q, want %q", tt.resolved, got, tt.want)
+		}
+	}
+}
@@ -20,6 +20,9 @@ var countCmd = &cobra.Command{
 By default, returns the total count of issues matching the filters.
 Use --by-* flags to group counts by different attributes.
 
+Without --by-* the count is a single COUNT(*) query and the output is just
+the number, so it is cheap enough for shell prompts (PS1) and status bars.
+
 Examples:
   bd count                          # Count all issues
   bd count --status open            # Count open issues
@@ -113,7 +116,7 @@ Examples:
 			filter.Priority = &priority
 		}
 		if assignee != "" {
-			filter.Assignee = &assignee
+			setAssigneeFilter(&filter, assignee)
 		}
 		if issueType != "" {
 			t := types.IssueType(issueType)
@@ -197,24 +200,28 @@ Examples:
 			filter.PriorityMax = &priorityMax
 		}
 
-		issues, err := store.SearchIssues(ctx, "", filter)
-		if err != nil {
-			FatalError("%v", err)
-		}
-
-		// If no grouping, just print count
+		// If no grouping, count in SQL and just print the number
 		if groupBy == "" {
+			n, err := store.CountIssues(ctx, "", filter)
+			if err != nil {
+				FatalError("%v", err)
+			}
 			if jsonOutput {
 				result := struct {
 					Count int `json:"count"`
-				}{Count: len(issues)}
+				}{Count: n}
 				outputJSON(result)
 			} else {
-				fmt.Println(len(issues))
+				fmt.Println(n)
 			}
 			return
 		}
 
+		issues, err := store.SearchIssues(ctx, "", filter)
+		if err != nil {
+			FatalError("%v", err)
+		}
+
 		// Group by the specified field
 		counts := make(map[string]int)
 
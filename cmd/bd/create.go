@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads"
 	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/debug"
 	"github.com/steveyegge/beads/internal/hooks"
@@ -43,6 +44,9 @@ var createCmd = &cobra.Command{
 			if dryRun {
 				FatalError("--dry-run is not supported with --file flag")
 			}
+			if key, _ := cmd.Flags().GetString("idempotency-key"); key != "" {
+				FatalError("--idempotency-key is not supported with --file flag")
+			}
 			createIssuesFromMarkdown(cmd, file)
 			return
 		}
@@ -71,6 +75,12 @@ var createCmd = &cobra.Command{
 		} else {
 			FatalError("title required (or use --file to create from markdown)")
 		}
+		if strings.TrimSpace(title) == "" {
+			FatalError("title cannot be empty or only whitespace")
+		}
+		if err := types.ValidateTitleLength(title); err != nil {
+			FatalError("%v", err)
+		}
 
 		// Get silent flag
 		silent, _ := cmd.Flags().GetBool("silent")
@@ -110,6 +120,17 @@ var createCmd = &cobra.Command{
 		if err != nil {
 			FatalError("%v", err)
 		}
+		if !cmd.Flags().Changed("priority") {
+			if p, ok, err := config.CreateDefaultPriority(); err != nil {
+				FatalError("%v", err)
+			} else if ok {
+				priority = p
+			}
+		}
+		status, err := createStatus(cmd)
+		if err != nil {
+			FatalError("%v", err)
+		}
 
 		issueType, _ := cmd.Flags().GetString("type")
 		assignee, _ := cmd.Flags().GetString("assignee")
@@ -119,6 +140,9 @@ var createCmd = &cobra.Command{
 		if len(labelAlias) > 0 {
 			labels = append(labels, labelAlias...)
 		}
+		if !cmd.Flags().Changed("labels") && !cmd.Flags().Changed("label") {
+			labels = config.CreateDefaultLabels()
+		}
 
 		explicitID, _ := cmd.Flags().GetString("id")
 		parentID, _ := cmd.Flags().GetString("parent")
@@ -235,7 +259,7 @@ var createCmd = &cobra.Command{
 				AcceptanceCriteria: acceptance,
 				Notes:              notes,
 				SpecID:             specID,
-				Status:             types.StatusOpen,
+				Status:             status,
 				Priority:           priority,
 				IssueType:          types.IssueType(issueType).Normalize(),
 				Assignee:           assignee,
@@ -315,7 +339,7 @@ var createCmd = &cobra.Command{
 								// Found a matching route - auto-route to that rig
 								rigName := routing.ExtractProjectFromPath(route.Path)
 								if rigName != "" {
-									createInRig(cmd, rigName, explicitID, title, description, issueType, priority, design, acceptance, notes, assignee, labels, externalRef, specID, wisp)
+									createInRig(cmd, rigName, explicitID, title, description, issueType, priority, status, design, acceptance, notes, assignee, labels, externalRef, specID, wisp)
 									return
 								}
 							}
@@ -335,7 +359,7 @@ var createCmd = &cobra.Command{
 			targetRig = prefixOverride
 		}
 		if targetRig != "" {
-			createInRig(cmd, targetRig, explicitID, title, description, issueType, priority, design, acceptance, notes, assignee, labels, externalRef, specID, wisp)
+			createInRig(cmd, targetRig, explicitID, title, description, issueType, priority, status, design, acceptance, notes, assignee, labels, externalRef, specID, wisp)
 			return
 		}
 
@@ -448,11 +472,27 @@ var createCmd = &cobra.Command{
 			FatalError("cannot specify both --id and --parent flags")
 		}
 
-		// If parent is specified, generate child ID and optionally inherit labels
+		// Idempotent create: a repeat of a create that already ran under the
+		// same key reports the issue it made instead of making another. The
+		// key is recorded in the issue's own transaction, so a create that
+		// fails leaves no key behind and a racing duplicate fails the insert.
+		idempotencyKey, _ := cmd.Flags().GetString("idempotency-key")
+		if idempotencyKey != "" {
+			existingID, err := store.LookupIdempotencyKey(rootCtx, idempotencyKey)
+			if err != nil {
+				FatalError("%v", err)
+			}
+			if existingID != "" {
+				reportIdempotentCreate(existingID, idempotencyKey, silent)
+				return
+			}
+		}
+
+		// If parent is specified, validate it and optionally inherit labels.
+		// The child ID itself is allocated by CreateIssue (Issue.ChildOf).
 		var inheritedLabels []string
 		if parentID != "" {
 			ctx := rootCtx
-			// Validate parent exists before generating child ID
 			_, err := store.GetIssue(ctx, parentID)
 			if err != nil {
 				if errors.Is(err, storage.ErrNotFound) {
@@ -460,11 +500,6 @@ var createCmd = &cobra.Command{
 				}
 				FatalError("failed to check parent issue: %v", err)
 			}
-			childID, err := store.GetNextChildID(ctx, parentID)
-			if err != nil {
-				FatalError("%v", err)
-			}
-			explicitID = childID // Set as explicit ID for the rest of the flow
 
 			// Inherit parent labels unless --no-inherit-labels is set (GH#2100)
 			noInheritLabels, _ := cmd.Flags().GetBool("no-inherit-labels")
@@ -505,6 +540,22 @@ var createCmd = &cobra.Command{
 			}
 		}
 
+		// A dotted child ID (bd-abc.1) whose parent is missing would be an
+		// orphan. Refuse unless --create-parent asks for placeholder parents,
+		// which are then created in the same transaction as the child.
+		var missingParents []string
+		if explicitID != "" && parentID == "" {
+			var err error
+			missingParents, err = missingParentIDs(rootCtx, store, explicitID)
+			if err != nil {
+				FatalError("%v", err)
+			}
+			if createParent, _ := cmd.Flags().GetBool("create-parent"); len(missingParents) > 0 && !createParent {
+				FatalErrorWithHint(fmt.Sprintf("parent issue %s does not exist", missingParents[len(missingParents)-1]),
+					"create the parent first, or pass --create-parent to add a placeholder")
+			}
+		}
+
 		var externalRefPtr *string
 		if externalRef != "" {
 			externalRefPtr = &externalRef
@@ -513,13 +564,14 @@ var createCmd = &cobra.Command{
 		// Direct mode
 		issue := &types.Issue{
 			ID:                 explicitID, // Set explicit ID if provided (empty string if not)
+			ChildOf:            parentID,   // The child ID is allocated when the issue is created
 			Title:              title,
 			Description:        description,
 			Design:             design,
 			AcceptanceCriteria: acceptance,
 			Notes:              notes,
 			SpecID:             specID,
-			Status:             types.StatusOpen,
+			Status:             status,
 			Priority:           priority,
 			IssueType:          types.IssueType(issueType).Normalize(),
 			Assignee:           assignee,
@@ -577,8 +629,34 @@ var createCmd = &cobra.Command{
 			// If error getting parent or parent has no source_repo, continue with default
 		}
 
-		if err := store.CreateIssue(ctx, issue, actor); err != nil {
-			FatalError("%v", err)
+		createOpts := storage.BatchCreateOptions{
+			SkipPrefixValidation: true,
+			IdempotencyKey:       idempotencyKey,
+			IdempotencyTTL:       config.GetDuration("create.idempotency-ttl"),
+		}
+		if len(missingParents) > 0 {
+			batch := append(placeholderParents(missingParents, issue), issue)
+			opts := createOpts
+			opts.OrphanHandling = storage.OrphanStrict
+			if err := store.CreateIssuesWithFullOptions(ctx, batch, actor, opts); err != nil {
+				reportCreateError(err, idempotencyKey, silent)
+				return
+			}
+			for _, parent := range batch[:len(missingParents)] {
+				if !jsonOutput {
+					fmt.Printf("%s Created placeholder parent: %s\n", ui.RenderPass("✓"), formatFeedbackID(parent.ID, parent.Title))
+				}
+			}
+		} else {
+			// Labels are added below, after parent labels are merged in
+			issue.Labels = []string{}
+			if err := libStore(store).CreateWithOptions(ctx, issue, beads.CreateOptions{
+				IdempotencyKey: createOpts.IdempotencyKey,
+				IdempotencyTTL: createOpts.IdempotencyTTL,
+			}); err != nil {
+				reportCreateError(err, idempotencyKey, silent)
+				return
+			}
 		}
 
 		// Track whether any post-create writes occurred. CreateIssue commits
@@ -768,6 +846,7 @@ var createCmd = &cobra.Command{
 		// Run create hook
 		if hookRunner != nil {
 			hookRunner.Run(hooks.EventCreate, issue)
+			hookRunner.FireWebhook(hooks.EventCreate, issue, "", actor)
 		}
 
 		if jsonOutput {
@@ -794,15 +873,19 @@ func init() {
 	createCmd.Flags().Bool("silent", false, "Output only the issue ID (for scripting)")
 	createCmd.Flags().Bool("dry-run", false, "Preview what would be created without actually creating")
 	registerPriorityFlag(createCmd, "2")
+	createCmd.Flags().String("status", "", "Initial status (default: create.default-status config, else open)")
 	createCmd.Flags().StringP("type", "t", "task", "Issue type (bug|feature|task|epic|chore|decision); custom types require types.custom config; aliases: enhancement/feat→feature, dec/adr→decision")
 	registerCommonIssueFlags(createCmd)
+	registerCommitMessageFlag(createCmd, "Dolt commit message for this create (default: generated)")
 	createCmd.Flags().String("spec-id", "", "Link to specification document")
 	createCmd.Flags().StringSliceP("labels", "l", []string{}, "Labels (comma-separated)")
 	createCmd.Flags().StringSlice("label", []string{}, "Alias for --labels")
 	_ = createCmd.Flags().MarkHidden("label") // Only fails if flag missing (caught in tests)
 	createCmd.Flags().String("id", "", "Explicit issue ID (e.g., 'bd-42' for partitioning)")
+	createCmd.Flags().String("idempotency-key", "", "Client-supplied key; repeating a create with the same key returns the first issue instead of a duplicate (see create.idempotency-ttl)")
 	createCmd.Flags().String("parent", "", "Parent issue ID for hierarchical child (e.g., 'bd-a3f8e9')")
 	createCmd.Flags().Bool("no-inherit-labels", false, "Don't inherit labels from parent issue")
+	createCmd.Flags().Bool("create-parent", false, "With --id <parent>.N, create a placeholder parent if it is missing (title from create.parent-title-template)")
 	createCmd.Flags().StringSlice("deps", []string{}, "Dependencies in format 'type:id' or 'id' (e.g., 'discovered-from:bd-20,blocks:bd-15' or 'bd-20')")
 	createCmd.Flags().String("waits-for", "", "Spawner issue ID to wait for (creates waits-for dependency for fanout gate)")
 	createCmd.Flags().String("waits-for-gate", "all-children", "Gate type: all-children (wait for all) or any-children (wait for first)")
@@ -837,9 +920,62 @@ func init() {
 	rootCmd.AddCommand(createCmd)
 }
 
+// reportCreateError exits on a failed create. When a concurrent create with
+// the same --idempotency-key won the race, it reports that create's issue
+// instead, as a repeat would.
+func reportCreateError(err error, key string, silent bool) {
+	if key != "" && errors.Is(err, storage.ErrIdempotencyKeyUsed) {
+		if existingID, lookupErr := store.LookupIdempotencyKey(rootCtx, key); lookupErr == nil && existingID != "" {
+			reportIdempotentCreate(existingID, key, silent)
+			return
+		}
+	}
+	FatalError("%v", err)
+}
+
+// reportIdempotentCreate outputs the issue an earlier create with key made,
+// in the same forms as a fresh create.
+func reportIdempotentCreate(issueID, key string, silent bool) {
+	issue, err := store.GetIssue(rootCtx, issueID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			FatalError("issue %s created with idempotency key %q no longer exists", issueID, key)
+		}
+		FatalError("%v", err)
+	}
+	if jsonOutput {
+		outputJSON(issue)
+	} else if silent {
+		fmt.Println(issue.ID)
+	} else {
+		fmt.Printf("%s Issue already created with idempotency key %q: %s\n", ui.RenderPass("✓"), key, formatFeedbackID(issue.ID, issue.Title))
+	}
+	SetLastTouchedID(issue.ID)
+}
+
+// createStatus returns the status for a new issue: --status if given, else
+// create.default-status, else open.
+func createStatus(cmd *cobra.Command) (types.Status, error) {
+	if cmd.Flags().Changed("status") {
+		s, _ := cmd.Flags().GetString("status")
+		if types.Status(s) == types.StatusClosed {
+			return "", fmt.Errorf("cannot create a closed issue; create it, then run 'bd close'")
+		}
+		return types.Status(s), nil
+	}
+	status, err := config.CreateDefaultStatus()
+	if err != nil || status == "" {
+		return types.StatusOpen, err
+	}
+	return status, nil
+}
+
 // createInRig creates an issue in a different rig using --rig flag or auto-routing.
 // This directly creates in the target rig's database.
-func createInRig(cmd *cobra.Command, rigName, explicitID, title, description, issueType string, priority int, design, acceptance, notes, assignee string, labels []string, externalRef, specID string, wisp bool) {
+func createInRig(cmd *cobra.Command, rigName, explicitID, title, description, issueType string, priority int, status types.Status, design, acceptance, notes, assignee string, labels []string, externalRef, specID string, wisp bool) {
+	if key, _ := cmd.Flags().GetString("idempotency-key"); key != "" {
+		FatalError("--idempotency-key is not supported when creating in another rig")
+	}
 	ctx := rootCtx
 
 	// Find the town-level beads directory (where routes.jsonl lives)
@@ -950,7 +1086,7 @@ func createInRig(cmd *cobra.Command, rigName, explicitID, title, description, is
 		AcceptanceCriteria: acceptance,
 		Notes:              notes,
 		SpecID:             specID,
-		Status:             types.StatusOpen,
+		Status:             status,
 		Priority:           priority,
 		IssueType:          types.IssueType(issueType).Normalize(),
 		Assignee:           assignee,
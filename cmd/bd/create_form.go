@@ -98,8 +98,8 @@ func parseCreateFormInput(raw *createFormRawInput) *createFormValues {
 // This function handles parent-child relationships, labels, dependencies,
 // and source_repo inheritance.
 func CreateIssueFromFormValues(ctx context.Context, s *dolt.DoltStore, fv *createFormValues, actor string) (*types.Issue, error) {
-	// If parent is specified, validate it exists and generate child ID
-	var explicitID string
+	// If parent is specified, validate it exists; the child ID is allocated
+	// when the issue is created
 	var inheritedLabels []string
 	if fv.ParentID != "" {
 		_, err := s.GetIssue(ctx, fv.ParentID)
@@ -109,11 +109,6 @@ func CreateIssueFromFormValues(ctx context.Context, s *dolt.DoltStore, fv *creat
 			}
 			return nil, fmt.Errorf("failed to check parent issue: %w", err)
 		}
-		childID, err := s.GetNextChildID(ctx, fv.ParentID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to generate child ID: %w", err)
-		}
-		explicitID = childID
 
 		// Inherit parent labels (GH#2100), matching bd create --parent behavior
 		inheritedLabels, _ = s.GetLabels(ctx, fv.ParentID)
@@ -135,10 +130,7 @@ func CreateIssueFromFormValues(ctx context.Context, s *dolt.DoltStore, fv *creat
 		Assignee:           fv.Assignee,
 		ExternalRef:        externalRefPtr,
 		CreatedBy:          getActorWithGit(), // GH#748: track who created the issue
-	}
-
-	if explicitID != "" {
-		issue.ID = explicitID
+		ChildOf:            fv.ParentID,
 	}
 
 	// Check if any dependencies are discovered-from type
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// hierarchicalParentID returns the parent of a dotted child ID such as
+// bd-abc.1 → bd-abc. Only a numeric last segment counts as a child, so IDs
+// like bd-v1.x are not treated as hierarchical.
+func hierarchicalParentID(id string) (string, bool) {
+	lastSep := strings.LastIndex(id, config.GetIDHierarchy().Separator())
+	if lastSep <= 0 {
+		return "", false
+	}
+	if _, err := strconv.Atoi(id[lastSep+1:]); err != nil {
+		return "", false
+	}
+	return id[:lastSep], true
+}
+
+// missingParentIDs returns the ancestors of id that do not exist yet, ordered
+// top-down so they can be created in sequence. Walking stops at the first
+// ancestor that exists.
+func missingParentIDs(ctx context.Context, s *dolt.DoltStore, id string) ([]string, error) {
+	var missing []string
+	for parent, ok := hierarchicalParentID(id); ok; parent, ok = hierarchicalParentID(parent) {
+		_, err := s.GetIssue(ctx, parent)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("failed to check parent issue %s: %w", parent, err)
+		}
+		missing = append([]string{parent}, missing...)
+	}
+	return missing, nil
+}
+
+// placeholderParentTitle expands create.parent-title-template for a
+// placeholder parent. {id} is the parent's ID and {child} the ID below it.
+func placeholderParentTitle(template, parentID, childID string) string {
+	if strings.TrimSpace(template) == "" {
+		template = "Placeholder parent for {child}"
+	}
+	return strings.NewReplacer("{id}", parentID, "{child}", childID).Replace(template)
+}
+
+// placeholderParents builds the placeholder issues for missing ancestors of
+// child, top-down, inheriting the child's ephemerality so wisps stay wisps.
+func placeholderParents(missing []string, child *types.Issue) []*types.Issue {
+	template := config.GetString("create.parent-title-template")
+	parents := make([]*types.Issue, 0, len(missing))
+	for i, id := range missing {
+		below := child.ID
+		if i+1 < len(missing) {
+			below = missing[i+1]
+		}
+		parents = append(parents, &types.Issue{
+			ID:        id,
+			Title:     placeholderParentTitle(template, id, below),
+			Status:    types.StatusOpen,
+			Priority:  child.Priority,
+			IssueType: types.TypeEpic,
+			Ephemeral: child.Ephemeral,
+			CreatedBy: child.CreatedBy,
+			Owner:     child.Owner,
+		})
+	}
+	return parents
+}
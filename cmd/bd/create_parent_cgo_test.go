@@ -0,0 +1,57 @@
+//go:build cgo
+
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestMissingParentIDs(t *testing.T) {
+	tmpDir := t.TempDir()
+	s := newTestStore(t, filepath.Join(tmpDir, ".beads", "beads.db"))
+	ctx := context.Background()
+
+	root := &types.Issue{ID: "test-root", Title: "Root", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeEpic}
+	if err := s.CreateIssue(ctx, root, "test"); err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+
+	missing, err := missingParentIDs(ctx, s, "test-root.1.2")
+	if err != nil {
+		t.Fatalf("missingParentIDs: %v", err)
+	}
+	if want := []string{"test-root.1"}; !reflect.DeepEqual(missing, want) {
+		t.Errorf("missing = %v, want %v", missing, want)
+	}
+
+	missing, err = missingParentIDs(ctx, s, "test-gone.1.2")
+	if err != nil {
+		t.Fatalf("missingParentIDs: %v", err)
+	}
+	if want := []string{"test-gone", "test-gone.1"}; !reflect.DeepEqual(missing, want) {
+		t.Fatalf("missing = %v, want %v", missing, want)
+	}
+
+	// Placeholders and child go in one strict batch, parents first
+	child := &types.Issue{ID: "test-gone.1.2", Title: "Child", Status: types.StatusOpen, Priority: 1, IssueType: types.TypeTask}
+	batch := append(placeholderParents(missing, child), child)
+	if err := s.CreateIssuesWithFullOptions(ctx, batch, "test", storage.BatchCreateOptions{
+		OrphanHandling:       storage.OrphanStrict,
+		SkipPrefixValidation: true,
+	}); err != nil {
+		t.Fatalf("CreateIssuesWithFullOptions: %v", err)
+	}
+	parent, err := s.GetIssue(ctx, "test-gone.1")
+	if err != nil {
+		t.Fatalf("placeholder parent not created: %v", err)
+	}
+	if parent.Title != "Placeholder parent for test-gone.1.2" {
+		t.Errorf("placeholder title = %q", parent.Title)
+	}
+}
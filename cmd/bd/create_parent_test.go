@@ -0,0 +1,34 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestHierarchicalParentID(t *testing.T) {
+	tests := []struct {
+		id     string
+		want   string
+		wantOK bool
+	}{
+		{"bd-abc.1", "bd-abc", true},
+		{"bd-abc.1.2", "bd-abc.1", true},
+		{"bd-abc", "", false},
+		{"bd-v1.x", "", false},
+		{".1", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := hierarchicalParentID(tt.id)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("hierarchicalParentID(%q) = (%q, %v), want (%q, %v)", tt.id, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestPlaceholderParentTitle(t *testing.T) {
+	if got := placeholderParentTitle("Epic {id} for {child}", "bd-abc", "bd-abc.1"); got != "Epic bd-abc for bd-abc.1" {
+		t.Errorf("got %q", got)
+	}
+	if got := placeholderParentTitle("", "bd-abc", "bd-abc.1"); got != "Placeholder parent for bd-abc.1" {
+		t.Errorf("empty template: got %q", got)
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/dolt"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 )
@@ -45,7 +47,13 @@ Cascade: Recursively delete all dependents
   bd delete bd-1 --cascade --force
 
 Force: Delete and orphan dependents
-  bd delete bd-1 --force`,
+  bd delete bd-1 --force
+
+CHILDREN:
+An issue that still has children (parent-child dependents or dotted IDs
+like bd-1.1) is never deleted by default; the error lists the child IDs.
+  bd delete bd-1 --cascade --force     # delete the whole subtree
+  bd delete bd-1 --orphan-ok --force   # delete and leave children orphaned`,
 	Args: cobra.MinimumNArgs(0),
 	Run: func(cmd *cobra.Command, args []string) {
 		CheckReadonly("delete")
@@ -53,6 +61,10 @@ Force: Delete and orphan dependents
 		force, _ := cmd.Flags().GetBool("force")
 		dryRun, _ := cmd.Flags().GetBool("dry-run")
 		cascade, _ := cmd.Flags().GetBool("cascade")
+		orphanOK, _ := cmd.Flags().GetBool("orphan-ok")
+		if cascade && orphanOK {
+			FatalError("cannot combine --cascade and --orphan-ok")
+		}
 		// Use global jsonOutput set by PersistentPreRun
 		// Collect issue IDs from args and/or file
 		issueIDs := make([]string, 0, len(args))
@@ -78,6 +90,13 @@ Force: Delete and orphan dependents
 			}
 		}
 
+		// Refuse to orphan children unless --cascade or --orphan-ok says what to do
+		expanded, err := guardDeleteChildren(rootCtx, store, issueIDs, cascade, orphanOK)
+		if err != nil {
+			FatalErrorWithHint(err.Error(), "use --cascade to delete the whole subtree, or --orphan-ok to leave the children orphaned")
+		}
+		issueIDs = expanded
+
 		// Handle batch deletion in direct mode
 		// Also use batch path for cascade (which needs to expand dependents)
 		if len(issueIDs) > 1 || cascade {
@@ -533,6 +552,90 @@ func updateTextReferencesInIssues(ctx context.Context, deletedIDs []string, conn
 	return updatedCount
 }
 
+// childIssueIDs returns the direct children of an issue: parent-child
+// dependents plus dotted IDs (id.N) that may lack the dependency row.
+func childIssueIDs(ctx context.Context, s *dolt.DoltStore, id string) ([]string, error) {
+	seen := make(map[string]bool)
+	dependents, err := s.GetDependentsWithMetadata(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting dependents of %s: %w", id, err)
+	}
+	for _, dep := range dependents {
+		if dep.DependencyType == types.DepParentChild {
+			seen[dep.Issue.ID] = true
+		}
+	}
+	candidates, err := s.SearchIssues(ctx, "", types.IssueFilter{IDPrefix: id + s.IDHierarchy().Separator()})
+	if err != nil {
+		return nil, fmt.Errorf("finding hierarchical children of %s: %w", id, err)
+	}
+	for _, issue := range candidates {
+		if parent, ok := hierarchicalParentID(issue.ID); ok && parent == id {
+			seen[issue.ID] = true
+		}
+	}
+
+	children := make([]string, 0, len(seen))
+	for child := range seen {
+		children = append(children, child)
+	}
+	sort.Strings(children)
+	return children, nil
+}
+
+// guardDeleteChildren enforces the children rule for bd delete. With
+// cascade the returned IDs include every descendant; with orphanOK the IDs
+// are returned unchanged; otherwise any child outside the deletion set is
+// an error naming the children.
+func guardDeleteChildren(ctx context.Context, s *dolt.DoltStore, issueIDs []string, cascade, orphanOK bool) ([]string, error) {
+	if orphanOK {
+		return issueIDs, nil
+	}
+
+	inSet := make(map[string]bool, len(issueIDs))
+	for _, id := range issueIDs {
+		inSet[id] = true
+	}
+
+	if cascade {
+		result := append([]string(nil), issueIDs...)
+		for i := 0; i < len(result); i++ {
+			children, err := childIssueIDs(ctx, s, result[i])
+			if err != nil {
+				return nil, err
+			}
+			for _, child := range children {
+				if !inSet[child] {
+					inSet[child] = true
+					result = append(result, child)
+				}
+			}
+		}
+		return result, nil
+	}
+
+	var problems []string
+	for _, id := range issueIDs {
+		children, err := childIssueIDs(ctx, s, id)
+		if err != nil {
+			return nil, err
+		}
+		var outside []string
+		for _, child := range children {
+			if !inSet[child] {
+				outside = append(outside, child)
+			}
+		}
+		if len(outside) > 0 {
+			problems = append(problems, fmt.Sprintf("%s has children %s", id, strings.Join(outside, ", ")))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("cannot delete: %s", strings.Join(problems, "; "))
+	}
+	return issueIDs, nil
+}
+
 // readIssueIDsFromFile reads issue IDs from a file (one per line)
 func readIssueIDsFromFile(filename string) ([]string, error) {
 	// #nosec G304 - user-provided file path is intentional
@@ -575,6 +678,7 @@ func init() {
 	deleteCmd.Flags().String("from-file", "", "Read issue IDs from file (one per line)")
 	deleteCmd.Flags().Bool("dry-run", false, "Preview what would be deleted without making changes")
 	deleteCmd.Flags().Bool("cascade", false, "Recursively delete all dependent issues")
+	deleteCmd.Flags().Bool("orphan-ok", false, "Allow deleting issues that still have children, leaving them orphaned")
 	deleteCmd.ValidArgsFunction = issueIDCompletion
 	rootCmd.AddCommand(deleteCmd)
 }
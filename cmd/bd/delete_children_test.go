@@ -0,0 +1,79 @@
+//go:build cgo
+
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestGuardDeleteChildren(t *testing.T) {
+	tmpDir := t.TempDir()
+	s := newTestStore(t, filepath.Join(tmpDir, ".beads", "beads.db"))
+	ctx := context.Background()
+
+	for _, issue := range []*types.Issue{
+		{ID: "test-epic", Title: "Epic", IssueType: types.TypeEpic},
+		{ID: "test-epic.1", Title: "Dotted child", IssueType: types.TypeTask},
+		{ID: "test-epic.1.1", Title: "Grandchild", IssueType: types.TypeTask},
+		{ID: "test-linked", Title: "Linked child", IssueType: types.TypeTask},
+		{ID: "test-solo", Title: "No children", IssueType: types.TypeTask},
+	} {
+		issue.Status = types.StatusOpen
+		issue.Priority = 2
+		if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue %s: %v", issue.ID, err)
+		}
+	}
+	dep := &types.Dependency{IssueID: "test-linked", DependsOnID: "test-epic", Type: types.DepParentChild}
+	if err := s.AddDependency(ctx, dep, "test"); err != nil {
+		t.Fatalf("AddDependency: %v", err)
+	}
+
+	t.Run("default refuses and lists children", func(t *testing.T) {
+		_, err := guardDeleteChildren(ctx, s, []string{"test-epic"}, false, false)
+		if err == nil {
+			t.Fatal("expected error for parent with children")
+		}
+		if !strings.Contains(err.Error(), "test-epic.1, test-linked") {
+			t.Errorf("error %q does not list the children", err)
+		}
+	})
+
+	t.Run("default allows childless and whole-subtree sets", func(t *testing.T) {
+		if _, err := guardDeleteChildren(ctx, s, []string{"test-solo"}, false, false); err != nil {
+			t.Errorf("childless issue: %v", err)
+		}
+		if _, err := guardDeleteChildren(ctx, s, []string{"test-epic.1", "test-epic.1.1"}, false, false); err != nil {
+			t.Errorf("children included in set: %v", err)
+		}
+	})
+
+	t.Run("cascade expands to the subtree", func(t *testing.T) {
+		ids, err := guardDeleteChildren(ctx, s, []string{"test-epic"}, true, false)
+		if err != nil {
+			t.Fatalf("cascade: %v", err)
+		}
+		sort.Strings(ids)
+		want := []string{"test-epic", "test-epic.1", "test-epic.1.1", "test-linked"}
+		if !reflect.DeepEqual(ids, want) {
+			t.Errorf("cascade ids = %v, want %v", ids, want)
+		}
+	})
+
+	t.Run("orphan-ok leaves the set unchanged", func(t *testing.T) {
+		ids, err := guardDeleteChildren(ctx, s, []string{"test-epic"}, false, true)
+		if err != nil {
+			t.Fatalf("orphan-ok: %v", err)
+		}
+		if !reflect.DeepEqual(ids, []string{"test-epic"}) {
+			t.Errorf("orphan-ok ids = %v, want [test-epic]", ids)
+		}
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/routing"
 	"github.com/steveyegge/beads/internal/storage/dolt"
 	"github.com/steveyegge/beads/internal/types"
@@ -47,8 +48,9 @@ func resolveIDWithRouting(ctx context.Context, localStore *dolt.DoltStore, id st
 // For example, "bd-abc.1" is a child of "bd-abc", and "bd-abc.1.2" is a child of "bd-abc.1".
 func isChildOf(childID, parentID string) bool {
 	// A child ID has the format "parentID.N" or "parentID.N.M" etc.
-	// Use ParseHierarchicalID to get the actual parent
-	_, actualParentID, depth := types.ParseHierarchicalID(childID)
+	// Use the hierarchy's Parse to get the actual parent
+	h := config.GetIDHierarchy()
+	_, actualParentID, depth := h.Parse(childID)
 	if depth == 0 {
 		return false // Not a hierarchical ID
 	}
@@ -57,7 +59,7 @@ func isChildOf(childID, parentID string) bool {
 		return true
 	}
 	// Also check if parentID is an ancestor (e.g., "bd-abc" is parent of "bd-abc.1.2")
-	return strings.HasPrefix(childID, parentID+".")
+	return h.IsChildOf(childID, parentID)
 }
 
 // warnIfCyclesExist checks for dependency cycles and prints a warning if found.
@@ -18,11 +18,13 @@ This command requires the Dolt storage backend. The refs can be:
 - Commit hashes (e.g., abc123def)
 - Branch names (e.g., main, feature-branch)
 - Special refs like HEAD, HEAD~1
+- Remote-tracking branches after 'bd dolt fetch' (e.g., origin/main)
 
 Examples:
   bd diff main feature-branch   # Compare main to feature branch
   bd diff HEAD~5 HEAD           # Show changes in last 5 commits
-  bd diff abc123 def456         # Compare two specific commits`,
+  bd diff abc123 def456         # Compare two specific commits
+  bd diff HEAD origin/main      # Preview what 'bd dolt pull' would bring in`,
 	Args: cobra.ExactArgs(2),
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx := rootCtx
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -12,6 +13,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/cmd/bd/doctor"
 	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/configfile"
 	"github.com/steveyegge/beads/internal/ui"
 )
@@ -40,6 +42,21 @@ type doctorResult struct {
 	Timestamp       string            `json:"timestamp,omitempty"`        // ISO8601 timestamp for historical tracking
 	Platform        map[string]string `json:"platform,omitempty"`         // platform info for debugging
 	SuppressedCount int               `json:"suppressed_count,omitempty"` // GH#1095: number of suppressed warnings
+	SkippedChecks   []string          `json:"skipped_checks,omitempty"`   // slugs --fast left out
+	Timings         []doctorTiming    `json:"timings,omitempty"`          // per check, shown with --timings
+
+	failed map[string]bool // checks that fail the run at their default severity
+}
+
+// fail marks check as failing the run at its default severity. Whether it
+// still fails after doctor.severity and --strict is decided by
+// applyDoctorSeverity.
+func (r *doctorResult) fail(check doctorCheck) {
+	r.OverallOK = false
+	if r.failed == nil {
+		r.failed = make(map[string]bool)
+	}
+	r.failed[check.Name] = true
 }
 
 var (
@@ -47,19 +64,28 @@ var (
 	doctorYes                  bool
 	doctorInteractive          bool   // per-fix confirmation mode
 	doctorDryRun               bool   // preview fixes without applying
+	doctorNoBackup             bool   // skip the pre-fix backup tag
 	doctorOutput               string // export diagnostics to file
 	doctorFixChildParent       bool   // opt-in fix for child→parent deps
+	doctorDeleteEmpty          bool   // delete untitled issues instead of retitling
 	doctorVerbose              bool   // show detailed output during fixes
 	perfMode                   bool
 	checkHealthMode            bool
-	doctorCheckFlag            string // run specific check (e.g., "pollution")
-	doctorClean                bool   // for pollution check, delete detected issues
-	doctorDeep                 bool   // full graph integrity validation
-	doctorGastown              bool   // running in gastown multi-workspace mode
-	gastownDuplicatesThreshold int    // duplicate tolerance threshold for gastown mode
-	doctorServer               bool   // run server mode health checks
-	doctorMigration            string // migration validation mode: "pre" or "post"
-	doctorAgent                bool   // agent-facing diagnostic mode (ZFC-compliant)
+	doctorCheckFlag            string         // run specific check (e.g., "pollution")
+	doctorClean                bool           // for pollution check, delete detected issues
+	doctorDeep                 bool           // full graph integrity validation
+	doctorGastown              bool           // running in gastown multi-workspace mode
+	gastownDuplicatesThreshold int            // duplicate tolerance threshold for gastown mode
+	doctorServer               bool           // run server mode health checks
+	doctorMigration            string         // migration validation mode: "pre" or "post"
+	doctorAgent                bool           // agent-facing diagnostic mode (ZFC-compliant)
+	doctorStrict               bool           // treat all warnings as failures
+	doctorListChecks           bool           // list check names, slugs and configured severity
+	doctorOnly                 []string       // run only these checks (slugs or aliases)
+	doctorSkip                 []string       // skip these checks
+	doctorFast                 bool           // skip slow checks (full scans, similarity, network)
+	doctorTimings              bool           // show how long each check took
+	doctorSelection            checkSelection // parsed --only/--skip/--fast
 )
 
 // ConfigKeyHintsDoctor is the config key for suppressing doctor hints
@@ -101,7 +127,8 @@ Specific Check Mode (--check):
     (stale JSONL, SQLite files, cruft .beads dirs). Use with --clean.
   - pollution: Detect and optionally clean test issues from database
   - validate: Run focused data-integrity checks (duplicates, orphaned
-    deps, test pollution, git conflicts). Use with --fix to auto-repair.
+    deps, dangling references, test pollution, git conflicts). Use with
+    --fix to auto-repair.
 
 Deep Validation Mode (--deep):
   Validate full graph integrity. May be slow on large databases.
@@ -147,6 +174,14 @@ Agent Mode (--agent):
   ZFC-compliant: Go observes and reports, the agent decides and acts.
   Combine with --json for structured agent-facing output.
 
+Fast Mode (--fast):
+  Run only checks that cost a few indexed queries or file reads, so
+  routine health checks stay cheap on huge databases. Checks that scan
+  every issue, compare titles for similarity, walk the dependency graph or
+  use the network are skipped and listed at the end; --list-checks marks
+  them "slow". A slow check named in --only still runs. Add --timings to
+  see where the time goes.
+
 Suppressing Warnings:
   Suppress specific warnings by setting doctor.suppress.<check-slug> config:
     bd config set doctor.suppress.pending-migrations true
@@ -155,6 +190,17 @@ Suppressing Warnings:
   Only warnings are suppressed; errors and passing checks always show.
   To unsuppress: bd config unset doctor.suppress.<slug>
 
+Severity Overrides:
+  Map check slugs to ignore, warn or fail under doctor.severity in
+  .beads/config.yaml to tune the exit code, e.g. for CI:
+    doctor:
+      severity:
+        orphaned-dependencies: warn   # report, never fail
+        dependency-cycles: fail       # any finding fails
+        git-hooks: ignore             # hide findings entirely
+  --strict makes every warning fail except checks mapped to warn or ignore.
+  Use --list-checks to see check slugs and their configured severity.
+
 Examples:
   bd doctor              # Check current directory
   bd doctor /path/to/repo # Check specific repository
@@ -165,10 +211,18 @@ Examples:
   bd doctor --fix --yes  # Automatically fix issues (no confirmation)
   bd doctor --fix -i     # Confirm each fix individually
   bd doctor --fix --fix-child-parent  # Also fix child→parent deps (opt-in)
+  bd doctor --fix --delete-empty  # Delete untitled issues instead of retitling them
   bd doctor --fix --force # Force repair even when database can't be opened
   bd doctor --fix --source=jsonl # Rebuild database from JSONL (source of truth)
   bd doctor --dry-run    # Preview what --fix would do without making changes
+  bd doctor --fix --no-backup  # Skip the pre-fix backup tag
   bd doctor --perf       # Performance diagnostics
+  bd doctor --strict     # Exit 1 on any warning (CI gate)
+  bd doctor --list-checks # Show check slugs for doctor.severity, --only and --skip
+  bd doctor --only orphans,cycles  # Run just the named checks
+  bd doctor --skip schema          # Run everything except the named checks
+  bd doctor --fast                 # Cheap checks only (e.g. in a pre-commit hook)
+  bd doctor --fast --timings       # Also show how long each check took
   bd doctor --output diagnostics.json  # Export diagnostics to file
   bd doctor --check=artifacts           # Show classic artifacts (JSONL, SQLite, cruft dirs)
   bd doctor --check=artifacts --clean  # Delete safe-to-delete artifacts (with confirmation)
@@ -261,6 +315,17 @@ Examples:
 			return
 		}
 
+		if doctorListChecks {
+			runListChecks()
+			return
+		}
+
+		doctorSelection, err = parseCheckSelection(doctorOnly, doctorSkip)
+		if err != nil {
+			FatalError("%v", err)
+		}
+		doctorSelection.fast = doctorFast
+
 		// Run diagnostics
 		result := runDiagnostics(absPath)
 
@@ -292,6 +357,10 @@ Examples:
 			fmt.Printf("✓ Diagnostics exported to %s\n", doctorOutput)
 		}
 
+		if !doctorTimings {
+			result.Timings = nil
+		}
+
 		// Output results
 		if doctorAgent {
 			agentResult := buildAgentResult(result)
@@ -305,6 +374,9 @@ Examples:
 		} else if doctorOutput == "" {
 			// Only print to console if not exporting (to avoid duplicate output)
 			printDiagnostics(result)
+			if doctorTimings {
+				printDoctorTimings(result.Timings)
+			}
 		}
 
 		// Exit with error if any checks failed
@@ -319,13 +391,21 @@ func init() {
 	doctorCmd.Flags().BoolVarP(&doctorYes, "yes", "y", false, "Skip confirmation prompt (for non-interactive use)")
 	doctorCmd.Flags().BoolVarP(&doctorInteractive, "interactive", "i", false, "Confirm each fix individually")
 	doctorCmd.Flags().BoolVar(&doctorDryRun, "dry-run", false, "Preview fixes without making changes")
+	doctorCmd.Flags().BoolVar(&doctorNoBackup, "no-backup", false, "Skip the pre-fix-<timestamp> Dolt tag --fix creates before repairing")
 	doctorCmd.Flags().BoolVar(&doctorFixChildParent, "fix-child-parent", false, "Remove child→parent dependencies (opt-in)")
+	doctorCmd.Flags().BoolVar(&doctorDeleteEmpty, "delete-empty", false, "With --fix, delete issues with empty titles instead of giving them placeholder titles")
 	doctorCmd.Flags().BoolVarP(&doctorVerbose, "verbose", "v", false, "Show all checks (default shows only warnings/errors)")
 	doctorCmd.Flags().BoolVar(&doctorGastown, "gastown", false, "Running in gastown multi-workspace mode (routes.jsonl is expected, higher duplicate tolerance)")
 	doctorCmd.Flags().IntVar(&gastownDuplicatesThreshold, "gastown-duplicates-threshold", 1000, "Duplicate tolerance threshold for gastown mode (wisps are ephemeral)")
 	doctorCmd.Flags().BoolVar(&doctorServer, "server", false, "Run Dolt server mode health checks (connectivity, version, schema)")
 	doctorCmd.Flags().StringVar(&doctorMigration, "migration", "", "Run Dolt migration validation: 'pre' (before migration) or 'post' (after migration)")
 	doctorCmd.Flags().BoolVar(&doctorAgent, "agent", false, "Agent-facing diagnostic mode: rich context for AI agents (ZFC-compliant)")
+	doctorCmd.Flags().BoolVar(&doctorStrict, "strict", false, "Fail on any warning, except checks doctor.severity maps to warn or ignore")
+	doctorCmd.Flags().BoolVar(&doctorListChecks, "list-checks", false, "List check names and slugs with their doctor.severity setting")
+	doctorCmd.Flags().StringSliceVar(&doctorOnly, "only", nil, "Run only the named checks (comma-separated slugs or aliases, e.g. orphans,cycles)")
+	doctorCmd.Flags().StringSliceVar(&doctorSkip, "skip", nil, "Skip the named checks (comma-separated slugs or aliases, e.g. schema)")
+	doctorCmd.Flags().BoolVar(&doctorFast, "fast", false, "Run only cheap checks, skipping full scans, similarity and network checks (see --list-checks)")
+	doctorCmd.Flags().BoolVar(&doctorTimings, "timings", false, "Show how long each check took")
 }
 
 // releaseDiagnosticLocks removes stale noms LOCK files that the diagnostics
@@ -361,475 +441,23 @@ func releaseDiagnosticLocks(path string) {
 	}
 }
 
+// runDiagnostics runs all checks, then applies doctor.suppress.*,
+// doctor.severity and --strict to decide what is shown and the exit code.
 func runDiagnostics(path string) doctorResult {
-	result := doctorResult{
-		Path:       path,
-		CLIVersion: Version,
-		OverallOK:  true,
-	}
-
-	// Auto-detect gastown mode: routes.jsonl is only created by gastown workspaces
-	if !doctorGastown {
-		routesFile := filepath.Join(path, ".beads", "routes.jsonl")
-		if _, err := os.Stat(routesFile); err == nil {
-			doctorGastown = true
-		}
-	}
-
-	// Check 1: Installation (.beads/ directory)
-	installCheck := convertWithCategory(doctor.CheckInstallation(path), doctor.CategoryCore)
-	result.Checks = append(result.Checks, installCheck)
-	if installCheck.Status != statusOK {
-		result.OverallOK = false
-	}
-
-	// Check Git Hooks early (even if .beads/ doesn't exist yet)
-	hooksCheck := convertWithCategory(doctor.CheckGitHooks(Version), doctor.CategoryGit)
-	result.Checks = append(result.Checks, hooksCheck)
-	// Don't fail overall check for missing hooks, just warn
-
-	// Check for stale .legacy hook sidecars calling removed "bd hook" command (GH#2398)
-	legacyCheck := convertWithCategory(doctor.CheckStaleLegacyHooks(), doctor.CategoryGit)
-	result.Checks = append(result.Checks, legacyCheck)
+	result := collectDiagnostics(path, doctorSelection)
 
-	// Check git hooks Dolt compatibility (hooks without Dolt check cause errors)
-	doltHooksCheck := convertWithCategory(doctor.CheckGitHooksDoltCompatibility(path), doctor.CategoryGit)
-	result.Checks = append(result.Checks, doltHooksCheck)
-	if doltHooksCheck.Status == statusError {
-		result.OverallOK = false
-	}
-
-	// If no .beads/, skip remaining checks
-	if installCheck.Status != statusOK {
-		return result
-	}
-
-	// Check 1a: Fresh clone detection
-	// Must come early - if this is a fresh clone, other checks may be misleading
-	freshCloneCheck := convertWithCategory(doctor.CheckFreshClone(path), doctor.CategoryCore)
-	result.Checks = append(result.Checks, freshCloneCheck)
-	if freshCloneCheck.Status == statusWarning || freshCloneCheck.Status == statusError {
-		result.OverallOK = false
-	}
-
-	// Check 1b: Metadata config file (GH#2478)
-	// Must come before database checks since they depend on metadata.json.
-	beadsDir := filepath.Join(path, ".beads")
-	beadsDir = beads.FollowRedirect(beadsDir)
-	configPath := configfile.ConfigPath(beadsDir)
-	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		metaCheck := doctorCheck{
-			Name:     "Metadata Config",
-			Status:   statusError,
-			Message:  "metadata.json is missing",
-			Fix:      "Run 'bd doctor --fix' to regenerate with defaults, or 'bd init --force'",
-			Category: doctor.CategoryCore,
-		}
-		result.Checks = append(result.Checks, metaCheck)
-		result.OverallOK = false
-	} else {
+	severity, err := config.DoctorSeverity()
+	if err != nil {
 		result.Checks = append(result.Checks, doctorCheck{
-			Name:     "Metadata Config",
-			Status:   statusOK,
-			Message:  "metadata.json present",
-			Category: doctor.CategoryCore,
+			Name:     "Doctor Severity Config",
+			Status:   statusWarning,
+			Message:  "Invalid doctor.severity configuration (overrides not applied)",
+			Detail:   err.Error(),
+			Fix:      "Use ignore, warn or fail for each check slug (see 'bd doctor --list-checks')",
+			Category: doctor.CategoryData,
 		})
 	}
-
-	// GH#1981: Run lock health check BEFORE any checks that open embedded
-	// Dolt databases. Earlier checks (CheckDatabaseVersion, CheckSchemaCompatibility,
-	// etc.) create noms LOCK files via flock(); if CheckLockHealth runs after them,
-	// it detects those same-process locks as "held by another process" (false positive).
-	earlyLockCheck := doctor.CheckLockHealth(path)
-
-	// bd-jgxi: Auto-migrate database version before checking it.
-	// Since doctor skips PersistentPreRun DB init (it's in noDbCommands),
-	// trackBdVersion() and autoMigrateOnVersionBump() haven't run yet.
-	//
-	// Scope version tracking to the doctor target. Without this, `bd doctor <path>`
-	// can accidentally touch the caller's current repo .beads state.
-	origBeadsDir, hadBeadsDir := os.LookupEnv("BEADS_DIR")
-	_ = os.Setenv("BEADS_DIR", beadsDir)
-	trackBdVersion()
-	if hadBeadsDir {
-		_ = os.Setenv("BEADS_DIR", origBeadsDir)
-	} else {
-		_ = os.Unsetenv("BEADS_DIR")
-	}
-
-	autoMigrateOnVersionBump(beadsDir)
-
-	// Check 1b: Dolt format compatibility (GH#2137)
-	// Must run before opening the database — old noms formats cause server panics.
-	doltFormatCheck := convertWithCategory(doctor.CheckDoltFormat(path), doctor.CategoryCore)
-	result.Checks = append(result.Checks, doltFormatCheck)
-	if doltFormatCheck.Status == statusError {
-		result.OverallOK = false
-	}
-
-	// Check 2: Database version
-	dbCheck := convertWithCategory(doctor.CheckDatabaseVersion(path, Version), doctor.CategoryCore)
-	result.Checks = append(result.Checks, dbCheck)
-	if dbCheck.Status == statusError {
-		result.OverallOK = false
-	}
-
-	// Check 2a: Schema compatibility
-	schemaCheck := convertWithCategory(doctor.CheckSchemaCompatibility(path), doctor.CategoryCore)
-	result.Checks = append(result.Checks, schemaCheck)
-	if schemaCheck.Status == statusError {
-		result.OverallOK = false
-	}
-
-	// Check 2b: Repo fingerprint (detects wrong database or URL change)
-	fingerprintCheck := convertWithCategory(doctor.CheckRepoFingerprint(path), doctor.CategoryCore)
-	result.Checks = append(result.Checks, fingerprintCheck)
-	if fingerprintCheck.Status == statusError {
-		result.OverallOK = false
-	}
-
-	// Check 2c: Database integrity
-	integrityCheck := convertWithCategory(doctor.CheckDatabaseIntegrity(path), doctor.CategoryCore)
-	result.Checks = append(result.Checks, integrityCheck)
-	if integrityCheck.Status == statusError {
-		result.OverallOK = false
-	}
-
-	// Check 3: ID format (hash vs sequential)
-	idCheck := convertWithCategory(doctor.CheckIDFormat(path), doctor.CategoryCore)
-	result.Checks = append(result.Checks, idCheck)
-	if idCheck.Status == statusWarning {
-		result.OverallOK = false
-	}
-
-	// Check 4: CLI version (GitHub)
-	versionCheck := convertWithCategory(doctor.CheckCLIVersion(Version), doctor.CategoryCore)
-	result.Checks = append(result.Checks, versionCheck)
-	// Don't fail overall check for outdated CLI, just warn
-
-	// Check 4.5: Claude plugin version (if running in Claude Code)
-	pluginCheck := convertWithCategory(doctor.CheckClaudePlugin(), doctor.CategoryIntegration)
-	result.Checks = append(result.Checks, pluginCheck)
-	// Don't fail overall check for outdated plugin, just warn
-
-	// Check 7: Database/JSONL configuration mismatch
-	configCheck := convertWithCategory(doctor.CheckDatabaseConfig(path), doctor.CategoryData)
-	result.Checks = append(result.Checks, configCheck)
-	if configCheck.Status == statusWarning || configCheck.Status == statusError {
-		result.OverallOK = false
-	}
-
-	// Check 7a: Configuration value validation
-	configValuesCheck := convertWithCategory(doctor.CheckConfigValues(path), doctor.CategoryData)
-	result.Checks = append(result.Checks, configValuesCheck)
-	// Don't fail overall check for config value warnings, just warn
-
-	// Check 7a1: Project identity (GH#2372 backfill)
-	projectIDCheck := convertWithCategory(doctor.CheckProjectIdentity(path), doctor.CategoryData)
-	result.Checks = append(result.Checks, projectIDCheck)
-	if projectIDCheck.Status == statusWarning || projectIDCheck.Status == statusError {
-		result.OverallOK = false
-	}
-
-	// Check 7b: Multi-repo custom types discovery (bd-9ji4z)
-	multiRepoTypesCheck := convertWithCategory(doctor.CheckMultiRepoTypes(path), doctor.CategoryData)
-	result.Checks = append(result.Checks, multiRepoTypesCheck)
-	// Don't fail overall check for multi-repo types, just informational
-
-	// Check 7c: Role configuration (beads.role)
-	roleCheck := convertDoctorCheck(doctor.CheckBeadsRole(path))
-	result.Checks = append(result.Checks, roleCheck)
-	// Don't fail overall check for role config, just warn - URL heuristic fallback still works
-
-	// Check 7e: Stale lock files (bootstrap, sync, startup)
-	staleLockCheck := convertDoctorCheck(doctor.CheckStaleLockFiles(path))
-	result.Checks = append(result.Checks, staleLockCheck)
-	if staleLockCheck.Status == statusWarning || staleLockCheck.Status == statusError {
-		result.OverallOK = false
-	}
-
-	// Check 7f: Remote consistency (SQL vs CLI)
-	remoteCheck := convertWithCategory(doctor.CheckRemoteConsistency(path), doctor.CategoryData)
-	result.Checks = append(result.Checks, remoteCheck)
-	// Don't fail overall for remote discrepancies, just warn
-
-	// Dolt health checks (connection, schema, issue count, status).
-	// GH#1981: Pass the pre-computed lock check (run before any embedded Dolt
-	// opens) to avoid false positives from doctor's own noms LOCK files.
-	for _, dc := range doctor.RunDoltHealthChecksWithLock(path, earlyLockCheck) {
-		result.Checks = append(result.Checks, convertDoctorCheck(dc))
-	}
-
-	// Federation health checks (bd-wkumz.6)
-	// Check 8d: Federation remotesapi port accessibility
-	remotesAPICheck := convertWithCategory(doctor.CheckFederationRemotesAPI(path), doctor.CategoryFederation)
-	result.Checks = append(result.Checks, remotesAPICheck)
-	// Don't fail overall for federation issues - they're only relevant for Dolt users
-
-	// Check 8e: Federation peer connectivity
-	peerConnCheck := convertWithCategory(doctor.CheckFederationPeerConnectivity(path), doctor.CategoryFederation)
-	result.Checks = append(result.Checks, peerConnCheck)
-
-	// Check 8f: Federation sync staleness
-	syncStalenessCheck := convertWithCategory(doctor.CheckFederationSyncStaleness(path), doctor.CategoryFederation)
-	result.Checks = append(result.Checks, syncStalenessCheck)
-
-	// Check 8g: Federation conflict detection
-	fedConflictsCheck := convertWithCategory(doctor.CheckFederationConflicts(path), doctor.CategoryFederation)
-	result.Checks = append(result.Checks, fedConflictsCheck)
-	if fedConflictsCheck.Status == statusError {
-		result.OverallOK = false // Unresolved conflicts are a real problem
-	}
-
-	// Check 8h: Dolt server mode configuration check
-	doltModeCheck := convertWithCategory(doctor.CheckDoltServerModeMismatch(path), doctor.CategoryFederation)
-	result.Checks = append(result.Checks, doltModeCheck)
-
-	// Check 9: Permissions
-	permCheck := convertWithCategory(doctor.CheckPermissions(path), doctor.CategoryCore)
-	result.Checks = append(result.Checks, permCheck)
-	if permCheck.Status == statusError {
-		result.OverallOK = false
-	}
-
-	// Check 10: Dependency cycles
-	cycleCheck := convertWithCategory(doctor.CheckDependencyCycles(path), doctor.CategoryMetadata)
-	result.Checks = append(result.Checks, cycleCheck)
-	if cycleCheck.Status == statusError || cycleCheck.Status == statusWarning {
-		result.OverallOK = false
-	}
-
-	// Check 11: Claude integration
-	claudeCheck := convertWithCategory(doctor.CheckClaude(path), doctor.CategoryIntegration)
-	result.Checks = append(result.Checks, claudeCheck)
-	// Don't fail overall check for missing Claude integration, just warn
-
-	// Check 11a: Claude settings file health (malformed JSON detection)
-	claudeSettingsCheck := convertWithCategory(doctor.CheckClaudeSettingsHealth(path), doctor.CategoryIntegration)
-	result.Checks = append(result.Checks, claudeSettingsCheck)
-	if claudeSettingsCheck.Status == statusError {
-		result.OverallOK = false // Malformed settings is a real problem
-	}
-
-	// Check 11b: Claude hook completeness (both SessionStart and PreCompact)
-	claudeHookCheck := convertWithCategory(doctor.CheckClaudeHookCompleteness(path), doctor.CategoryIntegration)
-	result.Checks = append(result.Checks, claudeHookCheck)
-	// Don't fail overall check for incomplete hooks, just warn
-
-	// Check 11c: bd prime output verification
-	bdPrimeOutputCheck := convertWithCategory(doctor.VerifyPrimeOutput(path), doctor.CategoryIntegration)
-	result.Checks = append(result.Checks, bdPrimeOutputCheck)
-	// Don't fail overall check for prime output issues, just warn
-
-	// Check 11e: bd in PATH (needed for Claude hooks to work)
-	bdPathCheck := convertWithCategory(doctor.CheckBdInPath(), doctor.CategoryIntegration)
-	result.Checks = append(result.Checks, bdPathCheck)
-	// Don't fail overall check for missing bd in PATH, just warn
-
-	// Check 11f: Documentation bd prime references match installed version
-	bdPrimeDocsCheck := convertWithCategory(doctor.CheckDocumentationBdPrimeReference(path), doctor.CategoryIntegration)
-	result.Checks = append(result.Checks, bdPrimeDocsCheck)
-	// Don't fail overall check for doc mismatch, just warn
-
-	// Check 12: Agent documentation presence
-	agentDocsCheck := convertWithCategory(doctor.CheckAgentDocumentation(path), doctor.CategoryIntegration)
-	result.Checks = append(result.Checks, agentDocsCheck)
-	// Don't fail overall check for missing docs, just warn
-
-	// Check 13: Legacy beads slash commands in documentation
-	legacyDocsCheck := convertWithCategory(doctor.CheckLegacyBeadsSlashCommands(path), doctor.CategoryMetadata)
-	result.Checks = append(result.Checks, legacyDocsCheck)
-	// Don't fail overall check for legacy docs, just warn
-
-	// Check 13a: MCP tool references in documentation
-	mcpToolRefsCheck := convertWithCategory(doctor.CheckLegacyMCPToolReferences(path), doctor.CategoryIntegration)
-	result.Checks = append(result.Checks, mcpToolRefsCheck)
-	// Don't fail overall check for MCP tool refs, just warn
-
-	// Check 14: Gitignore up to date
-	gitignoreCheck := convertWithCategory(doctor.CheckGitignore(path), doctor.CategoryGit)
-	result.Checks = append(result.Checks, gitignoreCheck)
-	// Don't fail overall check for gitignore, just warn
-
-	// Check 14a: Project-root .gitignore has Dolt exclusion patterns (GH#2034)
-	projectGitignoreCheck := convertWithCategory(doctor.CheckProjectGitignore(path), doctor.CategoryGit)
-	result.Checks = append(result.Checks, projectGitignoreCheck)
-	// Don't fail overall check for project gitignore, just warn
-
-	// Check 14b: redirect file tracking (worktree redirect files shouldn't be committed)
-	redirectTrackingCheck := convertWithCategory(doctor.CheckRedirectNotTracked(path), doctor.CategoryGit)
-	result.Checks = append(result.Checks, redirectTrackingCheck)
-	// Don't fail overall check for redirect tracking, just warn
-
-	// Check 14c: redirect target validity (target exists and has valid db)
-	redirectTargetCheck := convertWithCategory(doctor.CheckRedirectTargetValid(path), doctor.CategoryGit)
-	result.Checks = append(result.Checks, redirectTargetCheck)
-	// Don't fail overall check for redirect target, just warn
-
-	// Check 14d: redirect target sync worktree (target has beads-sync if needed)
-	redirectTargetSyncCheck := convertWithCategory(doctor.CheckRedirectTargetSyncWorktree(path), doctor.CategoryGit)
-	result.Checks = append(result.Checks, redirectTargetSyncCheck)
-	// Don't fail overall check for redirect target sync, just warn
-
-	// Check 14e: vestigial sync worktrees (unused worktrees in redirected repos)
-	vestigialWorktreesCheck := convertWithCategory(doctor.CheckNoVestigialSyncWorktrees(path), doctor.CategoryGit)
-	result.Checks = append(result.Checks, vestigialWorktreesCheck)
-	// Don't fail overall check for vestigial worktrees, just warn
-
-	// Check 14g: last-touched file tracking (runtime state shouldn't be committed)
-	lastTouchedTrackingCheck := convertWithCategory(doctor.CheckLastTouchedNotTracked(path), doctor.CategoryGit)
-	result.Checks = append(result.Checks, lastTouchedTrackingCheck)
-	// Don't fail overall check for last-touched tracking, just warn
-
-	// Check 14h: tracked runtime/sensitive files (GH#2535)
-	trackedRuntimeCheck := convertDoctorCheck(doctor.CheckTrackedRuntimeFiles(path))
-	result.Checks = append(result.Checks, trackedRuntimeCheck)
-	if trackedRuntimeCheck.Status == statusError {
-		result.OverallOK = false // Sensitive files in git is a real problem
-	}
-
-	// Check 15a: Git working tree cleanliness (AGENTS.md hygiene)
-	gitWorkingTreeCheck := convertWithCategory(doctor.CheckGitWorkingTree(path), doctor.CategoryGit)
-	result.Checks = append(result.Checks, gitWorkingTreeCheck)
-	// Don't fail overall check for dirty working tree, just warn
-
-	// Check 15b: Git upstream sync (ahead/behind/diverged)
-	gitUpstreamCheck := convertWithCategory(doctor.CheckGitUpstream(path), doctor.CategoryGit)
-	result.Checks = append(result.Checks, gitUpstreamCheck)
-	// Don't fail overall check for upstream drift, just warn
-
-	// Check 16: Metadata.json version tracking
-	metadataCheck := convertWithCategory(doctor.CheckMetadataVersionTracking(path, Version), doctor.CategoryMetadata)
-	result.Checks = append(result.Checks, metadataCheck)
-	// Don't fail overall check for metadata, just warn
-
-	// Check 17b: Orphaned issues - referenced in commits but still open
-	orphanedIssuesCheck := convertWithCategory(doctor.CheckOrphanedIssues(path), doctor.CategoryGit)
-	result.Checks = append(result.Checks, orphanedIssuesCheck)
-	// Don't fail overall check for orphaned issues, just warn
-
-	// Check 18: Deletions manifest (legacy)
-	deletionsCheck := convertWithCategory(doctor.CheckDeletionsManifest(path), doctor.CategoryMetadata)
-	result.Checks = append(result.Checks, deletionsCheck)
-	// Don't fail overall check for missing deletions manifest, just warn
-
-	// Check 20: Untracked .beads/*.jsonl files
-	untrackedCheck := convertWithCategory(doctor.CheckUntrackedBeadsFiles(path), doctor.CategoryData)
-	result.Checks = append(result.Checks, untrackedCheck)
-	// Don't fail overall check for untracked files, just warn
-
-	// Check 21: Orphaned dependencies (from bd repair-deps, bd validate)
-	orphanedDepsCheck := convertDoctorCheck(doctor.CheckOrphanedDependencies(path))
-	result.Checks = append(result.Checks, orphanedDepsCheck)
-	// Don't fail overall check for orphaned deps, just warn
-
-	// Check 22a: Child→parent dependencies (anti-pattern)
-	childParentDepsCheck := convertDoctorCheck(doctor.CheckChildParentDependencies(path))
-	result.Checks = append(result.Checks, childParentDepsCheck)
-	// Don't fail overall check for child→parent deps, just warn
-
-	// Check 23: Duplicate issues (from bd validate)
-	duplicatesCheck := convertDoctorCheck(doctor.CheckDuplicateIssues(path, doctorGastown, gastownDuplicatesThreshold))
-	result.Checks = append(result.Checks, duplicatesCheck)
-	// Don't fail overall check for duplicates, just warn
-
-	// Check 24: Test pollution (from bd validate)
-	pollutionCheck := convertDoctorCheck(doctor.CheckTestPollution(path))
-	result.Checks = append(result.Checks, pollutionCheck)
-	// Don't fail overall check for test pollution, just warn
-
-	// Check 26: Stale closed issues (maintenance)
-	staleClosedCheck := convertDoctorCheck(doctor.CheckStaleClosedIssues(path))
-	result.Checks = append(result.Checks, staleClosedCheck)
-	// Don't fail overall check for stale issues, just warn
-
-	// Check 26a: Stale molecules (complete but unclosed)
-	staleMoleculesCheck := convertDoctorCheck(doctor.CheckStaleMolecules(path))
-	result.Checks = append(result.Checks, staleMoleculesCheck)
-	// Don't fail overall check for stale molecules, just warn
-
-	// Check 26b: Persistent mol- issues (should have been ephemeral)
-	persistentMolCheck := convertDoctorCheck(doctor.CheckPersistentMolIssues(path))
-	result.Checks = append(result.Checks, persistentMolCheck)
-	// Don't fail overall check for persistent mol issues, just warn
-
-	// Check 26c: Legacy merge queue files (gastown mrqueue remnants)
-	staleMQFilesCheck := convertDoctorCheck(doctor.CheckStaleMQFiles(path))
-	result.Checks = append(result.Checks, staleMQFilesCheck)
-	// Don't fail overall check for legacy MQ files, just warn
-
-	// Check 26d: Patrol pollution (patrol digests, session beads)
-	patrolPollutionCheck := convertDoctorCheck(doctor.CheckPatrolPollution(path))
-	result.Checks = append(result.Checks, patrolPollutionCheck)
-	// Don't fail overall check for patrol pollution, just warn
-
-	// Check 29: Database size (pruning suggestion)
-	// Note: This check has no auto-fix - pruning is destructive and user-controlled
-	sizeCheck := convertDoctorCheck(doctor.CheckDatabaseSize(path))
-	result.Checks = append(result.Checks, sizeCheck)
-	// Don't fail overall check for size warning, just inform
-
-	// Check 30: Pending migrations (summarizes all available migrations)
-	migrationsCheck := convertDoctorCheck(doctor.CheckPendingMigrations(path))
-	result.Checks = append(result.Checks, migrationsCheck)
-	// Status is determined by the check itself based on migration priorities
-	if migrationsCheck.Status == statusError {
-		result.OverallOK = false
-	}
-
-	// Check 31: KV store sync status
-	kvSyncCheck := convertDoctorCheck(doctor.CheckKVSyncStatus(path))
-	result.Checks = append(result.Checks, kvSyncCheck)
-	// Don't fail overall check for KV sync warning, just inform
-
-	// Check 32: Dolt locks (uncommitted changes)
-	doltLocksCheck := convertDoctorCheck(doctor.CheckDoltLocks(path))
-	result.Checks = append(result.Checks, doltLocksCheck)
-	// Don't fail overall check for Dolt locks, just warn
-
-	// Check 33: Classic artifacts (post-Dolt-migration cleanup)
-	classicArtifactsCheck := convertDoctorCheck(doctor.CheckClassicArtifacts(path))
-	result.Checks = append(result.Checks, classicArtifactsCheck)
-	// Don't fail overall check for classic artifacts, just warn
-
-	// Check 36: Embedded mode concurrency issues (GH#2086)
-	concurrencyCheck := convertWithCategory(doctor.CheckEmbeddedModeConcurrency(path), doctor.CategoryRuntime)
-	result.Checks = append(result.Checks, concurrencyCheck)
-	// Don't fail overall — this is a recommendation, not a broken state
-
-	// GH#1095: Filter out suppressed checks (doctor.suppress.<slug> = true)
-	suppressed := doctor.GetSuppressedChecks(path)
-	if len(suppressed) > 0 {
-		var suppressedCount int
-		var filtered []doctorCheck
-		for _, check := range result.Checks {
-			slug := doctor.CheckNameToSlug(check.Name)
-			if suppressed[slug] && check.Status == statusWarning {
-				suppressedCount++
-				continue
-			}
-			filtered = append(filtered, check)
-		}
-		if suppressedCount > 0 {
-			result.Checks = filtered
-			// Recompute OverallOK after filtering
-			result.OverallOK = true
-			for _, check := range result.Checks {
-				if check.Status == statusError {
-					result.OverallOK = false
-					break
-				}
-				if check.Status == statusWarning {
-					// Some warnings are informational (don't fail), but
-					// replicate the per-check logic from above is complex.
-					// Conservative: don't change OverallOK for warnings here.
-				}
-			}
-			// Store suppressed count for display
-			result.SuppressedCount = suppressedCount
-		}
-	}
-
+	applyDoctorSeverity(&result, doctor.GetSuppressedChecks(path), severity, doctorStrict)
 	return result
 }
 
@@ -1074,13 +702,17 @@ func printDiagnostics(result doctorResult) {
 		}
 	}
 
+	if len(result.SkippedChecks) > 0 {
+		fmt.Printf("%s\n", ui.RenderMuted(fmt.Sprintf("(%d slow check(s) skipped by --fast: %s)", len(result.SkippedChecks), strings.Join(result.SkippedChecks, ", "))))
+	}
+
 	// GH#1095: Notify user about suppressed checks
 	if result.SuppressedCount > 0 {
-		noun := "warning"
+		noun := "finding"
 		if result.SuppressedCount > 1 {
-			noun = "warnings"
+			noun = "findings"
 		}
-		fmt.Printf("%s\n", ui.RenderMuted(fmt.Sprintf("(%d %s suppressed via doctor.suppress config)", result.SuppressedCount, noun)))
+		fmt.Printf("%s\n", ui.RenderMuted(fmt.Sprintf("(%d %s suppressed via doctor.suppress or doctor.severity config)", result.SuppressedCount, noun)))
 	}
 }
 
@@ -1145,6 +777,39 @@ func printAllChecks(checksByCategory map[string][]doctorCheck) {
 	}
 }
 
+// doctorTiming is how long one registered check took.
+type doctorTiming struct {
+	Slug       string        `json:"slug"`
+	Duration   time.Duration `json:"-"`
+	DurationMS int64         `json:"duration_ms"`
+}
+
+// MarshalJSON fills in duration_ms from Duration.
+func (t doctorTiming) MarshalJSON() ([]byte, error) {
+	type plain doctorTiming
+	t.DurationMS = t.Duration.Milliseconds()
+	return json.Marshal(plain(t))
+}
+
+// printDoctorTimings lists checks slowest first, with the total.
+func printDoctorTimings(timings []doctorTiming) {
+	if len(timings) == 0 {
+		return
+	}
+	sorted := slices.Clone(timings)
+	slices.SortStableFunc(sorted, func(a, b doctorTiming) int { return cmp.Compare(b.Duration, a.Duration) })
+
+	var total time.Duration
+	for _, t := range sorted {
+		total += t.Duration
+	}
+	fmt.Printf("\n%s\n", ui.RenderCategory("Timings"))
+	for _, t := range sorted {
+		fmt.Printf("  %-30s %8s\n", t.Slug, t.Duration.Round(time.Millisecond))
+	}
+	fmt.Printf("  %-30s %8s\n", "total", total.Round(time.Millisecond))
+}
+
 // runMigrationValidation runs Dolt migration validation checks.
 // Phase can be "pre" (before migration) or "post" (after migration).
 // Outputs machine-parseable JSON when --json flag is set.
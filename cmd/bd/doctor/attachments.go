@@ -0,0 +1,35 @@
+package doctor
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// attachmentRef is one row of the attachments table.
+type attachmentRef struct {
+	IssueID  string
+	Name     string
+	Location string
+}
+
+// missingAttachments returns a description of each file attachment whose
+// path no longer exists. URLs are skipped; relative paths are resolved
+// against repoRoot, as 'bd attach add' stores them.
+func missingAttachments(repoRoot string, refs []attachmentRef) []string {
+	var missing []string
+	for _, ref := range refs {
+		if u, err := url.Parse(ref.Location); err == nil && u.Scheme != "" && u.Host != "" {
+			continue
+		}
+		p := filepath.FromSlash(ref.Location)
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(repoRoot, p)
+		}
+		if _, err := os.Stat(p); os.IsNotExist(err) {
+			missing = append(missing, fmt.Sprintf("%s:%s (%s)", ref.IssueID, ref.Name, ref.Location))
+		}
+	}
+	return missing
+}
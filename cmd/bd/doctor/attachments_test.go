@@ -0,0 +1,31 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestMissingAttachments(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "logs"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	present := filepath.Join(root, "logs", "ok.txt")
+	if err := os.WriteFile(present, []byte("ok"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	refs := []attachmentRef{
+		{IssueID: "bd-1", Name: "ok.txt", Location: "logs/ok.txt"},
+		{IssueID: "bd-1", Name: "abs", Location: present},
+		{IssueID: "bd-2", Name: "gone.txt", Location: "logs/gone.txt"},
+		{IssueID: "bd-3", Name: "spec", Location: "https://example.com/spec.pdf"},
+	}
+	got := missingAttachments(root, refs)
+	want := []string{"bd-2:gone.txt (logs/gone.txt)"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("missingAttachments = %v, want %v", got, want)
+	}
+}
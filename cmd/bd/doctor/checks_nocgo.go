@@ -9,10 +9,18 @@ func CheckOrphanedDependencies(_ string) DoctorCheck {
 	return DoctorCheck{Name: "Orphaned Dependencies", Status: StatusWarning, Message: "Skipped: requires CGO"}
 }
 
+func CheckDanglingReferences(_ string) DoctorCheck {
+	return DoctorCheck{Name: "Dangling References", Status: StatusWarning, Message: "Skipped: requires CGO"}
+}
+
 func CheckDuplicateIssues(_ string, _ bool, _ int) DoctorCheck {
 	return DoctorCheck{Name: "Duplicate Issues", Status: StatusWarning, Message: "Skipped: requires CGO"}
 }
 
+func CheckNearDuplicateTitles(_ string) DoctorCheck {
+	return DoctorCheck{Name: "Near-Duplicate Titles", Status: StatusWarning, Message: "Skipped: requires CGO"}
+}
+
 func CheckTestPollution(_ string) DoctorCheck {
 	return DoctorCheck{Name: "Test Pollution", Status: StatusWarning, Message: "Skipped: requires CGO"}
 }
@@ -21,14 +29,50 @@ func CheckChildParentDependencies(_ string) DoctorCheck {
 	return DoctorCheck{Name: "Child-Parent Dependencies", Status: StatusWarning, Message: "Skipped: requires CGO"}
 }
 
+func CheckDuplicateDependencies(_ string) DoctorCheck {
+	return DoctorCheck{Name: "Duplicate Dependencies", Status: StatusWarning, Message: "Skipped: requires CGO"}
+}
+
+func CheckOrphanedChildren(_ string) DoctorCheck {
+	return DoctorCheck{Name: "Orphaned Children", Status: StatusWarning, Message: "Skipped: requires CGO"}
+}
+
+func CheckAttachmentPaths(_ string) DoctorCheck {
+	return DoctorCheck{Name: "Attachment Paths", Status: StatusWarning, Message: "Skipped: requires CGO"}
+}
+
+func CheckBooleanColumns(_ string) DoctorCheck {
+	return DoctorCheck{Name: "Boolean Columns", Status: StatusWarning, Message: "Skipped: requires CGO"}
+}
+
+func CheckFutureTimestamps(_ string) DoctorCheck {
+	return DoctorCheck{Name: "Future Timestamps", Status: StatusWarning, Message: "Skipped: requires CGO"}
+}
+
+func CheckTitleLength(_ string) DoctorCheck {
+	return DoctorCheck{Name: "Title Length", Status: StatusWarning, Message: "Skipped: requires CGO"}
+}
+
+func CheckEmptyTitles(_ string) DoctorCheck {
+	return DoctorCheck{Name: "Empty Titles", Status: StatusWarning, Message: "Skipped: requires CGO"}
+}
+
 func CheckGitConflicts(_ string) DoctorCheck {
 	return DoctorCheck{Name: "Git Conflicts", Status: StatusWarning, Message: "Skipped: requires CGO"}
 }
 
+func CheckStaleJSONL(_ string) DoctorCheck {
+	return DoctorCheck{Name: "Stale JSONL", Status: StatusWarning, Message: "Skipped: requires CGO"}
+}
+
 func CheckStaleClosedIssues(_ string) DoctorCheck {
 	return DoctorCheck{Name: "Stale Closed Issues", Status: StatusWarning, Message: "Skipped: requires CGO"}
 }
 
+func CheckIssueAgeSLA(_ string) DoctorCheck {
+	return DoctorCheck{Name: "Issue Age SLA", Status: StatusWarning, Message: "Skipped: requires CGO"}
+}
+
 func CheckStaleMolecules(_ string) DoctorCheck {
 	return DoctorCheck{Name: "Stale Molecules", Status: StatusWarning, Message: "Skipped: requires CGO"}
 }
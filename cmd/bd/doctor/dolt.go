@@ -540,8 +540,12 @@ func CheckLockHealth(path string) DoctorCheck {
 		if err == nil {
 			if lockErr := lockfile.FlockExclusiveNonBlocking(f); lockErr != nil {
 				// Lock is held by another process
+				holder := "another bd process"
+				if pid, ok := lockfile.ReadOwnerPID(accessLockPath); ok {
+					holder = fmt.Sprintf("bd process %d", pid)
+				}
 				warnings = append(warnings,
-					"advisory lock is currently held by another bd process")
+					"advisory lock is currently held by "+holder)
 			} else {
 				// We acquired it, meaning no one holds it — release immediately
 				_ = lockfile.FlockUnlock(f)
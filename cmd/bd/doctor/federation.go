@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/configfile"
 	"github.com/steveyegge/beads/internal/doltserver"
 	"github.com/steveyegge/beads/internal/storage/dolt"
@@ -28,9 +29,10 @@ func doltDatabaseName(beadsDir string) string {
 // use the configured host/port rather than falling back to defaults.
 func doltServerConfig(beadsDir, doltPath string) *dolt.Config {
 	cfg := &dolt.Config{
-		Path:     doltPath,
-		ReadOnly: true,
-		Database: doltDatabaseName(beadsDir),
+		Path:               doltPath,
+		ReadOnly:           true,
+		Database:           doltDatabaseName(beadsDir),
+		HierarchySeparator: config.GetIDHierarchyFromDir(beadsDir).Separator(),
 	}
 	if bcfg, err := configfile.Load(beadsDir); err == nil && bcfg != nil {
 		cfg.ServerHost = bcfg.GetDoltServerHost()
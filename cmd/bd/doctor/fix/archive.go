@@ -0,0 +1,30 @@
+package fix
+
+import (
+	"database/sql"
+
+	"github.com/steveyegge/beads/internal/config"
+)
+
+// ArchiveResolvesReferences reports whether issues moved to issues_archive by
+// bd archive should count as existing for orphan checks: the table must exist
+// and archive.resolve-references must be on (the default).
+func ArchiveResolvesReferences(db *sql.DB) bool {
+	if !config.GetBool("archive.resolve-references") {
+		return false
+	}
+	var n int
+	err := db.QueryRow(`SELECT COUNT(*) FROM information_schema.tables
+		WHERE table_schema = DATABASE() AND table_name = 'issues_archive'`).Scan(&n)
+	return err == nil && n > 0
+}
+
+// ArchivedTargetsClause returns a WHERE fragment (on dependencies aliased d)
+// that keeps references to archived issues from counting as orphans, or ""
+// when ArchiveResolvesReferences is false.
+func ArchivedTargetsClause(db *sql.DB) string {
+	if !ArchiveResolvesReferences(db) {
+		return ""
+	}
+	return " AND d.depends_on_id NOT IN (SELECT id FROM issues_archive)"
+}
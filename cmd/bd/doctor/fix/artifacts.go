@@ -5,6 +5,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/steveyegge/beads/internal/config"
 )
 
 // ClassicArtifacts removes beads classic artifacts found by scanning the path.
@@ -172,10 +174,10 @@ func cleanJSONLArtifacts(beadsDir string) (removed, skipped, errCount int) {
 		}
 	}
 
-	// issues.jsonl in dolt-native directory - skip (needs manual review)
-	issuesPath := filepath.Join(beadsDir, "issues.jsonl")
+	// JSONL export in dolt-native directory - skip (needs manual review)
+	issuesPath := config.JSONLPathFromDir(beadsDir)
 	if _, err := os.Stat(issuesPath); err == nil {
-		fmt.Printf("  Skip (needs review): %s (issues.jsonl in dolt-native dir)\n", issuesPath)
+		fmt.Printf("  Skip (needs review): %s (JSONL export in dolt-native dir)\n", issuesPath)
 		skipped++
 	}
 
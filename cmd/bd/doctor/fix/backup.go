@@ -0,0 +1,44 @@
+package fix
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage/dolt"
+)
+
+// BackupTagPrefix starts the names of the tags 'bd doctor --fix' creates
+// before repairing anything.
+const BackupTagPrefix = "pre-fix-"
+
+// BackupTagName returns the backup tag for a fix run started at now, e.g.
+// "pre-fix-20250102-150405".
+func BackupTagName(now time.Time) string {
+	return BackupTagPrefix + now.UTC().Format("20060102-150405")
+}
+
+// CreateBackup commits any pending changes in the database at path and tags
+// the commit, so the repairs that follow can be reviewed with
+// 'bd diff <tag> HEAD' and undone by resetting to the tag. Returns the tag.
+func CreateBackup(path string, now time.Time) (string, error) {
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+	ctx := context.Background()
+	store, err := dolt.NewFromConfig(ctx, beadsDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to open database: %w", err)
+	}
+	defer func() { _ = store.Close() }()
+
+	// Uncommitted changes must be in the backup too, or a reset to the tag
+	// would discard them along with the fixes
+	if err := store.Commit(ctx, "doctor: commit pending changes before fixes"); err != nil {
+		return "", fmt.Errorf("failed to commit pending changes: %w", err)
+	}
+	name := BackupTagName(now)
+	if _, err := store.CreateTag(ctx, name, "", "Backup before bd doctor --fix"); err != nil {
+		return "", err
+	}
+	return name, nil
+}
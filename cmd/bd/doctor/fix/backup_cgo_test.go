@@ -0,0 +1,92 @@
+//go:build cgo
+
+package fix
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/configfile"
+	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestCreateBackup_TagsHead(t *testing.T) {
+	port := fixTestServerPort()
+	if port == 0 {
+		t.Skip("Dolt test server not available, skipping")
+	}
+
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatalf("failed to create .beads: %v", err)
+	}
+
+	cfg := configfile.DefaultConfig()
+	cfg.Backend = configfile.BackendDolt
+	cfg.DoltMode = configfile.DoltModeServer
+	cfg.DoltServerHost = "127.0.0.1"
+	cfg.DoltServerPort = port
+	h := sha256.Sum256([]byte(t.Name() + fmt.Sprintf("%d", time.Now().UnixNano())))
+	cfg.DoltDatabase = "doctest_" + hex.EncodeToString(h[:6])
+	if err := cfg.Save(beadsDir); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+
+	ctx := context.Background()
+	store, err := dolt.NewFromConfig(ctx, beadsDir)
+	if err != nil {
+		t.Skipf("skipping: Dolt server not available: %v", err)
+	}
+	if err := store.SetConfig(ctx, "issue_prefix", "bd"); err != nil {
+		_ = store.Close()
+		t.Fatalf("failed to set issue_prefix: %v", err)
+	}
+	issue := &types.Issue{Title: "Before the fix", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		_ = store.Close()
+		t.Fatalf("failed to create issue: %v", err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatalf("failed to close setup store: %v", err)
+	}
+
+	now := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	tag, err := CreateBackup(tmpDir, now)
+	if err != nil {
+		t.Fatalf("CreateBackup returned error: %v", err)
+	}
+	if tag != "pre-fix-20250102-150405" {
+		t.Errorf("tag = %q, want pre-fix-20250102-150405", tag)
+	}
+
+	verifyStore, err := dolt.NewFromConfig(ctx, beadsDir)
+	if err != nil {
+		t.Skipf("skipping: Dolt server not available: %v", err)
+	}
+	defer func() { _ = verifyStore.Close() }()
+
+	info, err := verifyStore.GetTag(ctx, tag)
+	if err != nil {
+		t.Fatalf("backup tag not found: %v", err)
+	}
+	head, err := verifyStore.GetCurrentCommit(ctx)
+	if err != nil {
+		t.Fatalf("failed to get HEAD: %v", err)
+	}
+	if info.Hash != head {
+		t.Errorf("tag points at %s, want HEAD %s", info.Hash, head)
+	}
+
+	// A second run in the same second must not move the existing tag
+	if _, err := CreateBackup(tmpDir, now); err == nil {
+		t.Error("expected an error when the backup tag already exists")
+	}
+}
@@ -0,0 +1,139 @@
+package fix
+
+import (
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// booleanColumns are the issue flags declared TINYINT(1). The store scans
+// them into Go bools, which fails for anything but 0 and 1, so a single
+// drifted row breaks every query that reads it.
+var booleanColumns = []string{"ephemeral", "pinned", "is_template", "crystallizes"}
+
+// BooleanDrift is one issue flag holding something other than 0 or 1, as
+// left behind by imports that wrote 2, -1 or NULL.
+type BooleanDrift struct {
+	IssueID string
+	Column  string
+	Value   sql.NullInt64 // The original value; invalid for NULL
+}
+
+// Normalized is the value the fix writes: 1 for any non-zero value, 0 for
+// zero and NULL.
+func (b BooleanDrift) Normalized() int {
+	if b.Value.Valid && b.Value.Int64 != 0 {
+		return 1
+	}
+	return 0
+}
+
+func (b BooleanDrift) String() string {
+	value := "NULL"
+	if b.Value.Valid {
+		value = fmt.Sprint(b.Value.Int64)
+	}
+	return fmt.Sprintf("%s %s=%s", b.IssueID, b.Column, value)
+}
+
+// booleanDriftWhere matches rows where any boolean column is drifted.
+func booleanDriftWhere() string {
+	conds := make([]string, len(booleanColumns))
+	for i, col := range booleanColumns {
+		conds[i] = fmt.Sprintf("%[1]s IS NULL OR %[1]s NOT IN (0, 1)", col)
+	}
+	return strings.Join(conds, " OR ")
+}
+
+// LoadBooleanDrift returns the drifted boolean values in issues, ordered by
+// issue ID.
+func LoadBooleanDrift(db *sql.DB) ([]BooleanDrift, error) {
+	//nolint:gosec // G201: columns come from booleanColumns
+	rows, err := db.Query(fmt.Sprintf("SELECT id, %s FROM issues WHERE %s ORDER BY id",
+		strings.Join(booleanColumns, ", "), booleanDriftWhere()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query boolean columns: %w", err)
+	}
+	defer rows.Close()
+
+	var found []BooleanDrift
+	for rows.Next() {
+		var id string
+		values := make([]sql.NullInt64, len(booleanColumns))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan boolean columns: %w", err)
+		}
+		for i, v := range values {
+			if !v.Valid || (v.Int64 != 0 && v.Int64 != 1) {
+				found = append(found, BooleanDrift{IssueID: id, Column: booleanColumns[i], Value: v})
+			}
+		}
+	}
+	return found, rows.Err()
+}
+
+// NormalizeBooleanColumns rewrites every value found by LoadBooleanDrift to
+// 0 or 1, in one transaction committed to Dolt history, and returns what it
+// changed. Nothing is changed if any update fails.
+func NormalizeBooleanColumns(db *sql.DB) ([]BooleanDrift, error) {
+	found, err := LoadBooleanDrift(db)
+	if err != nil || len(found) == 0 {
+		return found, err
+	}
+
+	err = applyFixInTx(db, "doctor: normalize boolean columns", func(tx *sql.Tx) error {
+		for _, col := range booleanColumns {
+			// updated_at = updated_at keeps ON UPDATE from bumping it
+			//nolint:gosec // G201: col comes from booleanColumns
+			query := fmt.Sprintf(`UPDATE issues SET %[1]s = CASE WHEN %[1]s IS NULL OR %[1]s = 0 THEN 0 ELSE 1 END,
+				updated_at = updated_at WHERE %[1]s IS NULL OR %[1]s NOT IN (0, 1)`, col)
+			if _, err := tx.Exec(query); err != nil {
+				return fmt.Errorf("failed to normalize %s: %w", col, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+// BooleanColumns normalizes issue flags (ephemeral, pinned, is_template,
+// crystallizes) that hold values other than 0 and 1.
+func BooleanColumns(path string, verbose bool) error {
+	if err := validateBeadsWorkspace(path); err != nil {
+		return err
+	}
+
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+
+	db, err := openDoltDB(beadsDir)
+	if err != nil {
+		fmt.Printf("  Boolean columns fix skipped (%v)\n", err)
+		return nil
+	}
+	defer db.Close()
+
+	fixed, err := NormalizeBooleanColumns(db)
+	if err != nil {
+		return err
+	}
+	if len(fixed) == 0 {
+		fmt.Println("  No boolean values to fix")
+		return nil
+	}
+
+	if verbose || len(fixed) < 20 {
+		for _, b := range fixed {
+			fmt.Printf("  Set %s to %d\n", b, b.Normalized())
+		}
+	}
+	fmt.Printf("  Fixed %d boolean value(s)\n", len(fixed))
+	return nil
+}
@@ -0,0 +1,27 @@
+package fix
+
+import (
+	"database/sql"
+	"testing"
+)
+
+func TestBooleanDriftNormalized(t *testing.T) {
+	tests := []struct {
+		value      sql.NullInt64
+		wantString string
+		want       int
+	}{
+		{sql.NullInt64{Int64: 2, Valid: true}, "bd-1 pinned=2", 1},
+		{sql.NullInt64{Int64: -1, Valid: true}, "bd-1 pinned=-1", 1},
+		{sql.NullInt64{}, "bd-1 pinned=NULL", 0},
+	}
+	for _, tt := range tests {
+		b := BooleanDrift{IssueID: "bd-1", Column: "pinned", Value: tt.value}
+		if got := b.String(); got != tt.wantString {
+			t.Errorf("String() = %q, want %q", got, tt.wantString)
+		}
+		if got := b.Normalized(); got != tt.want {
+			t.Errorf("%s: Normalized() = %d, want %d", b, got, tt.want)
+		}
+	}
+}
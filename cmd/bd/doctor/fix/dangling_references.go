@@ -0,0 +1,166 @@
+package fix
+
+import (
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// extensionTables hold per-issue rows keyed by issue_id. Their foreign keys
+// cascade deletes from issues, but rows written with foreign_key_checks off
+// (imports, merges, manual SQL) can outlive the issue they belong to.
+var extensionTables = []string{
+	"dependencies", "labels", "comments", "events", "issue_meta",
+	"issue_links", "attachments", "issue_snapshots", "compaction_snapshots",
+}
+
+// DanglingRows counts the rows in one extension table whose issue_id is not
+// in issues.
+type DanglingRows struct {
+	Table    string
+	Count    int
+	IssueIDs []string // The missing issues, sorted
+}
+
+func (d DanglingRows) String() string {
+	return fmt.Sprintf("%s: %d row(s) for %s", d.Table, d.Count, strings.Join(d.IssueIDs, ", "))
+}
+
+// existingExtensionTables returns the extensionTables present in the
+// database; older schemas lack some of them.
+func existingExtensionTables(db *sql.DB) ([]string, error) {
+	rows, err := db.Query(`SELECT table_name FROM information_schema.tables WHERE table_schema = DATABASE()`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	defer rows.Close()
+
+	present := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan table name: %w", err)
+		}
+		present[strings.ToLower(name)] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var tables []string
+	for _, table := range extensionTables {
+		if present[table] {
+			tables = append(tables, table)
+		}
+	}
+	return tables, nil
+}
+
+// DetectDanglingReferences scans each extension table for rows whose
+// issue_id has no issue, and returns one entry per table that has any, in
+// extensionTables order.
+func DetectDanglingReferences(db *sql.DB) ([]DanglingRows, error) {
+	tables, err := existingExtensionTables(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []DanglingRows
+	for _, table := range tables {
+		//nolint:gosec // G201: table comes from extensionTables
+		rows, err := db.Query(fmt.Sprintf(`
+			SELECT t.issue_id, COUNT(*)
+			FROM %s t
+			LEFT JOIN issues i ON t.issue_id = i.id
+			WHERE i.id IS NULL
+			GROUP BY t.issue_id
+			ORDER BY t.issue_id`, table))
+		if err != nil {
+			return nil, fmt.Errorf("failed to query %s: %w", table, err)
+		}
+		d := DanglingRows{Table: table}
+		for rows.Next() {
+			var id string
+			var n int
+			if err := rows.Scan(&id, &n); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan %s: %w", table, err)
+			}
+			d.IssueIDs = append(d.IssueIDs, id)
+			d.Count += n
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", table, err)
+		}
+		if d.Count > 0 {
+			found = append(found, d)
+		}
+	}
+	return found, nil
+}
+
+// RemoveDanglingReferences deletes every row found by
+// DetectDanglingReferences, in one transaction committed to Dolt history,
+// and returns what it removed. Nothing is removed if any delete fails.
+func RemoveDanglingReferences(db *sql.DB) ([]DanglingRows, error) {
+	found, err := DetectDanglingReferences(db)
+	if err != nil || len(found) == 0 {
+		return found, err
+	}
+
+	err = applyFixInTx(db, "doctor: remove dangling references", func(tx *sql.Tx) error {
+		for _, d := range found {
+			//nolint:gosec // G201: table comes from extensionTables
+			query := fmt.Sprintf("DELETE FROM %s WHERE issue_id NOT IN (SELECT id FROM issues)", d.Table)
+			if _, err := tx.Exec(query); err != nil {
+				return fmt.Errorf("failed to clean %s: %w", d.Table, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+// DanglingReferences removes extension-table rows (labels, dependencies,
+// comments, metadata, ...) that belong to issues that no longer exist.
+func DanglingReferences(path string, verbose bool) error {
+	if err := validateBeadsWorkspace(path); err != nil {
+		return err
+	}
+
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+
+	db, err := openDoltDB(beadsDir)
+	if err != nil {
+		fmt.Printf("  Dangling references fix skipped (%v)\n", err)
+		return nil
+	}
+	defer db.Close()
+
+	removed, err := RemoveDanglingReferences(db)
+	if err != nil {
+		return err
+	}
+	if len(removed) == 0 {
+		fmt.Println("  No dangling references to fix")
+		return nil
+	}
+
+	total := 0
+	for _, d := range removed {
+		total += d.Count
+		if verbose {
+			fmt.Printf("  Removed %s\n", d)
+		} else {
+			fmt.Printf("  Removed %d row(s) from %s\n", d.Count, d.Table)
+		}
+	}
+	fmt.Printf("  Fixed %d dangling reference(s)\n", total)
+	return nil
+}
@@ -0,0 +1,224 @@
+package fix
+
+import (
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DependencyEdge is a single row of the dependencies table.
+type DependencyEdge struct {
+	IssueID     string
+	DependsOnID string
+	Type        string
+}
+
+func (e DependencyEdge) String() string {
+	return fmt.Sprintf("%s→%s (%s)", e.IssueID, e.DependsOnID, e.Type)
+}
+
+// DuplicateEdgeGroup is a set of rows that all describe the same edge.
+// Keep is the row retained by the fix; Redundant are the rows it deletes.
+type DuplicateEdgeGroup struct {
+	Keep      DependencyEdge
+	Redundant []DependencyEdge
+}
+
+// ContradictoryEdgePair is an A→B and B→A pair of the same type. These need
+// a human to decide which direction is intended, so the fix never removes them.
+type ContradictoryEdgePair struct {
+	Forward DependencyEdge
+	Reverse DependencyEdge
+}
+
+// contradictoryEdgeTypes are the dependency types for which A→B plus B→A is
+// a contradiction (deadlock or a parent/child cycle) rather than a modeling choice.
+var contradictoryEdgeTypes = map[string]bool{
+	"blocks":       true,
+	"parent-child": true,
+}
+
+// normalizeDependsOnID maps alternate spellings of a dependency target to the
+// canonical local ID: surrounding whitespace is trimmed, and an
+// external:<rig>:<id> ref whose <id> exists locally collapses to <id>.
+func normalizeDependsOnID(id string, localIDs map[string]bool) string {
+	id = strings.TrimSpace(id)
+	if rest, ok := strings.CutPrefix(id, "external:"); ok {
+		if _, target, ok := strings.Cut(rest, ":"); ok && localIDs[target] {
+			return target
+		}
+	}
+	return id
+}
+
+// FindDuplicateDependencies groups edges that share issue, type and
+// normalized target. The row already spelled canonically is kept when there
+// is one; otherwise the first row in sorted order is.
+func FindDuplicateDependencies(edges []DependencyEdge, localIDs map[string]bool) []DuplicateEdgeGroup {
+	groups := make(map[DependencyEdge][]DependencyEdge)
+	for _, e := range edges {
+		key := DependencyEdge{IssueID: e.IssueID, DependsOnID: normalizeDependsOnID(e.DependsOnID, localIDs), Type: e.Type}
+		groups[key] = append(groups[key], e)
+	}
+
+	var result []DuplicateEdgeGroup
+	for key, rows := range groups {
+		if len(rows) < 2 {
+			continue
+		}
+		sort.Slice(rows, func(i, j int) bool { return rows[i].DependsOnID < rows[j].DependsOnID })
+		keep := 0
+		for i, r := range rows {
+			if r.DependsOnID == key.DependsOnID {
+				keep = i
+				break
+			}
+		}
+		group := DuplicateEdgeGroup{Keep: rows[keep]}
+		for i, r := range rows {
+			if i != keep {
+				group.Redundant = append(group.Redundant, r)
+			}
+		}
+		result = append(result, group)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Keep.String() < result[j].Keep.String() })
+	return result
+}
+
+// FindContradictoryDependencies returns A→B / B→A pairs of the same
+// contradictory type, comparing normalized targets. Each pair is reported once.
+func FindContradictoryDependencies(edges []DependencyEdge, localIDs map[string]bool) []ContradictoryEdgePair {
+	seen := make(map[DependencyEdge]DependencyEdge)
+	for _, e := range edges {
+		if !contradictoryEdgeTypes[e.Type] {
+			continue
+		}
+		key := DependencyEdge{IssueID: e.IssueID, DependsOnID: normalizeDependsOnID(e.DependsOnID, localIDs), Type: e.Type}
+		if _, ok := seen[key]; !ok {
+			seen[key] = e
+		}
+	}
+
+	var result []ContradictoryEdgePair
+	for key, forward := range seen {
+		if key.IssueID >= key.DependsOnID {
+			continue
+		}
+		reverse, ok := seen[DependencyEdge{IssueID: key.DependsOnID, DependsOnID: key.IssueID, Type: key.Type}]
+		if !ok {
+			continue
+		}
+		result = append(result, ContradictoryEdgePair{Forward: forward, Reverse: reverse})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Forward.String() < result[j].Forward.String() })
+	return result
+}
+
+// LoadDependencyEdges reads every dependency row plus the set of local issue
+// IDs needed to normalize external refs.
+func LoadDependencyEdges(db *sql.DB) ([]DependencyEdge, map[string]bool, error) {
+	rows, err := db.Query("SELECT issue_id, depends_on_id, type FROM dependencies")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query dependencies: %w", err)
+	}
+	defer rows.Close()
+
+	var edges []DependencyEdge
+	for rows.Next() {
+		var e DependencyEdge
+		if err := rows.Scan(&e.IssueID, &e.DependsOnID, &e.Type); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan dependency: %w", err)
+		}
+		edges = append(edges, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	idRows, err := db.Query("SELECT id FROM issues")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query issues: %w", err)
+	}
+	defer idRows.Close()
+
+	localIDs := make(map[string]bool)
+	for idRows.Next() {
+		var id string
+		if err := idRows.Scan(&id); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan issue id: %w", err)
+		}
+		localIDs[id] = true
+	}
+	if err := idRows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return edges, localIDs, nil
+}
+
+// DuplicateDependencies removes redundant dependency rows that describe the
+// same edge through a different spelling of the target. Contradictory pairs
+// are listed for manual review but left in place.
+// If verbose is true, prints each removed dependency; otherwise shows only summary.
+func DuplicateDependencies(path string, verbose bool) error {
+	if err := validateBeadsWorkspace(path); err != nil {
+		return err
+	}
+
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+
+	db, err := openDoltDB(beadsDir)
+	if err != nil {
+		fmt.Printf("  Duplicate dependencies fix skipped (%v)\n", err)
+		return nil
+	}
+	defer db.Close()
+
+	edges, localIDs, err := LoadDependencyEdges(db)
+	if err != nil {
+		return err
+	}
+
+	duplicates := FindDuplicateDependencies(edges, localIDs)
+	if len(duplicates) == 0 {
+		fmt.Println("  No duplicate dependencies to fix")
+	} else {
+		// All or none, so a failure can't leave an edge half deduplicated
+		var removed int
+		err := applyFixInTx(db, "doctor: remove duplicate dependencies", func(tx *sql.Tx) error {
+			for _, group := range duplicates {
+				for _, r := range group.Redundant {
+					if _, err := tx.Exec("DELETE FROM dependencies WHERE issue_id = ? AND depends_on_id = ?",
+						r.IssueID, r.DependsOnID); err != nil {
+						return fmt.Errorf("failed to remove %s: %w", r, err)
+					}
+					removed++
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if verbose || len(duplicates) < 20 {
+			for _, group := range duplicates {
+				for _, r := range group.Redundant {
+					fmt.Printf("  Removed duplicate dependency: %s (kept %s)\n", r, group.Keep)
+				}
+			}
+		}
+		fmt.Printf("  Fixed %d duplicate dependency row(s)\n", removed)
+	}
+
+	if contradictions := FindContradictoryDependencies(edges, localIDs); len(contradictions) > 0 {
+		fmt.Printf("  ⚠ %d contradictory dependency pair(s) need manual review:\n", len(contradictions))
+		for _, pair := range contradictions {
+			fmt.Printf("    %s  vs  %s\n", pair.Forward, pair.Reverse)
+		}
+		fmt.Println("    Remove the unintended direction with 'bd dep remove <from> <to>'")
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package fix
+
+import (
+	"testing"
+)
+
+func TestFindDuplicateDependencies(t *testing.T) {
+	localIDs := map[string]bool{"bd-a": true, "bd-b": true}
+	edges := []DependencyEdge{
+		{IssueID: "bd-a", DependsOnID: "bd-b", Type: "blocks"},
+		{IssueID: "bd-a", DependsOnID: "external:bd:bd-b", Type: "blocks"},
+		{IssueID: "bd-a", DependsOnID: " bd-b", Type: "blocks"},
+		// Same target, different type: not a duplicate
+		{IssueID: "bd-b", DependsOnID: "bd-a", Type: "related"},
+		{IssueID: "bd-b", DependsOnID: "external:bd:bd-a", Type: "blocks"},
+		// External ref to an issue that is not local stays distinct
+		{IssueID: "bd-b", DependsOnID: "external:other:bd-z", Type: "blocks"},
+	}
+
+	groups := FindDuplicateDependencies(edges, localIDs)
+	if len(groups) != 1 {
+		t.Fatalf("got %d duplicate groups, want 1: %+v", len(groups), groups)
+	}
+	if groups[0].Keep.DependsOnID != "bd-b" {
+		t.Errorf("kept %q, want canonical bd-b", groups[0].Keep.DependsOnID)
+	}
+	if len(groups[0].Redundant) != 2 {
+		t.Errorf("got %d redundant rows, want 2", len(groups[0].Redundant))
+	}
+}
+
+func TestFindDuplicateDependencies_NoCanonicalRow(t *testing.T) {
+	localIDs := map[string]bool{"bd-a": true, "bd-b": true}
+	edges := []DependencyEdge{
+		{IssueID: "bd-a", DependsOnID: "external:bd:bd-b", Type: "blocks"},
+		{IssueID: "bd-a", DependsOnID: "bd-b ", Type: "blocks"},
+	}
+
+	groups := FindDuplicateDependencies(edges, localIDs)
+	if len(groups) != 1 || len(groups[0].Redundant) != 1 {
+		t.Fatalf("got %+v, want one group with one redundant row", groups)
+	}
+}
+
+func TestFindContradictoryDependencies(t *testing.T) {
+	localIDs := map[string]bool{"bd-a": true, "bd-b": true, "bd-c": true}
+	edges := []DependencyEdge{
+		{IssueID: "bd-a", DependsOnID: "bd-b", Type: "blocks"},
+		{IssueID: "bd-b", DependsOnID: "external:bd:bd-a", Type: "blocks"},
+		// Opposite directions with different types are not a contradiction
+		{IssueID: "bd-a", DependsOnID: "bd-c", Type: "blocks"},
+		{IssueID: "bd-c", DependsOnID: "bd-a", Type: "parent-child"},
+		// Symmetric relation types are fine in both directions
+		{IssueID: "bd-b", DependsOnID: "bd-c", Type: "related"},
+		{IssueID: "bd-c", DependsOnID: "bd-b", Type: "related"},
+	}
+
+	pairs := FindContradictoryDependencies(edges, localIDs)
+	if len(pairs) != 1 {
+		t.Fatalf("got %d contradictory pairs, want 1: %+v", len(pairs), pairs)
+	}
+	if pairs[0].Forward.IssueID != "bd-a" || pairs[0].Reverse.IssueID != "bd-b" {
+		t.Errorf("pair = %+v, want bd-a↔bd-b", pairs[0])
+	}
+}
@@ -0,0 +1,130 @@
+package fix
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/storage/dolt"
+)
+
+// blankTitleWhere matches titles that are empty or only whitespace. TRIM
+// only strips spaces, so tabs and line breaks are removed first.
+const blankTitleWhere = `TRIM(REPLACE(REPLACE(REPLACE(title, '\t', ''), '\n', ''), '\r', '')) = ''`
+
+// PlaceholderTitle is the title the fix gives an issue with a blank one.
+func PlaceholderTitle(id string) string {
+	return fmt.Sprintf("(untitled %s)", id)
+}
+
+// DetectEmptyTitles returns the IDs of issues whose title is empty or only
+// whitespace, sorted.
+func DetectEmptyTitles(db *sql.DB) ([]string, error) {
+	rows, err := db.Query("SELECT id FROM issues WHERE " + blankTitleWhere + " ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query titles: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan issue id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// RetitleEmptyTitles gives every issue found by DetectEmptyTitles a
+// PlaceholderTitle, in one transaction committed to Dolt history, and
+// returns the IDs it changed.
+func RetitleEmptyTitles(db *sql.DB) ([]string, error) {
+	ids, err := DetectEmptyTitles(db)
+	if err != nil || len(ids) == 0 {
+		return ids, err
+	}
+
+	err = applyFixInTx(db, "doctor: set placeholder titles on untitled issues", func(tx *sql.Tx) error {
+		for _, id := range ids {
+			if _, err := tx.Exec("UPDATE issues SET title = ? WHERE id = ?", PlaceholderTitle(id), id); err != nil {
+				return fmt.Errorf("failed to retitle %s: %w", id, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// DeleteEmptyTitles deletes every issue found by DetectEmptyTitles through
+// the store's delete path, so extension rows, dependencies and events are
+// handled like 'bd delete --force', in one transaction committed to Dolt
+// history. Returns the IDs it deleted.
+func DeleteEmptyTitles(ctx context.Context, store *dolt.DoltStore) ([]string, error) {
+	ids, err := DetectEmptyTitles(store.DB())
+	if err != nil || len(ids) == 0 {
+		return ids, err
+	}
+	if _, err := store.DeleteIssues(ctx, ids, false, true, false); err != nil {
+		return nil, fmt.Errorf("failed to delete untitled issues (no changes kept): %w", err)
+	}
+	return ids, nil
+}
+
+// EmptyTitles repairs issues with empty or whitespace-only titles: each gets
+// a placeholder title, or is deleted when deleteEmpty is set.
+func EmptyTitles(path string, deleteEmpty, verbose bool) error {
+	if err := validateBeadsWorkspace(path); err != nil {
+		return err
+	}
+
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+
+	var ids []string
+	verb := "Set placeholder titles on"
+	if deleteEmpty {
+		ctx := context.Background()
+		store, err := dolt.NewFromConfig(ctx, beadsDir)
+		if err != nil {
+			fmt.Printf("  Empty titles fix skipped (%v)\n", err)
+			return nil
+		}
+		defer func() { _ = store.Close() }()
+		verb = "Deleted"
+		if ids, err = DeleteEmptyTitles(ctx, store); err != nil {
+			return err
+		}
+	} else {
+		db, err := openDoltDB(beadsDir)
+		if err != nil {
+			fmt.Printf("  Empty titles fix skipped (%v)\n", err)
+			return nil
+		}
+		defer db.Close()
+		if ids, err = RetitleEmptyTitles(db); err != nil {
+			return err
+		}
+	}
+	if len(ids) == 0 {
+		fmt.Println("  No empty titles to fix")
+		return nil
+	}
+
+	if verbose {
+		for _, id := range ids {
+			if deleteEmpty {
+				fmt.Printf("  Deleted %s\n", id)
+			} else {
+				fmt.Printf("  Retitled %s to %q\n", id, PlaceholderTitle(id))
+			}
+		}
+	}
+	fmt.Printf("  %s %d untitled issue(s): %s\n", verb, len(ids), strings.Join(ids, ", "))
+	return nil
+}
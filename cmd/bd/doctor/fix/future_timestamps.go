@@ -0,0 +1,157 @@
+package fix
+
+import (
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// FutureTimestampTolerance is how far ahead of the local clock a timestamp
+// may be before it counts as skewed. It absorbs ordinary clock drift
+// between machines sharing a database.
+const FutureTimestampTolerance = 5 * time.Minute
+
+// futureTimestampColumns are the issue timestamps that age, staleness and
+// SLA calculations read.
+var futureTimestampColumns = []string{"created_at", "updated_at", "closed_at"}
+
+// FutureTimestamp is one issue timestamp set later than the local clock.
+type FutureTimestamp struct {
+	IssueID string
+	Column  string
+	At      time.Time
+	Skew    time.Duration // How far At is ahead of the time of the check
+}
+
+func (f FutureTimestamp) String() string {
+	return fmt.Sprintf("%s %s +%s", f.IssueID, f.Column, formatSkew(f.Skew))
+}
+
+// formatSkew renders d to the minute, with whole days split out so skews
+// from a wrong year stay readable ("400d2h0m0s").
+func formatSkew(d time.Duration) string {
+	d = d.Round(time.Minute)
+	days := d / (24 * time.Hour)
+	if days == 0 {
+		return d.String()
+	}
+	return fmt.Sprintf("%dd%s", days, (d - days*24*time.Hour).String())
+}
+
+// LoadFutureTimestamps returns issue timestamps more than
+// FutureTimestampTolerance after now, ordered by issue ID.
+func LoadFutureTimestamps(db *sql.DB, now time.Time) ([]FutureTimestamp, error) {
+	cutoff := now.UTC().Add(FutureTimestampTolerance)
+	rows, err := db.Query(`
+		SELECT id, created_at, updated_at, closed_at FROM issues
+		WHERE created_at > ? OR updated_at > ? OR closed_at > ?
+		ORDER BY id
+	`, cutoff, cutoff, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query timestamps: %w", err)
+	}
+	defer rows.Close()
+
+	var found []FutureTimestamp
+	for rows.Next() {
+		var id string
+		var created, updated, closed sql.NullTime
+		if err := rows.Scan(&id, &created, &updated, &closed); err != nil {
+			return nil, fmt.Errorf("failed to scan timestamps: %w", err)
+		}
+		for i, t := range []sql.NullTime{created, updated, closed} {
+			if t.Valid && t.Time.After(cutoff) {
+				found = append(found, FutureTimestamp{
+					IssueID: id,
+					Column:  futureTimestampColumns[i],
+					At:      t.Time,
+					Skew:    t.Time.Sub(now),
+				})
+			}
+		}
+	}
+	return found, rows.Err()
+}
+
+// ClampFutureTimestamps sets every timestamp found by LoadFutureTimestamps
+// to now, in one transaction committed to Dolt history, and returns what it
+// changed. Nothing is changed if any update fails. Clamping to a
+// single instant keeps created_at <= updated_at, and an updated_at that was
+// not skewed is left as it was rather than bumped by ON UPDATE.
+func ClampFutureTimestamps(db *sql.DB, now time.Time) ([]FutureTimestamp, error) {
+	found, err := LoadFutureTimestamps(db, now)
+	if err != nil || len(found) == 0 {
+		return found, err
+	}
+
+	var ids []string
+	columns := make(map[string][]string)
+	for _, f := range found {
+		if columns[f.IssueID] == nil {
+			ids = append(ids, f.IssueID)
+		}
+		columns[f.IssueID] = append(columns[f.IssueID], f.Column)
+	}
+
+	now = now.UTC()
+	err = applyFixInTx(db, "doctor: clamp future timestamps", func(tx *sql.Tx) error {
+		for _, id := range ids {
+			set := make([]string, 0, len(futureTimestampColumns))
+			args := make([]interface{}, 0, len(futureTimestampColumns)+1)
+			for _, col := range columns[id] {
+				set = append(set, col+" = ?")
+				args = append(args, now)
+			}
+			if !slices.Contains(columns[id], "updated_at") {
+				set = append(set, "updated_at = updated_at")
+			}
+			args = append(args, id)
+			//nolint:gosec // G201: set holds only names from futureTimestampColumns
+			if _, err := tx.Exec(fmt.Sprintf("UPDATE issues SET %s WHERE id = ?", strings.Join(set, ", ")), args...); err != nil {
+				return fmt.Errorf("failed to clamp timestamps of %s: %w", id, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+// FutureTimestamps clamps issue timestamps set in the future to the current
+// time, so age, staleness and SLA checks see sane values.
+func FutureTimestamps(path string, verbose bool) error {
+	if err := validateBeadsWorkspace(path); err != nil {
+		return err
+	}
+
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+
+	db, err := openDoltDB(beadsDir)
+	if err != nil {
+		fmt.Printf("  Future timestamps fix skipped (%v)\n", err)
+		return nil
+	}
+	defer db.Close()
+
+	clamped, err := ClampFutureTimestamps(db, time.Now())
+	if err != nil {
+		return err
+	}
+	if len(clamped) == 0 {
+		fmt.Println("  No future timestamps to fix")
+		return nil
+	}
+
+	if verbose || len(clamped) < 20 {
+		for _, f := range clamped {
+			fmt.Printf("  Clamped %s to now\n", f)
+		}
+	}
+	fmt.Printf("  Fixed %d future timestamp(s)\n", len(clamped))
+	return nil
+}
@@ -0,0 +1,23 @@
+package fix
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFutureTimestampString(t *testing.T) {
+	tests := []struct {
+		skew time.Duration
+		want string
+	}{
+		{90 * time.Second, "bd-1 created_at +2m0s"},
+		{5*time.Hour + 20*time.Minute, "bd-1 created_at +5h20m0s"},
+		{400*24*time.Hour + 2*time.Hour, "bd-1 created_at +400d2h0m0s"},
+	}
+	for _, tt := range tests {
+		f := FutureTimestamp{IssueID: "bd-1", Column: "created_at", Skew: tt.skew}
+		if got := f.String(); got != tt.want {
+			t.Errorf("String() with skew %v = %q, want %q", tt.skew, got, tt.want)
+		}
+	}
+}
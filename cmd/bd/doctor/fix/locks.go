@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/steveyegge/beads/internal/lockfile"
 	"github.com/steveyegge/beads/internal/storage/dolt"
 )
 
@@ -23,45 +24,27 @@ func StaleLockFiles(path string) error {
 	var removed []string
 	var errors []string
 
-	// Remove stale bootstrap lock
-	bootstrapLockPath := filepath.Join(beadsDir, "dolt.bootstrap.lock")
-	if info, err := os.Stat(bootstrapLockPath); err == nil {
-		age := time.Since(info.ModTime())
-		if age > 5*time.Minute {
-			if err := os.Remove(bootstrapLockPath); err != nil {
-				errors = append(errors, fmt.Sprintf("dolt.bootstrap.lock: %v", err))
-			} else {
-				removed = append(removed, "dolt.bootstrap.lock")
-			}
+	// Remove stale bootstrap, sync, and dolt-access locks. These use flock,
+	// which is released on process exit, so once the owner is gone the file
+	// is just clutter that can confuse diagnostics. A lock whose recorded
+	// owner PID is still running is never removed.
+	for _, lock := range []struct {
+		file      string
+		threshold time.Duration
+	}{
+		{"dolt.bootstrap.lock", 5 * time.Minute},
+		{".sync.lock", 1 * time.Hour},
+		{"dolt-access.lock", 5 * time.Minute},
+	} {
+		lockPath := filepath.Join(beadsDir, lock.file)
+		info, err := os.Stat(lockPath)
+		if err != nil || !isStaleLock(lockPath, info, lock.threshold) {
+			continue
 		}
-	}
-
-	// Remove stale sync lock
-	syncLockPath := filepath.Join(beadsDir, ".sync.lock")
-	if info, err := os.Stat(syncLockPath); err == nil {
-		age := time.Since(info.ModTime())
-		if age > 1*time.Hour {
-			if err := os.Remove(syncLockPath); err != nil {
-				errors = append(errors, fmt.Sprintf(".sync.lock: %v", err))
-			} else {
-				removed = append(removed, ".sync.lock")
-			}
-		}
-	}
-
-	// Remove stale dolt-access.lock (embedded dolt advisory flock).
-	// This lock uses flock which is released on process exit, but the file
-	// persists and can confuse diagnostics or cause issues if flock behavior
-	// varies across platforms.
-	accessLockPath := filepath.Join(beadsDir, "dolt-access.lock")
-	if info, err := os.Stat(accessLockPath); err == nil {
-		age := time.Since(info.ModTime())
-		if age > 5*time.Minute {
-			if err := os.Remove(accessLockPath); err != nil {
-				errors = append(errors, fmt.Sprintf("dolt-access.lock: %v", err))
-			} else {
-				removed = append(removed, "dolt-access.lock")
-			}
+		if err := os.Remove(lockPath); err != nil {
+			errors = append(errors, fmt.Sprintf("%s: %v", lock.file, err))
+		} else {
+			removed = append(removed, lock.file)
 		}
 	}
 
@@ -107,3 +90,13 @@ func StaleLockFiles(path string) error {
 
 	return nil
 }
+
+// isStaleLock reports whether a lock file can be removed: its recorded owner
+// PID is no longer running, or (for locks without an owner PID) it is older
+// than threshold.
+func isStaleLock(lockPath string, info os.FileInfo, threshold time.Duration) bool {
+	if pid, ok := lockfile.ReadOwnerPID(lockPath); ok {
+		return !lockfile.ProcessAlive(pid)
+	}
+	return time.Since(info.ModTime()) > threshold
+}
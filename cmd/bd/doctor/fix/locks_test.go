@@ -1,6 +1,7 @@
 package fix
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -217,4 +218,54 @@ func TestStaleLockFiles(t *testing.T) {
 			t.Error("stale bootstrap lock should be removed")
 		}
 	})
+
+	t.Run("lock owned by dead PID removed", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		beadsDir := filepath.Join(tmpDir, ".beads")
+		if err := os.MkdirAll(beadsDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		// Fresh mtime: only the dead owner PID makes it stale
+		lockPath := filepath.Join(beadsDir, "dolt-access.lock")
+		if err := os.WriteFile(lockPath, []byte(fmt.Sprintf("%d\n", deadPID)), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := StaleLockFiles(tmpDir); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err := os.Stat(lockPath); !os.IsNotExist(err) {
+			t.Error("lock owned by a dead PID should be removed")
+		}
+	})
+
+	t.Run("old lock owned by live PID preserved", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		beadsDir := filepath.Join(tmpDir, ".beads")
+		if err := os.MkdirAll(beadsDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		lockPath := filepath.Join(beadsDir, "dolt.bootstrap.lock")
+		if err := os.WriteFile(lockPath, []byte(fmt.Sprintf("%d\n", os.Getpid())), 0600); err != nil {
+			t.Fatal(err)
+		}
+		oldTime := time.Now().Add(-10 * time.Minute)
+		if err := os.Chtimes(lockPath, oldTime, oldTime); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := StaleLockFiles(tmpDir); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err := os.Stat(lockPath); os.IsNotExist(err) {
+			t.Error("lock held by a live process must NOT be removed")
+		}
+	})
 }
+
+// deadPID is a PID above any real pid_max, so it never names a live process.
+const deadPID = 1 << 30
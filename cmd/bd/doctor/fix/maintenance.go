@@ -62,19 +62,22 @@ func StaleClosedIssues(path string) error {
 		return fmt.Errorf("failed to query issues: %w", err)
 	}
 
-	// Filter out pinned issues and delete the rest
+	// Filter out pinned issues and delete the rest, all or none
+	var toDelete []string
 	var deleted, skipped int
 	for _, issue := range issues {
 		if issue.Pinned {
 			skipped++
 			continue
 		}
-
-		if err := store.DeleteIssue(ctx, issue.ID); err != nil {
-			fmt.Printf("  Warning: failed to delete %s: %v\n", issue.ID, err)
-			continue
+		toDelete = append(toDelete, issue.ID)
+	}
+	if len(toDelete) > 0 {
+		result, err := store.DeleteIssues(ctx, toDelete, false, true, false)
+		if err != nil {
+			return fmt.Errorf("failed to delete stale closed issues (no changes kept): %w", err)
 		}
-		deleted++
+		deleted = result.DeletedCount
 	}
 
 	if deleted == 0 && skipped == 0 {
@@ -146,15 +149,12 @@ func PatrolPollution(path string) error {
 		return nil
 	}
 
-	// Delete all pollution beads
-	var deleted int
-	for _, id := range toDelete {
-		if err := store.DeleteIssue(ctx, id); err != nil {
-			fmt.Printf("  Warning: failed to delete %s: %v\n", id, err)
-			continue
-		}
-		deleted++
+	// Delete all pollution beads in one transaction, all or none
+	result, err := store.DeleteIssues(ctx, toDelete, false, true, false)
+	if err != nil {
+		return fmt.Errorf("failed to delete pollution beads (no changes kept): %w", err)
 	}
+	deleted := result.DeletedCount
 
 	// Report results
 	if patrolDigestCount > 0 {
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/configfile"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/storage/dolt"
@@ -79,13 +80,13 @@ func DatabaseVersionWithBdVersion(path string, bdVersion string) error {
 		// Import from JSONL if present (fresh clone with committed issues).
 		// This closes the chicken-and-egg gap where doctor --fix creates an
 		// empty Dolt store and then bd init refuses because the store exists.
-		jsonlPath := filepath.Join(beadsDir, "issues.jsonl")
+		jsonlPath := config.JSONLPath(beadsDir)
 		if _, statErr := os.Stat(jsonlPath); statErr == nil {
 			count, importErr := importJSONLIntoStore(ctx, store, jsonlPath)
 			if importErr != nil {
 				fmt.Printf("  Warning: failed to import from JSONL: %v\n", importErr)
 			} else if count > 0 {
-				fmt.Printf("  → Imported %d issues from issues.jsonl\n", count)
+				fmt.Printf("  → Imported %d issues from %s\n", count, filepath.Base(jsonlPath))
 			}
 		}
 
@@ -144,9 +145,9 @@ func FreshCloneImport(path string, bdVersion string) error {
 	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
 
 	// Check for JSONL file
-	jsonlPath := filepath.Join(beadsDir, "issues.jsonl")
+	jsonlPath := config.JSONLPath(beadsDir)
 	if _, err := os.Stat(jsonlPath); os.IsNotExist(err) {
-		return fmt.Errorf("no issues.jsonl found")
+		return fmt.Errorf("no %s found", filepath.Base(jsonlPath))
 	}
 
 	// Check if Dolt store exists
@@ -175,7 +176,7 @@ func FreshCloneImport(path string, bdVersion string) error {
 	if importErr != nil {
 		return fmt.Errorf("failed to import from JSONL: %w", importErr)
 	}
-	fmt.Printf("  → Imported %d issues from issues.jsonl\n", count)
+	fmt.Printf("  → Imported %d issues from %s\n", count, filepath.Base(jsonlPath))
 	return nil
 }
 
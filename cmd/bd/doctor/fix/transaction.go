@@ -0,0 +1,42 @@
+package fix
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// applyFixInTx runs apply in one SQL transaction and, once it commits,
+// records a Dolt commit with commitMsg. If apply returns an error nothing
+// it wrote is kept, so a fix that fails half way leaves the database as it
+// found it and the remaining fixes still run.
+//
+// The transaction and the Dolt commit share one connection: each pooled
+// connection has its own working set in Dolt server mode (GH#2455).
+func applyFixInTx(db *sql.DB, commitMsg string, apply func(tx *sql.Tx) error) error {
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Close()
+
+	// Explicit transaction so writes persist when @@autocommit is OFF
+	// (e.g. Dolt server started with --no-auto-commit).
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err := apply(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return fmt.Errorf("%w (no changes kept)", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+
+	_, _ = conn.ExecContext(ctx, "CALL DOLT_COMMIT('-Am', ?)", commitMsg) // Best effort: "nothing to commit" is benign; rows already persisted
+	return nil
+}
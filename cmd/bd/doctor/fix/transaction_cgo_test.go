@@ -0,0 +1,102 @@
+//go:build cgo
+
+package fix
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/configfile"
+	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestApplyFixInTx_RollsBackOnError(t *testing.T) {
+	port := fixTestServerPort()
+	if port == 0 {
+		t.Skip("Dolt test server not available, skipping")
+	}
+
+	beadsDir := filepath.Join(t.TempDir(), ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatalf("failed to create .beads: %v", err)
+	}
+	cfg := configfile.DefaultConfig()
+	cfg.Backend = configfile.BackendDolt
+	cfg.DoltMode = configfile.DoltModeServer
+	cfg.DoltServerHost = "127.0.0.1"
+	cfg.DoltServerPort = port
+	h := sha256.Sum256([]byte(t.Name() + fmt.Sprintf("%d", time.Now().UnixNano())))
+	cfg.DoltDatabase = "doctest_" + hex.EncodeToString(h[:6])
+	if err := cfg.Save(beadsDir); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+
+	ctx := context.Background()
+	store, err := dolt.NewFromConfig(ctx, beadsDir)
+	if err != nil {
+		t.Skipf("skipping: Dolt server not available: %v", err)
+	}
+	defer func() { _ = store.Close() }()
+	if err := store.SetConfig(ctx, "issue_prefix", "bd"); err != nil {
+		t.Fatalf("failed to set issue_prefix: %v", err)
+	}
+
+	var ids []string
+	for _, title := range []string{"first", "second"} {
+		issue := &types.Issue{Title: title, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("failed to create issue %q: %v", title, err)
+		}
+		ids = append(ids, issue.ID)
+	}
+	db := store.DB()
+
+	countIssues := func() int {
+		t.Helper()
+		var n int
+		if err := db.QueryRow("SELECT COUNT(*) FROM issues").Scan(&n); err != nil {
+			t.Fatalf("failed to count issues: %v", err)
+		}
+		return n
+	}
+
+	// The first delete succeeds, then the fix fails: neither may stick.
+	errBoom := errors.New("boom")
+	err = applyFixInTx(db, "test: failing fix", func(tx *sql.Tx) error {
+		if _, err := tx.Exec("DELETE FROM issues WHERE id = ?", ids[0]); err != nil {
+			return err
+		}
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("applyFixInTx error = %v, want it to wrap %v", err, errBoom)
+	}
+	if !strings.Contains(err.Error(), "no changes kept") {
+		t.Errorf("applyFixInTx error = %q, want it to say no changes were kept", err)
+	}
+	if got := countIssues(); got != 2 {
+		t.Fatalf("after failed fix: %d issues, want 2 (rolled back)", got)
+	}
+
+	// A fix that succeeds keeps its changes.
+	err = applyFixInTx(db, "test: working fix", func(tx *sql.Tx) error {
+		_, err := tx.Exec("DELETE FROM issues WHERE id = ?", ids[0])
+		return err
+	})
+	if err != nil {
+		t.Fatalf("applyFixInTx: %v", err)
+	}
+	if got := countIssues(); got != 1 {
+		t.Fatalf("after successful fix: %d issues, want 1", got)
+	}
+}
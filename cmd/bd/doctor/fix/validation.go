@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/configfile"
 )
 
@@ -36,7 +37,8 @@ func OrphanedDependencies(path string, verbose bool) error {
 	}
 	defer db.Close()
 
-	// Find orphaned dependencies (exclude external: cross-rig tracking refs, #1593)
+	// Find orphaned dependencies (exclude external: cross-rig tracking refs, #1593,
+	// and archived targets so the fix never drops edges that bd unarchive needs)
 	query := `
 		SELECT d.issue_id, d.depends_on_id
 		FROM dependencies d
@@ -44,7 +46,7 @@ func OrphanedDependencies(path string, verbose bool) error {
 		WHERE i.id IS NULL
 		  AND d.depends_on_id NOT LIKE 'external:%'
 	`
-	rows, err := db.Query(query)
+	rows, err := db.Query(query + ArchivedTargetsClause(db))
 	if err != nil {
 		return fmt.Errorf("failed to query orphaned dependencies: %w", err)
 	}
@@ -71,35 +73,26 @@ func OrphanedDependencies(path string, verbose bool) error {
 		return nil
 	}
 
-	// Delete orphaned dependencies
-	// Uses explicit transaction so writes persist when @@autocommit is OFF
-	// (e.g. Dolt server started with --no-auto-commit).
-	showIndividual := verbose || len(orphans) < 20
-	tx, err := db.Begin()
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	var removed int
-	for _, o := range orphans {
-		_, err := tx.Exec("DELETE FROM dependencies WHERE issue_id = ? AND depends_on_id = ?",
-			o.issueID, o.dependsOnID)
-		if err != nil {
-			fmt.Printf("  Warning: failed to remove %s→%s: %v\n", o.issueID, o.dependsOnID, err)
-		} else {
-			removed++
-			if showIndividual {
-				fmt.Printf("  Removed orphaned dependency: %s→%s\n", o.issueID, o.dependsOnID)
+	// Delete orphaned dependencies, all or none
+	err = applyFixInTx(db, "doctor: remove orphaned dependencies", func(tx *sql.Tx) error {
+		for _, o := range orphans {
+			if _, err := tx.Exec("DELETE FROM dependencies WHERE issue_id = ? AND depends_on_id = ?",
+				o.issueID, o.dependsOnID); err != nil {
+				return fmt.Errorf("failed to remove %s→%s: %w", o.issueID, o.dependsOnID, err)
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit orphaned dependency removals: %w", err)
-	}
-
-	// Commit changes in Dolt
-	_, _ = db.Exec("CALL DOLT_COMMIT('-Am', 'doctor: remove orphaned dependencies')") // Best effort: commit advisory; schema fix already applied in-memory
 
-	fmt.Printf("  Fixed %d orphaned dependency reference(s)\n", removed)
+	if verbose || len(orphans) < 20 {
+		for _, o := range orphans {
+			fmt.Printf("  Removed orphaned dependency: %s→%s\n", o.issueID, o.dependsOnID)
+		}
+	}
+	fmt.Printf("  Fixed %d orphaned dependency reference(s)\n", len(orphans))
 	return nil
 }
 
@@ -127,10 +120,10 @@ func ChildParentDependencies(path string, verbose bool) error {
 	query := `
 		SELECT d.issue_id, d.depends_on_id, d.type
 		FROM dependencies d
-		WHERE d.issue_id LIKE CONCAT(d.depends_on_id, '.%')
+		WHERE d.issue_id LIKE CONCAT(d.depends_on_id, ?, '%')
 		  AND d.type IN ('blocks', 'conditional-blocks', 'waits-for')
 	`
-	rows, err := db.Query(query)
+	rows, err := db.Query(query, config.GetIDHierarchyFromDir(beadsDir).Separator())
 	if err != nil {
 		return fmt.Errorf("failed to query child-parent dependencies: %w", err)
 	}
@@ -158,35 +151,27 @@ func ChildParentDependencies(path string, verbose bool) error {
 		return nil
 	}
 
-	// Delete child→parent blocking dependencies (preserving parent-child type)
-	// Uses explicit transaction so writes persist when @@autocommit is OFF
-	// (e.g. Dolt server started with --no-auto-commit).
-	showIndividual := verbose || len(badDeps) < 20
-	tx, err := db.Begin()
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	var removed int
-	for _, d := range badDeps {
-		_, err := tx.Exec("DELETE FROM dependencies WHERE issue_id = ? AND depends_on_id = ? AND type = ?",
-			d.issueID, d.dependsOnID, d.depType)
-		if err != nil {
-			fmt.Printf("  Warning: failed to remove %s→%s: %v\n", d.issueID, d.dependsOnID, err)
-		} else {
-			removed++
-			if showIndividual {
-				fmt.Printf("  Removed child→parent dependency: %s→%s\n", d.issueID, d.dependsOnID)
+	// Delete child→parent blocking dependencies (preserving parent-child
+	// type), all or none
+	err = applyFixInTx(db, "doctor: remove child-parent dependency anti-patterns", func(tx *sql.Tx) error {
+		for _, d := range badDeps {
+			if _, err := tx.Exec("DELETE FROM dependencies WHERE issue_id = ? AND depends_on_id = ? AND type = ?",
+				d.issueID, d.dependsOnID, d.depType); err != nil {
+				return fmt.Errorf("failed to remove %s→%s: %w", d.issueID, d.dependsOnID, err)
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit dependency removals: %w", err)
-	}
-
-	// Commit changes in Dolt
-	_, _ = db.Exec("CALL DOLT_COMMIT('-Am', 'doctor: remove child-parent dependency anti-patterns')") // Best effort: commit advisory; schema fix already applied in-memory
 
-	fmt.Printf("  Fixed %d child→parent dependency anti-pattern(s)\n", removed)
+	if verbose || len(badDeps) < 20 {
+		for _, d := range badDeps {
+			fmt.Printf("  Removed child→parent dependency: %s→%s\n", d.issueID, d.dependsOnID)
+		}
+	}
+	fmt.Printf("  Fixed %d child→parent dependency anti-pattern(s)\n", len(badDeps))
 	return nil
 }
 
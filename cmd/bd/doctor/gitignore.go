@@ -62,6 +62,9 @@ dolt-server.port
 # Backup data (auto-exported JSONL, local-only)
 backup/
 
+# Pending bulk-operation plans (bd apply, local-only)
+plans/
+
 # Legacy files (from pre-Dolt versions)
 *.db
 *.db?*
@@ -13,6 +13,7 @@ import (
 	"github.com/steveyegge/beads/internal/beads"
 	"github.com/steveyegge/beads/internal/git"
 	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/types"
 )
 
 // CheckIDFormat checks whether issues use hash-based or sequential IDs
@@ -481,8 +482,12 @@ func isHashID(id string) bool {
 	}
 
 	suffix := id[lastSeperatorIndex+1:]
-	// Strip hierarchical suffix like .1 or .1.2
-	baseSuffix := strings.Split(suffix, ".")[0]
+	// Strip hierarchical suffix like .1 or .1.2, whatever the workspace's
+	// hierarchy.separator
+	baseSuffix := suffix
+	if i := strings.IndexAny(suffix, types.HierarchySafeSeparators); i >= 0 {
+		baseSuffix = suffix[:i]
+	}
 
 	if len(baseSuffix) == 0 {
 		return false
@@ -100,6 +100,7 @@ func TestIsHashID(t *testing.T) {
 		{"no separator", "abc", false},
 		{"empty suffix", "bd-", false},
 		{"hierarchical hash", "bd-0jkc.1", true},
+		{"ulid", "bd-01j9z3k4m5n6p7q8r9s0t1v2w3", true},
 		{"hierarchical sequential", "bd-1.2", false},
 		{"uppercase rejected", "bd-ABCD", false},
 		{"special chars rejected", "bd-ab!c", false},
@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/steveyegge/beads/internal/lockfile"
 )
 
 // staleLockThresholds defines the age thresholds for each lock type.
@@ -33,36 +35,22 @@ func CheckStaleLockFiles(path string) DoctorCheck {
 	var staleFiles []string
 	var details []string
 
-	// Check bootstrap lock (dolt.bootstrap.lock)
-	bootstrapLockPath := filepath.Join(beadsDir, "dolt.bootstrap.lock")
-	if info, err := os.Stat(bootstrapLockPath); err == nil {
-		age := time.Since(info.ModTime())
-		if age > staleLockThresholds["bootstrap.lock"] {
-			staleFiles = append(staleFiles, "dolt.bootstrap.lock")
-			details = append(details, fmt.Sprintf("dolt.bootstrap.lock: age %s (threshold: %s)",
-				age.Round(time.Second), staleLockThresholds["bootstrap.lock"]))
-		}
-	}
-
-	// Check sync lock (.sync.lock)
-	syncLockPath := filepath.Join(beadsDir, ".sync.lock")
-	if info, err := os.Stat(syncLockPath); err == nil {
-		age := time.Since(info.ModTime())
-		if age > staleLockThresholds[".sync.lock"] {
-			staleFiles = append(staleFiles, ".sync.lock")
-			details = append(details, fmt.Sprintf(".sync.lock: age %s (threshold: %s)",
-				age.Round(time.Second), staleLockThresholds[".sync.lock"]))
+	// Check bootstrap, sync, and embedded dolt advisory locks. Locks that
+	// record an owner PID are judged by whether that process is alive;
+	// older lock files without one fall back to the age threshold.
+	for _, lock := range []struct{ file, threshold string }{
+		{"dolt.bootstrap.lock", "bootstrap.lock"},
+		{".sync.lock", ".sync.lock"},
+		{"dolt-access.lock", "dolt-access.lock"},
+	} {
+		lockPath := filepath.Join(beadsDir, lock.file)
+		info, err := os.Stat(lockPath)
+		if err != nil {
+			continue
 		}
-	}
-
-	// Check dolt-access.lock (embedded dolt advisory flock)
-	accessLockPath := filepath.Join(beadsDir, "dolt-access.lock")
-	if info, err := os.Stat(accessLockPath); err == nil {
-		age := time.Since(info.ModTime())
-		if age > staleLockThresholds["dolt-access.lock"] {
-			staleFiles = append(staleFiles, "dolt-access.lock")
-			details = append(details, fmt.Sprintf("dolt-access.lock: age %s (threshold: %s)",
-				age.Round(time.Second), staleLockThresholds["dolt-access.lock"]))
+		if detail, stale := staleLockDetail(lockPath, lock.file, info, staleLockThresholds[lock.threshold]); stale {
+			staleFiles = append(staleFiles, lock.file)
+			details = append(details, detail)
 		}
 	}
 
@@ -111,3 +99,19 @@ func CheckStaleLockFiles(path string) DoctorCheck {
 		Category: CategoryRuntime,
 	}
 }
+
+// staleLockDetail reports whether a lock file is stale and why. A lock whose
+// recorded owner PID is still running is never stale, however old it is.
+func staleLockDetail(lockPath, name string, info os.FileInfo, threshold time.Duration) (string, bool) {
+	age := time.Since(info.ModTime()).Round(time.Second)
+	if pid, ok := lockfile.ReadOwnerPID(lockPath); ok {
+		if lockfile.ProcessAlive(pid) {
+			return "", false
+		}
+		return fmt.Sprintf("%s: owner PID %d is not running, age %s", name, pid, age), true
+	}
+	if age > threshold {
+		return fmt.Sprintf("%s: age %s (threshold: %s)", name, age, threshold), true
+	}
+	return "", false
+}
@@ -1,8 +1,10 @@
 package doctor
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 )
@@ -218,4 +220,48 @@ func TestCheckStaleLockFiles(t *testing.T) {
 			t.Error("expected non-empty message for stale locks")
 		}
 	})
+
+	t.Run("lock owned by dead PID reports owner", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		beadsDir := filepath.Join(tmpDir, ".beads")
+		if err := os.MkdirAll(beadsDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		lockPath := filepath.Join(beadsDir, "dolt-access.lock")
+		if err := os.WriteFile(lockPath, []byte(fmt.Sprintf("%d\n", deadPID)), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		result := CheckStaleLockFiles(tmpDir)
+		if result.Status != StatusWarning {
+			t.Fatalf("expected Warning for dead-owner lock, got %s: %s", result.Status, result.Message)
+		}
+		if !strings.Contains(result.Detail, fmt.Sprintf("owner PID %d is not running", deadPID)) {
+			t.Errorf("detail should report the owner PID, got: %s", result.Detail)
+		}
+	})
+
+	t.Run("old lock owned by live PID not stale", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		beadsDir := filepath.Join(tmpDir, ".beads")
+		if err := os.MkdirAll(beadsDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		lockPath := filepath.Join(beadsDir, ".sync.lock")
+		if err := os.WriteFile(lockPath, []byte(fmt.Sprintf("%d\n", os.Getpid())), 0600); err != nil {
+			t.Fatal(err)
+		}
+		oldTime := time.Now().Add(-2 * time.Hour)
+		_ = os.Chtimes(lockPath, oldTime, oldTime)
+
+		result := CheckStaleLockFiles(tmpDir)
+		if result.Status != StatusOK {
+			t.Errorf("lock held by a live process should not be stale, got %s: %s", result.Status, result.Message)
+		}
+	})
 }
+
+// deadPID is a PID above any real pid_max, so it never names a live process.
+const deadPID = 1 << 30
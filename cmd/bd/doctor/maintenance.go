@@ -10,9 +10,11 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/configfile"
 	"github.com/steveyegge/beads/internal/storage/dolt"
 	"github.com/steveyegge/beads/internal/types"
@@ -128,6 +130,93 @@ func checkStaleClosedIssuesDB(db *sql.DB, thresholdDays int) DoctorCheck {
 	}
 }
 
+// CheckIssueAgeSLA flags non-closed issues older than the age SLA configured
+// for their priority under sla.by-priority. Age is measured from created_at.
+func CheckIssueAgeSLA(path string) DoctorCheck {
+	slas, err := config.SLAByPriority()
+	if err != nil {
+		return DoctorCheck{
+			Name:     "Issue Age SLA",
+			Status:   StatusWarning,
+			Message:  "Invalid SLA configuration",
+			Detail:   err.Error(),
+			Fix:      "Fix sla.by-priority in .beads/config.yaml",
+			Category: CategoryMaintenance,
+		}
+	}
+	if len(slas) == 0 {
+		return DoctorCheck{
+			Name:     "Issue Age SLA",
+			Status:   StatusOK,
+			Message:  "Disabled (set sla.by-priority to enable)",
+			Category: CategoryMaintenance,
+		}
+	}
+
+	_, beadsDir := getBackendAndBeadsDir(path)
+	db, store, err := openStoreDB(beadsDir)
+	if err != nil {
+		return DoctorCheck{
+			Name:     "Issue Age SLA",
+			Status:   StatusOK,
+			Message:  "N/A (unable to open database)",
+			Category: CategoryMaintenance,
+		}
+	}
+	defer func() { _ = store.Close() }()
+
+	return checkIssueAgeSLADB(db, slas, time.Now())
+}
+
+// checkIssueAgeSLADB is the core logic for CheckIssueAgeSLA.
+func checkIssueAgeSLADB(db *sql.DB, slas map[int]time.Duration, now time.Time) DoctorCheck {
+	priorities := make([]int, 0, len(slas))
+	for p := range slas {
+		priorities = append(priorities, p)
+	}
+	sort.Ints(priorities)
+
+	total := 0
+	var details []string
+	for _, p := range priorities {
+		cutoff := now.Add(-slas[p]).UTC()
+		var count int
+		err := db.QueryRow(
+			"SELECT COUNT(*) FROM issues WHERE status != 'closed' AND priority = ? AND created_at < ?",
+			p, cutoff,
+		).Scan(&count)
+		if err != nil {
+			return DoctorCheck{
+				Name:     "Issue Age SLA",
+				Status:   StatusOK,
+				Message:  "N/A (query failed)",
+				Category: CategoryMaintenance,
+			}
+		}
+		if count > 0 {
+			total += count
+			details = append(details, fmt.Sprintf("P%d: %d issue(s) open longer than %s", p, count, slas[p]))
+		}
+	}
+
+	if total == 0 {
+		return DoctorCheck{
+			Name:     "Issue Age SLA",
+			Status:   StatusOK,
+			Message:  "No open issues exceed their priority SLA",
+			Category: CategoryMaintenance,
+		}
+	}
+	return DoctorCheck{
+		Name:     "Issue Age SLA",
+		Status:   StatusWarning,
+		Message:  fmt.Sprintf("%d open issue(s) exceed their priority SLA", total),
+		Detail:   strings.Join(details, "\n"),
+		Fix:      "Run 'bd stats --sla' for details, then triage or re-prioritize",
+		Category: CategoryMaintenance,
+	}
+}
+
 // CheckStaleMolecules detects complete-but-unclosed molecules.
 // A molecule is stale if all children are closed but the root is still open.
 func CheckStaleMolecules(path string) DoctorCheck {
@@ -535,7 +624,7 @@ func loadMaintenanceIssuesFromDatabase(beadsDir string) ([]*types.Issue, error)
 }
 
 func loadMaintenanceIssuesFromJSONL(beadsDir string) ([]*types.Issue, error) {
-	jsonlPath := filepath.Join(beadsDir, "issues.jsonl")
+	jsonlPath := config.JSONLPath(beadsDir)
 	file, err := os.Open(jsonlPath) // #nosec G304 - path constructed safely
 	if err != nil {
 		return nil, err
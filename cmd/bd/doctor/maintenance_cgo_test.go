@@ -402,3 +402,70 @@ func TestClassifyPatrolIssue(t *testing.T) {
 		})
 	}
 }
+
+// Issue age SLA: issues just over the threshold warn, just under do not
+func TestCheckIssueAgeSLA_StraddlesThreshold(t *testing.T) {
+	store := newTestDoltStore(t, "test")
+	ctx := context.Background()
+	now := time.Now()
+
+	ages := map[string]time.Duration{
+		"old":    25 * time.Hour,
+		"recent": 23 * time.Hour,
+	}
+	for name, age := range ages {
+		issue := &types.Issue{
+			Title:     "SLA " + name,
+			Status:    types.StatusOpen,
+			Priority:  0,
+			IssueType: types.TypeTask,
+		}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+		if _, err := store.DB().Exec("UPDATE issues SET created_at = ? WHERE id = ?", now.Add(-age).UTC(), issue.ID); err != nil {
+			t.Fatalf("Failed to set created_at: %v", err)
+		}
+	}
+
+	check := checkIssueAgeSLADB(store.DB(), map[int]time.Duration{0: 24 * time.Hour}, now)
+	if check.Status != StatusWarning {
+		t.Fatalf("Status = %q, want %q", check.Status, StatusWarning)
+	}
+	if check.Message != "1 open issue(s) exceed their priority SLA" {
+		t.Errorf("Message = %q, want 1 breaching issue", check.Message)
+	}
+
+	check = checkIssueAgeSLADB(store.DB(), map[int]time.Duration{0: 48 * time.Hour}, now)
+	if check.Status != StatusOK {
+		t.Errorf("Status = %q, want %q with a 2d SLA", check.Status, StatusOK)
+	}
+}
+
+// Stale JSONL: an export older than the last issue change warns, a newer one does not
+func TestCheckStaleJSONL_ComparesExportTime(t *testing.T) {
+	store := newTestDoltStore(t, "test")
+	ctx := context.Background()
+
+	issue := &types.Issue{Title: "Changed after export", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	changed := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	if _, err := store.DB().Exec("UPDATE issues SET updated_at = ? WHERE id = ?", changed, issue.ID); err != nil {
+		t.Fatalf("Failed to set updated_at: %v", err)
+	}
+
+	check := checkStaleJSONLDB(store.DB(), "/repo/.beads/issues.jsonl", changed.Add(-time.Minute))
+	if check.Status != StatusWarning {
+		t.Fatalf("Status = %q, want %q for an export older than the last change", check.Status, StatusWarning)
+	}
+	if !strings.Contains(check.Fix, "bd export --jsonl --remove") {
+		t.Errorf("Fix = %q, want the --remove hint", check.Fix)
+	}
+
+	check = checkStaleJSONLDB(store.DB(), "/repo/.beads/issues.jsonl", changed.Add(time.Minute))
+	if check.Status != StatusOK {
+		t.Errorf("Status = %q, want %q for an export newer than the last change", check.Status, StatusOK)
+	}
+}
@@ -0,0 +1,218 @@
+package doctor
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// NearDuplicateThreshold is the title similarity at or above which two
+	// open issues are reported as likely duplicates.
+	NearDuplicateThreshold = 0.8
+
+	// nearDuplicateBucketCap bounds how many titles in one first-token bucket
+	// are compared pairwise, so a common opening word ("Fix ...") cannot turn
+	// the check quadratic in the size of the database.
+	nearDuplicateBucketCap = 200
+
+	// nearDuplicateComparisonCap bounds the total number of pairs scored.
+	nearDuplicateComparisonCap = 100000
+)
+
+// nearDuplicateStopwords are dropped before comparing titles, so "Fix the
+// login bug" and "Fix login bug" tokenize the same.
+var nearDuplicateStopwords = map[string]bool{
+	"a": true, "an": true, "the": true, "to": true, "of": true, "in": true,
+	"on": true, "for": true, "and": true, "or": true, "with": true, "is": true,
+}
+
+// TitleIssue is the part of an issue the near-duplicate check looks at.
+type TitleIssue struct {
+	ID    string
+	Title string
+}
+
+// NearDuplicatePair is two issues whose titles score at or above the
+// threshold. IDs are ordered so A < B.
+type NearDuplicatePair struct {
+	A, B  TitleIssue
+	Score float64
+}
+
+// FindNearDuplicateTitles scores title similarity between issues and
+// returns the pairs at or above threshold, best first. Only issues whose
+// titles start with the same significant word are compared; buckets are
+// capped at nearDuplicateBucketCap titles and the whole scan at
+// nearDuplicateComparisonCap pairs. truncated reports whether either cap
+// cut the scan short. Pairs are only reported, never merged.
+func FindNearDuplicateTitles(issues []TitleIssue, threshold float64) (pairs []NearDuplicatePair, truncated bool) {
+	type entry struct {
+		issue  TitleIssue
+		tokens map[string]bool
+		norm   string
+	}
+	buckets := make(map[string][]entry)
+	var keys []string
+	for _, issue := range issues {
+		words := titleWords(issue.Title)
+		if len(words) == 0 {
+			continue
+		}
+		tokens := make(map[string]bool, len(words))
+		for _, w := range words {
+			tokens[w] = true
+		}
+		key := words[0]
+		if _, ok := buckets[key]; !ok {
+			keys = append(keys, key)
+		}
+		buckets[key] = append(buckets[key], entry{issue: issue, tokens: tokens, norm: strings.Join(words, " ")})
+	}
+	sort.Strings(keys)
+
+	compared := 0
+	for _, key := range keys {
+		bucket := buckets[key]
+		if len(bucket) > nearDuplicateBucketCap {
+			bucket = bucket[:nearDuplicateBucketCap]
+			truncated = true
+		}
+		for i := 0; i < len(bucket); i++ {
+			for j := i + 1; j < len(bucket); j++ {
+				if compared >= nearDuplicateComparisonCap {
+					truncated = true
+					sortNearDuplicatePairs(pairs)
+					return pairs, truncated
+				}
+				compared++
+				score := titleSimilarity(bucket[i].tokens, bucket[j].tokens, bucket[i].norm, bucket[j].norm)
+				if score < threshold {
+					continue
+				}
+				a, b := bucket[i].issue, bucket[j].issue
+				if b.ID < a.ID {
+					a, b = b, a
+				}
+				pairs = append(pairs, NearDuplicatePair{A: a, B: b, Score: score})
+			}
+		}
+	}
+	sortNearDuplicatePairs(pairs)
+	return pairs, truncated
+}
+
+func sortNearDuplicatePairs(pairs []NearDuplicatePair) {
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Score != pairs[j].Score {
+			return pairs[i].Score > pairs[j].Score
+		}
+		if pairs[i].A.ID != pairs[j].A.ID {
+			return pairs[i].A.ID < pairs[j].A.ID
+		}
+		return pairs[i].B.ID < pairs[j].B.ID
+	})
+}
+
+// TitleSimilarity scores two titles from 0 (unrelated) to 1 (the same
+// after normalization).
+func TitleSimilarity(a, b string) float64 {
+	wa, wb := titleWords(a), titleWords(b)
+	ta := make(map[string]bool, len(wa))
+	for _, w := range wa {
+		ta[w] = true
+	}
+	tb := make(map[string]bool, len(wb))
+	for _, w := range wb {
+		tb[w] = true
+	}
+	return titleSimilarity(ta, tb, strings.Join(wa, " "), strings.Join(wb, " "))
+}
+
+// titleSimilarity takes the better of word-set Jaccard similarity, which
+// ignores word order and filler, and the Levenshtein ratio of the
+// normalized titles, which catches small spelling changes ("bug"/"bugs").
+// Titles that differ in a number ("Phase 1"/"Phase 2") are usually distinct
+// work, so only Jaccard applies to them.
+func titleSimilarity(ta, tb map[string]bool, na, nb string) float64 {
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+	shared := 0
+	for w := range ta {
+		if tb[w] {
+			shared++
+		}
+	}
+	jaccard := float64(shared) / float64(len(ta)+len(tb)-shared)
+	if jaccard == 1 {
+		return 1
+	}
+	if !sameNumbers(ta, tb) {
+		return jaccard
+	}
+	return max(jaccard, levenshteinRatio(na, nb))
+}
+
+// sameNumbers reports whether two token sets hold the same numeric tokens.
+func sameNumbers(ta, tb map[string]bool) bool {
+	hasAll := func(from, other map[string]bool) bool {
+		for w := range from {
+			if isNumber(w) && !other[w] {
+				return false
+			}
+		}
+		return true
+	}
+	return hasAll(ta, tb) && hasAll(tb, ta)
+}
+
+func isNumber(s string) bool {
+	for _, r := range s {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// titleWords lowercases a title, splits it on anything but letters and
+// digits, and drops stopwords.
+func titleWords(title string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	words := fields[:0]
+	for _, f := range fields {
+		if !nearDuplicateStopwords[f] {
+			words = append(words, f)
+		}
+	}
+	return words
+}
+
+// levenshteinRatio returns 1 - editDistance/maxLen over runes.
+func levenshteinRatio(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return 1 - float64(prev[len(rb)])/float64(longest)
+}
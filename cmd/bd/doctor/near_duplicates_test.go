@@ -0,0 +1,70 @@
+package doctor
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTitleSimilarity(t *testing.T) {
+	similar := [][2]string{
+		{"Fix login bug", "Fix the login bug"},
+		{"Fix login bug", "Fix login bugs"},
+		{"Add dark mode to settings", "Add dark-mode to the settings"},
+		{"Crash when saving file", "Crash when saving a file!"},
+		{"Update README", "update readme"},
+	}
+	for _, p := range similar {
+		if got := TitleSimilarity(p[0], p[1]); got < NearDuplicateThreshold {
+			t.Errorf("TitleSimilarity(%q, %q) = %.2f, want >= %.2f", p[0], p[1], got, NearDuplicateThreshold)
+		}
+	}
+
+	distinct := [][2]string{
+		{"Fix login bug", "Fix logout crash"},
+		{"Add dark mode", "Remove light theme"},
+		{"Write migration for seq column", "Document the config table"},
+		{"Fix login bug", ""},
+		{"The", "A"},
+		{"Phase 1 rollout", "Phase 2 rollout"},
+	}
+	for _, p := range distinct {
+		if got := TitleSimilarity(p[0], p[1]); got >= NearDuplicateThreshold {
+			t.Errorf("TitleSimilarity(%q, %q) = %.2f, want < %.2f", p[0], p[1], got, NearDuplicateThreshold)
+		}
+	}
+}
+
+func TestFindNearDuplicateTitles(t *testing.T) {
+	issues := []TitleIssue{
+		{ID: "bd-3", Title: "Fix the login bug"},
+		{ID: "bd-1", Title: "Fix login bug"},
+		{ID: "bd-2", Title: "Fix logout crash"},
+		{ID: "bd-4", Title: "Login bug fix"}, // Different first word: not compared
+		{ID: "bd-5", Title: "Crash when saving file"},
+		{ID: "bd-6", Title: "Crash when saving files"},
+	}
+	pairs, truncated := FindNearDuplicateTitles(issues, NearDuplicateThreshold)
+	if truncated {
+		t.Error("small input should not be truncated")
+	}
+	if len(pairs) != 2 {
+		t.Fatalf("pairs = %+v, want 2", pairs)
+	}
+	if pairs[0].A.ID != "bd-1" || pairs[0].B.ID != "bd-3" || pairs[0].Score != 1 {
+		t.Errorf("best pair = %+v, want bd-1/bd-3 at 1.0", pairs[0])
+	}
+	if pairs[1].A.ID != "bd-5" || pairs[1].B.ID != "bd-6" {
+		t.Errorf("second pair = %+v, want bd-5/bd-6", pairs[1])
+	}
+}
+
+func TestFindNearDuplicateTitles_BucketCap(t *testing.T) {
+	var issues []TitleIssue
+	for i := 0; i < nearDuplicateBucketCap+10; i++ {
+		issues = append(issues, TitleIssue{ID: fmt.Sprintf("bd-%d", i), Title: fmt.Sprintf("Fix issue %d", i)})
+	}
+	_, truncated := FindNearDuplicateTitles(issues, NearDuplicateThreshold)
+	if !truncated {
+		t.Error("oversized bucket should report truncation")
+	}
+}
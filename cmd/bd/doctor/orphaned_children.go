@@ -0,0 +1,54 @@
+package doctor
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/utils"
+)
+
+// ClassifyChildlikeIDs splits dotted issue IDs whose parent is missing into
+// genuine orphans and IDs that only look hierarchical. A genuine orphan has a
+// numeric last segment and a root (the part before the first dot) that uses
+// one of the known prefixes, e.g. bd-abc.1. IDs imported from other systems
+// such as "release-1.2.x" or "v2.0" are non-hierarchical: the dot is part of
+// the name, and re-parenting them would be wrong.
+// With no known prefixes, only the shape of the ID is checked. h gives the
+// workspace's hierarchy separator in place of the dot.
+func ClassifyChildlikeIDs(ids []string, knownPrefixes []string, h types.IDHierarchy) (orphans, nonHierarchical []string) {
+	known := make(map[string]bool, len(knownPrefixes))
+	for _, p := range knownPrefixes {
+		if p = strings.TrimSuffix(strings.TrimSpace(p), "-"); p != "" {
+			known[p] = true
+		}
+	}
+
+	for _, id := range ids {
+		if isGenuineChildID(id, knownPrefixes, known, h) {
+			orphans = append(orphans, id)
+		} else {
+			nonHierarchical = append(nonHierarchical, id)
+		}
+	}
+	return orphans, nonHierarchical
+}
+
+func isGenuineChildID(id string, knownPrefixes []string, known map[string]bool, h types.IDHierarchy) bool {
+	sep := h.Separator()
+	lastSep := strings.LastIndex(id, sep)
+	if lastSep <= 0 {
+		return false
+	}
+	if _, err := strconv.Atoi(id[lastSep+1:]); err != nil {
+		return false
+	}
+	root, _, _ := strings.Cut(id, sep)
+	if !strings.Contains(root, "-") {
+		return false
+	}
+	if len(known) == 0 {
+		return true
+	}
+	return known[utils.ExtractIssuePrefixKnown(root, knownPrefixes)]
+}
@@ -0,0 +1,50 @@
+package doctor
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestClassifyChildlikeIDs(t *testing.T) {
+	ids := []string{
+		"bd-abc.1",                           // genuine child of bd-abc
+		"bd-abc.1.2",                         // genuine grandchild
+		"hq-cv-x9.3",                         // allowed multi-hyphen prefix
+		"hq-cv-01j9z3k4m5n6p7q8r9s0t1v2w3.1", // ULID root (id.scheme=ulid)
+		"release-1.2",                        // imported: prefix "release" is not configured
+		"bd-v1.x",                            // last segment not numeric
+		"v2.0",                               // no prefix at all
+		"jira-PROJ-12.4a",                    // last segment not numeric
+	}
+	orphans, other := ClassifyChildlikeIDs(ids, []string{"bd", "hq-cv"}, types.IDHierarchy{})
+
+	wantOrphans := []string{"bd-abc.1", "bd-abc.1.2", "hq-cv-x9.3", "hq-cv-01j9z3k4m5n6p7q8r9s0t1v2w3.1"}
+	wantOther := []string{"release-1.2", "bd-v1.x", "v2.0", "jira-PROJ-12.4a"}
+	if !reflect.DeepEqual(orphans, wantOrphans) {
+		t.Errorf("orphans = %v, want %v", orphans, wantOrphans)
+	}
+	if !reflect.DeepEqual(other, wantOther) {
+		t.Errorf("non-hierarchical = %v, want %v", other, wantOther)
+	}
+}
+
+func TestClassifyChildlikeIDs_NoKnownPrefixes(t *testing.T) {
+	orphans, other := ClassifyChildlikeIDs([]string{"release-1.2", "v2.0"}, nil, types.IDHierarchy{})
+	if !reflect.DeepEqual(orphans, []string{"release-1.2"}) || !reflect.DeepEqual(other, []string{"v2.0"}) {
+		t.Errorf("got orphans=%v other=%v", orphans, other)
+	}
+}
+
+func TestClassifyChildlikeIDs_CustomSeparator(t *testing.T) {
+	h, err := types.NewIDHierarchy("~")
+	if err != nil {
+		t.Fatalf("NewIDHierarchy: %v", err)
+	}
+
+	orphans, other := ClassifyChildlikeIDs([]string{"bd-abc~1", "bd-abc~1~2", "bd-abc~x", "bd-abc.1"}, []string{"bd"}, h)
+	if !reflect.DeepEqual(orphans, []string{"bd-abc~1", "bd-abc~1~2"}) || !reflect.DeepEqual(other, []string{"bd-abc~x", "bd-abc.1"}) {
+		t.Errorf("got orphans=%v other=%v", orphans, other)
+	}
+}
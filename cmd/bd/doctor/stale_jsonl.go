@@ -0,0 +1,76 @@
+//go:build cgo
+
+package doctor
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/steveyegge/beads/internal/config"
+)
+
+// CheckStaleJSONL warns when the JSONL export (export.jsonl-path, default
+// .beads/issues.jsonl) exists but is older than the last issue change. Dolt
+// is the source of truth and nothing refreshes the file automatically, so a
+// leftover export misleads anyone reading it or diffing it in git. The file
+// is never deleted for the user.
+func CheckStaleJSONL(path string) DoctorCheck {
+	_, beadsDir := getBackendAndBeadsDir(path)
+	jsonlPath := config.JSONLPath(beadsDir)
+	info, err := os.Stat(jsonlPath)
+	if err != nil {
+		return DoctorCheck{
+			Name:     "Stale JSONL",
+			Status:   StatusOK,
+			Message:  "No JSONL export present",
+			Category: CategoryData,
+		}
+	}
+
+	db, store, err := openStoreDB(beadsDir)
+	if err != nil {
+		return DoctorCheck{
+			Name:     "Stale JSONL",
+			Status:   StatusOK,
+			Message:  "N/A (unable to open database)",
+			Category: CategoryData,
+		}
+	}
+	defer func() { _ = store.Close() }()
+
+	return checkStaleJSONLDB(db, jsonlPath, info.ModTime())
+}
+
+// checkStaleJSONLDB is the core logic for CheckStaleJSONL.
+func checkStaleJSONLDB(db *sql.DB, jsonlPath string, exportedAt time.Time) DoctorCheck {
+	var lastChange sql.NullTime
+	if err := db.QueryRow("SELECT MAX(updated_at) FROM issues").Scan(&lastChange); err != nil {
+		return DoctorCheck{
+			Name:     "Stale JSONL",
+			Status:   StatusOK,
+			Message:  "N/A (query failed)",
+			Category: CategoryData,
+		}
+	}
+	if !lastChange.Valid || !lastChange.Time.After(exportedAt) {
+		return DoctorCheck{
+			Name:     "Stale JSONL",
+			Status:   StatusOK,
+			Message:  "JSONL export is up to date",
+			Category: CategoryData,
+		}
+	}
+
+	return DoctorCheck{
+		Name:    "Stale JSONL",
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%s is older than the database and is not kept up to date", jsonlPath),
+		Detail: fmt.Sprintf("Exported %s; issues last changed %s. The Dolt database is the source of truth;\n"+
+			"the JSONL file is only rewritten by 'bd export --jsonl'.",
+			exportedAt.Format(time.RFC3339), lastChange.Time.Local().Format(time.RFC3339)),
+		Fix:      "Refresh it once with 'bd export --jsonl', or delete it with 'bd export --jsonl --remove'",
+		Category: CategoryData,
+	}
+}
@@ -10,9 +10,12 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/steveyegge/beads/cmd/bd/doctor/fix"
 	"github.com/steveyegge/beads/internal/configfile"
 	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/types"
 )
 
 // openStoreDB opens the beads database and returns the underlying *sql.DB for
@@ -56,7 +59,8 @@ func checkOrphanedDependenciesDB(db *sql.DB) DoctorCheck {
 	// Query for orphaned dependencies.
 	// Exclude external: refs — these are synthetic cross-rig tracking deps
 	// injected by the JSONL exporter and intentionally reference issues not
-	// present in the local database (#1593).
+	// present in the local database (#1593). Targets moved to issues_archive
+	// by bd archive still exist unless archive.resolve-references is off.
 	query := `
 		SELECT d.issue_id, d.depends_on_id, d.type
 		FROM dependencies d
@@ -64,7 +68,7 @@ func checkOrphanedDependenciesDB(db *sql.DB) DoctorCheck {
 		WHERE i.id IS NULL
 		  AND d.depends_on_id NOT LIKE 'external:%'
 	`
-	rows, err := db.Query(query)
+	rows, err := db.Query(query + fix.ArchivedTargetsClause(db))
 	if err != nil {
 		return DoctorCheck{
 			Name:    "Orphaned Dependencies",
@@ -199,6 +203,82 @@ func checkDuplicateIssuesDB(db *sql.DB, gastownMode bool, gastownThreshold int)
 	}
 }
 
+// CheckNearDuplicateTitles reports open issues whose titles are similar
+// enough to be likely duplicates (see FindNearDuplicateTitles).
+func CheckNearDuplicateTitles(path string) DoctorCheck {
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+
+	db, store, err := openStoreDB(beadsDir)
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Near-Duplicate Titles",
+			Status:  StatusOK,
+			Message: "N/A (no database)",
+		}
+	}
+	defer func() { _ = store.Close() }()
+
+	return checkNearDuplicateTitlesDB(db, NearDuplicateThreshold)
+}
+
+// maxNearDuplicateDetail caps how many pairs the check lists.
+const maxNearDuplicateDetail = 10
+
+// checkNearDuplicateTitlesDB is the core logic for CheckNearDuplicateTitles.
+func checkNearDuplicateTitlesDB(db *sql.DB, threshold float64) DoctorCheck {
+	rows, err := db.Query("SELECT id, title FROM issues WHERE status != 'closed' ORDER BY id")
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Near-Duplicate Titles",
+			Status:  StatusWarning,
+			Message: "N/A (query failed)",
+			Detail:  err.Error(),
+		}
+	}
+	defer rows.Close()
+
+	var issues []TitleIssue
+	for rows.Next() {
+		var issue TitleIssue
+		if err := rows.Scan(&issue.ID, &issue.Title); err != nil {
+			continue
+		}
+		issues = append(issues, issue)
+	}
+
+	pairs, truncated := FindNearDuplicateTitles(issues, threshold)
+	if len(pairs) == 0 {
+		message := "No near-duplicate titles"
+		if truncated {
+			message += " (scan capped)"
+		}
+		return DoctorCheck{
+			Name:    "Near-Duplicate Titles",
+			Status:  StatusOK,
+			Message: message,
+		}
+	}
+
+	var detail strings.Builder
+	for i, p := range pairs {
+		if i == maxNearDuplicateDetail {
+			fmt.Fprintf(&detail, "  ... and %d more\n", len(pairs)-i)
+			break
+		}
+		fmt.Fprintf(&detail, "  %s ~ %s (%.2f): %q / %q\n", p.A.ID, p.B.ID, p.Score, p.A.Title, p.B.Title)
+	}
+	if truncated {
+		detail.WriteString("  (scan capped; some titles were not compared)\n")
+	}
+	return DoctorCheck{
+		Name:    "Near-Duplicate Titles",
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d likely duplicate pair(s) among open issues", len(pairs)),
+		Detail:  strings.TrimRight(detail.String(), "\n"),
+		Fix:     "Review each pair with 'bd show <a> <b>' and close the duplicate with 'bd duplicate <id> --of <canonical>'; nothing is merged automatically",
+	}
+}
+
 // CheckTestPollution detects test issues that may have leaked into the database.
 func CheckTestPollution(path string) DoctorCheck {
 	// Follow redirect to resolve actual beads directory (bd-tvus fix)
@@ -338,7 +418,88 @@ func CheckChildParentDependencies(path string) DoctorCheck {
 	}
 	defer func() { _ = store.Close() }()
 
-	return checkChildParentDependenciesDB(db)
+	return checkChildParentDependenciesDB(db, store.IDHierarchy())
+}
+
+// CheckDuplicateDependencies detects dependency rows that describe the same
+// edge through different target spellings, and A→B / B→A contradictions.
+func CheckDuplicateDependencies(path string) DoctorCheck {
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+
+	db, store, err := openStoreDB(beadsDir)
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Duplicate Dependencies",
+			Status:  StatusOK,
+			Message: "N/A (no database)",
+		}
+	}
+	defer func() { _ = store.Close() }()
+
+	return checkDuplicateDependenciesDB(db)
+}
+
+// checkDuplicateDependenciesDB is the core logic for CheckDuplicateDependencies.
+func checkDuplicateDependenciesDB(db *sql.DB) DoctorCheck {
+	edges, localIDs, err := fix.LoadDependencyEdges(db)
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Duplicate Dependencies",
+			Status:  StatusWarning,
+			Message: "N/A (query failed)",
+			Detail:  err.Error(),
+		}
+	}
+
+	duplicates := fix.FindDuplicateDependencies(edges, localIDs)
+	contradictions := fix.FindContradictoryDependencies(edges, localIDs)
+	if len(duplicates) == 0 && len(contradictions) == 0 {
+		return DoctorCheck{
+			Name:     "Duplicate Dependencies",
+			Status:   StatusOK,
+			Message:  "No duplicate or contradictory dependencies",
+			Category: CategoryMetadata,
+		}
+	}
+
+	var redundant int
+	var details []string
+	for _, group := range duplicates {
+		for _, r := range group.Redundant {
+			redundant++
+			details = append(details, fmt.Sprintf("duplicate %s of %s", r, group.Keep))
+		}
+	}
+	for _, pair := range contradictions {
+		details = append(details, fmt.Sprintf("contradiction %s vs %s", pair.Forward, pair.Reverse))
+	}
+	detail := strings.Join(details, "; ")
+	if len(detail) > 200 {
+		detail = detail[:200] + "..."
+	}
+
+	var parts []string
+	fixHint := ""
+	if redundant > 0 {
+		parts = append(parts, fmt.Sprintf("%d redundant dependency row(s)", redundant))
+		fixHint = "Run 'bd doctor --fix' to remove redundant rows"
+	}
+	if len(contradictions) > 0 {
+		parts = append(parts, fmt.Sprintf("%d contradictory pair(s)", len(contradictions)))
+		if fixHint != "" {
+			fixHint += "; "
+		}
+		fixHint += "review contradictory pairs manually with 'bd dep remove'"
+	}
+
+	return DoctorCheck{
+		Name:     "Duplicate Dependencies",
+		Status:   StatusWarning,
+		Message:  strings.Join(parts, ", "),
+		Detail:   detail,
+		Fix:      fixHint,
+		Category: CategoryMetadata,
+	}
 }
 
 // checkDoltConflicts queries the Dolt server for unresolved merge conflicts (GH-2249).
@@ -418,17 +579,17 @@ func checkDoltConflicts(beadsDir string) DoctorCheck {
 }
 
 // checkChildParentDependenciesDB is the core logic for CheckChildParentDependencies.
-func checkChildParentDependenciesDB(db *sql.DB) DoctorCheck {
+func checkChildParentDependenciesDB(db *sql.DB, h types.IDHierarchy) DoctorCheck {
 	// Query for child→parent BLOCKING dependencies where issue_id starts with depends_on_id + "."
 	// Only matches blocking types (blocks, conditional-blocks, waits-for) that cause deadlock.
 	// Excludes 'parent-child' type which is a legitimate structural hierarchy relationship.
 	query := `
 		SELECT d.issue_id, d.depends_on_id
 		FROM dependencies d
-		WHERE d.issue_id LIKE CONCAT(d.depends_on_id, '.%')
+		WHERE d.issue_id LIKE CONCAT(d.depends_on_id, ?, '%')
 		  AND d.type IN ('blocks', 'conditional-blocks', 'waits-for')
 	`
-	rows, err := db.Query(query)
+	rows, err := db.Query(query, h.Separator())
 	if err != nil {
 		return DoctorCheck{
 			Name:    "Child-Parent Dependencies",
@@ -477,3 +638,528 @@ func checkChildParentDependenciesDB(db *sql.DB) DoctorCheck {
 		Category: CategoryMetadata,
 	}
 }
+
+// CheckOrphanedChildren detects dotted child IDs whose parent issue is
+// missing, reporting genuine orphans separately from IDs that only look
+// hierarchical (see ClassifyChildlikeIDs).
+func CheckOrphanedChildren(path string) DoctorCheck {
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+
+	db, store, err := openStoreDB(beadsDir)
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Orphaned Children",
+			Status:  StatusOK,
+			Message: "N/A (no database)",
+		}
+	}
+	defer func() { _ = store.Close() }()
+
+	return checkOrphanedChildrenDB(db, store.IDHierarchy())
+}
+
+// checkOrphanedChildrenDB is the core logic for CheckOrphanedChildren.
+func checkOrphanedChildrenDB(db *sql.DB, h types.IDHierarchy) DoctorCheck {
+	// Same parent derivation as the orphan_detection migration: strip the
+	// last separator and segment. A parent moved to the archive is not
+	// missing; its children are reported as such rather than as orphans.
+	sep := h.Separator()
+	parentExpr := `SUBSTRING(child.id, 1, LENGTH(child.id) - LENGTH(SUBSTRING_INDEX(child.id, ?, -1)) - 1)`
+	archivedExpr := "0"
+	var args []any
+	if fix.ArchiveResolvesReferences(db) {
+		archivedExpr = parentExpr + " IN (SELECT id FROM issues_archive)"
+		args = append(args, sep)
+	}
+	args = append(args, sep, sep)
+	query := `
+		SELECT child.id, ` + archivedExpr + `
+		FROM issues child
+		LEFT JOIN issues parent
+			ON parent.id = ` + parentExpr + `
+		WHERE child.id LIKE CONCAT('%', ?, '%')
+			AND parent.id IS NULL
+		ORDER BY child.id`
+
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Orphaned Children",
+			Status:  StatusWarning,
+			Message: "N/A (query failed)",
+			Detail:  err.Error(),
+		}
+	}
+	defer rows.Close()
+
+	var candidates, archivedParent []string
+	for rows.Next() {
+		var id string
+		var archived bool
+		if err := rows.Scan(&id, &archived); err != nil {
+			continue
+		}
+		if archived {
+			archivedParent = append(archivedParent, id)
+		} else {
+			candidates = append(candidates, id)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return DoctorCheck{
+			Name:    "Orphaned Children",
+			Status:  StatusWarning,
+			Message: "Row iteration error",
+			Detail:  err.Error(),
+		}
+	}
+
+	if len(candidates) == 0 && len(archivedParent) == 0 {
+		return DoctorCheck{
+			Name:     "Orphaned Children",
+			Status:   StatusOK,
+			Message:  "No orphaned child issues",
+			Category: CategoryData,
+		}
+	}
+
+	orphans, nonHierarchical := ClassifyChildlikeIDs(candidates, knownIssuePrefixes(db), h)
+
+	var parts, details []string
+	fixHint := ""
+	if len(orphans) > 0 {
+		parts = append(parts, fmt.Sprintf("%d orphaned child issue(s)", len(orphans)))
+		details = append(details, "orphans: "+strings.Join(orphans, ", "))
+		fixHint = "Recreate the missing parents with 'bd create --id <parent-id>' or move orphans under another parent"
+	}
+	if len(nonHierarchical) > 0 {
+		parts = append(parts, fmt.Sprintf("%d dotted ID(s) that are not children", len(nonHierarchical)))
+		details = append(details, "not hierarchical (leave as is): "+strings.Join(nonHierarchical, ", "))
+	}
+	if len(archivedParent) > 0 {
+		parts = append(parts, fmt.Sprintf("%d child issue(s) with an archived parent", len(archivedParent)))
+		details = append(details, "parent archived (see 'bd unarchive'): "+strings.Join(archivedParent, ", "))
+	}
+	detail := strings.Join(details, "; ")
+	if len(detail) > 300 {
+		detail = detail[:300] + "..."
+	}
+
+	// Dotted IDs that don't match the issue prefix are informational only.
+	status := StatusOK
+	if len(orphans) > 0 {
+		status = StatusWarning
+	}
+	return DoctorCheck{
+		Name:     "Orphaned Children",
+		Status:   status,
+		Message:  strings.Join(parts, ", "),
+		Detail:   detail,
+		Fix:      fixHint,
+		Category: CategoryData,
+	}
+}
+
+// CheckAttachmentPaths flags file attachments (see 'bd attach') whose path
+// no longer exists.
+func CheckAttachmentPaths(path string) DoctorCheck {
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+
+	db, store, err := openStoreDB(beadsDir)
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Attachment Paths",
+			Status:  StatusOK,
+			Message: "N/A (no database)",
+		}
+	}
+	defer func() { _ = store.Close() }()
+
+	return checkAttachmentPathsDB(db, path)
+}
+
+// checkAttachmentPathsDB is the core logic for CheckAttachmentPaths.
+func checkAttachmentPathsDB(db *sql.DB, repoRoot string) DoctorCheck {
+	rows, err := db.Query("SELECT issue_id, name, url_or_path FROM attachments ORDER BY issue_id, name")
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Attachment Paths",
+			Status:  StatusOK,
+			Message: "N/A (no attachments table)",
+		}
+	}
+	defer rows.Close()
+
+	var refs []attachmentRef
+	for rows.Next() {
+		var ref attachmentRef
+		if err := rows.Scan(&ref.IssueID, &ref.Name, &ref.Location); err == nil {
+			refs = append(refs, ref)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return DoctorCheck{
+			Name:    "Attachment Paths",
+			Status:  StatusWarning,
+			Message: "Row iteration error",
+			Detail:  err.Error(),
+		}
+	}
+
+	missing := missingAttachments(repoRoot, refs)
+	if len(missing) == 0 {
+		return DoctorCheck{
+			Name:     "Attachment Paths",
+			Status:   StatusOK,
+			Message:  fmt.Sprintf("All %d attachment(s) resolve", len(refs)),
+			Category: CategoryData,
+		}
+	}
+	detail := strings.Join(missing, ", ")
+	if len(detail) > 300 {
+		detail = detail[:300] + "..."
+	}
+	return DoctorCheck{
+		Name:     "Attachment Paths",
+		Status:   StatusWarning,
+		Message:  fmt.Sprintf("%d attachment(s) point to missing files", len(missing)),
+		Detail:   detail,
+		Fix:      "Re-attach moved files with 'bd attach add <id> <path> --name <name>' or remove them with 'bd attach rm <id> <name>'",
+		Category: CategoryData,
+	}
+}
+
+// knownIssuePrefixes returns issue_prefix plus allowed_prefixes from config.
+func knownIssuePrefixes(db *sql.DB) []string {
+	var prefixes []string
+	rows, err := db.Query("SELECT `key`, value FROM config WHERE `key` IN ('issue_prefix', 'allowed_prefixes')")
+	if err != nil {
+		return nil
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key, value string
+		if err := rows.Scan(&key, &value); err != nil {
+			continue
+		}
+		for _, p := range strings.Split(value, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				prefixes = append(prefixes, p)
+			}
+		}
+	}
+	return prefixes
+}
+
+// CheckFutureTimestamps flags issues whose created_at, updated_at or
+// closed_at is later than now (beyond fix.FutureTimestampTolerance), which
+// usually means a skewed clock or a bad import and breaks age and SLA math.
+func CheckFutureTimestamps(path string) DoctorCheck {
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+
+	db, store, err := openStoreDB(beadsDir)
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Future Timestamps",
+			Status:  StatusOK,
+			Message: "N/A (no database)",
+		}
+	}
+	defer func() { _ = store.Close() }()
+
+	return checkFutureTimestampsDB(db, time.Now())
+}
+
+// checkFutureTimestampsDB is the core logic for CheckFutureTimestamps.
+func checkFutureTimestampsDB(db *sql.DB, now time.Time) DoctorCheck {
+	found, err := fix.LoadFutureTimestamps(db, now)
+	if err != nil {
+		return DoctorCheck{
+			Name:     "Future Timestamps",
+			Status:   StatusWarning,
+			Message:  "N/A (query failed)",
+			Detail:   err.Error(),
+			Category: CategoryData,
+		}
+	}
+	if len(found) == 0 {
+		return DoctorCheck{
+			Name:     "Future Timestamps",
+			Status:   StatusOK,
+			Message:  "No timestamps in the future",
+			Category: CategoryData,
+		}
+	}
+
+	refs := make([]string, len(found))
+	for i, f := range found {
+		refs[i] = f.String()
+	}
+	detail := strings.Join(refs, ", ")
+	if len(detail) > 300 {
+		detail = detail[:300] + "..."
+	}
+	return DoctorCheck{
+		Name:     "Future Timestamps",
+		Status:   StatusWarning,
+		Message:  fmt.Sprintf("%d timestamp(s) in the future", len(found)),
+		Detail:   detail,
+		Fix:      "Run 'bd doctor --fix' to clamp them to the current time, and check the clock of the machine that wrote them",
+		Category: CategoryData,
+	}
+}
+
+// CheckBooleanColumns detects issue flags (ephemeral, pinned, is_template,
+// crystallizes) holding values other than 0 and 1, typically from imports.
+func CheckBooleanColumns(path string) DoctorCheck {
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+
+	db, store, err := openStoreDB(beadsDir)
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Boolean Columns",
+			Status:  StatusOK,
+			Message: "N/A (no database)",
+		}
+	}
+	defer func() { _ = store.Close() }()
+
+	return checkBooleanColumnsDB(db)
+}
+
+func checkBooleanColumnsDB(db *sql.DB) DoctorCheck {
+	found, err := fix.LoadBooleanDrift(db)
+	if err != nil {
+		return DoctorCheck{
+			Name:     "Boolean Columns",
+			Status:   StatusWarning,
+			Message:  "N/A (query failed)",
+			Detail:   err.Error(),
+			Category: CategoryData,
+		}
+	}
+	if len(found) == 0 {
+		return DoctorCheck{
+			Name:     "Boolean Columns",
+			Status:   StatusOK,
+			Message:  "All flags are 0 or 1",
+			Category: CategoryData,
+		}
+	}
+
+	refs := make([]string, len(found))
+	for i, b := range found {
+		refs[i] = b.String()
+	}
+	detail := strings.Join(refs, ", ")
+	if len(detail) > 300 {
+		detail = detail[:300] + "..."
+	}
+	return DoctorCheck{
+		Name:     "Boolean Columns",
+		Status:   StatusWarning,
+		Message:  fmt.Sprintf("%d flag value(s) outside 0/1", len(found)),
+		Detail:   detail,
+		Fix:      "Run 'bd doctor --fix' to set non-zero values to 1 and NULL to 0",
+		Category: CategoryData,
+	}
+}
+
+// CheckDanglingReferences detects rows in the extension tables (labels,
+// dependencies, comments, metadata, ...) whose issue_id no longer exists,
+// the whole-schema counterpart of CheckOrphanedDependencies.
+func CheckDanglingReferences(path string) DoctorCheck {
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+
+	db, store, err := openStoreDB(beadsDir)
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Dangling References",
+			Status:  StatusOK,
+			Message: "N/A (no database)",
+		}
+	}
+	defer func() { _ = store.Close() }()
+
+	return checkDanglingReferencesDB(db)
+}
+
+func checkDanglingReferencesDB(db *sql.DB) DoctorCheck {
+	found, err := fix.DetectDanglingReferences(db)
+	if err != nil {
+		return DoctorCheck{
+			Name:     "Dangling References",
+			Status:   StatusWarning,
+			Message:  "N/A (query failed)",
+			Detail:   err.Error(),
+			Category: CategoryData,
+		}
+	}
+	if len(found) == 0 {
+		return DoctorCheck{
+			Name:     "Dangling References",
+			Status:   StatusOK,
+			Message:  "All extension rows belong to existing issues",
+			Category: CategoryData,
+		}
+	}
+
+	total := 0
+	refs := make([]string, len(found))
+	for i, d := range found {
+		total += d.Count
+		refs[i] = d.String()
+	}
+	detail := strings.Join(refs, "; ")
+	if len(detail) > 300 {
+		detail = detail[:300] + "..."
+	}
+	return DoctorCheck{
+		Name:     "Dangling References",
+		Status:   StatusWarning,
+		Message:  fmt.Sprintf("%d row(s) reference missing issues", total),
+		Detail:   detail,
+		Fix:      "Run 'bd doctor --fix' to delete them",
+		Category: CategoryData,
+	}
+}
+
+// CheckTitleLength flags issues, wisps and archived issues whose title is
+// exactly types.MaxTitleLength characters long. Titles written before length
+// validation may have been truncated to fit the column, so they deserve a
+// look.
+func CheckTitleLength(path string) DoctorCheck {
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+
+	db, store, err := openStoreDB(beadsDir)
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Title Length",
+			Status:  StatusOK,
+			Message: "N/A (no database)",
+		}
+	}
+	defer func() { _ = store.Close() }()
+
+	return checkTitleLengthDB(db)
+}
+
+// titleLengthTables are the tables holding issue titles, with the suffix
+// shown after their IDs in the check's detail.
+var titleLengthTables = []struct{ table, suffix string }{
+	{"issues", ""},
+	{"wisps", ""},
+	{"issues_archive", " (archived)"},
+}
+
+func checkTitleLengthDB(db *sql.DB) DoctorCheck {
+	var ids []string
+	for _, t := range titleLengthTables {
+		//nolint:gosec // G201: table comes from the fixed list above
+		rows, err := db.Query(fmt.Sprintf("SELECT id FROM %s WHERE CHAR_LENGTH(title) >= ? ORDER BY id", t.table), types.MaxTitleLength)
+		if err != nil {
+			if t.table != "issues" && strings.Contains(err.Error(), "doesn't exist") {
+				continue // Not migrated yet
+			}
+			return DoctorCheck{
+				Name:     "Title Length",
+				Status:   StatusWarning,
+				Message:  "N/A (query failed)",
+				Detail:   err.Error(),
+				Category: CategoryData,
+			}
+		}
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err == nil {
+				ids = append(ids, id+t.suffix)
+			}
+		}
+		err = rows.Err()
+		_ = rows.Close()
+		if err != nil {
+			return DoctorCheck{
+				Name:     "Title Length",
+				Status:   StatusWarning,
+				Message:  "Row iteration error",
+				Detail:   err.Error(),
+				Category: CategoryData,
+			}
+		}
+	}
+
+	if len(ids) == 0 {
+		return DoctorCheck{
+			Name:     "Title Length",
+			Status:   StatusOK,
+			Message:  fmt.Sprintf("No titles at the %d-character limit", types.MaxTitleLength),
+			Category: CategoryData,
+		}
+	}
+
+	detail := strings.Join(ids, ", ")
+	if len(detail) > 200 {
+		detail = detail[:200] + "..."
+	}
+	return DoctorCheck{
+		Name:     "Title Length",
+		Status:   StatusWarning,
+		Message:  fmt.Sprintf("%d title(s) at the %d-character limit (possibly truncated)", len(ids), types.MaxTitleLength),
+		Detail:   detail,
+		Fix:      "Review them and restore the full title with 'bd update <id> --title' or move the rest into the description ('bd unarchive' archived ones first)",
+		Category: CategoryData,
+	}
+}
+
+// CheckEmptyTitles flags issues whose title is empty or only whitespace.
+// NOT NULL lets an empty string through, so bad imports and scripts can
+// leave issues that render as blank lines.
+func CheckEmptyTitles(path string) DoctorCheck {
+	beadsDir := resolveBeadsDir(filepath.Join(path, ".beads"))
+
+	db, store, err := openStoreDB(beadsDir)
+	if err != nil {
+		return DoctorCheck{
+			Name:    "Empty Titles",
+			Status:  StatusOK,
+			Message: "N/A (no database)",
+		}
+	}
+	defer func() { _ = store.Close() }()
+
+	return checkEmptyTitlesDB(db)
+}
+
+func checkEmptyTitlesDB(db *sql.DB) DoctorCheck {
+	ids, err := fix.DetectEmptyTitles(db)
+	if err != nil {
+		return DoctorCheck{
+			Name:     "Empty Titles",
+			Status:   StatusWarning,
+			Message:  "N/A (query failed)",
+			Detail:   err.Error(),
+			Category: CategoryData,
+		}
+	}
+	if len(ids) == 0 {
+		return DoctorCheck{
+			Name:     "Empty Titles",
+			Status:   StatusOK,
+			Message:  "All issues have a title",
+			Category: CategoryData,
+		}
+	}
+
+	detail := strings.Join(ids, ", ")
+	if len(detail) > 200 {
+		detail = detail[:200] + "..."
+	}
+	return DoctorCheck{
+		Name:     "Empty Titles",
+		Status:   StatusWarning,
+		Message:  fmt.Sprintf("%d issue(s) with an empty or whitespace-only title", len(ids)),
+		Detail:   detail,
+		Fix:      "Run 'bd doctor --fix' to set placeholder titles, or add --delete-empty to delete them",
+		Category: CategoryData,
+	}
+}
@@ -9,9 +9,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/steveyegge/beads/cmd/bd/doctor/fix"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/configfile"
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -411,7 +414,7 @@ func TestCheckChildParentDependenciesDB_NoDeps(t *testing.T) {
 		t.Fatalf("Failed to create parent: %v", err)
 	}
 
-	check := checkChildParentDependenciesDB(store.DB())
+	check := checkChildParentDependenciesDB(store.DB(), types.IDHierarchy{})
 
 	if check.Status != StatusOK {
 		t.Errorf("Status = %q, want %q", check.Status, StatusOK)
@@ -460,7 +463,7 @@ func TestCheckChildParentDependenciesDB_BlockingDetected(t *testing.T) {
 		t.Fatalf("Failed to insert dependency: %v", err)
 	}
 
-	check := checkChildParentDependenciesDB(db)
+	check := checkChildParentDependenciesDB(db, types.IDHierarchy{})
 
 	if check.Status != StatusWarning {
 		t.Errorf("Status = %q, want %q", check.Status, StatusWarning)
@@ -500,7 +503,7 @@ func TestCheckChildParentDependenciesDB_NonBlockingIgnored(t *testing.T) {
 		t.Fatalf("Failed to insert dependency: %v", err)
 	}
 
-	check := checkChildParentDependenciesDB(db)
+	check := checkChildParentDependenciesDB(db, types.IDHierarchy{})
 
 	if check.Status != StatusOK {
 		t.Errorf("Status = %q, want %q (parent-child type should be ignored)", check.Status, StatusOK)
@@ -618,3 +621,422 @@ func TestCheckGitConflicts_DoltBackend_Clean(t *testing.T) {
 		t.Fatal("Expected no conflicts in clean database")
 	}
 }
+
+// TestCheckDuplicateDependenciesDB verifies that redundant spellings of the
+// same edge and A→B / B→A blocking pairs are both reported.
+func TestCheckDuplicateDependenciesDB(t *testing.T) {
+	store := newTestDoltStore(t, "test")
+	ctx := context.Background()
+
+	a := &types.Issue{Title: "A", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	b := &types.Issue{Title: "B", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	for _, issue := range []*types.Issue{a, b} {
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+	}
+
+	db := store.DB()
+	check := checkDuplicateDependenciesDB(db)
+	if check.Status != StatusOK {
+		t.Fatalf("Status = %q, want %q with no dependencies", check.Status, StatusOK)
+	}
+
+	for _, dep := range [][2]string{
+		{a.ID, b.ID},
+		{a.ID, "external:test:" + b.ID},
+	} {
+		if _, err := db.ExecContext(ctx,
+			`INSERT INTO dependencies (issue_id, depends_on_id, type, created_at, created_by) VALUES (?, ?, 'blocks', NOW(), 'test')`,
+			dep[0], dep[1]); err != nil {
+			t.Fatalf("Failed to insert dependency: %v", err)
+		}
+	}
+	check = checkDuplicateDependenciesDB(db)
+	if check.Status != StatusWarning || check.Message != "1 redundant dependency row(s)" {
+		t.Errorf("got (%q, %q), want warning for 1 redundant row", check.Status, check.Message)
+	}
+
+	if _, err := db.ExecContext(ctx,
+		`INSERT INTO dependencies (issue_id, depends_on_id, type, created_at, created_by) VALUES (?, ?, 'blocks', NOW(), 'test')`,
+		b.ID, a.ID); err != nil {
+		t.Fatalf("Failed to insert dependency: %v", err)
+	}
+	check = checkDuplicateDependenciesDB(db)
+	if check.Message != "1 redundant dependency row(s), 1 contradictory pair(s)" {
+		t.Errorf("Message = %q, want redundant row and contradictory pair", check.Message)
+	}
+}
+
+func TestCheckFutureTimestampsDB(t *testing.T) {
+	store := newTestDoltStore(t, "test")
+	ctx := context.Background()
+
+	past := &types.Issue{Title: "Past", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	future := &types.Issue{Title: "Future", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	for _, issue := range []*types.Issue{past, future} {
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+	}
+
+	db := store.DB()
+	now := time.Now().UTC()
+	if check := checkFutureTimestampsDB(db, now); check.Status != StatusOK {
+		t.Fatalf("Status = %q, want %q before skewing", check.Status, StatusOK)
+	}
+
+	// A row written by a machine whose clock ran three days ahead
+	if _, err := db.ExecContext(ctx, "UPDATE issues SET created_at = ?, updated_at = ? WHERE id = ?",
+		now.Add(72*time.Hour), now.Add(72*time.Hour), future.ID); err != nil {
+		t.Fatalf("Failed to skew timestamps: %v", err)
+	}
+	check := checkFutureTimestampsDB(db, now)
+	if check.Status != StatusWarning || check.Message != "2 timestamp(s) in the future" {
+		t.Fatalf("got (%q, %q), want warning for 2 timestamps", check.Status, check.Message)
+	}
+	if !strings.Contains(check.Detail, future.ID+" created_at +3d") || strings.Contains(check.Detail, past.ID) {
+		t.Errorf("Detail = %q, want only %s with its skew", check.Detail, future.ID)
+	}
+
+	clamped, err := fix.ClampFutureTimestamps(db, now)
+	if err != nil {
+		t.Fatalf("ClampFutureTimestamps: %v", err)
+	}
+	if len(clamped) != 2 {
+		t.Errorf("clamped %v, want created_at and updated_at of %s", clamped, future.ID)
+	}
+	if check := checkFutureTimestampsDB(db, now); check.Status != StatusOK {
+		t.Errorf("Status after clamping = %q (%s), want %q", check.Status, check.Detail, StatusOK)
+	}
+}
+
+func TestCheckBooleanColumnsDB(t *testing.T) {
+	store := newTestDoltStore(t, "test")
+	ctx := context.Background()
+
+	clean := &types.Issue{Title: "Clean", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	dirty := &types.Issue{Title: "Dirty", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	for _, issue := range []*types.Issue{clean, dirty} {
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+	}
+
+	db := store.DB()
+	if check := checkBooleanColumnsDB(db); check.Status != StatusOK {
+		t.Fatalf("Status = %q, want %q before corrupting", check.Status, StatusOK)
+	}
+
+	// What a sloppy import leaves behind
+	if _, err := db.ExecContext(ctx, "UPDATE issues SET pinned = 2, is_template = -1, crystallizes = NULL WHERE id = ?", dirty.ID); err != nil {
+		t.Fatalf("Failed to corrupt flags: %v", err)
+	}
+	check := checkBooleanColumnsDB(db)
+	if check.Status != StatusWarning || check.Message != "3 flag value(s) outside 0/1" {
+		t.Fatalf("got (%q, %q), want warning for 3 values", check.Status, check.Message)
+	}
+	for _, want := range []string{dirty.ID + " pinned=2", dirty.ID + " is_template=-1", dirty.ID + " crystallizes=NULL"} {
+		if !strings.Contains(check.Detail, want) {
+			t.Errorf("Detail = %q, want it to contain %q", check.Detail, want)
+		}
+	}
+	if strings.Contains(check.Detail, clean.ID) {
+		t.Errorf("Detail = %q, want %s left out", check.Detail, clean.ID)
+	}
+
+	fixed, err := fix.NormalizeBooleanColumns(db)
+	if err != nil {
+		t.Fatalf("NormalizeBooleanColumns: %v", err)
+	}
+	if len(fixed) != 3 {
+		t.Errorf("fixed %v, want 3 values", fixed)
+	}
+	if check := checkBooleanColumnsDB(db); check.Status != StatusOK {
+		t.Errorf("Status after fixing = %q (%s), want %q", check.Status, check.Detail, StatusOK)
+	}
+	var pinned, isTemplate, crystallizes int
+	if err := db.QueryRowContext(ctx, "SELECT pinned, is_template, crystallizes FROM issues WHERE id = ?", dirty.ID).
+		Scan(&pinned, &isTemplate, &crystallizes); err != nil {
+		t.Fatalf("Failed to read flags: %v", err)
+	}
+	if pinned != 1 || isTemplate != 1 || crystallizes != 0 {
+		t.Errorf("flags = (%d, %d, %d), want (1, 1, 0)", pinned, isTemplate, crystallizes)
+	}
+}
+
+func TestCheckDanglingReferencesDB(t *testing.T) {
+	store := newTestDoltStore(t, "test")
+	ctx := context.Background()
+
+	kept := &types.Issue{Title: "Kept", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, kept, "test"); err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	if err := store.AddLabel(ctx, kept.ID, "keep", "test"); err != nil {
+		t.Fatalf("Failed to add label: %v", err)
+	}
+
+	db := store.DB()
+	if check := checkDanglingReferencesDB(db); check.Status != StatusOK {
+		t.Fatalf("Status = %q (%s), want %q before corrupting", check.Status, check.Detail, StatusOK)
+	}
+
+	// The foreign keys cascade deletes, so rows for a missing issue can only
+	// be written with the checks off, as a careless import or merge would
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatalf("Failed to pin connection: %v", err)
+	}
+	for _, stmt := range []string{
+		"SET foreign_key_checks = 0",
+		"INSERT INTO labels (issue_id, label) VALUES ('test-gone', 'stale'), ('test-gone', 'old')",
+		"INSERT INTO dependencies (issue_id, depends_on_id, type, created_by) VALUES ('test-gone', '" + kept.ID + "', 'blocks', 'test')",
+		"INSERT INTO comments (issue_id, author, text) VALUES ('test-lost', 'alice', 'Nobody reads this')",
+		"SET foreign_key_checks = 1",
+	} {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			_ = conn.Close()
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+	_ = conn.Close()
+
+	check := checkDanglingReferencesDB(db)
+	if check.Status != StatusWarning || check.Message != "4 row(s) reference missing issues" {
+		t.Fatalf("got (%q, %q), want warning for 4 rows", check.Status, check.Message)
+	}
+	for _, want := range []string{"dependencies: 1 row(s) for test-gone", "labels: 2 row(s) for test-gone", "comments: 1 row(s) for test-lost"} {
+		if !strings.Contains(check.Detail, want) {
+			t.Errorf("Detail = %q, want it to contain %q", check.Detail, want)
+		}
+	}
+
+	removed, err := fix.RemoveDanglingReferences(db)
+	if err != nil {
+		t.Fatalf("RemoveDanglingReferences: %v", err)
+	}
+	if len(removed) != 3 {
+		t.Errorf("removed %v, want rows from 3 tables", removed)
+	}
+	if check := checkDanglingReferencesDB(db); check.Status != StatusOK {
+		t.Errorf("Status after fixing = %q (%s), want %q", check.Status, check.Detail, StatusOK)
+	}
+	labels, err := store.GetLabels(ctx, kept.ID)
+	if err != nil {
+		t.Fatalf("GetLabels: %v", err)
+	}
+	if len(labels) != 1 || labels[0] != "keep" {
+		t.Errorf("labels of %s = %v, want [keep] untouched", kept.ID, labels)
+	}
+}
+
+func TestCheckTitleLengthDB(t *testing.T) {
+	store := newTestDoltStore(t, "test")
+	ctx := context.Background()
+
+	full := &types.Issue{Title: strings.Repeat("t", types.MaxTitleLength), Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	short := &types.Issue{Title: strings.Repeat("t", types.MaxTitleLength-1), Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	wisp := &types.Issue{Title: strings.Repeat("w", types.MaxTitleLength), Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Ephemeral: true}
+	for _, issue := range []*types.Issue{short, full, wisp} {
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+	}
+	if _, err := store.DB().ExecContext(ctx, "INSERT INTO issues_archive (id, title, status) VALUES ('test-archived', ?, 'closed')",
+		strings.Repeat("a", types.MaxTitleLength)); err != nil {
+		t.Fatalf("Failed to insert archived issue: %v", err)
+	}
+
+	check := checkTitleLengthDB(store.DB())
+	if check.Status != StatusWarning || check.Message != "3 title(s) at the 500-character limit (possibly truncated)" {
+		t.Fatalf("got (%q, %q), want warning for 3 titles", check.Status, check.Message)
+	}
+	if want := full.ID + ", " + wisp.ID + ", test-archived (archived)"; check.Detail != want {
+		t.Errorf("Detail = %q, want %q", check.Detail, want)
+	}
+}
+
+func TestCheckEmptyTitlesDB(t *testing.T) {
+	store := newTestDoltStore(t, "test")
+	ctx := context.Background()
+	db := store.DB()
+
+	// Validation now rejects these, so write them the way a bad import would
+	for id, title := range map[string]string{"test-empty": "", "test-blank": " \t\n", "test-ok": "Has a title"} {
+		if _, err := db.ExecContext(ctx,
+			`INSERT INTO issues (id, title, description, design, acceptance_criteria, notes, status, priority, issue_type, created_at, updated_at)
+			 VALUES (?, ?, '', '', '', '', 'open', 2, 'task', NOW(), NOW())`, id, title); err != nil {
+			t.Fatalf("Failed to insert %s: %v", id, err)
+		}
+	}
+	if _, err := db.ExecContext(ctx, "INSERT INTO labels (issue_id, label) VALUES ('test-blank', 'imported')"); err != nil {
+		t.Fatalf("Failed to add label: %v", err)
+	}
+
+	check := checkEmptyTitlesDB(db)
+	if check.Status != StatusWarning || check.Message != "2 issue(s) with an empty or whitespace-only title" {
+		t.Fatalf("got (%q, %q), want warning for 2 issues", check.Status, check.Message)
+	}
+	if check.Detail != "test-blank, test-empty" {
+		t.Errorf("Detail = %q, want test-blank, test-empty", check.Detail)
+	}
+
+	retitled, err := fix.RetitleEmptyTitles(db)
+	if err != nil {
+		t.Fatalf("RetitleEmptyTitles: %v", err)
+	}
+	if len(retitled) != 2 {
+		t.Fatalf("retitled %v, want 2 issues", retitled)
+	}
+	var title string
+	if err := db.QueryRowContext(ctx, "SELECT title FROM issues WHERE id = 'test-empty'").Scan(&title); err != nil {
+		t.Fatalf("Failed to read title: %v", err)
+	}
+	if title != "(untitled test-empty)" {
+		t.Errorf("title = %q, want (untitled test-empty)", title)
+	}
+	if check := checkEmptyTitlesDB(db); check.Status != StatusOK {
+		t.Errorf("after retitle: got (%q, %q), want ok", check.Status, check.Message)
+	}
+
+	// --delete-empty removes the issue and its extension rows instead
+	if _, err := db.ExecContext(ctx, "UPDATE issues SET title = ' ' WHERE id = 'test-blank'"); err != nil {
+		t.Fatalf("Failed to blank title: %v", err)
+	}
+	deleted, err := fix.DeleteEmptyTitles(ctx, store)
+	if err != nil {
+		t.Fatalf("DeleteEmptyTitles: %v", err)
+	}
+	if len(deleted) != 1 || deleted[0] != "test-blank" {
+		t.Fatalf("deleted %v, want [test-blank]", deleted)
+	}
+	var n int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM labels WHERE issue_id = 'test-blank'").Scan(&n); err != nil {
+		t.Fatalf("Failed to count labels: %v", err)
+	}
+	if n != 0 {
+		t.Errorf("%d label row(s) left for the deleted issue", n)
+	}
+}
+
+func TestCheckOrphanedChildrenDB_CustomSeparator(t *testing.T) {
+	h, err := types.NewIDHierarchy(":")
+	if err != nil {
+		t.Fatalf("NewIDHierarchy: %v", err)
+	}
+
+	store := newTestDoltStore(t, "test")
+	ctx := context.Background()
+	db := store.DB()
+
+	// test-par:1 has its parent; test-gone:1 does not. test-v1.2 has a
+	// dot, which no longer marks a child.
+	for _, id := range []string{"test-par", "test-par:1", "test-gone:1", "test-v1.2"} {
+		if _, err := db.ExecContext(ctx,
+			`INSERT INTO issues (id, title, description, design, acceptance_criteria, notes, status, priority, issue_type, created_at, updated_at)
+			 VALUES (?, 'Separator', '', '', '', '', 'open', 2, 'task', NOW(), NOW())`, id); err != nil {
+			t.Fatalf("Failed to insert %s: %v", id, err)
+		}
+	}
+
+	check := checkOrphanedChildrenDB(db, h)
+	if check.Status != StatusWarning || check.Message != "1 orphaned child issue(s)" {
+		t.Fatalf("got (%q, %q), want warning for 1 orphan", check.Status, check.Message)
+	}
+	if check.Detail != "orphans: test-gone:1" {
+		t.Errorf("Detail = %q, want only test-gone:1", check.Detail)
+	}
+}
+
+// TestCheckOrphanedChildrenDB_MultiDot checks the immediate parent is taken
+// from the last separator, for one, two and three levels of nesting.
+func TestCheckOrphanedChildrenDB_MultiDot(t *testing.T) {
+	store := newTestDoltStore(t, "test")
+	ctx := context.Background()
+	db := store.DB()
+
+	// test-a.1.2 is missing, so test-a.1.2.3 is orphaned even though its
+	// root test-a and grandparent test-a.1 exist. test-b.1 is missing too.
+	for _, id := range []string{"test-a", "test-a.1", "test-a.1.2.3", "test-b", "test-b.1.2"} {
+		if _, err := db.ExecContext(ctx,
+			`INSERT INTO issues (id, title, description, design, acceptance_criteria, notes, status, priority, issue_type, created_at, updated_at)
+			 VALUES (?, 'Nested', '', '', '', '', 'open', 2, 'task', NOW(), NOW())`, id); err != nil {
+			t.Fatalf("Failed to insert %s: %v", id, err)
+		}
+	}
+
+	check := checkOrphanedChildrenDB(db, types.IDHierarchy{})
+	if check.Status != StatusWarning || check.Message != "2 orphaned child issue(s)" {
+		t.Fatalf("got (%q, %q), want warning for 2 orphans", check.Status, check.Message)
+	}
+	if check.Detail != "orphans: test-a.1.2.3, test-b.1.2" {
+		t.Errorf("Detail = %q, want test-a.1.2.3 and test-b.1.2", check.Detail)
+	}
+}
+
+func TestCheckOrphanedChildrenDB_ArchivedParent(t *testing.T) {
+	// archive.resolve-references defaults to true
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("config.Initialize: %v", err)
+	}
+	store := newTestDoltStore(t, "test")
+	ctx := context.Background()
+	db := store.DB()
+
+	for _, id := range []string{"test-done", "test-done.1", "test-gone.1"} {
+		issue := &types.Issue{ID: id, Title: "Issue " + id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("Failed to create %s: %v", id, err)
+		}
+	}
+	if err := store.CloseIssue(ctx, "test-done", "done", "test", ""); err != nil {
+		t.Fatalf("Failed to close parent: %v", err)
+	}
+	if _, err := store.ArchiveClosedIssues(ctx, time.Now().Add(time.Hour), false); err != nil {
+		t.Fatalf("Failed to archive parent: %v", err)
+	}
+
+	check := checkOrphanedChildrenDB(db, types.IDHierarchy{})
+	if check.Status != StatusWarning || check.Message != "1 orphaned child issue(s), 1 child issue(s) with an archived parent" {
+		t.Fatalf("got (%q, %q), want 1 orphan and 1 archived parent", check.Status, check.Message)
+	}
+	if check.Detail != "orphans: test-gone.1; parent archived (see 'bd unarchive'): test-done.1" {
+		t.Errorf("Detail = %q", check.Detail)
+	}
+
+	// Only an archived parent left: informational, not a warning
+	if err := store.DeleteIssue(ctx, "test-gone.1"); err != nil {
+		t.Fatalf("Failed to delete orphan: %v", err)
+	}
+	if check := checkOrphanedChildrenDB(db, types.IDHierarchy{}); check.Status != StatusOK || check.Message != "1 child issue(s) with an archived parent" {
+		t.Errorf("got (%q, %q), want OK with 1 archived parent", check.Status, check.Message)
+	}
+}
+
+func TestCheckNearDuplicateTitlesDB(t *testing.T) {
+	store := newTestDoltStore(t, "test")
+	ctx := context.Background()
+	db := store.DB()
+
+	for _, row := range []struct{ id, title, status string }{
+		{"test-1", "Fix login bug", "open"},
+		{"test-2", "Fix the login bug", "in_progress"},
+		{"test-3", "Fix logout crash", "open"},
+		{"test-4", "Fix login bug", "closed"},
+	} {
+		if _, err := db.ExecContext(ctx,
+			`INSERT INTO issues (id, title, description, design, acceptance_criteria, notes, status, priority, issue_type, created_at, updated_at)
+			 VALUES (?, ?, '', '', '', '', ?, 2, 'task', NOW(), NOW())`, row.id, row.title, row.status); err != nil {
+			t.Fatalf("Failed to insert %s: %v", row.id, err)
+		}
+	}
+
+	check := checkNearDuplicateTitlesDB(db, NearDuplicateThreshold)
+	if check.Status != StatusWarning || check.Message != "1 likely duplicate pair(s) among open issues" {
+		t.Fatalf("got (%q, %q), want one pair", check.Status, check.Message)
+	}
+	if !strings.Contains(check.Detail, "test-1 ~ test-2 (1.00)") {
+		t.Errorf("Detail = %q, want the test-1/test-2 pair", check.Detail)
+	}
+}
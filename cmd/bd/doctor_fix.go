@@ -6,6 +6,7 @@ import (
 	"os"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/steveyegge/beads/cmd/bd/doctor"
 	"github.com/steveyegge/beads/cmd/bd/doctor/fix"
@@ -198,6 +199,20 @@ func applyFixesInteractive(path string, issues []doctorCheck) {
 	}
 }
 
+// createFixBackup tags the database before fixes run so they can be reviewed
+// and undone. A failure only warns: the database may be what needs fixing.
+func createFixBackup(path string) {
+	tag, err := fix.CreateBackup(path, time.Now())
+	if err != nil {
+		fmt.Printf("%s Could not create backup tag: %v\n", ui.RenderWarn("⚠"), err)
+		fmt.Printf("  Continuing without a backup\n")
+		return
+	}
+	fmt.Printf("Backup tag: %s\n", ui.RenderAccent(tag))
+	fmt.Printf("  Review changes: bd diff %s HEAD\n", tag)
+	fmt.Printf("  Undo fixes:     bd sql --write \"CALL DOLT_RESET('--hard', '%s')\"\n", tag)
+}
+
 // applyFixList applies a list of fixes and reports results
 func applyFixList(path string, fixes []doctorCheck) {
 	// Apply fixes in a dependency-aware order.
@@ -237,8 +252,13 @@ func applyFixList(path string, fixes []doctorCheck) {
 		return 0
 	})
 
+	if !doctorNoBackup {
+		createFixBackup(path)
+	}
+
 	fixedCount := 0
 	errorCount := 0
+	var failed []string
 
 	for _, check := range fixes {
 		fmt.Printf("\nFixing %s...\n", check.Name)
@@ -291,6 +311,16 @@ func applyFixList(path string, fixes []doctorCheck) {
 			continue
 		case "Orphaned Dependencies":
 			err = fix.OrphanedDependencies(path, doctorVerbose)
+		case "Dangling References":
+			err = fix.DanglingReferences(path, doctorVerbose)
+		case "Duplicate Dependencies":
+			err = fix.DuplicateDependencies(path, doctorVerbose)
+		case "Future Timestamps":
+			err = fix.FutureTimestamps(path, doctorVerbose)
+		case "Boolean Columns":
+			err = fix.BooleanColumns(path, doctorVerbose)
+		case "Empty Titles":
+			err = fix.EmptyTitles(path, doctorDeleteEmpty, doctorVerbose)
 		case "Child-Parent Dependencies":
 			// Requires explicit opt-in flag (destructive, may remove intentional deps)
 			if !doctorFixChildParent {
@@ -351,6 +381,7 @@ func applyFixList(path string, fixes []doctorCheck) {
 
 		if err != nil {
 			errorCount++
+			failed = append(failed, check.Name)
 			fmt.Printf("  %s Error: %v\n", ui.RenderFail("✗"), err)
 			fmt.Printf("  Manual fix: %s\n", check.Fix)
 		} else {
@@ -362,6 +393,8 @@ func applyFixList(path string, fixes []doctorCheck) {
 	// Summary
 	fmt.Printf("\nFix summary: %d fixed, %d errors\n", fixedCount, errorCount)
 	if errorCount > 0 {
+		// Each fix runs on its own, so a failed one leaves the others applied
+		fmt.Printf("Failed: %s\n", strings.Join(failed, ", "))
 		fmt.Println("\nSome fixes failed. Please review the errors above and apply manual fixes as needed.")
 	}
 }
@@ -0,0 +1,451 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/beads/cmd/bd/doctor"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/configfile"
+)
+
+// doctorCheckSpec registers one step of the full bd doctor run. The registry
+// is the single list of checks used by runDiagnostics, --only/--skip and
+// --list-checks.
+type doctorCheckSpec struct {
+	Slug     string                   // CheckNameToSlug of the check name; used by --only/--skip and doctor.severity
+	Aliases  []string                 // Extra names accepted by --only/--skip (short forms, sub-check slugs)
+	Category string                   // Overrides the check's own category when non-empty
+	Fails    func(status string) bool // Default severity: whether status fails the run (nil: never)
+	Slow     bool                     // Scans every issue, walks the graph, or goes over the network; skipped by --fast
+	Run      func(env *doctorEnv) []doctor.DoctorCheck
+}
+
+// doctorEnv carries state shared between checks in one run.
+type doctorEnv struct {
+	path           string
+	beadsDir       string
+	install        doctor.DoctorCheck
+	earlyLockCheck doctor.DoctorCheck
+}
+
+// Default severities: which statuses make a check fail bd doctor.
+func failsUnlessOK(status string) bool  { return status != statusOK }
+func failsOnError(status string) bool   { return status == statusError }
+func failsOnWarning(status string) bool { return status == statusWarning }
+func failsOnProblem(status string) bool { return status == statusWarning || status == statusError }
+
+// single adapts a check function taking the workspace path.
+func single(check func(path string) doctor.DoctorCheck) func(env *doctorEnv) []doctor.DoctorCheck {
+	return func(env *doctorEnv) []doctor.DoctorCheck {
+		return []doctor.DoctorCheck{check(env.path)}
+	}
+}
+
+// fixed adapts a check function that does not look at the workspace.
+func fixed(check func() doctor.DoctorCheck) func(env *doctorEnv) []doctor.DoctorCheck {
+	return func(env *doctorEnv) []doctor.DoctorCheck {
+		return []doctor.DoctorCheck{check()}
+	}
+}
+
+// hostChecks run even when .beads/ does not exist yet.
+var hostChecks = []doctorCheckSpec{
+	// Check 1: Installation (.beads/ directory)
+	{Slug: "installation", Category: doctor.CategoryCore, Fails: failsUnlessOK,
+		Run: func(env *doctorEnv) []doctor.DoctorCheck { return []doctor.DoctorCheck{env.install} }},
+	// Check Git Hooks early (even if .beads/ doesn't exist yet)
+	{Slug: "git-hooks", Aliases: []string{"hooks"}, Category: doctor.CategoryGit,
+		Run: fixed(func() doctor.DoctorCheck { return doctor.CheckGitHooks(Version) })},
+	// Check for stale .legacy hook sidecars calling removed "bd hook" command (GH#2398)
+	{Slug: "stale-legacy-hooks", Category: doctor.CategoryGit, Run: fixed(doctor.CheckStaleLegacyHooks)},
+	// Check git hooks Dolt compatibility (hooks without Dolt check cause errors)
+	{Slug: "git-hooks-dolt-compatibility", Category: doctor.CategoryGit, Fails: failsOnError,
+		Run: single(doctor.CheckGitHooksDoltCompatibility)},
+}
+
+// workspaceChecks run once .beads/ exists, before the database is touched.
+var workspaceChecks = []doctorCheckSpec{
+	// Check 1a: Fresh clone detection
+	// Must come early - if this is a fresh clone, other checks may be misleading
+	{Slug: "fresh-clone", Category: doctor.CategoryCore, Fails: failsOnProblem, Run: single(doctor.CheckFreshClone)},
+	// Check 1b: Metadata config file (GH#2478)
+	// Must come before database checks since they depend on metadata.json.
+	{Slug: "metadata-config", Category: doctor.CategoryCore, Fails: failsOnError, Run: checkMetadataConfig},
+}
+
+// databaseChecks run after version tracking and auto-migration (see
+// doctorEnv.prepareDatabase).
+var databaseChecks = []doctorCheckSpec{
+	// Check 1b: Dolt format compatibility (GH#2137)
+	// Must run before opening the database — old noms formats cause server panics.
+	{Slug: "dolt-format", Category: doctor.CategoryCore, Fails: failsOnError, Run: single(doctor.CheckDoltFormat)},
+	// Check 2: Database version
+	{Slug: "database", Category: doctor.CategoryCore, Fails: failsOnError,
+		Run: single(func(path string) doctor.DoctorCheck { return doctor.CheckDatabaseVersion(path, Version) })},
+	// Check 2a: Schema compatibility
+	{Slug: "schema-compatibility", Aliases: []string{"schema"}, Category: doctor.CategoryCore, Fails: failsOnError,
+		Run: single(doctor.CheckSchemaCompatibility)},
+	// Check 2b: Repo fingerprint (detects wrong database or URL change)
+	{Slug: "repo-fingerprint", Category: doctor.CategoryCore, Fails: failsOnError, Run: single(doctor.CheckRepoFingerprint)},
+	// Check 2c: Database integrity
+	{Slug: "database-integrity", Aliases: []string{"integrity"}, Category: doctor.CategoryCore, Fails: failsOnError,
+		Run: single(doctor.CheckDatabaseIntegrity)},
+	// Check 3: ID format (hash vs sequential)
+	{Slug: "issue-ids", Category: doctor.CategoryCore, Fails: failsOnWarning, Run: single(doctor.CheckIDFormat)},
+	// Check 4: CLI version (GitHub)
+	{Slug: "cli-version", Category: doctor.CategoryCore, Slow: true,
+		Run: fixed(func() doctor.DoctorCheck { return doctor.CheckCLIVersion(Version) })},
+	// Check 4.5: Claude plugin version (if running in Claude Code)
+	{Slug: "claude-plugin", Category: doctor.CategoryIntegration, Run: fixed(doctor.CheckClaudePlugin)},
+	// Check 7: Database/JSONL configuration mismatch
+	{Slug: "database-config", Category: doctor.CategoryData, Fails: failsOnProblem, Run: single(doctor.CheckDatabaseConfig)},
+	// Check 7a: Configuration value validation
+	{Slug: "config-values", Category: doctor.CategoryData, Run: single(doctor.CheckConfigValues)},
+	// Check 7a1: Project identity (GH#2372 backfill)
+	{Slug: "project-identity", Category: doctor.CategoryData, Fails: failsOnProblem, Run: single(doctor.CheckProjectIdentity)},
+	// Check 7b: Multi-repo custom types discovery (bd-9ji4z)
+	{Slug: "multi-repo-types", Category: doctor.CategoryData, Run: single(doctor.CheckMultiRepoTypes)},
+	// Check 7c: Role configuration (beads.role)
+	// Warn only - URL heuristic fallback still works
+	{Slug: "role-configuration", Run: single(doctor.CheckBeadsRole)},
+	// Check 7e: Stale lock files (bootstrap, sync, startup)
+	{Slug: "lock-files", Fails: failsOnProblem, Run: single(doctor.CheckStaleLockFiles)},
+	// Check 7f: Remote consistency (SQL vs CLI)
+	{Slug: "remote-consistency", Category: doctor.CategoryData, Run: single(doctor.CheckRemoteConsistency)},
+	// Dolt health checks (connection, schema, issue count, status).
+	// GH#1981: Pass the pre-computed lock check (run before any embedded Dolt
+	// opens) to avoid false positives from doctor's own noms LOCK files.
+	{Slug: "dolt-health", Aliases: []string{"dolt", "dolt-connection", "dolt-schema", "dolt-issue-count", "dolt-status", "dolt-lock-health", "phantom-databases", "shared-server"},
+		Run: func(env *doctorEnv) []doctor.DoctorCheck {
+			return doctor.RunDoltHealthChecksWithLock(env.path, env.earlyLockCheck)
+		}},
+	// Federation health checks (bd-wkumz.6); only relevant for Dolt users
+	// Check 8d: Federation remotesapi port accessibility
+	{Slug: "federation-remotesapi", Category: doctor.CategoryFederation, Run: single(doctor.CheckFederationRemotesAPI)},
+	// Check 8e: Federation peer connectivity
+	{Slug: "peer-connectivity", Category: doctor.CategoryFederation, Slow: true, Run: single(doctor.CheckFederationPeerConnectivity)},
+	// Check 8f: Federation sync staleness
+	{Slug: "sync-staleness", Category: doctor.CategoryFederation, Run: single(doctor.CheckFederationSyncStaleness)},
+	// Check 8g: Federation conflict detection (unresolved conflicts are a real problem)
+	{Slug: "federation-conflicts", Category: doctor.CategoryFederation, Fails: failsOnError, Run: single(doctor.CheckFederationConflicts)},
+	// Check 8h: Dolt server mode configuration check
+	{Slug: "dolt-mode", Category: doctor.CategoryFederation, Run: single(doctor.CheckDoltServerModeMismatch)},
+	// Check 9: Permissions
+	{Slug: "permissions", Category: doctor.CategoryCore, Fails: failsOnError, Run: single(doctor.CheckPermissions)},
+	// Check 10: Dependency cycles
+	{Slug: "dependency-cycles", Aliases: []string{"cycles"}, Category: doctor.CategoryMetadata, Fails: failsOnProblem, Slow: true,
+		Run: single(doctor.CheckDependencyCycles)},
+	// Check 11: Claude integration
+	{Slug: "claude-integration", Category: doctor.CategoryIntegration, Run: single(doctor.CheckClaude)},
+	// Check 11a: Claude settings file health (malformed settings are a real problem)
+	{Slug: "claude-settings-health", Category: doctor.CategoryIntegration, Fails: failsOnError, Run: single(doctor.CheckClaudeSettingsHealth)},
+	// Check 11b: Claude hook completeness (both SessionStart and PreCompact)
+	{Slug: "claude-hook-completeness", Category: doctor.CategoryIntegration, Run: single(doctor.CheckClaudeHookCompleteness)},
+	// Check 11c: bd prime output verification
+	{Slug: "bd-prime-output", Aliases: []string{"bd-prime-command"}, Category: doctor.CategoryIntegration, Slow: true, Run: single(doctor.VerifyPrimeOutput)},
+	// Check 11e: bd in PATH (needed for Claude hooks to work)
+	{Slug: "cli-availability", Category: doctor.CategoryIntegration, Run: fixed(doctor.CheckBdInPath)},
+	// Check 11f: Documentation bd prime references match installed version
+	{Slug: "prime-documentation", Category: doctor.CategoryIntegration, Run: single(doctor.CheckDocumentationBdPrimeReference)},
+	// Check 12: Agent documentation presence
+	{Slug: "agent-documentation", Category: doctor.CategoryIntegration, Run: single(doctor.CheckAgentDocumentation)},
+	// Check 13: Legacy beads slash commands in documentation
+	{Slug: "legacy-commands", Category: doctor.CategoryMetadata, Run: single(doctor.CheckLegacyBeadsSlashCommands)},
+	// Check 13a: MCP tool references in documentation
+	{Slug: "mcp-tool-references", Category: doctor.CategoryIntegration, Run: single(doctor.CheckLegacyMCPToolReferences)},
+	// Check 14: Gitignore up to date
+	{Slug: "gitignore", Category: doctor.CategoryGit, Run: single(doctor.CheckGitignore)},
+	// Check 14a: Project-root .gitignore has Dolt exclusion patterns (GH#2034)
+	{Slug: "project-gitignore", Category: doctor.CategoryGit, Run: single(doctor.CheckProjectGitignore)},
+	// Check 14b: redirect file tracking (worktree redirect files shouldn't be committed)
+	{Slug: "redirect-tracking", Category: doctor.CategoryGit, Run: single(doctor.CheckRedirectNotTracked)},
+	// Check 14c: redirect target validity (target exists and has valid db)
+	{Slug: "redirect-target-valid", Category: doctor.CategoryGit, Run: single(doctor.CheckRedirectTargetValid)},
+	// Check 14d: redirect target sync worktree (target has beads-sync if needed)
+	{Slug: "redirect-target-sync", Category: doctor.CategoryGit, Run: single(doctor.CheckRedirectTargetSyncWorktree)},
+	// Check 14e: vestigial sync worktrees (unused worktrees in redirected repos)
+	{Slug: "vestigial-sync-worktrees", Category: doctor.CategoryGit, Run: single(doctor.CheckNoVestigialSyncWorktrees)},
+	// Check 14g: last-touched file tracking (runtime state shouldn't be committed)
+	{Slug: "last-touched-tracking", Category: doctor.CategoryGit, Run: single(doctor.CheckLastTouchedNotTracked)},
+	// Check 14h: tracked runtime/sensitive files (GH#2535); sensitive files in git are a real problem
+	{Slug: "tracked-runtime-files", Fails: failsOnError, Run: single(doctor.CheckTrackedRuntimeFiles)},
+	// Check 15a: Git working tree cleanliness (AGENTS.md hygiene)
+	{Slug: "git-working-tree", Category: doctor.CategoryGit, Run: single(doctor.CheckGitWorkingTree)},
+	// Check 15b: Git upstream sync (ahead/behind/diverged)
+	{Slug: "git-upstream", Category: doctor.CategoryGit, Run: single(doctor.CheckGitUpstream)},
+	// Check 16: Metadata.json version tracking
+	{Slug: "version-tracking", Category: doctor.CategoryMetadata,
+		Run: single(func(path string) doctor.DoctorCheck { return doctor.CheckMetadataVersionTracking(path, Version) })},
+	// Check 17b: Orphaned issues - referenced in commits but still open
+	{Slug: "orphaned-issues", Category: doctor.CategoryGit, Slow: true, Run: single(doctor.CheckOrphanedIssues)},
+	// Check 18: Deletions manifest (legacy)
+	{Slug: "deletions-manifest", Category: doctor.CategoryMetadata, Run: single(doctor.CheckDeletionsManifest)},
+	// Check 20: Untracked .beads/*.jsonl files
+	{Slug: "untracked-files", Category: doctor.CategoryData, Run: single(doctor.CheckUntrackedBeadsFiles)},
+	// Check 21: Orphaned dependencies (from bd repair-deps, bd validate)
+	{Slug: "orphaned-dependencies", Aliases: []string{"orphans"}, Run: single(doctor.CheckOrphanedDependencies)},
+	// Check 21a: Extension-table rows whose issue no longer exists
+	{Slug: "dangling-references", Aliases: []string{"orphans"}, Run: single(doctor.CheckDanglingReferences)},
+	// Check 22a: Child→parent dependencies (anti-pattern)
+	{Slug: "child-parent-dependencies", Run: single(doctor.CheckChildParentDependencies)},
+	// Check 22b: Duplicate and contradictory dependency edges
+	{Slug: "duplicate-dependencies", Run: single(doctor.CheckDuplicateDependencies)},
+	// Check 22c: Orphaned children vs. dotted IDs that only look hierarchical
+	{Slug: "orphaned-children", Aliases: []string{"orphans"}, Run: single(doctor.CheckOrphanedChildren)},
+	// Check 22d: File attachments whose path no longer exists
+	{Slug: "attachment-paths", Slow: true, Run: single(doctor.CheckAttachmentPaths)},
+	// Check 22e: created/updated/closed timestamps ahead of the clock
+	{Slug: "future-timestamps", Aliases: []string{"clock-skew"}, Run: single(doctor.CheckFutureTimestamps)},
+	// Check 22f: ephemeral/pinned/is_template/crystallizes outside 0/1
+	{Slug: "boolean-columns", Run: single(doctor.CheckBooleanColumns)},
+	// Check 22g: Titles at the column limit (possibly truncated)
+	{Slug: "title-length", Slow: true, Run: single(doctor.CheckTitleLength)},
+	// Check 22h: Empty or whitespace-only titles
+	{Slug: "empty-titles", Slow: true, Run: single(doctor.CheckEmptyTitles)},
+	// Check 23: Duplicate issues (from bd validate)
+	{Slug: "duplicate-issues", Aliases: []string{"duplicates"}, Slow: true,
+		Run: single(func(path string) doctor.DoctorCheck {
+			return doctor.CheckDuplicateIssues(path, doctorGastown, gastownDuplicatesThreshold)
+		})},
+	// Check 23a: Open issues with near-identical titles
+	{Slug: "near-duplicate-titles", Aliases: []string{"duplicates"}, Slow: true, Run: single(doctor.CheckNearDuplicateTitles)},
+	// Check 24: Test pollution (from bd validate)
+	{Slug: "test-pollution", Aliases: []string{"pollution"}, Slow: true, Run: single(doctor.CheckTestPollution)},
+	// Check 26: Stale closed issues (maintenance)
+	{Slug: "stale-closed-issues", Run: single(doctor.CheckStaleClosedIssues)},
+	// Check 26a: Stale molecules (complete but unclosed)
+	{Slug: "stale-molecules", Slow: true, Run: single(doctor.CheckStaleMolecules)},
+	// Check 26b: Persistent mol- issues (should have been ephemeral)
+	{Slug: "persistent-mol-issues", Slow: true, Run: single(doctor.CheckPersistentMolIssues)},
+	// Check 26c: Legacy merge queue files (gastown mrqueue remnants)
+	{Slug: "legacy-mq-files", Run: single(doctor.CheckStaleMQFiles)},
+	// Check 26d: Patrol pollution (patrol digests, session beads)
+	{Slug: "patrol-pollution", Slow: true, Run: single(doctor.CheckPatrolPollution)},
+	// Check 26e: Open issues exceeding their priority age SLA
+	{Slug: "issue-age-sla", Aliases: []string{"sla"}, Run: single(doctor.CheckIssueAgeSLA)},
+	// Check 29: Database size (pruning suggestion)
+	// Note: This check has no auto-fix - pruning is destructive and user-controlled
+	{Slug: "large-database", Run: single(doctor.CheckDatabaseSize)},
+	// Check 30: Pending migrations (summarizes all available migrations)
+	// Status is determined by the check itself based on migration priorities
+	{Slug: "pending-migrations", Aliases: []string{"migrations"}, Fails: failsOnError, Run: single(doctor.CheckPendingMigrations)},
+	// Check 31: KV store sync status
+	{Slug: "kv-store-sync", Run: single(doctor.CheckKVSyncStatus)},
+	// Check 32: Dolt locks (uncommitted changes)
+	{Slug: "dolt-locks", Run: single(doctor.CheckDoltLocks)},
+	// Check 33: Classic artifacts (post-Dolt-migration cleanup)
+	{Slug: "classic-artifacts", Aliases: []string{"artifacts"}, Run: single(doctor.CheckClassicArtifacts)},
+	// Check 33a: JSONL export older than the database
+	{Slug: "stale-jsonl", Aliases: []string{"artifacts"}, Run: single(doctor.CheckStaleJSONL)},
+	// Check 36: Embedded mode concurrency issues (GH#2086)
+	// A recommendation, not a broken state
+	{Slug: "embedded-mode-concurrency", Category: doctor.CategoryRuntime, Run: single(doctor.CheckEmbeddedModeConcurrency)},
+}
+
+// allDoctorChecks returns the registry in run order.
+func allDoctorChecks() []doctorCheckSpec {
+	specs := make([]doctorCheckSpec, 0, len(hostChecks)+len(workspaceChecks)+len(databaseChecks))
+	specs = append(specs, hostChecks...)
+	specs = append(specs, workspaceChecks...)
+	return append(specs, databaseChecks...)
+}
+
+func checkMetadataConfig(env *doctorEnv) []doctor.DoctorCheck {
+	if _, err := os.Stat(configfile.ConfigPath(env.beadsDir)); os.IsNotExist(err) {
+		return []doctor.DoctorCheck{{
+			Name:    "Metadata Config",
+			Status:  doctor.StatusError,
+			Message: "metadata.json is missing",
+			Fix:     "Run 'bd doctor --fix' to regenerate with defaults, or 'bd init --force'",
+		}}
+	}
+	return []doctor.DoctorCheck{{
+		Name:    "Metadata Config",
+		Status:  doctor.StatusOK,
+		Message: "metadata.json present",
+	}}
+}
+
+// prepareDatabase runs the side effects the database checks depend on.
+func (env *doctorEnv) prepareDatabase() {
+	// GH#1981: Run lock health check BEFORE any checks that open embedded
+	// Dolt databases. Earlier checks (CheckDatabaseVersion, CheckSchemaCompatibility,
+	// etc.) create noms LOCK files via flock(); if CheckLockHealth runs after them,
+	// it detects those same-process locks as "held by another process" (false positive).
+	env.earlyLockCheck = doctor.CheckLockHealth(env.path)
+
+	// bd-jgxi: Auto-migrate database version before checking it.
+	// Since doctor skips PersistentPreRun DB init (it's in noDbCommands),
+	// trackBdVersion() and autoMigrateOnVersionBump() haven't run yet.
+	//
+	// Scope version tracking to the doctor target. Without this, `bd doctor <path>`
+	// can accidentally touch the caller's current repo .beads state.
+	origBeadsDir, hadBeadsDir := os.LookupEnv("BEADS_DIR")
+	_ = os.Setenv("BEADS_DIR", env.beadsDir)
+	trackBdVersion()
+	if hadBeadsDir {
+		_ = os.Setenv("BEADS_DIR", origBeadsDir)
+	} else {
+		_ = os.Unsetenv("BEADS_DIR")
+	}
+
+	autoMigrateOnVersionBump(env.beadsDir)
+}
+
+// checkSelection restricts a run to the checks named by --only and --skip,
+// and with --fast to the cheap ones. The zero value selects every check.
+type checkSelection struct {
+	only map[string]bool
+	skip map[string]bool
+	fast bool
+}
+
+// parseCheckSelection validates --only and --skip names (slugs or aliases)
+// against the registry.
+func parseCheckSelection(only, skip []string) (checkSelection, error) {
+	known := make(map[string]bool)
+	for _, spec := range allDoctorChecks() {
+		known[spec.Slug] = true
+		for _, alias := range spec.Aliases {
+			known[alias] = true
+		}
+	}
+
+	var sel checkSelection
+	var unknown []string
+	collect := func(names []string) map[string]bool {
+		if len(names) == 0 {
+			return nil
+		}
+		set := make(map[string]bool, len(names))
+		for _, name := range names {
+			name = doctor.CheckNameToSlug(strings.TrimSpace(name))
+			if name == "" {
+				continue
+			}
+			if !known[name] {
+				unknown = append(unknown, name)
+			}
+			set[name] = true
+		}
+		return set
+	}
+	sel.only = collect(only)
+	sel.skip = collect(skip)
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return checkSelection{}, fmt.Errorf("unknown doctor check(s): %s (see 'bd doctor --list-checks')", strings.Join(unknown, ", "))
+	}
+	return sel, nil
+}
+
+func (sel checkSelection) matches(set map[string]bool, spec doctorCheckSpec) bool {
+	if set[spec.Slug] {
+		return true
+	}
+	for _, alias := range spec.Aliases {
+		if set[alias] {
+			return true
+		}
+	}
+	return false
+}
+
+// includes reports whether spec should run.
+func (sel checkSelection) includes(spec doctorCheckSpec) bool {
+	if sel.only != nil && !sel.matches(sel.only, spec) {
+		return false
+	}
+	return !sel.matches(sel.skip, spec)
+}
+
+// skipsAsSlow reports whether --fast leaves out spec. Naming a slow check
+// in --only still runs it.
+func (sel checkSelection) skipsAsSlow(spec doctorCheckSpec) bool {
+	return sel.fast && spec.Slow && !sel.matches(sel.only, spec)
+}
+
+// collectDiagnostics runs the selected checks without applying suppressions
+// or severity overrides. OverallOK reflects each check's default severity.
+func collectDiagnostics(path string, sel checkSelection) doctorResult {
+	result := doctorResult{
+		Path:       path,
+		CLIVersion: Version,
+		OverallOK:  true,
+	}
+
+	// Auto-detect gastown mode: routes.jsonl is only created by gastown workspaces
+	if !doctorGastown {
+		routesFile := filepath.Join(path, ".beads", "routes.jsonl")
+		if _, err := os.Stat(routesFile); err == nil {
+			doctorGastown = true
+		}
+	}
+
+	// Installation gates everything else, so it runs even when not selected.
+	env := &doctorEnv{
+		path:     path,
+		beadsDir: beads.FollowRedirect(filepath.Join(path, ".beads")),
+		install:  doctor.CheckInstallation(path),
+	}
+
+	run := func(specs []doctorCheckSpec) {
+		for _, spec := range specs {
+			if !sel.includes(spec) {
+				continue
+			}
+			if sel.skipsAsSlow(spec) {
+				result.SkippedChecks = append(result.SkippedChecks, spec.Slug)
+				continue
+			}
+			start := time.Now()
+			checks := spec.Run(env)
+			result.Timings = append(result.Timings, doctorTiming{Slug: spec.Slug, Duration: time.Since(start)})
+			for _, dc := range checks {
+				check := convertDoctorCheck(dc)
+				if spec.Category != "" {
+					check.Category = spec.Category
+				}
+				result.Checks = append(result.Checks, check)
+				if spec.Fails != nil && spec.Fails(check.Status) {
+					result.fail(check)
+				}
+			}
+		}
+	}
+
+	run(hostChecks)
+
+	// If no .beads/, skip remaining checks. Show why even when
+	// installation itself wasn't selected.
+	if env.install.Status != statusOK {
+		if !sel.includes(hostChecks[0]) {
+			install := convertWithCategory(env.install, doctor.CategoryCore)
+			result.Checks = append(result.Checks, install)
+			result.fail(install)
+		}
+		return result
+	}
+
+	run(workspaceChecks)
+
+	selectedDB := false
+	for _, spec := range databaseChecks {
+		if sel.includes(spec) && !sel.skipsAsSlow(spec) {
+			selectedDB = true
+			break
+		}
+	}
+	if selectedDB {
+		env.prepareDatabase()
+	}
+	// With nothing but slow checks selected this only records what --fast skipped
+	run(databaseChecks)
+
+	return result
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/cmd/bd/doctor"
+)
+
+func TestDoctorRegistrySlugs(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, spec := range allDoctorChecks() {
+		if spec.Slug != doctor.CheckNameToSlug(spec.Slug) {
+			t.Errorf("slug %q is not in CheckNameToSlug form", spec.Slug)
+		}
+		if seen[spec.Slug] {
+			t.Errorf("duplicate slug %q", spec.Slug)
+		}
+		seen[spec.Slug] = true
+		if spec.Run == nil {
+			t.Errorf("check %q has no Run func", spec.Slug)
+		}
+	}
+}
+
+func TestParseCheckSelection(t *testing.T) {
+	sel, err := parseCheckSelection([]string{"orphans", "Dependency Cycles"}, []string{"orphaned-children"})
+	if err != nil {
+		t.Fatalf("parseCheckSelection: %v", err)
+	}
+	want := map[string]bool{
+		"orphaned-dependencies": true, // via the "orphans" alias
+		"orphaned-children":     false,
+		"dependency-cycles":     true,
+		"schema-compatibility":  false,
+	}
+	for _, spec := range allDoctorChecks() {
+		if expected, ok := want[spec.Slug]; ok && sel.includes(spec) != expected {
+			t.Errorf("includes(%s) = %v, want %v", spec.Slug, !expected, expected)
+		}
+	}
+
+	if _, err := parseCheckSelection([]string{"cycles", "orphanz"}, nil); err == nil || !strings.Contains(err.Error(), "orphanz") {
+		t.Errorf("expected unknown check error naming orphanz, got %v", err)
+	}
+
+	var all checkSelection
+	for _, spec := range allDoctorChecks() {
+		if !all.includes(spec) {
+			t.Errorf("zero selection excludes %s", spec.Slug)
+		}
+	}
+}
+
+func TestCheckSelectionFast(t *testing.T) {
+	sel, err := parseCheckSelection([]string{"near-duplicate-titles", "installation"}, nil)
+	if err != nil {
+		t.Fatalf("parseCheckSelection: %v", err)
+	}
+	sel.fast = true
+	fast := checkSelection{fast: true}
+
+	slow := 0
+	for _, spec := range allDoctorChecks() {
+		if !spec.Slow {
+			if fast.skipsAsSlow(spec) {
+				t.Errorf("--fast skips cheap check %s", spec.Slug)
+			}
+			continue
+		}
+		slow++
+		if !fast.skipsAsSlow(spec) {
+			t.Errorf("--fast runs slow check %s", spec.Slug)
+		}
+		// Naming a slow check in --only runs it anyway
+		if got := sel.skipsAsSlow(spec); got != (spec.Slug != "near-duplicate-titles") {
+			t.Errorf("--only near-duplicate-titles --fast: skipsAsSlow(%s) = %v", spec.Slug, got)
+		}
+	}
+	if slow == 0 {
+		t.Fatal("no checks are marked Slow")
+	}
+
+}
+
+func TestDoctorTimings(t *testing.T) {
+	// Without .beads/ only the host checks run, and each is timed
+	result := collectDiagnostics(t.TempDir(), checkSelection{only: map[string]bool{"installation": true}})
+	if len(result.Timings) != 1 || result.Timings[0].Slug != "installation" {
+		t.Fatalf("timings = %+v, want one entry for installation", result.Timings)
+	}
+
+	data, err := json.Marshal(doctorTiming{Slug: "dependency-cycles", Duration: 1500 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if want := `{"slug":"dependency-cycles","duration_ms":1500}`; string(data) != want {
+		t.Errorf("timing JSON = %s, want %s", data, want)
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/beads/cmd/bd/doctor"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// applyDoctorSeverity filters suppressed and ignored findings, rewrites
+// statuses for checks mapped to warn or fail, and recomputes OverallOK:
+//   - fail: any non-OK status fails the run (warnings become errors)
+//   - warn: findings are reported as warnings and never fail
+//   - ignore: findings are dropped (passing checks still show)
+//   - unmapped: the check's default severity applies; with strict, any
+//     warning fails too
+//
+// doctor.suppress.<slug> keeps its original meaning: hide warnings only.
+func applyDoctorSeverity(result *doctorResult, suppressed map[string]bool, severity map[string]string, strict bool) {
+	var kept []doctorCheck
+	suppressedCount := 0
+	overallOK := true
+
+	for _, check := range result.Checks {
+		slug := doctor.CheckNameToSlug(check.Name)
+		level := severity[slug]
+
+		if check.Status != statusOK {
+			if level == config.DoctorSeverityIgnore || (suppressed[slug] && check.Status == statusWarning) {
+				suppressedCount++
+				continue
+			}
+			switch level {
+			case config.DoctorSeverityFail:
+				check.Status = statusError
+				overallOK = false
+			case config.DoctorSeverityWarn:
+				check.Status = statusWarning
+			default:
+				if result.failed[check.Name] || strict {
+					overallOK = false
+				}
+			}
+		}
+		kept = append(kept, check)
+	}
+
+	result.Checks = kept
+	result.OverallOK = overallOK
+	result.SuppressedCount = suppressedCount
+}
+
+// checkSeverityInfo describes one registered check for --list-checks.
+type checkSeverityInfo struct {
+	Slug     string   `json:"slug"`
+	Aliases  []string `json:"aliases,omitempty"`
+	Severity string   `json:"severity"`       // ignore, warn, fail, or "default"
+	Slow     bool     `json:"slow,omitempty"` // skipped by --fast
+}
+
+// runListChecks prints the registered checks so teams can look up the names
+// to use with --only, --skip and doctor.severity.
+func runListChecks() {
+	severity, err := config.DoctorSeverity()
+	if err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+
+	known := make(map[string]bool)
+	var checks []checkSeverityInfo
+	for _, spec := range allDoctorChecks() {
+		known[spec.Slug] = true
+		for _, alias := range spec.Aliases {
+			known[alias] = true
+		}
+		level := severity[spec.Slug]
+		if level == "" {
+			level = "default"
+		}
+		checks = append(checks, checkSeverityInfo{Slug: spec.Slug, Aliases: spec.Aliases, Severity: level, Slow: spec.Slow})
+	}
+	sort.Slice(checks, func(i, j int) bool { return checks[i].Slug < checks[j].Slug })
+
+	// Overrides for names no check uses (e.g. a typo) would silently do nothing.
+	var unknown []string
+	for slug := range severity {
+		if !known[slug] {
+			unknown = append(unknown, slug)
+		}
+	}
+	sort.Strings(unknown)
+
+	if jsonOutput {
+		outputJSON(map[string]interface{}{
+			"checks":        checks,
+			"unknown_slugs": unknown,
+		})
+		return
+	}
+
+	fmt.Printf("\n%s Doctor checks (use with --only, --skip and doctor.severity):\n\n", ui.RenderAccent("🩺"))
+	for _, c := range checks {
+		level := fmt.Sprintf("%-8s", c.Severity)
+		if c.Severity == "default" {
+			level = ui.RenderMuted(level)
+		} else {
+			level = ui.RenderAccent(level)
+		}
+		aliases := ""
+		if len(c.Aliases) > 0 {
+			aliases = ui.RenderMuted("(" + strings.Join(c.Aliases, ", ") + ")")
+		}
+		if c.Slow {
+			aliases = strings.TrimSpace(ui.RenderWarn("slow") + " " + aliases)
+		}
+		fmt.Printf("  %-30s %s %s\n", c.Slug, level, aliases)
+	}
+	fmt.Println()
+	if len(unknown) > 0 {
+		fmt.Fprintf(os.Stderr, "%s doctor.severity has entries for unknown checks: %s\n", ui.RenderWarn("⚠"), strings.Join(unknown, ", "))
+	}
+}
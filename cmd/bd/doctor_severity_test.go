@@ -0,0 +1,73 @@
+package main
+
+import "testing"
+
+func TestApplyDoctorSeverity(t *testing.T) {
+	newResult := func() doctorResult {
+		r := doctorResult{OverallOK: true, Checks: []doctorCheck{
+			{Name: "Orphaned Dependencies", Status: statusWarning},
+			{Name: "Dependency Cycles", Status: statusWarning},
+			{Name: "Git Hooks", Status: statusWarning},
+			{Name: "Database", Status: statusOK},
+		}}
+		r.fail(r.Checks[1]) // cycles fail by default
+		return r
+	}
+	statusOf := func(r doctorResult, name string) string {
+		for _, c := range r.Checks {
+			if c.Name == name {
+				return c.Status
+			}
+		}
+		return ""
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		r := newResult()
+		applyDoctorSeverity(&r, nil, nil, false)
+		if r.OverallOK || len(r.Checks) != 4 {
+			t.Errorf("OverallOK = %v with %d checks, want false with 4", r.OverallOK, len(r.Checks))
+		}
+	})
+
+	t.Run("warn downgrades a failing check", func(t *testing.T) {
+		r := newResult()
+		applyDoctorSeverity(&r, nil, map[string]string{"dependency-cycles": "warn"}, false)
+		if !r.OverallOK {
+			t.Error("expected OverallOK when the only failing check is mapped to warn")
+		}
+	})
+
+	t.Run("fail escalates a warning", func(t *testing.T) {
+		r := newResult()
+		applyDoctorSeverity(&r, nil, map[string]string{"dependency-cycles": "warn", "orphaned-dependencies": "fail"}, false)
+		if r.OverallOK {
+			t.Error("expected failure from orphaned-dependencies mapped to fail")
+		}
+		if got := statusOf(r, "Orphaned Dependencies"); got != statusError {
+			t.Errorf("status = %q, want %q", got, statusError)
+		}
+	})
+
+	t.Run("strict respects warn and ignore", func(t *testing.T) {
+		r := newResult()
+		applyDoctorSeverity(&r, map[string]bool{"git-hooks": true}, map[string]string{
+			"dependency-cycles":     "warn",
+			"orphaned-dependencies": "ignore",
+		}, true)
+		if !r.OverallOK {
+			t.Errorf("expected OverallOK, remaining checks: %+v", r.Checks)
+		}
+		if r.SuppressedCount != 2 || len(r.Checks) != 2 {
+			t.Errorf("SuppressedCount = %d, checks = %d; want 2 and 2", r.SuppressedCount, len(r.Checks))
+		}
+	})
+
+	t.Run("strict fails unmapped warnings", func(t *testing.T) {
+		r := newResult()
+		applyDoctorSeverity(&r, nil, map[string]string{"dependency-cycles": "warn"}, true)
+		if r.OverallOK {
+			t.Error("expected --strict to fail on unmapped warnings")
+		}
+	})
+}
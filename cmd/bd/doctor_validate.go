@@ -75,11 +75,12 @@ func runValidateCheckInner(path string) bool {
 	return overallOK
 }
 
-// collectValidateChecks runs the four data-integrity checks.
+// collectValidateChecks runs the data-integrity checks.
 func collectValidateChecks(path string) []validateCheckResult {
 	return []validateCheckResult{
 		{check: convertDoctorCheck(doctor.CheckDuplicateIssues(path, doctorGastown, gastownDuplicatesThreshold))},
 		{check: convertDoctorCheck(doctor.CheckOrphanedDependencies(path)), fixable: true},
+		{check: convertDoctorCheck(doctor.CheckDanglingReferences(path)), fixable: true},
 		{check: convertDoctorCheck(doctor.CheckTestPollution(path))},
 		{check: convertDoctorCheck(doctor.CheckGitConflicts(path))},
 	}
@@ -17,7 +17,9 @@ import (
 	"github.com/steveyegge/beads/internal/beads"
 	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/configfile"
+	"github.com/steveyegge/beads/internal/debug"
 	"github.com/steveyegge/beads/internal/doltserver"
+	"github.com/steveyegge/beads/internal/storage/dolt"
 	"github.com/steveyegge/beads/internal/storage/doltutil"
 	"github.com/steveyegge/beads/internal/ui"
 	"golang.org/x/term"
@@ -147,11 +149,18 @@ uncommitted changes in its working set).
 
 Use --set-upstream to set the remote branch as the upstream for tracking.
 
+Use --squash (or set dolt.squash-on-push: true) to fold the commits made
+since the last push into one before pushing; see 'bd dolt squash'.
+
 Optionally specify remote (and optionally branch) to push to a specific remote:
-  bd dolt push origin          # push to origin/main
+  bd dolt push origin          # push the current branch to origin
   bd dolt push origin main     # push to origin/main
   bd dolt push central main --force
-  bd dolt push central main --set-upstream`,
+  bd dolt push central main --set-upstream
+
+Without arguments, push.remote and push.branch from config.yaml pick the
+target (auto-push honors them too); otherwise default.remote and the current
+branch are used. The updated remote ref is reported on success.`,
 	Args: cobra.MaximumNArgs(2),
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx := context.Background()
@@ -162,57 +171,100 @@ Optionally specify remote (and optionally branch) to push to a specific remote:
 		}
 		force, _ := cmd.Flags().GetBool("force")
 		setUpstream, _ := cmd.Flags().GetBool("set-upstream")
+		squash, _ := cmd.Flags().GetBool("squash")
+		if !cmd.Flags().Changed("squash") {
+			squash = config.GetBool("dolt.squash-on-push")
+		}
 
-		if len(args) == 0 {
-			fmt.Println("Pushing to Dolt remote...")
+		remote, branch, explicit := resolvePushTarget(st, args)
+		if branch == "" {
+			branch = currentPushBranch(ctx, st)
+		}
+
+		if squash {
+			squashBeforePush(ctx, st, remote, branch)
+		}
+
+		var err error
+		if !explicit && !setUpstream {
+			if !jsonOutput {
+				debug.Noticef("Pushing to Dolt remote...\n")
+			}
 			if force {
-				if err := st.ForcePush(ctx); err != nil {
-					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-					if isRemoteNotFoundErr(err) {
-						fmt.Fprintf(os.Stderr, "Hint: use 'bd dolt remote add <name> <url>' (not 'dolt remote add').\n")
-						fmt.Fprintf(os.Stderr, "  Running 'dolt remote add' directly may add the remote to the wrong directory.\n")
-						fmt.Fprintf(os.Stderr, "  Use 'bd dolt remote list' to check for discrepancies.\n")
-					}
-					os.Exit(1)
-				}
+				err = st.ForcePush(ctx)
 			} else {
-				if err := st.Push(ctx); err != nil {
-					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-					if isRemoteNotFoundErr(err) {
-						fmt.Fprintf(os.Stderr, "Hint: use 'bd dolt remote add <name> <url>' (not 'dolt remote add').\n")
-						fmt.Fprintf(os.Stderr, "  Running 'dolt remote add' directly may add the remote to the wrong directory.\n")
-						fmt.Fprintf(os.Stderr, "  Use 'bd dolt remote list' to check for discrepancies.\n")
-					}
-					os.Exit(1)
-				}
+				err = st.Push(ctx)
 			}
 		} else {
-			remote := args[0]
-			branch := "main"
-			if len(args) >= 2 {
-				branch = args[1]
+			if !jsonOutput {
+				debug.Noticef("Pushing to %s/%s...\n", remote, branch)
 			}
-			fmt.Printf("Pushing to %s/%s...\n", remote, branch)
-			var err error
 			if force {
 				err = st.ForcePushToRemote(ctx, remote, branch)
 			} else {
 				err = st.PushToRemote(ctx, remote, branch, setUpstream)
 			}
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-				if isRemoteNotFoundErr(err) {
-					fmt.Fprintf(os.Stderr, "Hint: use 'bd dolt remote add <name> <url>' (not 'dolt remote add').\n")
-					fmt.Fprintf(os.Stderr, "  Running 'dolt remote add' directly may add the remote to the wrong directory.\n")
-					fmt.Fprintf(os.Stderr, "  Use 'bd dolt remote list' to check for discrepancies.\n")
-				}
-				os.Exit(1)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			if isRemoteNotFoundErr(err) {
+				fmt.Fprintf(os.Stderr, "Hint: use 'bd dolt remote add <name> <url>' (not 'dolt remote add').\n")
+				fmt.Fprintf(os.Stderr, "  Running 'dolt remote add' directly may add the remote to the wrong directory.\n")
+				fmt.Fprintf(os.Stderr, "  Use 'bd dolt remote list' to check for discrepancies.\n")
 			}
+			os.Exit(1)
+		}
+
+		commit, _ := st.GetCurrentCommit(ctx)
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"remote":       remote,
+				"branch":       branch,
+				"ref":          fmt.Sprintf("remotes/%s/%s", remote, branch),
+				"commit":       commit,
+				"forced":       force,
+				"set_upstream": setUpstream,
+			})
+			return
 		}
-		fmt.Println("Push complete.")
+		debug.Noticef("Push complete: %s/%s is now at %s\n", remote, branch, shortHash(commit))
 	},
 }
 
+// resolvePushTarget returns the remote and branch to push to: explicit
+// arguments first, then push.remote/push.branch, then the store's defaults
+// (default.remote and its branch). When a remote is given (on the command line
+// or as push.remote) without a branch, branch is empty and the caller resolves
+// it with currentPushBranch. explicit is false when only store defaults apply,
+// so callers can use Push and its credential routing unchanged.
+func resolvePushTarget(st *dolt.DoltStore, args []string) (remote, branch string, explicit bool) {
+	remote = config.GetString("push.remote")
+	branch = config.GetString("push.branch")
+	if len(args) >= 1 {
+		remote = args[0]
+	}
+	if len(args) >= 2 {
+		branch = args[1]
+	}
+	explicit = remote != "" || branch != ""
+	if remote == "" {
+		remote = st.DefaultRemote()
+	}
+	if branch == "" && !explicit {
+		branch = st.DefaultBranch()
+	}
+	return remote, branch, explicit
+}
+
+// currentPushBranch returns the checked-out branch, falling back to the
+// store's default branch when it cannot be read.
+func currentPushBranch(ctx context.Context, st *dolt.DoltStore) string {
+	if branch, err := st.CurrentBranch(ctx); err == nil && branch != "" {
+		return branch
+	}
+	return st.DefaultBranch()
+}
+
 var doltPullCmd = &cobra.Command{
 	Use:   "pull [remote] [branch]",
 	Short: "Pull commits from Dolt remote",
@@ -236,7 +288,7 @@ Optionally specify remote (and optionally branch) to pull from a specific remote
 		}
 
 		if len(args) == 0 {
-			fmt.Println("Pulling from Dolt remote...")
+			debug.Noticef("Pulling from Dolt remote...\n")
 			if err := st.Pull(ctx); err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				if isRemoteNotFoundErr(err) {
@@ -252,7 +304,7 @@ Optionally specify remote (and optionally branch) to pull from a specific remote
 			if len(args) >= 2 {
 				branch = args[1]
 			}
-			fmt.Printf("Pulling from %s/%s...\n", remote, branch)
+			debug.Noticef("Pulling from %s/%s...\n", remote, branch)
 			if err := st.PullFromRemote(ctx, remote, branch); err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				if isRemoteNotFoundErr(err) {
@@ -263,7 +315,80 @@ Optionally specify remote (and optionally branch) to pull from a specific remote
 				os.Exit(1)
 			}
 		}
-		fmt.Println("Pull complete.")
+		debug.Noticef("Pull complete.\n")
+	},
+}
+
+var doltFetchCmd = &cobra.Command{
+	Use:   "fetch [remote]",
+	Short: "Fetch commits from a Dolt remote without merging",
+	Long: `Download commits from a Dolt remote and update its remote-tracking
+branches (remotes/<remote>/<branch>) without touching the local branch.
+
+This separates the network step from the merge step: fetch, inspect the
+incoming changes, then 'bd dolt pull' when ready. Without a remote, the
+default remote (default.remote, or origin) is fetched.
+
+Examples:
+  bd dolt fetch                 # fetch the default remote
+  bd dolt fetch central         # fetch a specific remote
+  bd diff HEAD origin/main      # preview what a pull would bring in`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		st := getStore()
+		if st == nil {
+			fmt.Fprintf(os.Stderr, "Error: no store available\n")
+			os.Exit(1)
+		}
+
+		remote := st.DefaultRemote()
+		if len(args) == 1 {
+			remote = args[0]
+		}
+		if !jsonOutput {
+			debug.Noticef("Fetching from %s...\n", remote)
+		}
+		updated, err := st.FetchRemote(ctx, remote)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			if isRemoteNotFoundErr(err) {
+				fmt.Fprintf(os.Stderr, "Hint: use 'bd dolt remote add <name> <url>' (not 'dolt remote add').\n")
+				fmt.Fprintf(os.Stderr, "  Use 'bd dolt remote list' to check for discrepancies.\n")
+			}
+			os.Exit(1)
+		}
+
+		if jsonOutput {
+			if updated == nil {
+				updated = []dolt.FetchedRef{}
+			}
+			outputJSON(map[string]interface{}{
+				"remote":  remote,
+				"updated": updated,
+			})
+			return
+		}
+		if len(updated) == 0 {
+			fmt.Println("Already up to date.")
+			return
+		}
+		for _, ref := range updated {
+			switch {
+			case ref.Old == "":
+				fmt.Printf("  %s %s (new)\n", ref.Ref, shortHash(ref.New))
+			case ref.New == "":
+				fmt.Printf("  %s deleted (was %s)\n", ref.Ref, shortHash(ref.Old))
+			default:
+				fmt.Printf("  %s %s..%s\n", ref.Ref, shortHash(ref.Old), shortHash(ref.New))
+			}
+		}
+		for _, ref := range updated {
+			if ref.New != "" {
+				fmt.Printf("\nPreview with: bd diff HEAD %s\n", strings.TrimPrefix(ref.Ref, "remotes/"))
+				break
+			}
+		}
 	},
 }
 
@@ -316,6 +441,69 @@ For more options (--stdin, custom messages), see: bd vc commit`,
 	},
 }
 
+var doltSquashCmd = &cobra.Command{
+	Use:   "squash",
+	Short: "Squash unpushed auto-commits into one commit",
+	Long: `Replace the Dolt commits made since the last push with a single commit.
+
+With auto-commit on, every bd write makes its own commit. Squashing before
+a push keeps the shared history readable: the new commit's message lists
+the affected issue IDs and the subjects of the commits it replaces.
+
+Only commits after the merge base with the remote-tracking branch are
+rewritten, so commits that were already pushed are never touched. The
+working set must be clean (run 'bd dolt commit' first).
+
+Examples:
+  bd dolt squash --dry-run     # Show what would be squashed
+  bd dolt squash               # Squash commits not yet on origin/<branch>
+  bd dolt push --squash        # Squash, then push`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("dolt squash")
+		st := getStore()
+		if st == nil {
+			FatalErrorRespectJSON("no store available")
+		}
+		remote, _ := cmd.Flags().GetString("remote")
+		branch, _ := cmd.Flags().GetString("branch")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		result, err := st.SquashUnpushed(rootCtx, remote, branch, dryRun)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if !dryRun && result.Commit != "" {
+			commandDidExplicitDoltCommit = true
+		}
+
+		if jsonOutput {
+			outputJSON(result)
+			return
+		}
+		if result.Squashed == 0 {
+			fmt.Println("Nothing to squash (fewer than two unpushed commits).")
+			return
+		}
+		if dryRun {
+			fmt.Printf("Would squash %d commits since %s:\n\n%s\n", result.Squashed, shortHash(result.Base), result.Message)
+			return
+		}
+		fmt.Printf("Squashed %d commits into %s\n", result.Squashed, shortHash(result.Commit))
+	},
+}
+
+// squashBeforePush runs the squash step of 'bd dolt push --squash'.
+func squashBeforePush(ctx context.Context, st *dolt.DoltStore, remote, branch string) {
+	result, err := st.SquashUnpushed(ctx, remote, branch, false)
+	if err != nil {
+		FatalErrorRespectJSON("squash failed: %v", err)
+	}
+	if result.Squashed > 0 {
+		fmt.Printf("Squashed %d commits into %s\n", result.Squashed, shortHash(result.Commit))
+	}
+}
+
 var doltStartCmd = &cobra.Command{
 	Use:   "start",
 	Short: "Start the Dolt SQL server for this project",
@@ -907,6 +1095,10 @@ func init() {
 	doltStopCmd.Flags().Bool("force", false, "Force stop the server")
 	doltPushCmd.Flags().Bool("force", false, "Force push (overwrite remote changes)")
 	doltPushCmd.Flags().Bool("set-upstream", false, "Set upstream for the branch")
+	doltPushCmd.Flags().Bool("squash", false, "Squash unpushed commits into one before pushing (default from dolt.squash-on-push)")
+	doltSquashCmd.Flags().String("remote", "", "Remote whose tracking branch marks the last push (default: the configured remote)")
+	doltSquashCmd.Flags().String("branch", "", "Branch on the remote (default: current branch)")
+	doltSquashCmd.Flags().Bool("dry-run", false, "Show the commits and message without rewriting history")
 	doltCommitCmd.Flags().StringP("message", "m", "", "Commit message (default: auto-generated)")
 	doltCleanDatabasesCmd.Flags().Bool("dry-run", false, "Show what would be dropped without dropping")
 	doltRemoteRemoveCmd.Flags().Bool("force", false, "Force remove even when SQL and CLI URLs conflict")
@@ -918,7 +1110,9 @@ func init() {
 	doltCmd.AddCommand(doltTestCmd)
 	doltCmd.AddCommand(doltCommitCmd)
 	doltCmd.AddCommand(doltPushCmd)
+	doltCmd.AddCommand(doltSquashCmd)
 	doltCmd.AddCommand(doltPullCmd)
+	doltCmd.AddCommand(doltFetchCmd)
 	doltCmd.AddCommand(doltStartCmd)
 	doltCmd.AddCommand(doltStopCmd)
 	doltCmd.AddCommand(doltStatusCmd)
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/debug"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/storage/dolt"
 )
@@ -22,6 +25,113 @@ func transact(ctx context.Context, s *dolt.DoltStore, commitMsg string, fn func(
 	return err
 }
 
+// commandTxExemptCommands manage Dolt history themselves (commits, merges,
+// branches, squashes, GC) or run indefinitely, so their writes are not held
+// for a single end-of-command commit. Subcommands inherit the exemption.
+var commandTxExemptCommands = map[string]bool{
+	"backup":     true,
+	"branch":     true,
+	"compact":    true,
+	"dolt":       true,
+	"federation": true,
+	"gc":         true,
+	"hooks":      true,
+	"migrate":    true,
+	"serve":      true,
+	"tag":        true,
+	"vc":         true,
+}
+
+// commandCommitMessage is --commit-message on mutating commands. When set it
+// replaces the generated message of the command's Dolt commit. (-m/--message
+// is already a hidden alias for --description or --reason on these commands.)
+var commandCommitMessage string
+
+// registerCommitMessageFlag adds --commit-message to a mutating command.
+func registerCommitMessageFlag(cmd *cobra.Command, usage string) {
+	cmd.Flags().StringVar(&commandCommitMessage, "commit-message", "", usage)
+}
+
+// customCommitMessage returns the trimmed --commit-message value, or "".
+func customCommitMessage() string {
+	return strings.TrimSpace(commandCommitMessage)
+}
+
+// commandTxTimeout bounds the rollback run on the fatal-error path, where
+// rootCtx may already be canceled.
+const commandTxTimeout = 10 * time.Second
+
+// beginCommandTx opens a command transaction on the global store, so every
+// write the command makes lands in one Dolt commit (see commitCommandTx).
+// Failing to open one is not fatal: writes then commit individually.
+func beginCommandTx(cmd *cobra.Command) {
+	if store == nil || dryRun {
+		return
+	}
+	for c := cmd; c != nil; c = c.Parent() {
+		if commandTxExemptCommands[c.Name()] {
+			return
+		}
+	}
+	active, err := store.BeginCommandTx(rootCtx)
+	if err != nil {
+		debug.Warnf("failed to begin command transaction: %v\n", err)
+		return
+	}
+	commandTxActive = active
+	if !active && customCommitMessage() != "" {
+		// Writes will commit one by one (or wait for 'bd dolt commit' in
+		// batch mode), so there is no single commit to put the message on.
+		debug.Warnf("--commit-message ignored: the working set has uncommitted changes, so this command's writes are not committed together\n")
+	}
+}
+
+// commitCommandTx creates the command's single Dolt commit. Commands that
+// also commit explicitly (e.g. bd sql --write) are unaffected: their commit
+// includes everything written so far and this one has nothing left to do.
+func commitCommandTx(cmdName string) error {
+	if !commandTxActive {
+		return nil
+	}
+	commandTxActive = false
+	st := getStore()
+	if st == nil || st.IsClosed() {
+		return nil
+	}
+	var committed bool
+	var err error
+	if msg := customCommitMessage(); msg != "" {
+		committed, err = st.CommitCommandTxWithMessage(rootCtx, msg)
+	} else {
+		committed, err = st.CommitCommandTx(rootCtx, formatDoltAutoCommitMessage(cmdName, getActor(), nil))
+	}
+	if err != nil {
+		return err
+	}
+	if committed {
+		commandDidExplicitDoltCommit = true
+	}
+	return nil
+}
+
+// rollbackCommandTx discards the command's writes when it fails before
+// PersistentPostRun, so a failed bulk command leaves no partial commit.
+func rollbackCommandTx() {
+	if !commandTxActive {
+		return
+	}
+	commandTxActive = false
+	st := getStore()
+	if st == nil || st.IsClosed() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), commandTxTimeout)
+	defer cancel()
+	if err := st.RollbackCommandTx(ctx); err != nil {
+		debug.Warnf("failed to roll back command: %v\n", err)
+	}
+}
+
 type doltAutoCommitParams struct {
 	// Command is the top-level bd command name (e.g., "create", "update").
 	Command string
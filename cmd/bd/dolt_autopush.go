@@ -62,7 +62,8 @@ func savePushState(ps *pushState) error {
 
 // isDoltAutoPushEnabled returns whether auto-push to Dolt remote should run.
 // If user explicitly configured dolt.auto-push, use that.
-// Otherwise, auto-enable when a Dolt remote named "origin" exists.
+// Otherwise, auto-enable when the push remote (push.remote, default.remote, or
+// "origin") exists.
 func isDoltAutoPushEnabled(ctx context.Context) bool {
 	if config.GetValueSource("dolt.auto-push") != config.SourceDefault {
 		return config.GetBool("dolt.auto-push")
@@ -72,7 +73,8 @@ func isDoltAutoPushEnabled(ctx context.Context) bool {
 	if st == nil || st.IsClosed() {
 		return false
 	}
-	has, err := st.HasRemote(ctx, "origin")
+	remote, _, _ := resolvePushTarget(st, nil)
+	has, err := st.HasRemote(ctx, remote)
 	if err != nil {
 		debug.Logf("dolt auto-push: failed to check remote: %v\n", err)
 		return false
@@ -130,10 +132,19 @@ func maybeAutoPush(ctx context.Context) {
 		return
 	}
 
-	// Push
-	debug.Logf("dolt auto-push: pushing to origin...\n")
-	if err := st.Push(ctx); err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: dolt auto-push failed: %v\n", err)
+	// Push, honoring push.remote/push.branch when configured
+	remote, branch, explicit := resolvePushTarget(st, nil)
+	if branch == "" {
+		branch = currentPushBranch(ctx, st)
+	}
+	debug.Logf("dolt auto-push: pushing to %s/%s...\n", remote, branch)
+	if explicit {
+		err = st.PushToRemote(ctx, remote, branch, false)
+	} else {
+		err = st.Push(ctx)
+	}
+	if err != nil {
+		debug.Warnf("dolt auto-push failed: %v\n", err)
 		return
 	}
 
@@ -109,7 +109,7 @@ func TestE2E_DoctorFixMetadataRoundtrip(t *testing.T) {
 	}
 
 	// Delete metadata to simulate a pre-Phase-1 database
-	sqlOut, sqlErr := runBDExecAllowErrorWithEnv(t, tmpDir, env, "sql",
+	sqlOut, sqlErr := runBDExecAllowErrorWithEnv(t, tmpDir, env, "sql", "--write", "--yes",
 		"DELETE FROM metadata WHERE key IN ('bd_version', 'repo_id', 'clone_id')")
 	if sqlErr != nil {
 		t.Fatalf("bd sql DELETE failed: %v\n%s", sqlErr, sqlOut)
@@ -179,7 +179,7 @@ func TestE2E_MigrateDoltMetadata(t *testing.T) {
 
 	// Delete repo_id and clone_id to simulate a pre-Phase-3 database
 	// (bd_version is set by init, but identity fields are missing)
-	sqlOut, sqlErr := runBDExecAllowErrorWithEnv(t, tmpDir, env, "sql",
+	sqlOut, sqlErr := runBDExecAllowErrorWithEnv(t, tmpDir, env, "sql", "--write", "--yes",
 		"DELETE FROM metadata WHERE key IN ('repo_id', 'clone_id')")
 	if sqlErr != nil {
 		t.Fatalf("bd sql DELETE failed: %v\n%s", sqlErr, sqlOut)
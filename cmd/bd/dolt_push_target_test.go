@@ -0,0 +1,43 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage/dolt"
+)
+
+func TestResolvePushTarget(t *testing.T) {
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("config.Initialize: %v", err)
+	}
+	t.Cleanup(func() {
+		config.Set("push.remote", "")
+		config.Set("push.branch", "")
+	})
+	st := &dolt.DoltStore{} // zero store: empty default remote and branch
+
+	tests := []struct {
+		name                   string
+		cfgRemote, cfgBranch   string
+		args                   []string
+		wantRemote, wantBranch string
+		wantExplicit           bool
+	}{
+		{name: "store defaults", wantExplicit: false},
+		{name: "remote arg leaves branch to the caller", args: []string{"central"}, wantRemote: "central", wantBranch: "", wantExplicit: true},
+		{name: "remote and branch args", args: []string{"central", "dev"}, wantRemote: "central", wantBranch: "dev", wantExplicit: true},
+		{name: "config target", cfgRemote: "upstream", cfgBranch: "beads", wantRemote: "upstream", wantBranch: "beads", wantExplicit: true},
+		{name: "args override config", cfgRemote: "upstream", cfgBranch: "beads", args: []string{"central"}, wantRemote: "central", wantBranch: "beads", wantExplicit: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.Set("push.remote", tt.cfgRemote)
+			config.Set("push.branch", tt.cfgBranch)
+			remote, branch, explicit := resolvePushTarget(st, tt.args)
+			if remote != tt.wantRemote || branch != tt.wantBranch || explicit != tt.wantExplicit {
+				t.Errorf("got (%q, %q, %v), want (%q, %q, %v)", remote, branch, explicit, tt.wantRemote, tt.wantBranch, tt.wantExplicit)
+			}
+		})
+	}
+}
@@ -0,0 +1,452 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/debug"
+	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/utils"
+)
+
+// dryRun is the global --dry-run flag. Commands with a --dry-run flag of
+// their own (create, delete, import, ...) shadow it and preview in their own
+// way; the commands in dryRunCommands preview through the helpers below.
+// checkDryRunSupported refuses every other command under --dry-run, so an
+// unsupported command never writes while the user thinks it is previewing.
+var dryRun bool
+
+// dryRunCommands are the command paths (below bd) that honor the global
+// --dry-run.
+var dryRunCommands = map[string]bool{
+	"update":   true,
+	"close":    true,
+	"reopen":   true,
+	"assign":   true,
+	"unassign": true,
+	"move":     true,
+}
+
+// checkDryRunSupported refuses cmd under the global --dry-run unless it can
+// preview: through a --dry-run flag of its own or as one of dryRunCommands.
+// PersistentPreRun runs it for every command, so a write command that never
+// calls CheckReadonly cannot write under --dry-run either.
+func checkDryRunSupported(cmd *cobra.Command) error {
+	if !dryRun || cmd.LocalFlags().Lookup("dry-run") != nil {
+		return nil
+	}
+	if path := dryRunCommandPath(cmd); !dryRunCommands[path] {
+		return fmt.Errorf("'%s' does not support --dry-run; nothing was changed", path)
+	}
+	return nil
+}
+
+// dryRunCommandPath is cmd's path below the root command, e.g. "dolt push".
+func dryRunCommandPath(cmd *cobra.Command) string {
+	return strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+}
+
+// dryRunChange is one field a command would change.
+type dryRunChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// dryRunIssue is one issue a command would touch, or leave alone and why.
+type dryRunIssue struct {
+	ID      string         `json:"id"`
+	Title   string         `json:"title,omitempty"`
+	Changes []dryRunChange `json:"changes,omitempty"`
+	Skipped string         `json:"skipped,omitempty"`
+}
+
+// dryRunResult is what a --dry-run prints and, with --json, emits.
+type dryRunResult struct {
+	DryRun  bool          `json:"dry_run"`
+	Command string        `json:"command"`
+	Summary string        `json:"summary"`
+	Issues  []dryRunIssue `json:"issues"`
+	Notes   []string      `json:"notes,omitempty"` // Effects not captured as field changes
+}
+
+func newDryRunResult(command string) *dryRunResult {
+	return &dryRunResult{DryRun: true, Command: command, Issues: []dryRunIssue{}}
+}
+
+// affected returns how many issues the command would change.
+func (r *dryRunResult) affected() int {
+	n := 0
+	for _, issue := range r.Issues {
+		if issue.Skipped == "" {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *dryRunResult) skip(id, title, reason string) {
+	r.Issues = append(r.Issues, dryRunIssue{ID: id, Title: title, Skipped: reason})
+}
+
+func printDryRun(r *dryRunResult) {
+	if jsonOutput {
+		outputJSON(r)
+		return
+	}
+	fmt.Printf("%s Dry run: %s (nothing was changed)\n", ui.RenderWarn("○"), r.Summary)
+	for _, issue := range r.Issues {
+		if issue.Skipped != "" {
+			fmt.Printf("  %s: skipped, %s\n", issue.ID, issue.Skipped)
+			continue
+		}
+		fmt.Printf("  %s\n", formatFeedbackID(issue.ID, issue.Title))
+		for _, c := range issue.Changes {
+			fmt.Printf("    %s: %s → %s\n", c.Field, dryRunValue(c.From), dryRunValue(c.To))
+		}
+	}
+	for _, note := range r.Notes {
+		fmt.Printf("  %s\n", note)
+	}
+}
+
+func dryRunValue(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	if len(s) > 60 || strings.Contains(s, "\n") {
+		return fmt.Sprintf("(%d chars)", len(s))
+	}
+	return s
+}
+
+// dryRunFieldString renders an update value the way it would be stored.
+func dryRunFieldString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return v.UTC().Format(time.RFC3339)
+	case json.RawMessage:
+		return string(v)
+	case []string:
+		return strings.Join(v, ", ")
+	case float64:
+		return fmt.Sprint(int64(v)) // JSON numbers on Issue are all integers
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// diffIssueUpdates describes what 'bd update' would do to issue given the
+// updates map it builds from flags, and the issue's current parent.
+// Pseudo-keys (append_notes, label and metadata edits) are folded into the
+// fields they change. Unchanged fields are left out.
+func diffIssueUpdates(issue *types.Issue, updates map[string]interface{}, currentParent string) ([]dryRunChange, error) {
+	raw, err := json.Marshal(issue)
+	if err != nil {
+		return nil, err
+	}
+	var current map[string]interface{}
+	if err := json.Unmarshal(raw, &current); err != nil {
+		return nil, err
+	}
+
+	proposed := make(map[string]string)
+	from := func(field string) string { return dryRunFieldString(current[field]) }
+	for key, v := range updates {
+		switch key {
+		case "wisp":
+			proposed["ephemeral"] = dryRunFieldString(v)
+		case "append_notes":
+			notes := issue.Notes
+			if notes != "" {
+				notes += "\n"
+			}
+			proposed["notes"] = notes + dryRunFieldString(v)
+		case "add_labels", "remove_labels", "set_labels":
+			// Handled together below
+		case "_set_metadata":
+			unset, _ := updates["_unset_metadata"].([]string)
+			merged, err := applyMetadataEdits(issue.Metadata, v.([]string), unset)
+			if err != nil {
+				return nil, err
+			}
+			proposed["metadata"] = string(merged)
+		case "_unset_metadata":
+			if _, ok := updates["_set_metadata"]; !ok {
+				merged, err := applyMetadataEdits(issue.Metadata, nil, v.([]string))
+				if err != nil {
+					return nil, err
+				}
+				proposed["metadata"] = string(merged)
+			}
+		case "metadata":
+			meta := v.(json.RawMessage)
+			if len(issue.Metadata) > 0 {
+				merged, err := mergeMetadata(issue.Metadata, meta)
+				if err != nil {
+					return nil, err
+				}
+				meta = merged
+			}
+			proposed["metadata"] = string(meta)
+		default:
+			proposed[key] = dryRunFieldString(v)
+		}
+	}
+
+	set, setOK := updates["set_labels"].([]string)
+	add, _ := updates["add_labels"].([]string)
+	remove, _ := updates["remove_labels"].([]string)
+	if setOK || len(add) > 0 || len(remove) > 0 {
+		labels := slices.Clone(issue.Labels)
+		if setOK {
+			labels = slices.Clone(set)
+		}
+		for _, l := range add {
+			if !slices.Contains(labels, l) {
+				labels = append(labels, l)
+			}
+		}
+		labels = slices.DeleteFunc(labels, func(l string) bool { return slices.Contains(remove, l) })
+		sort.Strings(labels)
+		currentLabels := slices.Clone(issue.Labels)
+		sort.Strings(currentLabels)
+		current["labels"] = strings.Join(currentLabels, ", ")
+		proposed["labels"] = strings.Join(labels, ", ")
+	}
+	current["parent"] = currentParent
+	current["metadata"] = string(issue.Metadata)
+
+	fields := make([]string, 0, len(proposed))
+	for field := range proposed {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	var changes []dryRunChange
+	for _, field := range fields {
+		if f, t := from(field), proposed[field]; f != t {
+			changes = append(changes, dryRunChange{Field: field, From: f, To: t})
+		}
+	}
+	return changes, nil
+}
+
+// currentParentID returns the issue's parent-child parent, or "".
+func currentParentID(ctx context.Context, issueStore *dolt.DoltStore, id string) string {
+	deps, _ := issueStore.GetDependencyRecords(ctx, id) // Best effort: preview only
+	for _, dep := range deps {
+		if dep.Type == types.DepParentChild {
+			return dep.DependsOnID
+		}
+	}
+	return ""
+}
+
+// dryRunUpdate previews 'bd update' on args.
+func dryRunUpdate(ctx context.Context, args []string, updates map[string]interface{}, claim bool) *dryRunResult {
+	r := newDryRunResult("update")
+	for _, id := range args {
+		result, err := resolveAndGetIssueWithRouting(ctx, store, id)
+		if err != nil || result == nil || result.Issue == nil {
+			if result != nil {
+				result.Close()
+			}
+			r.skip(id, "", "not found")
+			continue
+		}
+		issue := result.Issue
+		if err := validateIssueUpdatable(id, issue); err != nil {
+			r.skip(result.ResolvedID, issue.Title, err.Error())
+			result.Close()
+			continue
+		}
+		issueUpdates := updates
+		if claim {
+			if issue.Assignee != "" {
+				r.skip(result.ResolvedID, issue.Title, fmt.Sprintf("already claimed by %s", issue.Assignee))
+				result.Close()
+				continue
+			}
+			issueUpdates = make(map[string]interface{}, len(updates)+2)
+			for k, v := range updates {
+				issueUpdates[k] = v
+			}
+			issueUpdates["assignee"] = actor
+			issueUpdates["status"] = string(types.StatusInProgress)
+		}
+		parent := ""
+		if _, ok := updates["parent"]; ok {
+			parent = currentParentID(ctx, result.Store, result.ResolvedID)
+		}
+		changes, err := diffIssueUpdates(issue, issueUpdates, parent)
+		result.Close()
+		if err != nil {
+			FatalErrorRespectJSON("%s: %v", result.ResolvedID, err)
+		}
+		if len(changes) == 0 {
+			r.skip(result.ResolvedID, issue.Title, "already up to date")
+			continue
+		}
+		r.Issues = append(r.Issues, dryRunIssue{ID: result.ResolvedID, Title: issue.Title, Changes: changes})
+	}
+	r.Summary = fmt.Sprintf("would update %d issue(s)", r.affected())
+	return r
+}
+
+// dryRunClose previews 'bd close' on already resolved IDs, applying the
+// same checks that would make the real close skip an issue.
+func dryRunClose(ctx context.Context, ids []string, reason string, force bool) *dryRunResult {
+	r := newDryRunResult("close")
+	for _, id := range ids {
+		issue, _ := store.GetIssue(ctx, id)
+		if err := validateIssueClosable(id, issue, force); err != nil {
+			r.skip(id, "", err.Error())
+			continue
+		}
+		if !force && issue.IssueType == types.TypeEpic {
+			if n := countEpicOpenChildren(ctx, id); n > 0 {
+				r.skip(id, issue.Title, fmt.Sprintf("epic has %d open child issue(s)", n))
+				continue
+			}
+		}
+		if !force {
+			if err := checkGateSatisfaction(issue); err != nil {
+				r.skip(id, issue.Title, err.Error())
+				continue
+			}
+			if blocked, blockers, err := store.IsBlocked(ctx, id); err == nil && blocked && len(blockers) > 0 {
+				r.skip(id, issue.Title, fmt.Sprintf("blocked by open issues %v", blockers))
+				continue
+			}
+		}
+		r.Issues = append(r.Issues, dryRunIssue{ID: id, Title: issue.Title, Changes: []dryRunChange{
+			{Field: "status", From: string(issue.Status), To: string(types.StatusClosed)},
+			{Field: "close_reason", From: issue.CloseReason, To: reason},
+		}})
+	}
+	r.Summary = fmt.Sprintf("would close %d issue(s)", r.affected())
+	if r.affected() > 0 {
+		r.Notes = append(r.Notes, "Molecules whose last open step this closes would also be closed.")
+	}
+	return r
+}
+
+// dryRunReopen previews 'bd reopen' on args.
+func dryRunReopen(ctx context.Context, args []string) *dryRunResult {
+	r := newDryRunResult("reopen")
+	for _, id := range args {
+		fullID, err := utils.ResolvePartialID(ctx, store, id)
+		if err != nil {
+			r.skip(id, "", err.Error())
+			continue
+		}
+		issue, err := store.GetIssue(ctx, fullID)
+		if err != nil {
+			r.skip(fullID, "", err.Error())
+			continue
+		}
+		if issue.Status == types.StatusOpen {
+			r.skip(fullID, issue.Title, "already open")
+			continue
+		}
+		changes, err := diffIssueUpdates(issue, map[string]interface{}{
+			"status":      string(types.StatusOpen),
+			"defer_until": nil,
+		}, "")
+		if err != nil {
+			FatalErrorRespectJSON("%s: %v", fullID, err)
+		}
+		if issue.ClosedAt != nil {
+			changes = append(changes, dryRunChange{Field: "closed_at", From: dryRunFieldString(issue.ClosedAt)})
+		}
+		r.Issues = append(r.Issues, dryRunIssue{ID: fullID, Title: issue.Title, Changes: changes})
+	}
+	r.Summary = fmt.Sprintf("would reopen %d issue(s)", r.affected())
+	return r
+}
+
+// dryRunAssign previews 'bd assign' and 'bd unassign' on resolved IDs.
+func dryRunAssign(ctx context.Context, ids []string, assignee string) *dryRunResult {
+	assignees := make([]string, len(ids))
+	for i := range assignees {
+		assignees[i] = assignee
+	}
+	return dryRunAssignEach(ctx, ids, assignees, assignee)
+}
+
+// dryRunAssignEach previews an assign where each issue may get a different
+// assignee (as with '@group'), named target in the summary.
+func dryRunAssignEach(ctx context.Context, ids, assignees []string, target string) *dryRunResult {
+	command, summary := "assign", "would assign %d issue(s) to "+target
+	if target == "" {
+		command, summary = "unassign", "would unassign %d issue(s)"
+	}
+	r := newDryRunResult(command)
+	for i, id := range ids {
+		issue, err := store.GetIssue(ctx, id)
+		if err != nil {
+			FatalErrorRespectJSON("getting %s: %v", id, err)
+		}
+		if issue.Assignee == assignees[i] {
+			unchanged := "already assigned to " + assignees[i]
+			if assignees[i] == "" {
+				unchanged = "already unassigned"
+			}
+			r.skip(id, issue.Title, unchanged)
+			continue
+		}
+		r.Issues = append(r.Issues, dryRunIssue{ID: id, Title: issue.Title, Changes: []dryRunChange{
+			{Field: "assignee", From: issue.Assignee, To: assignees[i]},
+		}})
+	}
+	r.Summary = fmt.Sprintf(summary, r.affected())
+	return r
+}
+
+// dryRunMove previews 'bd move'. The new ID is only known once the target
+// rig creates the issue, so the preview names it <new>.
+func dryRunMove(ctx context.Context, issueStore *dolt.DoltStore, source *types.Issue, targetRig string, keepOpen, skipDeps bool) *dryRunResult {
+	r := newDryRunResult("move")
+	r.Summary = fmt.Sprintf("would copy %s into rig %q as a new open issue", source.ID, targetRig)
+	moved := dryRunIssue{ID: source.ID, Title: source.Title}
+	if !keepOpen {
+		moved.Changes = append(moved.Changes,
+			dryRunChange{Field: "status", From: string(source.Status), To: string(types.StatusClosed)},
+			dryRunChange{Field: "close_reason", From: source.CloseReason, To: "Moved to <new>"})
+	}
+	r.Issues = append(r.Issues, moved)
+
+	if skipDeps {
+		return r
+	}
+	if deps, err := issueStore.GetDependencyRecords(ctx, source.ID); err == nil && len(deps) > 0 {
+		r.Notes = append(r.Notes, fmt.Sprintf("%d dependencies FROM %s would be removed (recreate in target rig if needed)", len(deps), source.ID))
+	}
+	dependents, err := issueStore.GetDependents(ctx, source.ID)
+	if err != nil {
+		debug.Warnf("could not list dependents of %s: %v\n", source.ID, err)
+	}
+	for _, dependent := range dependents {
+		r.Issues = append(r.Issues, dryRunIssue{ID: dependent.ID, Title: dependent.Title, Changes: []dryRunChange{
+			{Field: "depends_on", From: source.ID, To: fmt.Sprintf("external:%s:<new>", targetRig)},
+		}})
+	}
+	return r
+}
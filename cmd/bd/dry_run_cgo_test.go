@@ -0,0 +1,62 @@
+//go:build cgo
+
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestDryRunLeavesIssuesUnchanged(t *testing.T) {
+	saveAndRestoreGlobals(t)
+	s := newTestStore(t, filepath.Join(t.TempDir(), ".beads", "dolt"))
+	store = s
+	ctx := context.Background()
+	rootCtx = ctx
+
+	open := &types.Issue{Title: "Open task", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Labels: []string{"ui"}}
+	closed := &types.Issue{Title: "Closed task", Status: types.StatusClosed, Priority: 1, IssueType: types.TypeTask}
+	for _, issue := range []*types.Issue{open, closed} {
+		if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue: %v", err)
+		}
+	}
+	snapshot := func() map[string]types.Issue {
+		t.Helper()
+		got := make(map[string]types.Issue)
+		for _, id := range []string{open.ID, closed.ID} {
+			issue, err := s.GetIssue(ctx, id)
+			if err != nil {
+				t.Fatalf("GetIssue(%s): %v", id, err)
+			}
+			got[id] = *issue
+		}
+		return got
+	}
+	before := snapshot()
+
+	updates := map[string]interface{}{"status": "in_progress", "add_labels": []string{"p1"}}
+	if r := dryRunUpdate(ctx, []string{open.ID}, updates, true); r.affected() != 1 {
+		t.Errorf("update preview affected %d issue(s), want 1: %+v", r.affected(), r.Issues)
+	}
+	if r := dryRunClose(ctx, []string{open.ID, closed.ID}, "done", false); r.affected() != 1 {
+		t.Errorf("close preview affected %d issue(s), want 1 (closed one skipped): %+v", r.affected(), r.Issues)
+	}
+	if r := dryRunReopen(ctx, []string{closed.ID}); r.affected() != 1 {
+		t.Errorf("reopen preview affected %d issue(s), want 1: %+v", r.affected(), r.Issues)
+	}
+	if r := dryRunAssign(ctx, []string{open.ID, closed.ID}, "alice"); r.affected() != 2 {
+		t.Errorf("assign preview affected %d issue(s), want 2: %+v", r.affected(), r.Issues)
+	}
+
+	after := snapshot()
+	for id, b := range before {
+		a := after[id]
+		if a.Status != b.Status || a.Assignee != b.Assignee || !a.UpdatedAt.Equal(b.UpdatedAt) || len(a.Labels) != len(b.Labels) {
+			t.Errorf("%s changed under dry run:\n before %+v\n after  %+v", id, b, a)
+		}
+	}
+}
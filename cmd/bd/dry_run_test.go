@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestDiffIssueUpdates(t *testing.T) {
+	issue := &types.Issue{
+		ID:       "bd-1",
+		Title:    "Fix login",
+		Status:   types.StatusOpen,
+		Priority: 2,
+		Notes:    "first",
+		Labels:   []string{"ui", "auth"},
+		Metadata: json.RawMessage(`{"a":1}`),
+	}
+	tests := []struct {
+		name    string
+		updates map[string]interface{}
+		parent  string
+		want    []dryRunChange
+	}{
+		{
+			name:    "scalar fields",
+			updates: map[string]interface{}{"status": "in_progress", "priority": 0, "title": "Fix login"},
+			want: []dryRunChange{
+				{Field: "priority", From: "2", To: "0"},
+				{Field: "status", From: "open", To: "in_progress"},
+			},
+		},
+		{
+			name:    "append notes",
+			updates: map[string]interface{}{"append_notes": "second"},
+			want:    []dryRunChange{{Field: "notes", From: "first", To: "first\nsecond"}},
+		},
+		{
+			name:    "label edits",
+			updates: map[string]interface{}{"add_labels": []string{"p1", "ui"}, "remove_labels": []string{"auth"}},
+			want:    []dryRunChange{{Field: "labels", From: "auth, ui", To: "p1, ui"}},
+		},
+		{
+			name:    "set metadata",
+			updates: map[string]interface{}{"_set_metadata": []string{"b=2"}, "_unset_metadata": []string{}},
+			want:    []dryRunChange{{Field: "metadata", From: `{"a":1}`, To: `{"a":1,"b":2}`}},
+		},
+		{
+			name:    "reparent",
+			updates: map[string]interface{}{"parent": "bd-9"},
+			parent:  "bd-2",
+			want:    []dryRunChange{{Field: "parent", From: "bd-2", To: "bd-9"}},
+		},
+		{
+			name:    "no-op",
+			updates: map[string]interface{}{"status": "open", "assignee": ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := diffIssueUpdates(issue, tt.updates, tt.parent)
+			if err != nil {
+				t.Fatalf("diffIssueUpdates: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDryRunHonoredOrRefused(t *testing.T) {
+	saved := dryRun
+	dryRun = true
+	defer func() { dryRun = saved }()
+
+	// Write commands without CheckReadonly or a --dry-run of their own
+	mustRefuse := map[string]bool{
+		"dolt push":          true,
+		"dolt commit":        true,
+		"dolt remote add":    true,
+		"dolt remote remove": true,
+		"config set":         true,
+		"tag":                true,
+		"tag rm":             true,
+	}
+	// Commands that preview, globally or through their own flag
+	mustHonor := map[string]bool{"update": true, "close": true, "move": true, "create": true, "delete": true, "import": true}
+
+	seen := make(map[string]bool)
+	var walk func(cmd *cobra.Command)
+	walk = func(cmd *cobra.Command) {
+		for _, sub := range cmd.Commands() {
+			walk(sub)
+		}
+		path := dryRunCommandPath(cmd)
+		seen[path] = true
+		honored := cmd.LocalFlags().Lookup("dry-run") != nil || dryRunCommands[path]
+		err := checkDryRunSupported(cmd)
+		switch {
+		case honored && err != nil:
+			t.Errorf("%q previews but is refused: %v", path, err)
+		case !honored && err == nil:
+			t.Errorf("%q neither previews nor refuses --dry-run", path)
+		case mustRefuse[path] && err == nil:
+			t.Errorf("%q writes but accepts --dry-run", path)
+		case mustHonor[path] && err != nil:
+			t.Errorf("%q should preview under --dry-run: %v", path, err)
+		}
+	}
+	walk(rootCmd)
+
+	for path := range dryRunCommands {
+		if !seen[path] {
+			t.Errorf("dryRunCommands lists %q, which is not a command", path)
+		}
+	}
+	for _, paths := range []map[string]bool{mustRefuse, mustHonor} {
+		for path := range paths {
+			if !seen[path] {
+				t.Errorf("%q is not a command", path)
+			}
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+
+	"github.com/steveyegge/beads/internal/debug"
 )
 
 // FatalError writes an error message to stderr and exits with code 1.
@@ -27,7 +29,7 @@ func FatalError(format string, args ...interface{}) {
 	} else {
 		fmt.Fprintf(os.Stderr, "Error: %s\n", msg)
 	}
-	os.Exit(1)
+	exitAfterFatalError(1)
 }
 
 // FatalErrorRespectJSON writes an error message and exits with code 1.
@@ -49,7 +51,7 @@ func FatalErrorRespectJSON(format string, args ...interface{}) {
 	} else {
 		fmt.Fprintf(os.Stderr, "Error: %s\n", msg)
 	}
-	os.Exit(1)
+	exitAfterFatalError(1)
 }
 
 // FatalErrorWithHint writes an error message with a hint to stderr and exits.
@@ -66,7 +68,7 @@ func FatalErrorWithHint(message, hint string) {
 		fmt.Fprintf(os.Stderr, "Error: %s\n", message)
 		fmt.Fprintf(os.Stderr, "Hint: %s\n", hint)
 	}
-	os.Exit(1)
+	exitAfterFatalError(1)
 }
 
 // WarnError writes a warning message to stderr and returns.
@@ -82,8 +84,10 @@ func FatalErrorWithHint(message, hint string) {
 //	if err := createConfigYaml(beadsDir, false); err != nil {
 //	    WarnError("failed to create config.yaml: %v", err)
 //	}
+//
+// Warnings are suppressed by --quiet.
 func WarnError(format string, args ...interface{}) {
-	fmt.Fprintf(os.Stderr, "Warning: "+format+"\n", args...)
+	debug.Warnf(format+"\n", args...)
 }
 
 // CheckReadonly exits with an error if readonly mode is enabled.
@@ -99,6 +103,12 @@ func WarnError(format string, args ...interface{}) {
 //	    },
 //	}
 func CheckReadonly(operation string) {
+	if dryRun {
+		// PersistentPreRun already refused commands that cannot preview
+		// (checkDryRunSupported). Previews only read, so they are allowed in
+		// read-only mode.
+		return
+	}
 	if readonlyMode {
 		FatalError("operation '%s' is not allowed in read-only mode", operation)
 	}
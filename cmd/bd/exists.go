@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/utils"
+)
+
+var existsCmd = &cobra.Command{
+	Use:     "exists <id>",
+	GroupID: "issues",
+	Short:   "Test whether an issue exists (exit status only)",
+	Long: `Test whether an issue exists, for use in shell conditionals.
+
+Exits 0 when the issue exists and is not closed, 1 when it does not, and 2
+on errors such as an ambiguous partial ID. Nothing is printed unless --print
+is given. Partial IDs are resolved as in 'bd show'.
+
+Examples:
+  if bd exists bd-abc; then bd close bd-abc; fi
+  bd exists abc --any-status        # Any status, including closed
+  bd exists bd-abc --closed         # Only closed issues
+  id=$(bd exists abc --print)       # Resolved full ID`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		anyStatus, _ := cmd.Flags().GetBool("any-status")
+		closedOnly, _ := cmd.Flags().GetBool("closed")
+		printID, _ := cmd.Flags().GetBool("print")
+		if anyStatus && closedOnly {
+			existsFatal(fmt.Errorf("cannot specify both --any-status and --closed"))
+		}
+		if store == nil {
+			existsFatal(fmt.Errorf("no database available"))
+		}
+
+		var closed *bool
+		if !anyStatus {
+			closed = &closedOnly
+		}
+		id, ok, err := resolveExistingIssue(rootCtx, store, args[0], closed)
+		if err != nil {
+			existsFatal(err)
+		}
+		if !ok {
+			os.Exit(1)
+		}
+		if printID {
+			fmt.Println(id)
+		}
+	},
+}
+
+// existsFatal reports an error with exit status 2, keeping 1 for "no such
+// issue" so scripts can tell the two apart.
+func existsFatal(err error) {
+	fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	exitAfterFatalError(2)
+}
+
+// resolveExistingIssue checks input against the store, first as an exact
+// ID and then as a partial one, and returns the resolved ID when the issue
+// exists and matches closed (see DoltStore.IssueExists). An ambiguous
+// partial ID is an error; an unknown one is reported as not found.
+func resolveExistingIssue(ctx context.Context, s *dolt.DoltStore, input string, closed *bool) (string, bool, error) {
+	// Exact IDs, the common case in scripts, take one lookup.
+	ok, err := s.IssueExists(ctx, input, closed)
+	if err != nil || ok {
+		return input, ok, err
+	}
+	if closed != nil {
+		if found, err := s.IssueExists(ctx, input, nil); err != nil || found {
+			return input, false, err // Exists, in the other status
+		}
+	}
+
+	id, err := utils.ResolvePartialID(ctx, s, input)
+	if err != nil {
+		if isNotFoundErr(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	ok, err = s.IssueExists(ctx, id, closed)
+	return id, ok, err
+}
+
+func init() {
+	existsCmd.Flags().Bool("any-status", false, "Match issues in any status, including closed")
+	existsCmd.Flags().Bool("closed", false, "Match only closed issues")
+	existsCmd.Flags().Bool("print", false, "Print the resolved issue ID when it exists")
+	rootCmd.AddCommand(existsCmd)
+}
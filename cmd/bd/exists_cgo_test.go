@@ -0,0 +1,60 @@
+//go:build cgo
+
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestResolveExistingIssue(t *testing.T) {
+	s := newTestStore(t, filepath.Join(t.TempDir(), ".beads", "dolt"))
+	ctx := context.Background()
+
+	for _, issue := range []*types.Issue{
+		{ID: "test-abc1", Title: "Open one", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "test-abc2", Title: "Open two", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask},
+		{ID: "test-xyz9", Title: "Done", Status: types.StatusClosed, Priority: 2, IssueType: types.TypeTask},
+	} {
+		if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatalf("CreateIssue(%s): %v", issue.ID, err)
+		}
+	}
+
+	notClosed, closed := false, true
+	tests := []struct {
+		name   string
+		input  string
+		closed *bool
+		wantID string
+		wantOK bool
+	}{
+		{"present open", "test-abc1", &notClosed, "test-abc1", true},
+		{"present by partial ID", "xyz9", nil, "test-xyz9", true},
+		{"closed hidden by default", "test-xyz9", &notClosed, "", false},
+		{"closed with --closed", "test-xyz9", &closed, "test-xyz9", true},
+		{"open with --closed", "test-abc1", &closed, "", false},
+		{"absent", "test-nope", nil, "", false},
+		{"absent partial", "qqq", &notClosed, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok, err := resolveExistingIssue(ctx, s, tt.input, tt.closed)
+			if err != nil {
+				t.Fatalf("resolveExistingIssue(%q): %v", tt.input, err)
+			}
+			if ok != tt.wantOK || (ok && id != tt.wantID) {
+				t.Errorf("resolveExistingIssue(%q) = %q, %v; want %q, %v", tt.input, id, ok, tt.wantID, tt.wantOK)
+			}
+		})
+	}
+
+	// "abc" matches both open issues.
+	if _, _, err := resolveExistingIssue(ctx, s, "abc", nil); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("ambiguous prefix error = %v, want an ambiguous ID error", err)
+	}
+}
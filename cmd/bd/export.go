@@ -1,15 +1,18 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"io"
 	"os"
-	"time"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
-	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/export"
 	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/utils"
 )
 
 var exportCmd = &cobra.Command{
@@ -18,17 +21,50 @@ var exportCmd = &cobra.Command{
 	Long: `Export all issues to JSONL (newline-delimited JSON) format.
 
 Each line is a complete JSON object representing one issue, including its
-labels, dependencies, and comment count. The output is compatible with
+labels, dependencies, metadata (see 'bd meta'), attachment references
+(see 'bd attach'), and comment count. The output is compatible with
 'bd import' for round-trip backup and restore.
 
 By default, exports only regular issues (excluding infrastructure beads
 like agents, rigs, roles, and messages). Use --all to include everything.
 
+Use --shard-by prefix to write one file per top-level issue instead
+(<dir>/<root-id>.jsonl, default dir .beads/issues/), so edits to different
+subtrees touch different files and merge without git conflicts. Children
+are grouped with their top-level ancestor by hierarchical ID or parent-child
+dependency. The shards written are recorded in <dir>/.shards.json, and the
+next sharded export removes only recorded shards whose subtree no longer
+exists; other files in the directory are left alone. 'bd import' accepts the
+shard directory and reads the recorded shards.
+
+Use --jsonl to write the project's JSONL file. Its location comes from the
+export.jsonl-path config key (relative to .beads/ or absolute) and defaults
+to .beads/issues.jsonl; missing parent directories are created. Saved views
+(see 'bd view') and assignee groups (see 'bd group') are written to
+.beads/views.jsonl and .beads/groups.jsonl alongside it. The file is not
+refreshed automatically; 'bd doctor' warns when it falls behind the
+database. Use --jsonl --remove to delete it instead.
+
+Use --issue to export one issue, and add --subtree to include every
+descendant (hierarchical IDs under it and parent-child children, at any
+depth), with labels, dependencies and comments. Dependency edges that point
+outside the exported set are dropped and listed on stderr, so the file
+imports cleanly into another repo; pair it with 'bd import --under' to
+reparent the subtree there.
+
+--format chooses jsonl (default), csv or markdown. Markdown uses the layout
+'bd create -f' reads. Only jsonl can be re-imported with 'bd import'.
+
 EXAMPLES:
   bd export                          # Export to stdout
   bd export -o backup.jsonl          # Export to file
+  bd export --jsonl                  # Export to the configured JSONL file
+  bd export --jsonl --remove         # Delete the configured JSONL file
+  bd export --shard-by prefix        # One file per top-level issue in .beads/issues/
   bd export --all -o full.jsonl      # Include infra + templates + gates
-  bd export --scrub -o clean.jsonl   # Exclude test/pollution records`,
+  bd export --scrub -o clean.jsonl   # Exclude test/pollution records
+  bd export --issue bd-abc --subtree -o epic.jsonl      # One epic and its descendants
+  bd export --issue bd-abc --subtree --format markdown  # Share an epic as markdown`,
 	GroupID: "sync",
 	RunE:    runExport,
 }
@@ -38,6 +74,12 @@ var (
 	exportAll          bool
 	exportIncludeInfra bool
 	exportScrub        bool
+	exportToJSONL      bool
+	exportShardBy      string
+	exportRemove       bool
+	exportIssue        string
+	exportSubtree      bool
+	exportFormat       string
 )
 
 func init() {
@@ -45,15 +87,66 @@ func init() {
 	exportCmd.Flags().BoolVar(&exportAll, "all", false, "Include all records (infra, templates, gates)")
 	exportCmd.Flags().BoolVar(&exportIncludeInfra, "include-infra", false, "Include infrastructure beads (agents, rigs, roles, messages)")
 	exportCmd.Flags().BoolVar(&exportScrub, "scrub", false, "Exclude test/pollution records")
+	exportCmd.Flags().BoolVar(&exportToJSONL, "jsonl", false, "Write to the configured JSONL file (export.jsonl-path, default .beads/issues.jsonl)")
+	exportCmd.Flags().StringVar(&exportShardBy, "shard-by", "", "Write one JSONL file per shard into a directory (-o, default .beads/issues/). Values: prefix")
+	exportCmd.Flags().BoolVar(&exportRemove, "remove", false, "With --jsonl, delete the configured JSONL file instead of writing it")
+	exportCmd.Flags().StringVar(&exportIssue, "issue", "", "Export only this issue (add --subtree for its descendants)")
+	exportCmd.Flags().BoolVar(&exportSubtree, "subtree", false, "With --issue, also export every descendant of the issue")
+	exportCmd.Flags().StringVar(&exportFormat, "format", export.FormatJSONL, "Output format: jsonl, csv or markdown")
+	exportCmd.MarkFlagsMutuallyExclusive("output", "jsonl")
+	exportCmd.MarkFlagsMutuallyExclusive("issue", "jsonl")
+	exportCmd.MarkFlagsMutuallyExclusive("issue", "shard-by")
+	exportCmd.MarkFlagsMutuallyExclusive("shard-by", "jsonl")
 	rootCmd.AddCommand(exportCmd)
 }
 
 func runExport(cmd *cobra.Command, args []string) error {
 	ctx := rootCtx
 
+	if exportShardBy != "" && exportShardBy != exportShardByPrefix {
+		return fmt.Errorf("invalid --shard-by %q (valid: %s)", exportShardBy, exportShardByPrefix)
+	}
+
+	if exportRemove {
+		return removeJSONLExport()
+	}
+
+	switch exportFormat {
+	case export.FormatJSONL, export.FormatCSV, export.FormatMarkdown:
+	default:
+		return fmt.Errorf("invalid --format %q (valid: %s, %s, %s)", exportFormat, export.FormatJSONL, export.FormatCSV, export.FormatMarkdown)
+	}
+	if exportFormat != export.FormatJSONL && (exportShardBy != "" || exportToJSONL) {
+		return fmt.Errorf("--format %s cannot be combined with --shard-by or --jsonl", exportFormat)
+	}
+	if exportSubtree && exportIssue == "" {
+		return fmt.Errorf("--subtree requires --issue")
+	}
+
 	// Determine output destination
+	if exportShardBy != "" {
+		if exportOutput == "" {
+			beadsDir := beads.FindBeadsDir()
+			if beadsDir == "" {
+				return fmt.Errorf("not in a beads repository")
+			}
+			exportOutput = filepath.Join(beadsDir, defaultShardDir)
+		}
+	} else if exportToJSONL {
+		beadsDir := beads.FindBeadsDir()
+		if beadsDir == "" {
+			return fmt.Errorf("not in a beads repository")
+		}
+		exportOutput = config.JSONLPath(beadsDir)
+		if err := os.MkdirAll(filepath.Dir(exportOutput), 0o750); err != nil {
+			return fmt.Errorf("failed to create JSONL directory: %w", err)
+		}
+	}
+
 	var w io.Writer
-	if exportOutput != "" {
+	if exportShardBy != "" {
+		// Shard files are created after the issues are grouped
+	} else if exportOutput != "" {
 		f, err := os.Create(exportOutput) //nolint:gosec // user-provided output path
 		if err != nil {
 			return fmt.Errorf("failed to create output file: %w", err)
@@ -64,53 +157,16 @@ func runExport(cmd *cobra.Command, args []string) error {
 		w = os.Stdout
 	}
 
-	// Build filter for issues table. Export all statuses (this is a backup tool).
-	filter := types.IssueFilter{Limit: 0}
-
-	// Exclude infra types by default (agents, rigs, roles, messages)
-	if !exportAll && !exportIncludeInfra {
-		var infraTypes []string
-		if store != nil {
-			infraSet := store.GetInfraTypes(ctx)
-			if len(infraSet) > 0 {
-				for t := range infraSet {
-					infraTypes = append(infraTypes, t)
-				}
-			}
-		}
-		if len(infraTypes) == 0 {
-			infraTypes = dolt.DefaultInfraTypes()
-		}
-		for _, t := range infraTypes {
-			filter.ExcludeTypes = append(filter.ExcludeTypes, types.IssueType(t))
-		}
-	}
-
-	// Exclude templates by default
-	if !exportAll {
-		isTemplate := false
-		filter.IsTemplate = &isTemplate
-	}
-
-	// Fetch all matching issues from the issues table
-	issues, err := store.SearchIssues(ctx, "", filter)
+	issues, depCounts, commentCounts, err := loadExportIssues(ctx, exportAll, exportIncludeInfra, exportScrub)
 	if err != nil {
-		return fmt.Errorf("failed to search issues: %w", err)
-	}
-
-	// Also fetch wisps (ephemeral beads) using the store's ephemeral routing.
-	// SearchIssues with Ephemeral=true queries the wisps table directly.
-	ephemeral := true
-	wispFilter := filter
-	wispFilter.Ephemeral = &ephemeral
-	wispIssues, err := store.SearchIssues(ctx, "", wispFilter)
-	if err == nil && len(wispIssues) > 0 {
-		issues = append(issues, wispIssues...)
+		return err
 	}
 
-	// Scrub test/pollution records if requested
-	if exportScrub {
-		issues = filterOutPollution(issues)
+	if exportIssue != "" {
+		issues, depCounts, err = selectExportSubtree(ctx, issues, exportIssue, exportSubtree)
+		if err != nil {
+			return err
+		}
 	}
 
 	if len(issues) == 0 {
@@ -120,54 +176,29 @@ func runExport(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	// Bulk-load relational data
-	issueIDs := make([]string, len(issues))
-	for i, issue := range issues {
-		issueIDs[i] = issue.ID
+	if exportShardBy != "" {
+		shards, err := writeShardedJSONL(exportOutput, issues, depCounts, commentCounts)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Exported %d issues to %d shard(s) in %s\n", len(issues), shards, exportOutput)
+		exportSavedViews(ctx)
+		exportAssigneeGroups(ctx)
+		return nil
 	}
 
-	labelsMap, _ := store.GetLabelsForIssues(ctx, issueIDs)
-	allDeps, _ := store.GetDependencyRecordsForIssues(ctx, issueIDs)
-	commentCounts, _ := store.GetCommentCounts(ctx, issueIDs)
-	depCounts, _ := store.GetDependencyCounts(ctx, issueIDs)
-
-	// Populate relational data on each issue
-	for _, issue := range issues {
-		issue.Labels = labelsMap[issue.ID]
-		issue.Dependencies = allDeps[issue.ID]
+	var count int
+	switch exportFormat {
+	case export.FormatCSV:
+		count, err = export.WriteCSV(w, issues)
+	case export.FormatMarkdown:
+		count, err = export.WriteMarkdown(w, issues)
+	default:
+		// Write JSONL: one JSON object per line
+		count, err = export.WriteJSONL(w, issues, depCounts, commentCounts)
 	}
-
-	// Write JSONL: one JSON object per line
-	count := 0
-	for _, issue := range issues {
-		counts := depCounts[issue.ID]
-		if counts == nil {
-			counts = &types.DependencyCounts{}
-		}
-
-		// Sanitize zero-value timestamps that can't be marshaled to JSON.
-		// NULL datetime columns scanned as time.Time{} (year 0001) cause
-		// MarshalJSON to fail with "year outside of range [0,9999]". (GH#2488)
-		sanitizeZeroTime(issue)
-
-		record := &types.IssueWithCounts{
-			Issue:           issue,
-			DependencyCount: counts.DependencyCount,
-			DependentCount:  counts.DependentCount,
-			CommentCount:    commentCounts[issue.ID],
-		}
-
-		data, err := json.Marshal(record)
-		if err != nil {
-			return fmt.Errorf("failed to marshal issue %s: %w", issue.ID, err)
-		}
-		if _, err := w.Write(data); err != nil {
-			return fmt.Errorf("failed to write: %w", err)
-		}
-		if _, err := w.Write([]byte{'\n'}); err != nil {
-			return fmt.Errorf("failed to write newline: %w", err)
-		}
-		count++
+	if err != nil {
+		return err
 	}
 
 	// Sync to disk if writing to file
@@ -181,21 +212,84 @@ func runExport(cmd *cobra.Command, args []string) error {
 	if exportOutput != "" {
 		fmt.Fprintf(os.Stderr, "Exported %d issues to %s\n", count, exportOutput)
 	}
+	if exportToJSONL {
+		exportSavedViews(ctx)
+		exportAssigneeGroups(ctx)
+	}
 
 	return nil
 }
 
-// sanitizeZeroTime replaces Go zero-value time.Time fields with Unix epoch.
-// NULL datetime columns in Dolt scan as time.Time{} (year 0001-01-01), which
-// causes json.Marshal to fail with "year outside of range [0,9999]". (GH#2488)
-func sanitizeZeroTime(issue *types.Issue) {
-	epoch := time.Unix(0, 0).UTC()
-	if issue.CreatedAt.IsZero() {
-		issue.CreatedAt = epoch
+// loadExportIssues fetches the issues and wisps 'bd export' writes, with
+// labels, dependencies, meta and attachments populated. Infra types and templates are left out
+// unless all (or includeInfra, for infra types) is set.
+func loadExportIssues(ctx context.Context, all, includeInfra, scrub bool) ([]*types.Issue, map[string]*types.DependencyCounts, map[string]int, error) {
+	opts := export.Options{All: all, IncludeInfra: includeInfra}
+	if scrub {
+		opts.Filter = filterOutPollution
 	}
-	if issue.UpdatedAt.IsZero() {
-		issue.UpdatedAt = epoch
+	snap, err := export.LoadIssues(ctx, store, opts)
+	if err != nil {
+		return nil, nil, nil, err
 	}
+	return snap.Issues, snap.DepCounts, snap.CommentCounts, nil
+}
+
+// selectExportSubtree narrows the loaded issues to one issue (and with
+// withDescendants its subtree), loads their comments, and drops dependency
+// edges that leave the exported set, reporting them on stderr.
+func selectExportSubtree(ctx context.Context, issues []*types.Issue, id string, withDescendants bool) ([]*types.Issue, map[string]*types.DependencyCounts, error) {
+	rootID, err := utils.ResolvePartialID(ctx, store, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("resolving --issue %s: %w", id, err)
+	}
+	subtree := selectSubtree(issues, rootID, withDescendants)
+	if subtree == nil {
+		return nil, nil, fmt.Errorf("issue %s is not exported (use --all for templates and infra types)", rootID)
+	}
+
+	ids := make([]string, len(subtree))
+	for i, issue := range subtree {
+		ids[i] = issue.ID
+	}
+	comments, err := store.GetCommentsForIssues(ctx, ids)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load comments: %w", err)
+	}
+	for _, issue := range subtree {
+		issue.Comments = comments[issue.ID]
+	}
+
+	if dropped := pruneExternalDeps(subtree); len(dropped) > 0 {
+		fmt.Fprintf(os.Stderr, "Dropped %d dependency edge(s) pointing outside the export:\n", len(dropped))
+		for _, dep := range dropped {
+			fmt.Fprintf(os.Stderr, "  %s\n", formatDroppedDep(dep))
+		}
+	}
+	return subtree, subtreeDepCounts(subtree), nil
+}
+
+// removeJSONLExport deletes the configured JSONL file. The database is the
+// source of truth, so a JSONL nobody refreshes is better removed than left
+// to go stale (see 'bd doctor').
+func removeJSONLExport() error {
+	if !exportToJSONL {
+		return fmt.Errorf("--remove requires --jsonl")
+	}
+	beadsDir := beads.FindBeadsDir()
+	if beadsDir == "" {
+		return fmt.Errorf("not in a beads repository")
+	}
+	path := config.JSONLPath(beadsDir)
+	if err := os.Remove(path); err != nil {
+		if os.IsNotExist(err) {
+			fmt.Fprintf(os.Stderr, "No JSONL file at %s\n", path)
+			return nil
+		}
+		return fmt.Errorf("failed to remove JSONL file: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Removed %s\n", path)
+	return nil
 }
 
 // filterOutPollution removes issues that look like test/pollution records.
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/export"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+const (
+	// exportShardByPrefix shards by top-level ancestor (the ID prefix of a subtree).
+	exportShardByPrefix = "prefix"
+
+	// defaultShardDir is the shard directory under .beads/ when -o is not given.
+	defaultShardDir = "issues"
+
+	// shardManifestFile lists the shards the last sharded export wrote, so the
+	// next one prunes only its own files. It does not end in .jsonl, so a
+	// directory import never reads it as a shard.
+	shardManifestFile = ".shards.json"
+)
+
+// shardManifest is the content of shardManifestFile.
+type shardManifest struct {
+	Shards []string `json:"shards"`
+}
+
+// shardRootID returns the top-level ancestor of an issue: parent-child
+// dependencies are followed upward first, then the hierarchical ID root
+// (bd-abc.1.2 → bd-abc) of whatever ancestor that reaches.
+func shardRootID(id string, parentOf map[string]string) string {
+	seen := map[string]bool{id: true}
+	for {
+		parent, ok := parentOf[id]
+		if !ok || seen[parent] {
+			break
+		}
+		seen[parent] = true
+		id = parent
+	}
+	root, _, _ := config.GetIDHierarchy().Parse(id)
+	return root
+}
+
+// shardFileName maps a root ID to a safe file name.
+func shardFileName(rootID string) string {
+	return strings.NewReplacer("/", "_", `\`, "_").Replace(rootID) + ".jsonl"
+}
+
+// writeShardedJSONL writes issues into dir as one JSONL file per top-level
+// ancestor and removes shard files for subtrees that no longer exist. Only
+// files listed in the previous export's manifest are removed, so other JSONL
+// files in dir (issues.jsonl when dir is .beads/) are never touched.
+// Returns the number of shard files written.
+func writeShardedJSONL(dir string, issues []*types.Issue, depCounts map[string]*types.DependencyCounts, commentCounts map[string]int) (int, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return 0, fmt.Errorf("failed to create shard directory: %w", err)
+	}
+
+	parentOf := make(map[string]string)
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep.Type == types.DepParentChild && dep.IssueID == issue.ID {
+				parentOf[issue.ID] = dep.DependsOnID
+			}
+		}
+	}
+
+	shards := make(map[string][]*types.Issue)
+	for _, issue := range issues {
+		name := shardFileName(shardRootID(issue.ID, parentOf))
+		shards[name] = append(shards[name], issue)
+	}
+
+	names := make([]string, 0, len(shards))
+	for name := range shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := writeShardFile(filepath.Join(dir, name), shards[name], depCounts, commentCounts); err != nil {
+			return 0, err
+		}
+	}
+
+	// Drop shards of the previous export whose subtree was deleted or
+	// re-parented away
+	previous, err := readShardManifest(dir)
+	if err != nil {
+		return len(names), err
+	}
+	for _, name := range previous {
+		if _, ok := shards[name]; ok {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, name)); err != nil && !os.IsNotExist(err) {
+			return len(names), fmt.Errorf("failed to remove stale shard %s: %w", name, err)
+		}
+	}
+
+	if err := writeShardManifest(dir, names); err != nil {
+		return len(names), err
+	}
+	return len(names), nil
+}
+
+// readShardManifest returns the shard names recorded in dir by the last
+// sharded export, or nil if there is no manifest. Names that are not plain
+// .jsonl file names are ignored, so a hand-edited manifest cannot point
+// outside dir.
+func readShardManifest(dir string) ([]string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, shardManifestFile)) //nolint:gosec // path built from export directory
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read shard manifest: %w", err)
+	}
+	var m shardManifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse shard manifest %s: %w", filepath.Join(dir, shardManifestFile), err)
+	}
+	names := make([]string, 0, len(m.Shards))
+	for _, name := range m.Shards {
+		if name == filepath.Base(name) && strings.HasSuffix(name, ".jsonl") {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// writeShardManifest records the shards just written, via a temp file and
+// rename.
+func writeShardManifest(dir string, names []string) error {
+	data, err := json.MarshalIndent(shardManifest{Shards: names}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode shard manifest: %w", err)
+	}
+	path := filepath.Join(dir, shardManifestFile)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write shard manifest: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to write shard manifest: %w", err)
+	}
+	return nil
+}
+
+// writeShardFile writes a single shard via a temp file and rename so readers
+// never see a partially written shard.
+func writeShardFile(path string, issues []*types.Issue, depCounts map[string]*types.DependencyCounts, commentCounts map[string]int) error {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp) //nolint:gosec // path built from export directory
+	if err != nil {
+		return fmt.Errorf("failed to create shard %s: %w", filepath.Base(path), err)
+	}
+	w := bufio.NewWriter(f)
+	if _, err := export.WriteJSONL(w, issues, depCounts, commentCounts); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to write shard %s: %w", filepath.Base(path), err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to sync shard %s: %w", filepath.Base(path), err)
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to close shard %s: %w", filepath.Base(path), err)
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestShardRootID(t *testing.T) {
+	parentOf := map[string]string{
+		"bd-child": "bd-epic",
+		"bd-epic":  "bd-top.1",
+		"bd-loopa": "bd-loopb",
+		"bd-loopb": "bd-loopa",
+	}
+
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{"bd-abc", "bd-abc"},
+		{"bd-abc.1.2", "bd-abc"},
+		{"bd-child", "bd-top"},
+		{"bd-loopa", "bd-loopb"},
+	}
+	for _, tt := range tests {
+		if got := shardRootID(tt.id, parentOf); got != tt.want {
+			t.Errorf("shardRootID(%q) = %q, want %q", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestWriteShardedJSONL(t *testing.T) {
+	dir := t.TempDir()
+
+	// A shard from a previous export whose subtree no longer exists, and a
+	// JSONL file no shard export wrote
+	stale := filepath.Join(dir, "bd-gone.jsonl")
+	other := filepath.Join(dir, "issues.jsonl")
+	for _, path := range []string{stale, other} {
+		if err := os.WriteFile(path, []byte("{}\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writeShardManifest(dir, []string{"bd-gone.jsonl", "bd-solo.jsonl"}); err != nil {
+		t.Fatal(err)
+	}
+
+	issues := []*types.Issue{
+		{ID: "bd-abc", Title: "Epic", Status: types.StatusOpen},
+		{ID: "bd-abc.1", Title: "Child", Status: types.StatusOpen},
+		{ID: "bd-xyz", Title: "Linked child", Status: types.StatusOpen,
+			Dependencies: []*types.Dependency{{IssueID: "bd-xyz", DependsOnID: "bd-abc", Type: types.DepParentChild}}},
+		{ID: "bd-solo", Title: "Standalone", Status: types.StatusOpen},
+	}
+
+	n, err := writeShardedJSONL(dir, issues, nil, nil)
+	if err != nil {
+		t.Fatalf("writeShardedJSONL: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("wrote %d shards, want 2", n)
+	}
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("stale shard was not removed")
+	}
+	if _, err := os.Stat(other); err != nil {
+		t.Errorf("JSONL file not written by a shard export was removed: %v", err)
+	}
+	if got, err := readShardManifest(dir); err != nil || strings.Join(got, " ") != "bd-abc.jsonl bd-solo.jsonl" {
+		t.Errorf("manifest = %v (err %v), want the two current shards", got, err)
+	}
+	if shards, err := listJSONLShards(dir); err != nil || len(shards) != 2 {
+		t.Errorf("directory import would read %v (err %v), want only the manifest's shards", shards, err)
+	}
+
+	abc, err := readJSONLIssues(filepath.Join(dir, "bd-abc.jsonl"))
+	if err != nil {
+		t.Fatalf("read bd-abc shard: %v", err)
+	}
+	if len(abc) != 3 {
+		t.Errorf("bd-abc shard has %d issues, want 3", len(abc))
+	}
+	solo, err := readJSONLIssues(filepath.Join(dir, "bd-solo.jsonl"))
+	if err != nil {
+		t.Fatalf("read bd-solo shard: %v", err)
+	}
+	if len(solo) != 1 || solo[0].ID != "bd-solo" {
+		t.Errorf("bd-solo shard = %v, want [bd-solo]", solo)
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// selectSubtree returns the issue rootID and, when withDescendants is set,
+// every descendant: issues whose hierarchical ID sits under rootID
+// (bd-abc.1, bd-abc.1.2) and issues linked below it by parent-child
+// dependencies, at any depth. Issues keep their input order. Returns nil
+// when rootID is not among issues.
+func selectSubtree(issues []*types.Issue, rootID string, withDescendants bool) []*types.Issue {
+	h := config.GetIDHierarchy()
+	byID := make(map[string]*types.Issue, len(issues))
+	childrenOf := make(map[string][]string)
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+		if _, parent, depth := h.Parse(issue.ID); depth > 0 {
+			childrenOf[parent] = append(childrenOf[parent], issue.ID)
+		}
+		for _, dep := range issue.Dependencies {
+			if dep.Type == types.DepParentChild && dep.IssueID == issue.ID {
+				childrenOf[dep.DependsOnID] = append(childrenOf[dep.DependsOnID], issue.ID)
+			}
+		}
+	}
+	if byID[rootID] == nil {
+		return nil
+	}
+
+	in := map[string]bool{rootID: true}
+	if withDescendants {
+		queue := []string{rootID}
+		// Prefix match, so bd-abc.1.2 is found even if bd-abc.1 is gone
+		for _, issue := range issues {
+			if h.IsChildOf(issue.ID, rootID) {
+				in[issue.ID] = true
+				queue = append(queue, issue.ID)
+			}
+		}
+		for len(queue) > 0 {
+			id := queue[0]
+			queue = queue[1:]
+			for _, child := range childrenOf[id] {
+				if !in[child] {
+					in[child] = true
+					queue = append(queue, child)
+				}
+			}
+		}
+	}
+
+	var subtree []*types.Issue
+	for _, issue := range issues {
+		if in[issue.ID] {
+			subtree = append(subtree, issue)
+		}
+	}
+	return subtree
+}
+
+// pruneExternalDeps removes dependency edges that leave the subtree, so the
+// export imports cleanly elsewhere, and returns the dropped edges sorted by
+// issue. The root's link to its own parent is among them: on import the
+// root becomes top-level (or is reparented with 'bd import --under').
+func pruneExternalDeps(subtree []*types.Issue) []*types.Dependency {
+	in := make(map[string]bool, len(subtree))
+	for _, issue := range subtree {
+		in[issue.ID] = true
+	}
+	var dropped []*types.Dependency
+	for _, issue := range subtree {
+		kept := issue.Dependencies[:0]
+		for _, dep := range issue.Dependencies {
+			if in[dep.DependsOnID] {
+				kept = append(kept, dep)
+			} else {
+				dropped = append(dropped, dep)
+			}
+		}
+		issue.Dependencies = kept
+	}
+	sort.SliceStable(dropped, func(i, j int) bool { return dropped[i].IssueID < dropped[j].IssueID })
+	return dropped
+}
+
+// subtreeDepCounts recounts dependencies within the exported issues, since
+// the database-wide counts include edges that were pruned.
+func subtreeDepCounts(subtree []*types.Issue) map[string]*types.DependencyCounts {
+	counts := make(map[string]*types.DependencyCounts, len(subtree))
+	for _, issue := range subtree {
+		counts[issue.ID] = &types.DependencyCounts{}
+	}
+	for _, issue := range subtree {
+		for _, dep := range issue.Dependencies {
+			counts[issue.ID].DependencyCount++
+			if c := counts[dep.DependsOnID]; c != nil {
+				c.DependentCount++
+			}
+		}
+	}
+	return counts
+}
+
+// formatDroppedDep renders a pruned edge for the export report.
+func formatDroppedDep(dep *types.Dependency) string {
+	return fmt.Sprintf("%s -(%s)-> %s", dep.IssueID, dep.Type, dep.DependsOnID)
+}
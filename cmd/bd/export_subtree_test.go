@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/export"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// subtreeFixture is an epic with a hierarchical child and grandchild, a
+// child linked only by a parent-child dependency, and unrelated issues.
+func subtreeFixture() []*types.Issue {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	issue := func(id, title string, deps ...*types.Dependency) *types.Issue {
+		return &types.Issue{ID: id, Title: title, Status: types.StatusOpen, Priority: 2,
+			IssueType: types.TypeTask, CreatedAt: created, UpdatedAt: created, Dependencies: deps}
+	}
+	dep := func(from, to string, t types.DependencyType) *types.Dependency {
+		return &types.Dependency{IssueID: from, DependsOnID: to, Type: t}
+	}
+	return []*types.Issue{
+		issue("bd-top", "Program"),
+		issue("bd-epic", "Epic", dep("bd-epic", "bd-top", types.DepParentChild)),
+		issue("bd-epic.1", "Child", dep("bd-epic.1", "bd-epic", types.DepParentChild), dep("bd-epic.1", "bd-other", types.DepBlocks)),
+		issue("bd-epic.1.1", "Grandchild", dep("bd-epic.1.1", "bd-epic.1", types.DepParentChild)),
+		issue("bd-linked", "Linked child", dep("bd-linked", "bd-epic", types.DepParentChild), dep("bd-linked", "bd-epic.1", types.DepBlocks)),
+		issue("bd-linked.1", "Linked grandchild"),
+		issue("bd-other", "Unrelated"),
+		issue("bd-epicure", "Shares a prefix but is not a child"),
+	}
+}
+
+func subtreeIDs(issues []*types.Issue) []string {
+	var ids []string
+	for _, issue := range issues {
+		ids = append(ids, issue.ID)
+	}
+	return ids
+}
+
+func TestSelectSubtree(t *testing.T) {
+	issues := subtreeFixture()
+
+	got := subtreeIDs(selectSubtree(issues, "bd-epic", true))
+	want := []string{"bd-epic", "bd-epic.1", "bd-epic.1.1", "bd-linked", "bd-linked.1"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("subtree = %v, want %v", got, want)
+	}
+
+	if got := subtreeIDs(selectSubtree(issues, "bd-epic", false)); !reflect.DeepEqual(got, []string{"bd-epic"}) {
+		t.Errorf("without --subtree = %v, want [bd-epic]", got)
+	}
+	if got := selectSubtree(issues, "bd-missing", true); got != nil {
+		t.Errorf("missing root = %v, want nil", got)
+	}
+
+	// A grandchild whose parent was deleted is still found by its ID prefix
+	orphaned := []*types.Issue{{ID: "bd-x"}, {ID: "bd-x.1.1"}}
+	if got := subtreeIDs(selectSubtree(orphaned, "bd-x", true)); !reflect.DeepEqual(got, []string{"bd-x", "bd-x.1.1"}) {
+		t.Errorf("orphaned grandchild subtree = %v", got)
+	}
+}
+
+func TestPruneExternalDeps(t *testing.T) {
+	subtree := selectSubtree(subtreeFixture(), "bd-epic", true)
+	dropped := pruneExternalDeps(subtree)
+
+	var got []string
+	for _, dep := range dropped {
+		got = append(got, formatDroppedDep(dep))
+	}
+	want := []string{"bd-epic -(parent-child)-> bd-top", "bd-epic.1 -(blocks)-> bd-other"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("dropped = %v, want %v", got, want)
+	}
+	for _, issue := range subtree {
+		for _, dep := range issue.Dependencies {
+			if dep.DependsOnID == "bd-top" || dep.DependsOnID == "bd-other" {
+				t.Errorf("%s still depends on %s", issue.ID, dep.DependsOnID)
+			}
+		}
+	}
+
+	counts := subtreeDepCounts(subtree)
+	if c := counts["bd-epic.1"]; c.DependencyCount != 1 || c.DependentCount != 2 {
+		t.Errorf("bd-epic.1 counts = %+v, want 1 dependency and 2 dependents", c)
+	}
+	if c := counts["bd-epic"]; c.DependencyCount != 0 || c.DependentCount != 2 {
+		t.Errorf("bd-epic counts = %+v, want 0 dependencies and 2 dependents", c)
+	}
+}
+
+// TestExportSubtreeJSONLRoundTrip checks that an exported subtree reads back
+// through the import parser with labels, dependencies and comments intact.
+func TestExportSubtreeJSONLRoundTrip(t *testing.T) {
+	subtree := selectSubtree(subtreeFixture(), "bd-epic", true)
+	pruneExternalDeps(subtree)
+	subtree[1].Labels = []string{"backend", "urgent"}
+	subtree[1].Comments = []*types.Comment{{IssueID: "bd-epic.1", Author: "alice", Text: "Looks good", CreatedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)}}
+
+	path := filepath.Join(t.TempDir(), "epic.jsonl")
+	var buf bytes.Buffer
+	if _, err := export.WriteJSONL(&buf, subtree, subtreeDepCounts(subtree), nil); err != nil {
+		t.Fatalf("export.WriteJSONL: %v", err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := readJSONLIssues(path)
+	if err != nil {
+		t.Fatalf("readJSONLIssues: %v", err)
+	}
+	if got := subtreeIDs(read); !reflect.DeepEqual(got, subtreeIDs(subtree)) {
+		t.Fatalf("read back %v, want %v", got, subtreeIDs(subtree))
+	}
+	child := read[1]
+	if !reflect.DeepEqual(child.Labels, []string{"backend", "urgent"}) {
+		t.Errorf("labels = %v", child.Labels)
+	}
+	if len(child.Comments) != 1 || child.Comments[0].Text != "Looks good" {
+		t.Errorf("comments = %v", child.Comments)
+	}
+	if len(child.Dependencies) != 1 || child.Dependencies[0].DependsOnID != "bd-epic" {
+		t.Errorf("dependencies = %v, want only the edge to bd-epic", child.Dependencies)
+	}
+}
+
+func TestExportSubtreeMarkdownRoundTrip(t *testing.T) {
+	subtree := selectSubtree(subtreeFixture(), "bd-epic", true)
+	pruneExternalDeps(subtree)
+	subtree[0].Description = "Ship the epic"
+	subtree[0].Labels = []string{"backend"}
+
+	path := filepath.Join(t.TempDir(), "epic.md")
+	var buf bytes.Buffer
+	if _, err := export.WriteMarkdown(&buf, subtree); err != nil {
+		t.Fatalf("export.WriteMarkdown: %v", err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	templates, err := parseMarkdownFile(path)
+	if err != nil {
+		t.Fatalf("parseMarkdownFile: %v", err)
+	}
+	if len(templates) != len(subtree) {
+		t.Fatalf("parsed %d issues, want %d", len(templates), len(subtree))
+	}
+	epic := templates[0]
+	if epic.Title != "Epic" || epic.Description != "Ship the epic" || !reflect.DeepEqual(epic.Labels, []string{"backend"}) {
+		t.Errorf("epic = %+v", epic)
+	}
+	if got := templates[1].Dependencies; !reflect.DeepEqual(got, []string{"parent-child:bd-epic"}) {
+		t.Errorf("child dependencies = %v, want [parent-child:bd-epic]", got)
+	}
+}
+
+func TestWriteCSVRecords(t *testing.T) {
+	subtree := selectSubtree(subtreeFixture(), "bd-epic", true)
+	pruneExternalDeps(subtree)
+	subtree[1].Labels = []string{"a", "b"}
+	subtree[1].Description = "line one\nline, two"
+
+	var buf bytes.Buffer
+	n, err := export.WriteCSV(&buf, subtree)
+	if err != nil {
+		t.Fatalf("export.WriteCSV: %v", err)
+	}
+	if n != len(subtree) {
+		t.Errorf("wrote %d rows, want %d", n, len(subtree))
+	}
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV does not parse: %v", err)
+	}
+	if !reflect.DeepEqual(rows[0], export.CSVHeader) {
+		t.Errorf("header = %v", rows[0])
+	}
+	child := rows[2]
+	if child[0] != "bd-epic.1" || child[6] != "bd-epic" || child[7] != "a,b" || child[8] != "parent-child:bd-epic" {
+		t.Errorf("child row = %v", child)
+	}
+	if child[12] != "line one\nline, two" {
+		t.Errorf("description = %q", child[12])
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/testutil"
 )
 
@@ -122,6 +123,71 @@ func TestExportToFile(t *testing.T) {
 	}
 }
 
+func TestExportToConfiguredJSONLPath(t *testing.T) {
+	if testDoltServerPort == 0 {
+		t.Skip("Dolt test server not available")
+	}
+	if testutil.DoltContainerCrashed() {
+		t.Skipf("Dolt test server crashed: %v", testutil.DoltContainerCrashError())
+	}
+
+	ensureTestMode(t)
+	saveAndRestoreGlobals(t)
+
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(tmpDir)
+	t.Setenv("BEADS_DIR", beadsDir)
+
+	dbName := uniqueTestDBName(t)
+	testDBPath := filepath.Join(beadsDir, "dolt")
+	writeTestMetadata(t, testDBPath, dbName)
+	s := newTestStore(t, testDBPath)
+	store = s
+	t.Cleanup(func() { store = nil })
+
+	ctx := context.Background()
+	rootCtx = ctx
+
+	if _, err := s.DB().ExecContext(ctx, `INSERT INTO issues (id, title, description, design, acceptance_criteria, notes, status, priority, issue_type) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		"exp-1", "Export Issue 1", "description one", "", "", "", "open", 1, "task"); err != nil {
+		t.Fatalf("insert issue: %v", err)
+	}
+
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("config.Initialize: %v", err)
+	}
+	// Relative to .beads/, in a directory that doesn't exist yet
+	config.Set("export.jsonl-path", "exports/team.jsonl")
+	t.Cleanup(func() { config.Set("export.jsonl-path", "") })
+
+	exportToJSONL = true
+	exportOutput = ""
+	exportAll = false
+	exportIncludeInfra = false
+	exportScrub = false
+	t.Cleanup(func() { exportToJSONL = false; exportOutput = "" })
+
+	if err := runExport(nil, nil); err != nil {
+		t.Fatalf("runExport: %v", err)
+	}
+
+	want := filepath.Join(beadsDir, "exports", "team.jsonl")
+	data, err := os.ReadFile(want)
+	if err != nil {
+		t.Fatalf("expected export at configured path %s: %v", want, err)
+	}
+	if lines := splitJSONL(data); len(lines) != 1 {
+		t.Fatalf("expected 1 line, got %d", len(lines))
+	}
+	if _, err := os.Stat(filepath.Join(beadsDir, "issues.jsonl")); !os.IsNotExist(err) {
+		t.Error("default issues.jsonl should not be written when export.jsonl-path is set")
+	}
+}
+
 func TestExportToStdout(t *testing.T) {
 	if testDoltServerPort == 0 {
 		t.Skip("Dolt test server not available")
@@ -3,6 +3,8 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"sort"
 	"strings"
 
@@ -30,11 +32,15 @@ type GraphLayout struct {
 }
 
 var (
-	graphCompact bool
-	graphBox     bool
-	graphAll     bool
-	graphDOT     bool
-	graphHTML    bool
+	graphCompact  bool
+	graphBox      bool
+	graphAll      bool
+	graphDOT      bool
+	graphHTML     bool
+	graphFormat   string
+	graphRoot     string
+	graphDepsOnly bool
+	graphOut      string
 )
 
 var graphCmd = &cobra.Command{
@@ -54,6 +60,13 @@ Display formats:
   --compact        Tree format, one line per issue, more scannable
   --dot            Graphviz DOT format (pipe to dot -Tsvg > graph.svg)
   --html           Self-contained interactive HTML with D3.js visualization
+  --format dot     Same as --dot
+  --format mermaid Mermaid flowchart for Markdown docs
+
+DOT and Mermaid exports draw nodes colored by status, blocking edges as
+solid arrows, and parent/child edges as dashed arrows. Use --deps-only to
+drop the hierarchy edges, --root <id> to limit the graph to an issue and
+its descendants, and --out <file> to write to a file instead of stdout.
 
 The graph shows execution order:
 - Layer 0 / leftmost = no dependencies (can start immediately)
@@ -68,11 +81,24 @@ Examples:
   bd graph --dot issue-id | dot -Tsvg > graph.svg  # SVG via Graphviz
   bd graph --dot issue-id | dot -Tpng > graph.png  # PNG via Graphviz
   bd graph --html issue-id > graph.html  # Interactive browser view
+  bd graph --format mermaid --root epic-id --out docs/plan.mmd
+  bd graph --all --format dot --deps-only | dot -Tsvg > deps.svg
   bd graph --all --html > all.html       # All issues, interactive`,
 	Args: cobra.RangeArgs(0, 1),
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx := rootCtx
 
+		format, err := resolveGraphFormat()
+		if err != nil {
+			FatalError("%v", err)
+		}
+		if graphRoot != "" {
+			if len(args) > 0 || graphAll {
+				FatalError("--root cannot be combined with an issue ID or --all")
+			}
+			args = []string{graphRoot}
+		}
+
 		// Validate args
 		if graphAll && len(args) > 0 {
 			FatalError("cannot specify issue ID with --all flag")
@@ -102,12 +128,25 @@ Examples:
 				return
 			}
 
+			if format != "" {
+				w, closeOut := graphOutputWriter()
+				defer closeOut()
+				opts := graphExportOptions{DepsOnly: graphDepsOnly}
+				if format == graphFormatMermaid {
+					merged := mergeGraphSubgraphs(subgraphs)
+					writeGraphMermaid(w, computeLayout(merged), merged, opts)
+					return
+				}
+				for _, subgraph := range subgraphs {
+					writeGraphDOT(w, computeLayout(subgraph), subgraph, opts)
+				}
+				return
+			}
+
 			// Render all subgraphs
 			for i, subgraph := range subgraphs {
 				layout := computeLayout(subgraph)
-				if graphDOT {
-					renderGraphDOT(layout, subgraph)
-				} else if graphHTML {
+				if graphHTML {
 					renderGraphHTML(layout, subgraph)
 				} else if graphCompact {
 					renderGraphCompact(layout, subgraph)
@@ -116,7 +155,7 @@ Examples:
 				} else {
 					renderGraphVisual(layout, subgraph)
 				}
-				if !graphDOT && !graphHTML && i < len(subgraphs)-1 {
+				if !graphHTML && i < len(subgraphs)-1 {
 					fmt.Println(strings.Repeat("─", 60))
 				}
 			}
@@ -135,6 +174,10 @@ Examples:
 			FatalError("loading graph: %v", err)
 		}
 
+		if graphRoot != "" {
+			subgraph = scopeSubgraphToSubtree(subgraph, issueID)
+		}
+
 		// Compute layout
 		layout := computeLayout(subgraph)
 
@@ -148,8 +191,15 @@ Examples:
 		}
 
 		// Render graph in selected format
-		if graphDOT {
-			renderGraphDOT(layout, subgraph)
+		if format != "" {
+			w, closeOut := graphOutputWriter()
+			defer closeOut()
+			opts := graphExportOptions{DepsOnly: graphDepsOnly}
+			if format == graphFormatMermaid {
+				writeGraphMermaid(w, layout, subgraph, opts)
+			} else {
+				writeGraphDOT(w, layout, subgraph, opts)
+			}
 		} else if graphHTML {
 			renderGraphHTML(layout, subgraph)
 		} else if graphCompact {
@@ -168,10 +218,62 @@ func init() {
 	graphCmd.Flags().BoolVar(&graphBox, "box", false, "ASCII boxes showing layers")
 	graphCmd.Flags().BoolVar(&graphDOT, "dot", false, "Output Graphviz DOT format (pipe to: dot -Tsvg > graph.svg)")
 	graphCmd.Flags().BoolVar(&graphHTML, "html", false, "Output self-contained interactive HTML (redirect to file)")
+	graphCmd.Flags().StringVar(&graphFormat, "format", "", "Export format: dot or mermaid")
+	graphCmd.Flags().StringVar(&graphRoot, "root", "", "Limit the graph to this issue and its descendants")
+	graphCmd.Flags().BoolVar(&graphDepsOnly, "deps-only", false, "Export only dependency edges, not parent/child (dot/mermaid)")
+	graphCmd.Flags().StringVarP(&graphOut, "out", "o", "", "Write dot/mermaid output to a file instead of stdout")
 	graphCmd.ValidArgsFunction = issueIDCompletion
 	rootCmd.AddCommand(graphCmd)
 }
 
+// Graph export formats accepted by --format.
+const (
+	graphFormatDOT     = "dot"
+	graphFormatMermaid = "mermaid"
+)
+
+// resolveGraphFormat combines --dot and --format into one export format
+// ("" means a terminal or HTML rendering).
+func resolveGraphFormat() (string, error) {
+	format := strings.ToLower(graphFormat)
+	switch format {
+	case "", graphFormatDOT, graphFormatMermaid:
+	default:
+		return "", fmt.Errorf("invalid --format %q (valid: %s, %s)", graphFormat, graphFormatDOT, graphFormatMermaid)
+	}
+	if graphDOT {
+		if format == graphFormatMermaid {
+			return "", fmt.Errorf("--dot conflicts with --format %s", format)
+		}
+		format = graphFormatDOT
+	}
+	if format != "" && graphHTML {
+		return "", fmt.Errorf("--html cannot be combined with --format %s", format)
+	}
+	if format == "" && (graphOut != "" || graphDepsOnly) {
+		return "", fmt.Errorf("--out and --deps-only require --format %s or %s", graphFormatDOT, graphFormatMermaid)
+	}
+	return format, nil
+}
+
+// graphOutputWriter returns stdout, or the --out file. The returned func
+// closes the file and reports where the graph was written.
+func graphOutputWriter() (io.Writer, func()) {
+	if graphOut == "" {
+		return os.Stdout, func() {}
+	}
+	f, err := os.Create(graphOut) //nolint:gosec // user-provided output path
+	if err != nil {
+		FatalError("failed to create %s: %v", graphOut, err)
+	}
+	return f, func() {
+		if err := f.Close(); err != nil {
+			FatalError("failed to write %s: %v", graphOut, err)
+		}
+		fmt.Fprintf(os.Stderr, "Wrote graph to %s\n", graphOut)
+	}
+}
+
 // loadGraphSubgraph loads an issue and its subgraph for visualization
 // Unlike template loading, this includes ALL dependency types (not just parent-child)
 func loadGraphSubgraph(ctx context.Context, s *dolt.DoltStore, issueID string) (*TemplateSubgraph, error) {
@@ -4,31 +4,53 @@ import (
 	"encoding/json"
 	"fmt"
 	"html"
+	"io"
 	"os"
 	"strings"
 
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/types"
 )
 
-// renderGraphDOT renders the graph in Graphviz DOT format.
+// graphExportOptions controls which edges the DOT and Mermaid exporters emit.
+type graphExportOptions struct {
+	DepsOnly bool // omit parent-child (hierarchy) edges
+}
+
+// includeEdge reports whether an exporter should draw dep. Only blocking and
+// hierarchy edges are drawn, and only when both endpoints are in the layout.
+func (o graphExportOptions) includeEdge(layout *GraphLayout, dep *types.Dependency) bool {
+	switch dep.Type {
+	case types.DepBlocks:
+	case types.DepParentChild:
+		if o.DepsOnly {
+			return false
+		}
+	default:
+		return false
+	}
+	return layout.Nodes[dep.IssueID] != nil && layout.Nodes[dep.DependsOnID] != nil
+}
+
+// writeGraphDOT writes the graph in Graphviz DOT format to w.
 // Output can be piped to graphviz: bd graph --dot <id> | dot -Tsvg > graph.svg
-func renderGraphDOT(layout *GraphLayout, subgraph *TemplateSubgraph) {
+func writeGraphDOT(w io.Writer, layout *GraphLayout, subgraph *TemplateSubgraph, opts graphExportOptions) {
 	if len(layout.Nodes) == 0 {
-		fmt.Println("digraph beads { }")
+		fmt.Fprintln(w, "digraph beads { }")
 		return
 	}
 
-	fmt.Println("digraph beads {")
-	fmt.Println("  rankdir=LR;")
-	fmt.Println("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\", fontsize=11];")
-	fmt.Println("  edge [color=\"#666666\"];")
-	fmt.Println()
+	fmt.Fprintln(w, "digraph beads {")
+	fmt.Fprintln(w, "  rankdir=LR;")
+	fmt.Fprintln(w, "  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\", fontsize=11];")
+	fmt.Fprintln(w, "  edge [color=\"#666666\"];")
+	fmt.Fprintln(w)
 
 	// Emit nodes grouped by layer using subgraph clusters for rank alignment
 	for layerIdx, layer := range layout.Layers {
-		fmt.Printf("  subgraph cluster_layer_%d {\n", layerIdx)
-		fmt.Println("    style=invis;")
-		fmt.Printf("    rank=same;\n")
+		fmt.Fprintf(w, "  subgraph cluster_layer_%d {\n", layerIdx)
+		fmt.Fprintln(w, "    style=invis;")
+		fmt.Fprintf(w, "    rank=same;\n")
 		for _, id := range layer {
 			node := layout.Nodes[id]
 			if node == nil {
@@ -37,30 +59,159 @@ func renderGraphDOT(layout *GraphLayout, subgraph *TemplateSubgraph) {
 			label, fillColor, fontColor := dotNodeAttrs(node)
 			// Escape quotes in label
 			label = strings.ReplaceAll(label, "\"", "\\\"")
-			fmt.Printf("    \"%s\" [label=\"%s\", fillcolor=\"%s\", fontcolor=\"%s\"];\n",
+			fmt.Fprintf(w, "    \"%s\" [label=\"%s\", fillcolor=\"%s\", fontcolor=\"%s\"];\n",
 				dotEscapeID(id), label, fillColor, fontColor)
 		}
-		fmt.Println("  }")
+		fmt.Fprintln(w, "  }")
 	}
-	fmt.Println()
+	fmt.Fprintln(w)
 
 	// Emit edges
 	for _, dep := range subgraph.Dependencies {
-		// Only include blocking dependencies in the graph
-		if dep.Type != types.DepBlocks && dep.Type != types.DepParentChild {
-			continue
-		}
-		// Ensure both endpoints exist in the subgraph
-		if layout.Nodes[dep.IssueID] == nil || layout.Nodes[dep.DependsOnID] == nil {
+		if !opts.includeEdge(layout, dep) {
 			continue
 		}
 		edgeStyle := dotEdgeStyle(dep.Type)
 		// dep.DependsOnID -> dep.IssueID (blocker points to blocked)
-		fmt.Printf("  \"%s\" -> \"%s\"%s;\n",
+		fmt.Fprintf(w, "  \"%s\" -> \"%s\"%s;\n",
 			dotEscapeID(dep.DependsOnID), dotEscapeID(dep.IssueID), edgeStyle)
 	}
 
-	fmt.Println("}")
+	fmt.Fprintln(w, "}")
+}
+
+// writeGraphMermaid writes the graph as a Mermaid flowchart to w, for
+// embedding in Markdown. Node IDs are renumbered (n0, n1, ...) because issue
+// IDs may contain characters Mermaid does not accept in identifiers.
+func writeGraphMermaid(w io.Writer, layout *GraphLayout, subgraph *TemplateSubgraph, opts graphExportOptions) {
+	fmt.Fprintln(w, "graph LR")
+
+	nodeIDs := make(map[string]string, len(layout.Nodes))
+	classes := make(map[string][]string)
+	for _, layer := range layout.Layers {
+		for _, id := range layer {
+			node := layout.Nodes[id]
+			if node == nil {
+				continue
+			}
+			mid := fmt.Sprintf("n%d", len(nodeIDs))
+			nodeIDs[id] = mid
+			title := truncateTitle(node.Issue.Title, 40)
+			label := fmt.Sprintf("%s %s<br/>P%d | %s", statusPlainIcon(node.Issue.Status), id, node.Issue.Priority, title)
+			fmt.Fprintf(w, "  %s[\"%s\"]\n", mid, mermaidEscape(label))
+			class := mermaidStatusClass(node.Issue.Status)
+			classes[class] = append(classes[class], mid)
+		}
+	}
+
+	for _, dep := range subgraph.Dependencies {
+		if !opts.includeEdge(layout, dep) {
+			continue
+		}
+		arrow := "-->"
+		if dep.Type == types.DepParentChild {
+			arrow = "-.->"
+		}
+		// Blocker points to blocked, matching the DOT output
+		fmt.Fprintf(w, "  %s %s %s\n", nodeIDs[dep.DependsOnID], arrow, nodeIDs[dep.IssueID])
+	}
+
+	for _, class := range []string{"open", "in_progress", "blocked", "closed", "other"} {
+		members := classes[class]
+		if len(members) == 0 {
+			continue
+		}
+		fill, font := mermaidClassColors(class)
+		fmt.Fprintf(w, "  classDef %s fill:%s,color:%s\n", class, fill, font)
+		fmt.Fprintf(w, "  class %s %s\n", strings.Join(members, ","), class)
+	}
+}
+
+// mermaidStatusClass maps a status to the Mermaid class used for coloring.
+func mermaidStatusClass(status types.Status) string {
+	switch status {
+	case types.StatusOpen, types.StatusInProgress, types.StatusBlocked, types.StatusClosed:
+		return string(status)
+	default:
+		return "other"
+	}
+}
+
+// mermaidClassColors reuses the DOT palette so both exports look alike.
+func mermaidClassColors(class string) (fill, font string) {
+	_, fill, font = dotNodeAttrs(&GraphNode{Issue: &types.Issue{Status: types.Status(class)}})
+	return fill, font
+}
+
+// mermaidEscape makes a label safe inside a quoted Mermaid node label.
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "\n", " ").Replace(s)
+}
+
+// scopeSubgraphToSubtree restricts a loaded subgraph to rootID and its
+// descendants, following parent-child edges and hierarchical IDs.
+func scopeSubgraphToSubtree(subgraph *TemplateSubgraph, rootID string) *TemplateSubgraph {
+	children := make(map[string][]string)
+	for _, dep := range subgraph.Dependencies {
+		if dep.Type == types.DepParentChild {
+			children[dep.DependsOnID] = append(children[dep.DependsOnID], dep.IssueID)
+		}
+	}
+	keep := map[string]bool{rootID: true}
+	h := config.GetIDHierarchy()
+	queue := []string{rootID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, child := range children[id] {
+			if !keep[child] {
+				keep[child] = true
+				queue = append(queue, child)
+			}
+		}
+		for _, issue := range subgraph.Issues {
+			if !keep[issue.ID] && h.IsChildOf(issue.ID, id) {
+				keep[issue.ID] = true
+				queue = append(queue, issue.ID)
+			}
+		}
+	}
+
+	scoped := &TemplateSubgraph{
+		Root:     subgraph.IssueMap[rootID],
+		IssueMap: make(map[string]*types.Issue),
+	}
+	for _, issue := range subgraph.Issues {
+		if keep[issue.ID] {
+			scoped.Issues = append(scoped.Issues, issue)
+			scoped.IssueMap[issue.ID] = issue
+		}
+	}
+	for _, dep := range subgraph.Dependencies {
+		if keep[dep.IssueID] && keep[dep.DependsOnID] {
+			scoped.Dependencies = append(scoped.Dependencies, dep)
+		}
+	}
+	return scoped
+}
+
+// mergeGraphSubgraphs combines connected components into one graph so
+// single-document formats (Mermaid) can render --all.
+func mergeGraphSubgraphs(subgraphs []*TemplateSubgraph) *TemplateSubgraph {
+	merged := &TemplateSubgraph{IssueMap: make(map[string]*types.Issue)}
+	for _, sg := range subgraphs {
+		if merged.Root == nil {
+			merged.Root = sg.Root
+		}
+		for _, issue := range sg.Issues {
+			if _, ok := merged.IssueMap[issue.ID]; !ok {
+				merged.Issues = append(merged.Issues, issue)
+				merged.IssueMap[issue.ID] = issue
+			}
+		}
+		merged.Dependencies = append(merged.Dependencies, sg.Dependencies...)
+	}
+	return merged
 }
 
 // dotNodeAttrs returns the DOT label, fill color, and font color for a node
@@ -62,12 +62,12 @@ func makeTestSubgraph() (*TemplateSubgraph, *GraphLayout) {
 	return subgraph, layout
 }
 
-func TestRenderGraphDOT(t *testing.T) {
+func TestWriteGraphDOT(t *testing.T) {
 	// Not parallel: captureGraphOutput redirects global os.Stdout
 	subgraph, layout := makeTestSubgraph()
 
 	output := captureGraphOutput(func() {
-		renderGraphDOT(layout, subgraph)
+		writeGraphDOT(os.Stdout, layout, subgraph, graphExportOptions{})
 	})
 
 	// Verify DOT structure
@@ -99,7 +99,7 @@ func TestRenderGraphDOT(t *testing.T) {
 	}
 }
 
-func TestRenderGraphDOT_Empty(t *testing.T) {
+func TestWriteGraphDOT_Empty(t *testing.T) {
 	// Not parallel: captureGraphOutput redirects global os.Stdout
 	emptySubgraph := &TemplateSubgraph{
 		Root:     &types.Issue{ID: "empty"},
@@ -113,7 +113,7 @@ func TestRenderGraphDOT_Empty(t *testing.T) {
 	}
 
 	output := captureGraphOutput(func() {
-		renderGraphDOT(layout, emptySubgraph)
+		writeGraphDOT(os.Stdout, layout, emptySubgraph, graphExportOptions{})
 	})
 
 	if !strings.Contains(output, "digraph beads { }") {
@@ -284,3 +284,78 @@ func TestDotEdgeStyle(t *testing.T) {
 		t.Errorf("related edge should have no style, got %q", related)
 	}
 }
+
+func TestWriteGraphMermaid(t *testing.T) {
+	t.Parallel()
+	subgraph, layout := makeTestSubgraph()
+
+	var buf bytes.Buffer
+	writeGraphMermaid(&buf, layout, subgraph, graphExportOptions{})
+	output := buf.String()
+
+	if !strings.HasPrefix(output, "graph LR\n") {
+		t.Errorf("Mermaid output should start with 'graph LR', got: %q", output)
+	}
+	for _, id := range []string{"test-a", "test-b", "test-c", "test-d"} {
+		if !strings.Contains(output, id) {
+			t.Errorf("Mermaid output should label node %q", id)
+		}
+	}
+	if !strings.Contains(output, " --> ") {
+		t.Error("Mermaid output should contain a blocking edge")
+	}
+	if !strings.Contains(output, " -.-> ") {
+		t.Error("Mermaid output should contain a dashed parent-child edge")
+	}
+	if !strings.Contains(output, "classDef blocked fill:#f8d7da") {
+		t.Error("Mermaid output should color blocked issues")
+	}
+}
+
+func TestGraphExportDepsOnly(t *testing.T) {
+	t.Parallel()
+	subgraph, layout := makeTestSubgraph()
+	opts := graphExportOptions{DepsOnly: true}
+
+	var dot, mermaid bytes.Buffer
+	writeGraphDOT(&dot, layout, subgraph, opts)
+	writeGraphMermaid(&mermaid, layout, subgraph, opts)
+
+	if strings.Contains(dot.String(), "style=dashed") {
+		t.Error("DOT --deps-only output should not contain parent-child edges")
+	}
+	if !strings.Contains(dot.String(), "\"test-b\" -> \"test-c\"") {
+		t.Error("DOT --deps-only output should keep blocking edges")
+	}
+	if strings.Contains(mermaid.String(), "-.->") {
+		t.Error("Mermaid --deps-only output should not contain parent-child edges")
+	}
+}
+
+func TestScopeSubgraphToSubtree(t *testing.T) {
+	t.Parallel()
+	epic := &types.Issue{ID: "bd-epic", Status: types.StatusOpen}
+	child := &types.Issue{ID: "bd-child", Status: types.StatusOpen}
+	dotted := &types.Issue{ID: "bd-epic.1", Status: types.StatusOpen}
+	other := &types.Issue{ID: "bd-other", Status: types.StatusOpen}
+	subgraph := &TemplateSubgraph{
+		Root:     other,
+		Issues:   []*types.Issue{epic, child, dotted, other},
+		IssueMap: map[string]*types.Issue{"bd-epic": epic, "bd-child": child, "bd-epic.1": dotted, "bd-other": other},
+		Dependencies: []*types.Dependency{
+			{IssueID: "bd-child", DependsOnID: "bd-epic", Type: types.DepParentChild},
+			{IssueID: "bd-epic", DependsOnID: "bd-other", Type: types.DepBlocks},
+		},
+	}
+
+	scoped := scopeSubgraphToSubtree(subgraph, "bd-epic")
+	if scoped.Root != epic {
+		t.Errorf("Root = %v, want bd-epic", scoped.Root)
+	}
+	if len(scoped.Issues) != 3 || scoped.IssueMap["bd-other"] != nil {
+		t.Errorf("scoped issues = %d (%v), want bd-epic, bd-child, bd-epic.1", len(scoped.Issues), scoped.IssueMap)
+	}
+	if len(scoped.Dependencies) != 1 {
+		t.Errorf("scoped dependencies = %d, want 1 (edge to bd-other dropped)", len(scoped.Dependencies))
+	}
+}
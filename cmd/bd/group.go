@@ -0,0 +1,389 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/debug"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// groupsFileName is the git-tracked file (in .beads/) that carries assignee
+// groups alongside the JSONL export so the team shares them.
+const groupsFileName = "groups.jsonl"
+
+// groupNamePattern restricts group names to things that are safe to type as
+// '@<name>' in an assignee position.
+var groupNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+var groupCmd = &cobra.Command{
+	Use:     "group",
+	GroupID: "issues",
+	Short:   "Manage assignee groups for @name expansion",
+	Long: `Assignee groups name a set of people so commands can take '@<name>'
+wherever they take an assignee:
+
+  bd list --assignee @backend       # Issues assigned to any member
+  bd assign bd-abc bd-def @backend  # Spread issues across the members
+
+Groups are resolved when the command runs, so membership changes take effect
+immediately. They are stored in the database and written to
+.beads/groups.jsonl by 'bd export --jsonl' so they travel with the repo;
+'bd import' loads them back.
+
+Examples:
+  bd group add backend alice bob
+  bd group rm backend bob         # Remove one member
+  bd group rm backend             # Remove the whole group
+  bd group ls`,
+}
+
+var groupAddCmd = &cobra.Command{
+	Use:   "add <name> <member...>",
+	Short: "Add members to a group, creating it if needed",
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("group add")
+		name, err := parseGroupName(args[0])
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		members, err := parseGroupMembers(args[1:])
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		requireViewStore()
+
+		if err := store.AddGroupMembers(rootCtx, name, members); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		commandDidWrite.Store(true)
+		outputGroup(name, fmt.Sprintf("Added %s to @%s", strings.Join(members, ", "), name))
+	},
+}
+
+var groupRmCmd = &cobra.Command{
+	Use:     "rm <name> [member...]",
+	Aliases: []string{"remove"},
+	Short:   "Remove members from a group, or the whole group",
+	Args:    cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("group rm")
+		name, err := parseGroupName(args[0])
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		members, err := parseGroupMembers(args[1:])
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		requireViewStore()
+
+		existing, err := store.GetGroupMembers(rootCtx, name)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if len(existing) == 0 {
+			FatalErrorRespectJSON("no group named %q", name)
+		}
+		if err := store.RemoveGroupMembers(rootCtx, name, members); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		commandDidWrite.Store(true)
+		if len(members) == 0 {
+			if jsonOutput {
+				outputJSON(map[string]interface{}{"deleted": name})
+				return
+			}
+			fmt.Printf("%s Deleted group @%s\n", ui.RenderPass("✓"), name)
+			return
+		}
+		outputGroup(name, fmt.Sprintf("Removed %s from @%s", strings.Join(members, ", "), name))
+	},
+}
+
+var groupLsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List assignee groups and their members",
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		requireViewStore()
+		groups, err := store.ListGroups(rootCtx)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if jsonOutput {
+			if groups == nil {
+				groups = []*types.AssigneeGroup{}
+			}
+			outputJSON(groups)
+			return
+		}
+		if len(groups) == 0 {
+			fmt.Println("No assignee groups (create one with: bd group add <name> <member...>)")
+			return
+		}
+		width := 0
+		for _, g := range groups {
+			if len(g.Name)+1 > width {
+				width = len(g.Name) + 1
+			}
+		}
+		for _, g := range groups {
+			fmt.Printf("%-*s  %s\n", width, "@"+g.Name, strings.Join(g.Members, ", "))
+		}
+	},
+}
+
+// outputGroup prints a group's membership after a change.
+func outputGroup(name, message string) {
+	members, err := store.GetGroupMembers(rootCtx, name)
+	if err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+	if jsonOutput {
+		if members == nil {
+			members = []string{}
+		}
+		outputJSON(&types.AssigneeGroup{Name: name, Members: members})
+		return
+	}
+	fmt.Printf("%s %s\n", ui.RenderPass("✓"), message)
+	if len(members) > 0 {
+		fmt.Printf("  @%s: %s\n", name, strings.Join(members, ", "))
+	}
+}
+
+// parseGroupName accepts a group name with or without its leading '@'.
+func parseGroupName(arg string) (string, error) {
+	name := strings.TrimPrefix(arg, "@")
+	if !groupNamePattern.MatchString(name) {
+		return "", fmt.Errorf("invalid group name %q: use letters, digits, '-' and '_'", arg)
+	}
+	return name, nil
+}
+
+// parseGroupMembers trims member names and rejects empty ones and nested
+// groups, which are not expanded.
+func parseGroupMembers(args []string) ([]string, error) {
+	members := make([]string, 0, len(args))
+	for _, arg := range args {
+		member := strings.TrimSpace(arg)
+		if member == "" {
+			return nil, fmt.Errorf("member names cannot be empty")
+		}
+		if strings.HasPrefix(member, "@") {
+			return nil, fmt.Errorf("member %q: groups cannot contain other groups", member)
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// assigneeGroupName reports whether an assignee value names a group
+// ('@<name>') and returns the name without the '@'.
+func assigneeGroupName(assignee string) (string, bool) {
+	name, ok := strings.CutPrefix(assignee, "@")
+	return name, ok && name != ""
+}
+
+// resolveAssigneeGroup returns the members of the named group, or an error if
+// the group does not exist.
+func resolveAssigneeGroup(ctx context.Context, name string) ([]string, error) {
+	if store == nil {
+		return nil, fmt.Errorf("cannot resolve @%s: database not initialized", name)
+	}
+	members, err := store.GetGroupMembers(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, fmt.Errorf("no group named %q (list groups with: bd group ls)", name)
+	}
+	return members, nil
+}
+
+// setAssigneeFilter sets the assignee filter for list-style commands,
+// expanding '@<name>' to an 'assignee IN (members)' match.
+func setAssigneeFilter(filter *types.IssueFilter, assignee string) {
+	group, ok := assigneeGroupName(assignee)
+	if !ok {
+		filter.Assignee = &assignee
+		return
+	}
+	members, err := resolveAssigneeGroup(rootCtx, group)
+	if err != nil {
+		FatalErrorRespectJSON("--assignee %s: %v", assignee, err)
+	}
+	filter.AssigneeIn = members
+}
+
+// groupMemberLoads counts each member's issues that are not closed.
+func groupMemberLoads(ctx context.Context, members []string) (map[string]int, error) {
+	loads := make(map[string]int, len(members))
+	for _, member := range members {
+		m := member
+		n, err := store.CountIssues(ctx, "", types.IssueFilter{
+			Assignee:      &m,
+			ExcludeStatus: []types.Status{types.StatusClosed},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("counting issues for %s: %w", member, err)
+		}
+		loads[member] = n
+	}
+	return loads, nil
+}
+
+// pickLeastLoaded chooses an assignee for each of n issues, giving each one
+// to the member with the fewest open issues so far (counting earlier picks).
+// Ties go to the member whose name sorts first.
+func pickLeastLoaded(members []string, loads map[string]int, n int) []string {
+	sorted := append([]string(nil), members...)
+	sort.Strings(sorted)
+	current := make(map[string]int, len(sorted))
+	for _, m := range sorted {
+		current[m] = loads[m]
+	}
+
+	picks := make([]string, n)
+	for i := range picks {
+		best := sorted[0]
+		for _, m := range sorted[1:] {
+			if current[m] < current[best] {
+				best = m
+			}
+		}
+		picks[i] = best
+		current[best]++
+	}
+	return picks
+}
+
+// writeGroupsJSONL writes all assignee groups to .beads/groups.jsonl. The
+// file is only created once a group exists. Returns the number of groups
+// written.
+func writeGroupsJSONL(ctx context.Context, beadsDir string) (int, error) {
+	groups, err := store.ListGroups(ctx)
+	if err != nil {
+		return 0, err
+	}
+	path := filepath.Join(beadsDir, groupsFileName)
+	if len(groups) == 0 {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return 0, nil
+		}
+	}
+
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp) //nolint:gosec // path is inside .beads/
+	if err != nil {
+		return 0, fmt.Errorf("failed to create %s: %w", groupsFileName, err)
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, g := range groups {
+		if err := enc.Encode(g); err != nil {
+			_ = f.Close()
+			_ = os.Remove(tmp)
+			return 0, fmt.Errorf("failed to write group %s: %w", g.Name, err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return 0, fmt.Errorf("failed to write %s: %w", groupsFileName, err)
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return 0, fmt.Errorf("failed to close %s: %w", groupsFileName, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return 0, fmt.Errorf("failed to replace %s: %w", groupsFileName, err)
+	}
+	return len(groups), nil
+}
+
+// exportAssigneeGroups writes groups.jsonl next to the repo's JSONL export.
+// Failures are warnings: the issue export itself already succeeded.
+func exportAssigneeGroups(ctx context.Context) {
+	beadsDir := beads.FindBeadsDir()
+	if beadsDir == "" || store == nil {
+		return
+	}
+	n, err := writeGroupsJSONL(ctx, beadsDir)
+	if err != nil {
+		debug.Warnf("failed to export assignee groups: %v\n", err)
+		return
+	}
+	if n > 0 {
+		fmt.Fprintf(os.Stderr, "Exported %d group(s) to %s\n", n, filepath.Join(beadsDir, groupsFileName))
+	}
+}
+
+// importGroupsJSONL loads .beads/groups.jsonl (if present) into the
+// assignee_groups table. Each group's membership is replaced by the file's.
+// Returns the number of groups imported.
+func importGroupsJSONL(ctx context.Context, beadsDir string) (int, error) {
+	path := filepath.Join(beadsDir, groupsFileName)
+	f, err := os.Open(path) //nolint:gosec // path is inside .beads/
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to open %s: %w", groupsFileName, err)
+	}
+	defer f.Close()
+
+	count := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var group types.AssigneeGroup
+		if err := json.Unmarshal([]byte(line), &group); err != nil {
+			return count, fmt.Errorf("failed to parse group from %s: %w", groupsFileName, err)
+		}
+		name, err := parseGroupName(group.Name)
+		if err != nil {
+			debug.Warnf("skipping group: %v\n", err)
+			continue
+		}
+		members, err := parseGroupMembers(group.Members)
+		if err != nil {
+			debug.Warnf("skipping group %s: %v\n", name, err)
+			continue
+		}
+		if err := store.RemoveGroupMembers(ctx, name, nil); err != nil {
+			return count, err
+		}
+		if err := store.AddGroupMembers(ctx, name, members); err != nil {
+			return count, err
+		}
+		count++
+	}
+	if err := scanner.Err(); err != nil {
+		return count, fmt.Errorf("failed to read %s: %w", groupsFileName, err)
+	}
+	return count, nil
+}
+
+func init() {
+	groupCmd.AddCommand(groupAddCmd)
+	groupCmd.AddCommand(groupRmCmd)
+	groupCmd.AddCommand(groupLsCmd)
+	rootCmd.AddCommand(groupCmd)
+}
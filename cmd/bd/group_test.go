@@ -0,0 +1,45 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPickLeastLoaded(t *testing.T) {
+	tests := []struct {
+		name    string
+		members []string
+		loads   map[string]int
+		n       int
+		want    []string
+	}{
+		{"ties go to first name", []string{"carol", "alice", "bob"}, nil, 4, []string{"alice", "bob", "carol", "alice"}},
+		{"lightest first", []string{"alice", "bob"}, map[string]int{"alice": 3, "bob": 1}, 3, []string{"bob", "bob", "alice"}},
+		{"single member", []string{"dave"}, map[string]int{"dave": 9}, 2, []string{"dave", "dave"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pickLeastLoaded(tt.members, tt.loads, tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pickLeastLoaded = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseGroupArgs(t *testing.T) {
+	if name, err := parseGroupName("@backend"); err != nil || name != "backend" {
+		t.Errorf("parseGroupName(@backend) = %q, %v", name, err)
+	}
+	if _, err := parseGroupName("-x"); err == nil {
+		t.Error("expected an error for a group name starting with '-'")
+	}
+	if _, err := parseGroupMembers([]string{"alice", "@docs"}); err == nil {
+		t.Error("expected an error for a nested group member")
+	}
+	if group, ok := assigneeGroupName("@backend"); !ok || group != "backend" {
+		t.Errorf("assigneeGroupName(@backend) = %q, %v", group, ok)
+	}
+	if _, ok := assigneeGroupName("alice"); ok {
+		t.Error("plain assignee should not be treated as a group")
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/debug"
 )
 
 var importCmd = &cobra.Command{
@@ -15,43 +18,107 @@ var importCmd = &cobra.Command{
 	Long: `Import issues from a JSONL file (newline-delimited JSON) into the database.
 
 If no file is specified, imports from .beads/issues.jsonl (the git-tracked
-export). This is the incremental counterpart to 'bd export': new issues are
-created and existing issues are updated (upsert semantics).
+export, or export.jsonl-path when configured). A directory of shards written
+by 'bd export --shard-by' is also accepted: every *.jsonl file in it is
+loaded in a single import. Saved views in .beads/views.jsonl and assignee
+groups in .beads/groups.jsonl are loaded too when importing the repo's own
+export.
+
+This is the incremental counterpart to 'bd export': new issues are created
+and existing issues are updated (upsert semantics).
 
 This command makes the git-tracked JSONL portable again — after 'git pull'
 brings new issues, 'bd import' loads them into the local Dolt database.
 
+The Dolt database is the source of truth. If the database changed an issue
+after the JSONL copy was written, importing would roll that change back, so
+bd import refuses and lists the issues; pass --force to overwrite them. The
+import is committed to Dolt history either way.
+
+If the same ID appears on more than one line, --on-conflict picks the
+record that is imported: strict fails the import, first or last keeps
+that line, and merge overlays the fields of later lines onto earlier ones.
+Every duplicate ID is reported with its lines and which one won, on
+stderr (silenced by --quiet) and in the "duplicates" field of --json output.
+
+Statuses from other trackers are folded into beads statuses: case and
+spacing are ignored ("Open", "In Progress"), and import.status-map in
+config.yaml maps the rest (e.g. resolved: closed). Statuses that still don't
+match are imported unchanged with a warning, or rejected with --strict.
+
+GRAFTING A SUBTREE:
+  --under <parent> imports a subtree (see 'bd export --issue --subtree') as
+  descendants of an existing issue. The incoming top-level issues take the
+  parent's next free child slots, their descendants are renumbered beneath
+  them, and dependencies, comments and ID mentions are rewritten to match.
+  IDs that would collide with existing ones get the next free slot. The
+  old→new mapping is printed, and the whole graft is one transaction.
+
 EXAMPLES:
   bd import                        # Import from .beads/issues.jsonl
   bd import backup.jsonl           # Import from a specific file
-  bd import --dry-run              # Show what would be imported`,
+  bd import .beads/issues/         # Import all shards in a directory
+  bd import --dry-run              # Show what would be imported
+  bd import --force                # Overwrite newer database changes
+  bd import jira.jsonl --strict    # Fail on unmapped statuses
+  bd import --on-conflict strict   # Fail if any ID appears twice
+  bd import epic.jsonl --under bd-abc  # Graft a subtree under bd-abc`,
 	GroupID: "sync",
 	RunE:   runImport,
 }
 
 var (
 	importDryRun bool
+	importForce  bool
+	importStrict bool
+	importUnder  string
+
+	importOnConflict string
 )
 
 func init() {
 	importCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Show what would be imported without importing")
+	importCmd.Flags().BoolVar(&importForce, "force", false, "Import even if the database changed issues after the JSONL was written")
+	importCmd.Flags().BoolVar(&importStrict, "strict", false, "Fail on statuses that are neither beads statuses nor mapped by import.status-map")
+	importCmd.Flags().StringVar(&importOnConflict, "on-conflict", string(onConflictLast), "When an ID appears on several lines: strict (fail), first, last, or merge (later lines' fields win)")
+	importCmd.Flags().StringVar(&importUnder, "under", "", "Graft the imported issues under this existing issue, renumbering their IDs as its descendants")
 	rootCmd.AddCommand(importCmd)
 }
 
+// importResult is what bd import --json prints.
+type importResult struct {
+	Source     string            `json:"source"`
+	Imported   int               `json:"imported"`
+	OnConflict string            `json:"on_conflict"`
+	Duplicates []importDuplicate `json:"duplicates"`
+	Under      string            `json:"under,omitempty"`
+	Grafted    []graftedID       `json:"grafted,omitempty"`
+	Views      int               `json:"views,omitempty"`
+	Groups     int               `json:"groups,omitempty"`
+}
+
 func runImport(cmd *cobra.Command, args []string) error {
 	ctx := rootCtx
 
 	// Determine source file
 	var jsonlPath string
+	var repoBeadsDir string // set when importing the repo's own export
 	if len(args) > 0 {
 		jsonlPath = args[0]
 	} else {
-		// Default: .beads/issues.jsonl
+		// Default: .beads/issues.jsonl, falling back to the shard directory
 		beadsDir := beads.FindBeadsDir()
 		if beadsDir == "" {
 			return fmt.Errorf("no .beads directory found — run 'bd init' first")
 		}
-		jsonlPath = filepath.Join(beadsDir, "issues.jsonl")
+		repoBeadsDir = beadsDir
+		jsonlPath = config.JSONLPath(beadsDir)
+		if _, err := os.Stat(jsonlPath); os.IsNotExist(err) {
+			shardDir := filepath.Join(beadsDir, defaultShardDir)
+			if fi, err := os.Stat(shardDir); err == nil && fi.IsDir() {
+				jsonlPath = shardDir
+			}
+		}
 	}
 
 	// Check file exists
@@ -59,12 +126,22 @@ func runImport(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("cannot read %s: %w", jsonlPath, err)
 	}
-	if info.Size() == 0 {
+	if !info.IsDir() && info.Size() == 0 {
 		fmt.Fprintf(os.Stderr, "Empty file: %s\n", jsonlPath)
 		return nil
 	}
 
+	conflictPolicy, err := parseImportConflictPolicy(importOnConflict)
+	if err != nil {
+		return err
+	}
+
 	if importDryRun {
+		if info.IsDir() {
+			shards, _ := filepath.Glob(filepath.Join(jsonlPath, "*.jsonl"))
+			fmt.Fprintf(os.Stderr, "Would import from: %s (%d shard(s))\n", jsonlPath, len(shards))
+			return nil
+		}
 		fmt.Fprintf(os.Stderr, "Would import from: %s (%d bytes)\n", jsonlPath, info.Size())
 		return nil
 	}
@@ -74,15 +151,118 @@ func runImport(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("no database — run 'bd init' or 'bd bootstrap' first")
 	}
 
-	count, err := importFromLocalJSONL(ctx, store, jsonlPath)
+	var records []*jsonlRecord
+	if info.IsDir() {
+		records, err = readJSONLDirRecords(jsonlPath)
+	} else {
+		records, err = readJSONLRecords(jsonlPath)
+	}
+	if err != nil {
+		return fmt.Errorf("import failed: %w", err)
+	}
+	issues, dups, err := resolveDuplicateIDs(records, conflictPolicy)
+	if err != nil {
+		return fmt.Errorf("import failed: %w", err)
+	}
+	reportDuplicateIDs(dups, conflictPolicy)
+	result := importResult{Source: jsonlPath, OnConflict: string(conflictPolicy), Duplicates: dups}
+	if result.Duplicates == nil {
+		result.Duplicates = []importDuplicate{}
+	}
+
+	customStatuses, err := store.GetCustomStatuses(ctx)
+	if err != nil {
+		return fmt.Errorf("import failed: %w", err)
+	}
+	warnings, err := normalizeImportStatuses(issues, config.ImportStatusMap(), customStatuses, importStrict)
+	if err != nil {
+		return fmt.Errorf("import failed: %w", err)
+	}
+	for _, w := range warnings {
+		debug.Warnf("%s\n", w)
+	}
+
+	if importUnder != "" {
+		// The grafted IDs are allocated in the import transaction, so none
+		// of them can overwrite an existing issue.
+		graftParent, grafted, err := importIssuesUnder(ctx, importUnder, issues, filepath.Base(jsonlPath))
+		if err != nil {
+			return fmt.Errorf("import failed: %w", err)
+		}
+		if jsonOutput {
+			result.Imported, result.Under, result.Grafted = len(issues), graftParent, grafted
+			outputJSON(result)
+			return nil
+		}
+		fmt.Fprintf(os.Stderr, "Imported %d issues from %s under %s\n", len(issues), jsonlPath, graftParent)
+		for _, g := range grafted {
+			fmt.Fprintf(os.Stderr, "  %s → %s\n", g.Old, g.New)
+		}
+		return nil
+	}
+
+	newer, err := issuesNewerInStore(ctx, store, issues)
+	if err != nil {
+		return fmt.Errorf("import failed: %w", err)
+	}
+	if len(newer) > 0 {
+		if !importForce {
+			return fmt.Errorf("%d issue(s) changed in the database after %s was written: %s\n"+
+				"The Dolt database is the source of truth; importing would roll these back.\n"+
+				"Refresh the file with 'bd export --jsonl', or re-run with --force to overwrite them",
+				len(newer), jsonlPath, strings.Join(newer, ", "))
+		}
+		debug.Warnf("overwriting %d issue(s) changed in the database since %s was written\n", len(newer), jsonlPath)
+	}
+
+	count, err := importParsedIssues(ctx, store, issues)
 	if err != nil {
 		return fmt.Errorf("import failed: %w", err)
 	}
 
-	if err := store.Commit(ctx, fmt.Sprintf("bd import: %d issues from %s", count, filepath.Base(jsonlPath))); err != nil {
+	commitMsg := fmt.Sprintf("bd import: %d issues from %s", count, filepath.Base(jsonlPath))
+	if err := store.Commit(ctx, commitMsg); err != nil {
 		return fmt.Errorf("commit: %w", err)
 	}
 
-	fmt.Fprintf(os.Stderr, "Imported %d issues from %s\n", count, jsonlPath)
+	result.Imported = count
+	if !jsonOutput {
+		fmt.Fprintf(os.Stderr, "Imported %d issues from %s\n", count, jsonlPath)
+	}
+
+	// Saved views travel with the repo export (see 'bd view')
+	if repoBeadsDir != "" {
+		n, err := importViewsJSONL(ctx, repoBeadsDir)
+		if err != nil {
+			return fmt.Errorf("import views: %w", err)
+		}
+		if n > 0 {
+			if err := store.Commit(ctx, fmt.Sprintf("bd import: %d view(s) from %s", n, viewsFileName)); err != nil {
+				return fmt.Errorf("commit: %w", err)
+			}
+			result.Views = n
+			if !jsonOutput {
+				fmt.Fprintf(os.Stderr, "Imported %d view(s) from %s\n", n, filepath.Join(repoBeadsDir, viewsFileName))
+			}
+		}
+
+		// Assignee groups travel the same way (see 'bd group')
+		n, err = importGroupsJSONL(ctx, repoBeadsDir)
+		if err != nil {
+			return fmt.Errorf("import groups: %w", err)
+		}
+		if n > 0 {
+			if err := store.Commit(ctx, fmt.Sprintf("bd import: %d group(s) from %s", n, groupsFileName)); err != nil {
+				return fmt.Errorf("commit: %w", err)
+			}
+			result.Groups = n
+			if !jsonOutput {
+				fmt.Fprintf(os.Stderr, "Imported %d group(s) from %s\n", n, filepath.Join(repoBeadsDir, groupsFileName))
+			}
+		}
+	}
+	if jsonOutput {
+		outputJSON(result)
+	}
 	return nil
 }
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/debug"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// jsonlRecord is one issue read from a JSONL file, with where it came from.
+type jsonlRecord struct {
+	Issue *types.Issue
+	File  string
+	Line  int
+	raw   json.RawMessage // The line as written, for --on-conflict merge
+}
+
+// location is the record's "file:line", with the file's base name.
+func (r *jsonlRecord) location() string {
+	return fmt.Sprintf("%s:%d", filepath.Base(r.File), r.Line)
+}
+
+func recordIssues(records []*jsonlRecord) []*types.Issue {
+	issues := make([]*types.Issue, 0, len(records))
+	for _, r := range records {
+		issues = append(issues, r.Issue)
+	}
+	return issues
+}
+
+// importConflictPolicy decides which record wins when an import file holds
+// more than one line for the same issue ID.
+type importConflictPolicy string
+
+const (
+	onConflictStrict importConflictPolicy = "strict" // Fail the import
+	onConflictFirst  importConflictPolicy = "first"  // Keep the earliest line
+	onConflictLast   importConflictPolicy = "last"   // Keep the latest line
+	onConflictMerge  importConflictPolicy = "merge"  // Overlay later lines' fields onto earlier ones
+)
+
+func parseImportConflictPolicy(s string) (importConflictPolicy, error) {
+	switch p := importConflictPolicy(strings.ToLower(strings.TrimSpace(s))); p {
+	case onConflictStrict, onConflictFirst, onConflictLast, onConflictMerge:
+		return p, nil
+	}
+	return "", fmt.Errorf("invalid --on-conflict %q (want strict, first, last or merge)", s)
+}
+
+// importDuplicate describes one ID that appeared on several lines.
+type importDuplicate struct {
+	ID     string   `json:"id"`
+	Lines  []string `json:"lines"`  // "file:line" of every occurrence, in file order
+	Winner string   `json:"winner"` // "file:line" of the kept record, or "merged"
+}
+
+func (d importDuplicate) String() string {
+	return fmt.Sprintf("%s on %s", d.ID, strings.Join(d.Lines, ", "))
+}
+
+// resolveDuplicateIDs collapses records sharing an ID to one issue, placed
+// where the ID first appeared. With onConflictStrict any duplicate is an
+// error; otherwise every duplicate is returned so the caller can report it.
+func resolveDuplicateIDs(records []*jsonlRecord, policy importConflictPolicy) ([]*types.Issue, []importDuplicate, error) {
+	byID := make(map[string][]*jsonlRecord, len(records))
+	var order []string
+	for _, r := range records {
+		if _, ok := byID[r.Issue.ID]; !ok {
+			order = append(order, r.Issue.ID)
+		}
+		byID[r.Issue.ID] = append(byID[r.Issue.ID], r)
+	}
+	if len(order) == len(records) {
+		return recordIssues(records), nil, nil
+	}
+
+	var dups []importDuplicate
+	for _, id := range order {
+		if group := byID[id]; len(group) > 1 {
+			dup := importDuplicate{ID: id}
+			for _, r := range group {
+				dup.Lines = append(dup.Lines, r.location())
+			}
+			dups = append(dups, dup)
+		}
+	}
+	if policy == onConflictStrict {
+		lines := make([]string, 0, len(dups))
+		for _, d := range dups {
+			lines = append(lines, "  "+d.String())
+		}
+		return nil, dups, fmt.Errorf("%d issue ID(s) appear more than once:\n%s\n"+
+			"Re-run with --on-conflict first, last or merge to choose which record wins",
+			len(dups), strings.Join(lines, "\n"))
+	}
+
+	issues := make([]*types.Issue, 0, len(order))
+	d := 0
+	for _, id := range order {
+		group := byID[id]
+		if len(group) == 1 {
+			issues = append(issues, group[0].Issue)
+			continue
+		}
+		switch policy {
+		case onConflictFirst:
+			issues = append(issues, group[0].Issue)
+			dups[d].Winner = group[0].location()
+		case onConflictMerge:
+			merged, err := mergeJSONLRecords(group)
+			if err != nil {
+				return nil, dups, fmt.Errorf("merging duplicate %s: %w", id, err)
+			}
+			issues = append(issues, merged)
+			dups[d].Winner = "merged"
+		default:
+			last := group[len(group)-1]
+			issues = append(issues, last.Issue)
+			dups[d].Winner = last.location()
+		}
+		d++
+	}
+	return issues, dups, nil
+}
+
+// mergeJSONLRecords overlays the fields present in each later line onto the
+// earlier ones. Fields a line leaves out keep their earlier value.
+func mergeJSONLRecords(group []*jsonlRecord) (*types.Issue, error) {
+	fields := make(map[string]json.RawMessage)
+	for _, r := range group {
+		var line map[string]json.RawMessage
+		if err := json.Unmarshal(r.raw, &line); err != nil {
+			return nil, fmt.Errorf("%s: %w", r.location(), err)
+		}
+		for k, v := range line {
+			fields[k] = v
+		}
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	var issue types.Issue
+	if err := json.Unmarshal(data, &issue); err != nil {
+		return nil, err
+	}
+	issue.SetDefaults()
+	return &issue, nil
+}
+
+// reportDuplicateIDs warns on stderr about every duplicate ID and which
+// record won. --quiet silences the warning; bd import --json still lists
+// the duplicates in its result.
+func reportDuplicateIDs(dups []importDuplicate, policy importConflictPolicy) {
+	if len(dups) == 0 || debug.IsQuiet() {
+		return
+	}
+	debug.Warnf("%d issue ID(s) appear more than once (--on-conflict %s):\n", len(dups), policy)
+	for _, d := range dups {
+		won := "kept " + d.Winner
+		if d.Winner == "merged" {
+			won = "merged, later lines override"
+		}
+		fmt.Fprintf(os.Stderr, "  %s; %s\n", d, won)
+	}
+}
+
+// dedupeRecordsKeepLast applies the default --on-conflict policy for
+// imports that have no flag (e.g. 'bd init' loading an existing export).
+func dedupeRecordsKeepLast(records []*jsonlRecord) []*types.Issue {
+	issues, dups, _ := resolveDuplicateIDs(records, onConflictLast)
+	reportDuplicateIDs(dups, onConflictLast)
+	return issues
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResolveDuplicateIDs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	content := `{"id":"bd-1","title":"Original","status":"open","priority":2,"assignee":"alice"}
+{"id":"bd-2","title":"Other","status":"open","priority":3}
+
+{"id":"bd-1","title":"Edited","status":"in_progress","priority":1}
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write JSONL: %v", err)
+	}
+	records, err := readJSONLRecords(path)
+	if err != nil {
+		t.Fatalf("readJSONLRecords: %v", err)
+	}
+
+	if _, dups, err := resolveDuplicateIDs(records, onConflictStrict); err == nil {
+		t.Error("strict: expected an error for the duplicate ID")
+	} else if len(dups) != 1 || !strings.Contains(err.Error(), "bd-1 on issues.jsonl:1, issues.jsonl:4") {
+		t.Errorf("strict: error %q should list bd-1 with both lines", err)
+	}
+
+	tests := []struct {
+		policy       importConflictPolicy
+		wantWinner   string
+		wantTitle    string
+		wantAssignee string
+	}{
+		{onConflictFirst, "issues.jsonl:1", "Original", "alice"},
+		{onConflictLast, "issues.jsonl:4", "Edited", ""},
+		{onConflictMerge, "merged", "Edited", "alice"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			issues, dups, err := resolveDuplicateIDs(records, tt.policy)
+			if err != nil {
+				t.Fatalf("resolveDuplicateIDs: %v", err)
+			}
+			if len(issues) != 2 || issues[0].ID != "bd-1" || issues[1].ID != "bd-2" {
+				t.Fatalf("got %d issues, want bd-1 then bd-2", len(issues))
+			}
+			if len(dups) != 1 || dups[0].ID != "bd-1" || dups[0].Winner != tt.wantWinner {
+				t.Errorf("dups = %+v, want bd-1 won by %s", dups, tt.wantWinner)
+			}
+			if got := issues[0]; got.Title != tt.wantTitle || got.Assignee != tt.wantAssignee {
+				t.Errorf("bd-1 = (%q, %q), want (%q, %q)", got.Title, got.Assignee, tt.wantTitle, tt.wantAssignee)
+			}
+		})
+	}
+}
+
+func TestParseImportConflictPolicy(t *testing.T) {
+	if p, err := parseImportConflictPolicy(" Merge "); err != nil || p != onConflictMerge {
+		t.Errorf("parse Merge = (%q, %v), want merge", p, err)
+	}
+	if _, err := parseImportConflictPolicy("newest"); err == nil {
+		t.Error("expected an error for an unknown policy")
+	}
+}
@@ -333,3 +333,46 @@ func TestImportFromLocalJSONL(t *testing.T) {
 		}
 	})
 }
+
+func TestIssuesNewerInStore(t *testing.T) {
+	skipIfNoDolt(t)
+
+	tmpDir := t.TempDir()
+	store := newTestStore(t, filepath.Join(tmpDir, "dolt"))
+	ctx := context.Background()
+
+	jsonlContent := `{"id":"test-old1","title":"Exported","issue_type":"task","status":"open","priority":2,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}
+{"id":"test-new1","title":"Not yet in the database","issue_type":"task","status":"open","priority":2,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}
+`
+	jsonlPath := filepath.Join(tmpDir, "issues.jsonl")
+	if err := os.WriteFile(jsonlPath, []byte(jsonlContent), 0644); err != nil {
+		t.Fatalf("Failed to write JSONL file: %v", err)
+	}
+	issues, err := readJSONLIssues(jsonlPath)
+	if err != nil {
+		t.Fatalf("readJSONLIssues failed: %v", err)
+	}
+	if _, err := importParsedIssues(ctx, store, issues[:1]); err != nil {
+		t.Fatalf("importParsedIssues failed: %v", err)
+	}
+
+	newer, err := issuesNewerInStore(ctx, store, issues)
+	if err != nil {
+		t.Fatalf("issuesNewerInStore failed: %v", err)
+	}
+	if len(newer) != 0 {
+		t.Fatalf("issuesNewerInStore = %v right after import, want none", newer)
+	}
+
+	// Change the issue in the database after the export was written.
+	if err := store.UpdateIssue(ctx, "test-old1", map[string]interface{}{"title": "Edited in Dolt"}, "test"); err != nil {
+		t.Fatalf("UpdateIssue failed: %v", err)
+	}
+	newer, err = issuesNewerInStore(ctx, store, issues)
+	if err != nil {
+		t.Fatalf("issuesNewerInStore failed: %v", err)
+	}
+	if len(newer) != 1 || newer[0] != "test-old1" {
+		t.Errorf("issuesNewerInStore = %v, want [test-old1]", newer)
+	}
+}
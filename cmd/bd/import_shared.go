@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -66,25 +68,138 @@ func importIssuesCore(ctx context.Context, _ string, store storage.DoltStorage,
 // any manual cleanup done to the JSONL file (e.g., via bd compact --purge-tombstones).
 // Returns the number of issues imported and any error.
 func importFromLocalJSONL(ctx context.Context, store storage.DoltStorage, localPath string) (int, error) {
+	records, err := readJSONLRecords(localPath)
+	if err != nil {
+		return 0, err
+	}
+	return importParsedIssues(ctx, store, dedupeRecordsKeepLast(records))
+}
+
+// importFromJSONLDir imports every *.jsonl shard in dir (as written by
+// 'bd export --shard-by') in a single batch, so dependencies that cross
+// shards resolve regardless of file order.
+func importFromJSONLDir(ctx context.Context, store storage.DoltStorage, dir string) (int, error) {
+	records, err := readJSONLDirRecords(dir)
+	if err != nil {
+		return 0, err
+	}
+	return importParsedIssues(ctx, store, dedupeRecordsKeepLast(records))
+}
+
+// readJSONLDir parses the *.jsonl shards in dir, in file name order. When
+// dir holds a sharded export's manifest, only the shards it lists are read.
+func readJSONLDir(dir string) ([]*types.Issue, error) {
+	records, err := readJSONLDirRecords(dir)
+	if err != nil {
+		return nil, err
+	}
+	return recordIssues(records), nil
+}
+
+// readJSONLDirRecords is readJSONLDir keeping each issue's shard and line.
+func readJSONLDirRecords(dir string) ([]*jsonlRecord, error) {
+	shards, err := listJSONLShards(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*jsonlRecord
+	for _, shard := range shards {
+		shardRecords, err := readJSONLRecords(shard)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, shardRecords...)
+	}
+	return records, nil
+}
+
+// listJSONLShards returns the shard paths readJSONLDir reads, sorted.
+func listJSONLShards(dir string) ([]string, error) {
+	names, err := readShardManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	if names == nil {
+		shards, err := filepath.Glob(filepath.Join(dir, "*.jsonl"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list shards in %s: %w", dir, err)
+		}
+		sort.Strings(shards)
+		return shards, nil
+	}
+	shards := make([]string, len(names))
+	for i, name := range names {
+		shards[i] = filepath.Join(dir, name)
+	}
+	sort.Strings(shards)
+	return shards, nil
+}
+
+// issuesNewerInStore returns the sorted IDs of issues the database changed
+// after the given JSONL copies were written. Upserting those copies would
+// roll the database back, and the database is the source of truth.
+func issuesNewerInStore(ctx context.Context, store storage.DoltStorage, issues []*types.Issue) ([]string, error) {
+	if len(issues) == 0 {
+		return nil, nil
+	}
+	byID := make(map[string]*types.Issue, len(issues))
+	ids := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+		ids = append(ids, issue.ID)
+	}
+	existing, err := store.GetIssuesByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load existing issues: %w", err)
+	}
+	var newer []string
+	for _, current := range existing {
+		incoming := byID[current.ID]
+		if incoming == nil || incoming.UpdatedAt.IsZero() {
+			continue
+		}
+		if current.UpdatedAt.Truncate(time.Second).After(incoming.UpdatedAt.Truncate(time.Second)) {
+			newer = append(newer, current.ID)
+		}
+	}
+	sort.Strings(newer)
+	return newer, nil
+}
+
+// readJSONLIssues parses issues from a JSONL file, skipping legacy tombstones.
+func readJSONLIssues(localPath string) ([]*types.Issue, error) {
+	records, err := readJSONLRecords(localPath)
+	if err != nil {
+		return nil, err
+	}
+	return recordIssues(records), nil
+}
+
+// readJSONLRecords parses issues from a JSONL file like readJSONLIssues,
+// keeping the line each one came from so duplicate IDs can be reported.
+func readJSONLRecords(localPath string) ([]*jsonlRecord, error) {
 	//nolint:gosec // G304: path from user-provided CLI argument
 	data, err := os.ReadFile(localPath)
 	if err != nil {
-		return 0, fmt.Errorf("failed to read JSONL file %s: %w", localPath, err)
+		return nil, fmt.Errorf("failed to read JSONL file %s: %w", localPath, err)
 	}
 
 	scanner := bufio.NewScanner(strings.NewReader(string(data)))
 	// Allow up to 64MB per line for large descriptions
 	scanner.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024)
-	var issues []*types.Issue
+	var records []*jsonlRecord
 
+	lineNum := 0
 	for scanner.Scan() {
+		lineNum++
 		line := scanner.Text()
 		if line == "" {
 			continue
 		}
 		var issue types.Issue
 		if err := json.Unmarshal([]byte(line), &issue); err != nil {
-			return 0, fmt.Errorf("failed to parse issue from JSONL: %w", err)
+			return nil, fmt.Errorf("failed to parse issue from JSONL: %w", err)
 		}
 		// Skip tombstone entries: these are deleted issues exported by older
 		// versions (pre-v0.50) with status "tombstone" and deleted_at set.
@@ -93,12 +208,22 @@ func importFromLocalJSONL(ctx context.Context, store storage.DoltStorage, localP
 			continue
 		}
 		issue.SetDefaults()
-		issues = append(issues, &issue)
+		records = append(records, &jsonlRecord{
+			Issue: &issue,
+			File:  localPath,
+			Line:  lineNum,
+			raw:   json.RawMessage(line),
+		})
 	}
 	if err := scanner.Err(); err != nil {
-		return 0, fmt.Errorf("failed to scan JSONL: %w", err)
+		return nil, fmt.Errorf("failed to scan JSONL: %w", err)
 	}
+	return records, nil
+}
 
+// importParsedIssues upserts parsed issues, auto-detecting the prefix on a
+// fresh database. Returns the number of issues imported.
+func importParsedIssues(ctx context.Context, store storage.DoltStorage, issues []*types.Issue) (int, error) {
 	if len(issues) == 0 {
 		return 0, nil
 	}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/debug"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// normalizeImportStatuses folds statuses from other trackers ("Open",
+// "CLOSED", "resolved") into beads statuses, in place. A status that is
+// already valid is kept; otherwise import.status-map (mapping, with
+// lowercased keys) is consulted, then a case-insensitive match against
+// known statuses where spaces and hyphens count as underscores.
+//
+// Unmapped statuses are an error under strict; otherwise they pass through
+// unchanged and are returned as warnings, one per distinct value. The store
+// still rejects statuses it doesn't know, so strict mainly reports every
+// unmapped value up front instead of failing on the first one.
+func normalizeImportStatuses(issues []*types.Issue, mapping map[string]string, customStatuses []string, strict bool) ([]string, error) {
+	sources := make([]string, 0, len(mapping))
+	for source := range mapping {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	for _, source := range sources {
+		if target := mapping[source]; !types.Status(target).IsValidWithCustom(customStatuses) {
+			return nil, fmt.Errorf("import.status-map: %q maps to %q, which is not a status", source, target)
+		}
+	}
+
+	unmapped := make(map[string][]string)
+	for _, issue := range issues {
+		raw := string(issue.Status)
+		if issue.Status.IsValidWithCustom(customStatuses) {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(raw))
+		target, ok := mapping[key]
+		if !ok {
+			folded := strings.NewReplacer(" ", "_", "-", "_").Replace(key)
+			if types.Status(folded).IsValidWithCustom(customStatuses) {
+				target, ok = folded, true
+			}
+		}
+		if !ok {
+			unmapped[raw] = append(unmapped[raw], issue.ID)
+			continue
+		}
+		debug.Logf("import: %s status %q -> %q\n", issue.ID, raw, target)
+		setImportedStatus(issue, types.Status(target))
+	}
+	if len(unmapped) == 0 {
+		return nil, nil
+	}
+
+	values := make([]string, 0, len(unmapped))
+	for raw := range unmapped {
+		values = append(values, raw)
+	}
+	sort.Strings(values)
+	warnings := make([]string, 0, len(values))
+	for _, raw := range values {
+		warnings = append(warnings, fmt.Sprintf("unmapped status %q on %d issue(s): %s", raw, len(unmapped[raw]), strings.Join(unmapped[raw], ", ")))
+	}
+	if strict {
+		return nil, fmt.Errorf("%s\nMap them with import.status-map in config.yaml, or add them to status.custom", strings.Join(warnings, "\n"))
+	}
+	return warnings, nil
+}
+
+// setImportedStatus changes an imported issue's status, dropping closed_at
+// when the issue is mapped away from closed. Issues mapped to closed get a
+// closed_at from the store if they have none.
+func setImportedStatus(issue *types.Issue, status types.Status) {
+	issue.Status = status
+	if status != types.StatusClosed {
+		issue.ClosedAt = nil
+	}
+}
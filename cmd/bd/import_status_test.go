@@ -0,0 +1,63 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestNormalizeImportStatuses(t *testing.T) {
+	closedAt := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	issues := []*types.Issue{
+		{ID: "bd-1", Status: "Open"},
+		{ID: "bd-2", Status: "CLOSED"},
+		{ID: "bd-3", Status: "In Progress"},
+		{ID: "bd-4", Status: "Resolved"},
+		{ID: "bd-5", Status: "triage"},
+		{ID: "bd-6", Status: "Reopened", ClosedAt: &closedAt},
+		{ID: "bd-7", Status: "review"},
+		{ID: "bd-8", Status: "blocked"},
+	}
+	mapping := map[string]string{"resolved": "closed", "reopened": "open"}
+
+	warnings, err := normalizeImportStatuses(issues, mapping, []string{"review"}, false)
+	if err != nil {
+		t.Fatalf("normalizeImportStatuses: %v", err)
+	}
+	want := []types.Status{"open", "closed", "in_progress", "closed", "triage", "open", "review", "blocked"}
+	for i, issue := range issues {
+		if issue.Status != want[i] {
+			t.Errorf("%s status = %q, want %q", issue.ID, issue.Status, want[i])
+		}
+	}
+	if issues[5].ClosedAt != nil {
+		t.Errorf("bd-6 mapped to open kept closed_at %v", issues[5].ClosedAt)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `"triage"`) || !strings.Contains(warnings[0], "bd-5") {
+		t.Errorf("warnings = %q, want one for triage on bd-5", warnings)
+	}
+}
+
+func TestNormalizeImportStatusesStrict(t *testing.T) {
+	issues := []*types.Issue{
+		{ID: "bd-1", Status: "Done"},
+		{ID: "bd-2", Status: "wontfix"},
+		{ID: "bd-3", Status: "wontfix"},
+	}
+	_, err := normalizeImportStatuses(issues, map[string]string{"done": "closed"}, nil, true)
+	if err == nil {
+		t.Fatal("strict import of unknown statuses succeeded")
+	}
+	if msg := err.Error(); !strings.Contains(msg, `"wontfix" on 2 issue(s): bd-2, bd-3`) || strings.Contains(msg, "Done") {
+		t.Errorf("error = %q, want only wontfix reported", msg)
+	}
+}
+
+func TestNormalizeImportStatusesBadTarget(t *testing.T) {
+	_, err := normalizeImportStatuses(nil, map[string]string{"resolved": "finished"}, nil, false)
+	if err == nil || !strings.Contains(err.Error(), `"finished"`) {
+		t.Errorf("err = %v, want an error naming the bad target", err)
+	}
+}
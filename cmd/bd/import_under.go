@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/issueops"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/utils"
+)
+
+// graftedID is one entry of the old→new mapping printed by 'bd import --under'.
+type graftedID struct {
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// graftUnder rewrites issues, typically a subtree written by
+// 'bd export --issue --subtree', so that they import as descendants of
+// parentID. Issues with no parent among issues become children of parentID
+// in the slots nextSlot hands out; descendants keep their relative suffix
+// (bd-x.2 under bd-x becomes <new>.2), and descendants linked only by a
+// parent-child dependency get the next free slot under their new parent.
+// An ID that would collide with one in taken is moved to the next free
+// slot instead. Dependencies, comments and ID mentions in text fields are
+// rewritten to match. Returns the mapping in input order.
+func graftUnder(issues []*types.Issue, parentID string, nextSlot func() (string, error), taken map[string]bool) ([]graftedID, error) {
+	h := config.GetIDHierarchy()
+	sep := h.Separator()
+	byID := make(map[string]*types.Issue, len(issues))
+	for _, issue := range issues {
+		byID[issue.ID] = issue
+	}
+
+	// treeParent is an issue's parent within the incoming set: an explicit
+	// parent-child dependency wins over the ID hierarchy, as it does in
+	// 'bd list --parent'.
+	treeParent := make(map[string]string, len(issues))
+	childrenOf := make(map[string][]string)
+	var roots []string
+	for _, issue := range issues {
+		parent := ""
+		for _, dep := range issue.Dependencies {
+			if dep.Type == types.DepParentChild && dep.IssueID == issue.ID && byID[dep.DependsOnID] != nil && dep.DependsOnID != issue.ID {
+				parent = dep.DependsOnID
+				break
+			}
+		}
+		if parent == "" {
+			// Nearest ancestor by ID, so bd-x.1.1 still lands under bd-x
+			// when bd-x.1 is not part of the import
+			for id := issue.ID; parent == ""; {
+				p, _, ok := issueops.ParseHierarchicalID(id, h)
+				if !ok {
+					break
+				}
+				if byID[p] != nil {
+					parent = p
+				}
+				id = p
+			}
+		}
+		if parent == "" {
+			roots = append(roots, issue.ID)
+			continue
+		}
+		treeParent[issue.ID] = parent
+		childrenOf[parent] = append(childrenOf[parent], issue.ID)
+	}
+
+	used := make(map[string]bool, len(taken)+len(issues))
+	for id := range taken {
+		used[id] = true
+	}
+	// Slots directly under parentID come from nextSlot; the first error
+	// stops allocation and is returned once the walk unwinds.
+	var slotErr error
+	lastSlot := make(map[string]int)
+	nextFree := func(newParent string) string {
+		if newParent == parentID {
+			for slotErr == nil {
+				id, err := nextSlot()
+				if err != nil {
+					slotErr = err
+					break
+				}
+				if !used[id] {
+					return id
+				}
+			}
+			return ""
+		}
+		for {
+			lastSlot[newParent]++
+			id := h.ChildID(newParent, lastSlot[newParent])
+			if !used[id] {
+				return id
+			}
+		}
+	}
+	claim := func(newParent, id string) string {
+		if id == "" || used[id] {
+			id = nextFree(newParent)
+		}
+		if p, n, ok := issueops.ParseHierarchicalID(id, h); ok && p == newParent && n > lastSlot[newParent] {
+			lastSlot[newParent] = n
+		}
+		used[id] = true
+		return id
+	}
+
+	newIDs := make(map[string]string, len(issues))
+	var visit func(oldID, newID string)
+	visit = func(oldID, newID string) {
+		newIDs[oldID] = newID
+		// Children that keep their suffix go first, so they are not bumped
+		// by linked children taking the low slots
+		var linked []string
+		for _, child := range childrenOf[oldID] {
+			if _, done := newIDs[child]; done {
+				continue
+			}
+			if !strings.HasPrefix(child, oldID+sep) {
+				linked = append(linked, child)
+				continue
+			}
+			visit(child, claim(newID, newID+child[len(oldID):]))
+		}
+		for _, child := range linked {
+			if _, done := newIDs[child]; !done {
+				visit(child, claim(newID, ""))
+			}
+		}
+	}
+	for _, root := range roots {
+		visit(root, nextFree(parentID))
+		used[newIDs[root]] = true
+	}
+	// Issues caught in a parent-child cycle have no root; graft each directly
+	for _, issue := range issues {
+		if _, done := newIDs[issue.ID]; !done {
+			visit(issue.ID, claim(parentID, ""))
+		}
+	}
+
+	if slotErr != nil {
+		return nil, slotErr
+	}
+
+	rewriteText := idMentionRewriter(newIDs)
+	var mapping []graftedID
+	for _, issue := range issues {
+		oldID := issue.ID
+		issue.ID = newIDs[oldID]
+		mapping = append(mapping, graftedID{Old: oldID, New: issue.ID})
+
+		_, hasParent := treeParent[oldID]
+		var deps []*types.Dependency
+		for _, dep := range issue.Dependencies {
+			if !hasParent && dep.Type == types.DepParentChild && dep.IssueID == oldID {
+				// The root's old parent is replaced by parentID below
+				continue
+			}
+			if id, ok := newIDs[dep.IssueID]; ok {
+				dep.IssueID = id
+			}
+			if id, ok := newIDs[dep.DependsOnID]; ok {
+				dep.DependsOnID = id
+			}
+			deps = append(deps, dep)
+		}
+		if !hasParent {
+			deps = append(deps, &types.Dependency{IssueID: issue.ID, DependsOnID: parentID, Type: types.DepParentChild})
+		}
+		issue.Dependencies = deps
+		for _, c := range issue.Comments {
+			c.IssueID = issue.ID
+			c.Text = rewriteText(c.Text)
+		}
+
+		issue.Title = rewriteText(issue.Title)
+		issue.Description = rewriteText(issue.Description)
+		issue.Design = rewriteText(issue.Design)
+		issue.Notes = rewriteText(issue.Notes)
+		issue.AcceptanceCriteria = rewriteText(issue.AcceptanceCriteria)
+	}
+	return mapping, nil
+}
+
+// idMentionRewriter returns a function that replaces whole-word mentions of
+// the old IDs in newIDs. Longer IDs are tried first so bd-x.1 is not
+// rewritten as a mention of bd-x.
+func idMentionRewriter(newIDs map[string]string) func(string) string {
+	if len(newIDs) == 0 {
+		return func(s string) string { return s }
+	}
+	olds := make([]string, 0, len(newIDs))
+	for old := range newIDs {
+		olds = append(olds, old)
+	}
+	sort.Slice(olds, func(i, j int) bool {
+		if len(olds[i]) != len(olds[j]) {
+			return len(olds[i]) > len(olds[j])
+		}
+		return olds[i] < olds[j]
+	})
+	quoted := make([]string, len(olds))
+	for i, old := range olds {
+		quoted[i] = regexp.QuoteMeta(old)
+	}
+	pattern := regexp.MustCompile(`\b(?:` + strings.Join(quoted, "|") + `)\b`)
+	return func(s string) string {
+		if s == "" {
+			return s
+		}
+		return pattern.ReplaceAllStringFunc(s, func(m string) string { return newIDs[m] })
+	}
+}
+
+// importIssuesUnder resolves the --under target, refusing one that does not
+// exist, and imports issues as its descendants (see graftUnder). The target's
+// child slots are allocated in the import transaction, so a concurrent
+// create under it cannot take the same IDs. Returns the resolved target and
+// the old→new mapping.
+func importIssuesUnder(ctx context.Context, under string, issues []*types.Issue, source string) (string, []graftedID, error) {
+	parentID, err := utils.ResolvePartialID(ctx, store, under)
+	if err != nil {
+		return "", nil, fmt.Errorf("--under target %s not found: %w", under, err)
+	}
+	for _, issue := range issues {
+		if issue.ID == parentID {
+			return "", nil, fmt.Errorf("--under target %s is part of the import", parentID)
+		}
+	}
+
+	var mapping []graftedID
+	commitMsg := fmt.Sprintf("bd import: %d issues from %s under %s", len(issues), source, parentID)
+	opts := storage.BatchCreateOptions{
+		OrphanHandling:       storage.OrphanAllow,
+		SkipPrefixValidation: true,
+	}
+	err = store.CreateIssuesUnder(ctx, parentID, issues, getActorWithGit(), opts, commitMsg,
+		func(taken map[string]bool, nextChildID func() (string, error)) error {
+			var err error
+			mapping, err = graftUnder(issues, parentID, nextChildID, taken)
+			return err
+		})
+	if err != nil {
+		return "", nil, err
+	}
+	return parentID, mapping, nil
+}
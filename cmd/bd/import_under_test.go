@@ -0,0 +1,92 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func graftMapping(mapping []graftedID) map[string]string {
+	m := make(map[string]string, len(mapping))
+	for _, g := range mapping {
+		m[g.Old] = g.New
+	}
+	return m
+}
+
+// childSlots stands in for the store's child counter: it hands out the
+// children of parentID after last, in order.
+func childSlots(parentID string, last int) func() (string, error) {
+	return func() (string, error) {
+		last++
+		return types.GenerateChildID(parentID, last), nil
+	}
+}
+
+func TestGraftUnder(t *testing.T) {
+	subtree := selectSubtree(subtreeFixture(), "bd-epic", true)
+	pruneExternalDeps(subtree)
+	subtree[0].Description = "Split into bd-epic.1 and bd-linked; see bd-epicure"
+	subtree[1].Comments = []*types.Comment{{IssueID: "bd-epic.1", Text: "Blocked by bd-linked"}}
+
+	// bd-target.1 and bd-target.2 exist; the counter says slot 3 was used
+	taken := map[string]bool{"bd-target.1": true, "bd-target.2": true, "bd-target.4.1": true}
+	mapping, err := graftUnder(subtree, "bd-target", childSlots("bd-target", 3), taken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"bd-epic":     "bd-target.4",
+		"bd-epic.1":   "bd-target.4.2", // bd-target.4.1 is taken
+		"bd-epic.1.1": "bd-target.4.2.1",
+		"bd-linked":   "bd-target.4.3",
+		"bd-linked.1": "bd-target.4.3.1",
+	}
+	if got := graftMapping(mapping); !reflect.DeepEqual(got, want) {
+		t.Fatalf("mapping = %v, want %v", got, want)
+	}
+	if got := subtreeIDs(subtree); !reflect.DeepEqual(got, []string{"bd-target.4", "bd-target.4.2", "bd-target.4.2.1", "bd-target.4.3", "bd-target.4.3.1"}) {
+		t.Errorf("ids = %v", got)
+	}
+
+	epic := subtree[0]
+	if len(epic.Dependencies) != 1 || *epic.Dependencies[0] != (types.Dependency{IssueID: "bd-target.4", DependsOnID: "bd-target", Type: types.DepParentChild}) {
+		t.Errorf("root dependencies = %v, want parent-child to bd-target", epic.Dependencies)
+	}
+	if epic.Description != "Split into bd-target.4.2 and bd-target.4.3; see bd-epicure" {
+		t.Errorf("description = %q", epic.Description)
+	}
+	linked := subtree[3]
+	var edges []string
+	for _, dep := range linked.Dependencies {
+		edges = append(edges, formatDroppedDep(dep))
+	}
+	if want := []string{"bd-target.4.3 -(parent-child)-> bd-target.4", "bd-target.4.3 -(blocks)-> bd-target.4.2"}; !reflect.DeepEqual(edges, want) {
+		t.Errorf("linked dependencies = %v, want %v", edges, want)
+	}
+	if c := subtree[1].Comments[0]; c.IssueID != "bd-target.4.2" || c.Text != "Blocked by bd-target.4.3" {
+		t.Errorf("comment = %+v", c)
+	}
+}
+
+func TestGraftUnder_SeveralRoots(t *testing.T) {
+	issues := []*types.Issue{
+		{ID: "ext-a"},
+		{ID: "ext-b", Dependencies: []*types.Dependency{{IssueID: "ext-b", DependsOnID: "ext-gone", Type: types.DepParentChild}}},
+		{ID: "ext-a.2"},
+	}
+	mapping, err := graftUnder(issues, "bd-p", childSlots("bd-p", 0), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"ext-a": "bd-p.1", "ext-b": "bd-p.2", "ext-a.2": "bd-p.1.2"}
+	if got := graftMapping(mapping); !reflect.DeepEqual(got, want) {
+		t.Fatalf("mapping = %v, want %v", got, want)
+	}
+	// The link to a parent outside the import is replaced by the target
+	if deps := issues[1].Dependencies; len(deps) != 1 || deps[0].DependsOnID != "bd-p" {
+		t.Errorf("ext-b dependencies = %v, want only parent-child to bd-p", deps)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/types"
 )
 
@@ -183,8 +184,8 @@ func extractPrefix(issueID string) string {
 	// Check if suffix is numeric
 	if len(suffix) > 0 {
 		numPart := suffix
-		if dotIdx := strings.Index(suffix, "."); dotIdx > 0 {
-			numPart = suffix[:dotIdx]
+		if sepIdx := strings.Index(suffix, config.GetIDHierarchy().Separator()); sepIdx > 0 {
+			numPart = suffix[:sepIdx]
 		}
 		var num int
 		if _, err := fmt.Sscanf(numPart, "%d", &num); err == nil {
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"github.com/steveyegge/beads"
+	"github.com/steveyegge/beads/internal/storage/dolt"
+)
+
+// libStore wraps s in the library's beads.Store, so that create, update,
+// close, list and show run the same operations as programs that embed
+// beads. It records changes as the current actor. Script hooks and
+// webhooks stay with the commands; the Store has no OnEvent hooks.
+func libStore(s *dolt.DoltStore) *beads.Store {
+	ls, err := beads.NewStore(s)
+	if err != nil {
+		// Unreachable: NewStore accepts every *dolt.DoltStore
+		FatalError("%v", err)
+	}
+	ls.SetActor(actor)
+	return ls
+}
@@ -0,0 +1,357 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// commitSHAPattern matches abbreviated and full git object names.
+var commitSHAPattern = regexp.MustCompile(`^[0-9a-fA-F]{7,64}$`)
+
+// fixesKeywordPattern matches a closing keyword ("Fixes", "closes:", ...)
+// at the end of the text that precedes an issue reference.
+var fixesKeywordPattern = regexp.MustCompile(`(?i)\b(fix|fixes|fixed|close|closes|closed|resolve|resolves|resolved)\b[\s:]*$`)
+
+var linkCmd = &cobra.Command{
+	Use:     "link",
+	GroupID: "issues",
+	Short:   "Link issues to git commits and URLs",
+	Long: `Associate issues with git commits or URLs (pull requests, CI runs, docs).
+Links are listed by 'bd show'.
+
+Each link has a type: "fixes" for the change that resolves the issue and
+"mentions" for anything else that refers to it.
+
+Examples:
+  bd link add bd-42 --commit 8f3k2a1 --type fixes
+  bd link add bd-42 --url https://github.com/org/repo/pull/7
+  bd link ls bd-42
+  bd link rm bd-42 https://github.com/org/repo/pull/7
+  bd link scan                    # Link commits whose messages mention issue IDs`,
+}
+
+var linkAddCmd = &cobra.Command{
+	Use:   "add <issue-id> (--commit <sha> | --url <url>)",
+	Short: "Link an issue to a commit or URL",
+	Long: `Link an issue to a git commit or a URL.
+
+An abbreviated --commit is expanded to the full SHA when it names a commit
+in the current git repository.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("link add")
+		commit, _ := cmd.Flags().GetString("commit")
+		rawURL, _ := cmd.Flags().GetString("url")
+		linkType, _ := cmd.Flags().GetString("type")
+
+		if (commit == "") == (rawURL == "") {
+			FatalErrorRespectJSON("specify exactly one of --commit or --url")
+		}
+		link := &types.IssueLink{
+			IssueID:   resolveIssueArg(args[0]),
+			Type:      types.LinkType(linkType),
+			CreatedBy: getActorWithGit(),
+		}
+		if !link.Type.IsValid() {
+			FatalErrorRespectJSON("invalid --type %q (want %s or %s)", linkType, types.LinkFixes, types.LinkMentions)
+		}
+		var err error
+		if commit != "" {
+			link.Kind = types.LinkKindCommit
+			link.Target, err = resolveLinkCommit(commit)
+		} else {
+			link.Kind = types.LinkKindURL
+			link.Target, err = validateLinkURL(rawURL)
+		}
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		added, err := store.AddIssueLink(rootCtx, link)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if added {
+			commandDidWrite.Store(true)
+		}
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"issue_id": link.IssueID,
+				"kind":     link.Kind,
+				"target":   link.Target,
+				"type":     link.Type,
+				"added":    added,
+			})
+			return
+		}
+		if !added {
+			fmt.Printf("%s already linked to %s\n", link.IssueID, formatLinkTarget(link))
+			return
+		}
+		fmt.Printf("%s Linked %s to %s (%s)\n", ui.RenderPass("✓"), link.IssueID, formatLinkTarget(link), link.Type)
+	},
+}
+
+var linkRmCmd = &cobra.Command{
+	Use:     "rm <issue-id> <sha-or-url>",
+	Aliases: []string{"remove"},
+	Short:   "Remove links from an issue to a commit or URL",
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("link rm")
+		issueID, target := resolveIssueArg(args[0]), args[1]
+		if commitSHAPattern.MatchString(target) {
+			if sha, err := resolveLinkCommit(target); err == nil {
+				target = sha
+			}
+		}
+		n, err := store.RemoveIssueLink(rootCtx, issueID, target)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if n == 0 {
+			FatalErrorRespectJSON("%s is not linked to %s", issueID, target)
+		}
+		commandDidWrite.Store(true)
+		if jsonOutput {
+			outputJSON(map[string]interface{}{"issue_id": issueID, "target": target, "removed": n})
+			return
+		}
+		fmt.Printf("%s Removed %d link(s) from %s\n", ui.RenderPass("✓"), n, issueID)
+	},
+}
+
+var linkLsCmd = &cobra.Command{
+	Use:     "ls <issue-id>",
+	Aliases: []string{"list"},
+	Short:   "List the links of an issue",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		issueID := resolveIssueArg(args[0])
+		links, err := store.GetIssueLinks(rootCtx, issueID)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if jsonOutput {
+			if links == nil {
+				links = []*types.IssueLink{}
+			}
+			outputJSON(links)
+			return
+		}
+		if len(links) == 0 {
+			fmt.Printf("%s has no links\n", issueID)
+			return
+		}
+		for _, link := range links {
+			fmt.Println(formatLinkLine(link))
+		}
+	},
+}
+
+var linkScanCmd = &cobra.Command{
+	Use:   "scan [revision-range]",
+	Short: "Link commits that mention issue IDs in their messages",
+	Long: `Read 'git log' and link each commit to the issues its message mentions.
+
+A reference preceded by a closing keyword ("Fixes bd-42", "closes: bd-42",
+"resolved bd-42") is linked as "fixes"; any other reference as "mentions".
+Only IDs of existing issues with the configured prefix are linked, and links
+that already exist are skipped, so scanning again is safe.
+
+The optional revision range is passed to git log (default: HEAD).
+
+Examples:
+  bd link scan                    # Whole history of HEAD
+  bd link scan main..feature      # Commits on a branch
+  bd link scan -n 50 --dry-run    # Preview the last 50 commits`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		limit, _ := cmd.Flags().GetInt("limit")
+		if !dryRun {
+			CheckReadonly("link scan")
+		}
+		requireViewStore()
+		ctx := rootCtx
+
+		prefix, err := store.GetConfig(ctx, "issue_prefix")
+		if err != nil || strings.TrimSpace(prefix) == "" {
+			FatalErrorRespectJSON("issue_prefix is not configured")
+		}
+
+		rc, err := beads.GetRepoContext()
+		if err != nil {
+			FatalErrorRespectJSON("not in a git repository: %v", err)
+		}
+		gitArgs := []string{"log", "--format=%H%x1f%B%x1e"}
+		if limit > 0 {
+			gitArgs = append(gitArgs, fmt.Sprintf("-n%d", limit))
+		}
+		if len(args) > 0 {
+			gitArgs = append(gitArgs, args[0])
+		}
+		out, err := rc.GitCmdCWD(ctx, gitArgs...).Output()
+		if err != nil {
+			FatalErrorRespectJSON("git log failed: %v", err)
+		}
+
+		refs := scanCommitReferences(string(out), prefix)
+		ids := make([]string, 0, len(refs))
+		seen := make(map[string]bool)
+		for _, ref := range refs {
+			if !seen[ref.IssueID] {
+				seen[ref.IssueID] = true
+				ids = append(ids, ref.IssueID)
+			}
+		}
+		existing, err := store.GetIssuesByIDs(ctx, ids)
+		if err != nil {
+			FatalErrorRespectJSON("failed to look up issues: %v", err)
+		}
+		known := make(map[string]bool, len(existing))
+		for _, issue := range existing {
+			known[issue.ID] = true
+		}
+
+		actor := getActorWithGit()
+		var linked []*types.IssueLink
+		for _, ref := range refs {
+			if !known[ref.IssueID] {
+				continue
+			}
+			link := &types.IssueLink{IssueID: ref.IssueID, Kind: types.LinkKindCommit, Target: ref.Commit, Type: ref.Type, CreatedBy: actor}
+			if !dryRun {
+				added, err := store.AddIssueLink(ctx, link)
+				if err != nil {
+					FatalErrorRespectJSON("%v", err)
+				}
+				if !added {
+					continue
+				}
+			}
+			linked = append(linked, link)
+		}
+		if len(linked) > 0 && !dryRun {
+			commandDidWrite.Store(true)
+		}
+
+		if jsonOutput {
+			if linked == nil {
+				linked = []*types.IssueLink{}
+			}
+			outputJSON(map[string]interface{}{"dry_run": dryRun, "links": linked})
+			return
+		}
+		if len(linked) == 0 {
+			fmt.Println("No new links found")
+			return
+		}
+		verb := "Linked"
+		if dryRun {
+			verb = "Would link"
+		}
+		for _, link := range linked {
+			fmt.Printf("  %s %s %s\n", link.IssueID, ui.RenderMuted(string(link.Type)), ui.RenderAccent(shortHash(link.Target)))
+		}
+		fmt.Printf("%s %d commit reference(s)\n", verb, len(linked))
+	},
+}
+
+// commitReference is an issue ID found in a commit message.
+type commitReference struct {
+	Commit  string
+	IssueID string
+	Type    types.LinkType
+}
+
+// scanCommitReferences extracts issue references from git log output
+// formatted as "%H%x1f%B%x1e". Each (commit, issue) pair is reported once,
+// as "fixes" if any of its mentions follows a closing keyword.
+func scanCommitReferences(log, prefix string) []commitReference {
+	idPattern := regexp.MustCompile(`\b` + regexp.QuoteMeta(prefix) + `-[a-z0-9]+(?:\.[0-9]+)*\b`)
+	var refs []commitReference
+	for _, record := range strings.Split(log, "\x1e") {
+		sha, body, ok := strings.Cut(strings.TrimLeft(record, "\n"), "\x1f")
+		if !ok || sha == "" {
+			continue
+		}
+		index := make(map[string]int)
+		for _, loc := range idPattern.FindAllStringIndex(body, -1) {
+			id := body[loc[0]:loc[1]]
+			linkType := types.LinkMentions
+			lineStart := strings.LastIndex(body[:loc[0]], "\n") + 1
+			if fixesKeywordPattern.MatchString(body[lineStart:loc[0]]) {
+				linkType = types.LinkFixes
+			}
+			if i, seen := index[id]; seen {
+				if linkType == types.LinkFixes {
+					refs[i].Type = types.LinkFixes
+				}
+				continue
+			}
+			index[id] = len(refs)
+			refs = append(refs, commitReference{Commit: sha, IssueID: id, Type: linkType})
+		}
+	}
+	return refs
+}
+
+// resolveLinkCommit expands a commit to its full SHA using the current git
+// repository. Outside a repository a well-formed SHA is accepted as given.
+func resolveLinkCommit(commit string) (string, error) {
+	if rc, err := beads.GetRepoContext(); err == nil {
+		out, err := rc.GitCmdCWD(rootCtx, "rev-parse", "--verify", "--quiet", commit+"^{commit}").Output()
+		if err == nil {
+			return strings.TrimSpace(string(out)), nil
+		}
+		if !commitSHAPattern.MatchString(commit) {
+			return "", fmt.Errorf("unknown commit %q", commit)
+		}
+	}
+	if !commitSHAPattern.MatchString(commit) {
+		return "", fmt.Errorf("invalid commit %q: expected a hex SHA", commit)
+	}
+	return strings.ToLower(commit), nil
+}
+
+// validateLinkURL accepts absolute http(s) URLs.
+func validateLinkURL(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("invalid URL %q: expected an absolute http(s) URL", raw)
+	}
+	return u.String(), nil
+}
+
+func formatLinkTarget(link *types.IssueLink) string {
+	if link.Kind == types.LinkKindCommit {
+		return "commit " + shortHash(link.Target)
+	}
+	return link.Target
+}
+
+// formatLinkLine renders one link for 'bd show' and 'bd link ls'.
+func formatLinkLine(link *types.IssueLink) string {
+	return fmt.Sprintf("  %-8s %s", link.Type, formatLinkTarget(link))
+}
+
+func init() {
+	linkAddCmd.Flags().String("commit", "", "Git commit SHA (abbreviated SHAs are expanded)")
+	linkAddCmd.Flags().String("url", "", "URL, e.g. a pull request")
+	linkAddCmd.Flags().String("type", string(types.LinkMentions), "Link type: fixes or mentions")
+	linkScanCmd.Flags().Bool("dry-run", false, "Show the links that would be created")
+	linkScanCmd.Flags().IntP("limit", "n", 0, "Only scan the last N commits (0 = all)")
+	linkCmd.AddCommand(linkAddCmd)
+	linkCmd.AddCommand(linkRmCmd)
+	linkCmd.AddCommand(linkLsCmd)
+	linkCmd.AddCommand(linkScanCmd)
+	rootCmd.AddCommand(linkCmd)
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestScanCommitReferences(t *testing.T) {
+	log := "aaa111\x1fAdd login form\n\nPart of bd-abc12, see also bd-x9.1\x1e\n" +
+		"bbb222\x1fFixes bd-abc12\n\nMentions bd-abc12 again and bd-zz9; other prefixes like gt-abc12 are ignored\x1e\n" +
+		"ccc333\x1fRefactor\n\nresolves: bd-x9.1\x1e\n"
+
+	got := scanCommitReferences(log, "bd")
+	want := []commitReference{
+		{Commit: "aaa111", IssueID: "bd-abc12", Type: types.LinkMentions},
+		{Commit: "aaa111", IssueID: "bd-x9.1", Type: types.LinkMentions},
+		{Commit: "bbb222", IssueID: "bd-abc12", Type: types.LinkFixes},
+		{Commit: "bbb222", IssueID: "bd-zz9", Type: types.LinkMentions},
+		{Commit: "ccc333", IssueID: "bd-x9.1", Type: types.LinkFixes},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("scanCommitReferences() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestValidateLinkURL(t *testing.T) {
+	if got, err := validateLinkURL(" https://github.com/org/repo/pull/7 "); err != nil || got != "https://github.com/org/repo/pull/7" {
+		t.Errorf("validateLinkURL(pull URL) = %q, %v", got, err)
+	}
+	for _, raw := range []string{"github.com/org/repo", "ftp://example.com/x", "not a url", "https://"} {
+		if _, err := validateLinkURL(raw); err == nil {
+			t.Errorf("validateLinkURL(%q) = nil error, want error", raw)
+		}
+	}
+}
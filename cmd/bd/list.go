@@ -13,6 +13,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/query"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/storage/dolt"
 	"github.com/steveyegge/beads/internal/types"
@@ -30,7 +31,7 @@ func withStorage(ctx context.Context, store *dolt.DoltStore, dbPath string, fn s
 		return fn(store)
 	} else if dbPath != "" {
 		// Open read-only connection
-		roStore, err := dolt.New(ctx, &dolt.Config{Path: dbPath, ReadOnly: true})
+		roStore, err := dolt.New(ctx, &dolt.Config{Path: dbPath, ReadOnly: true, HierarchySeparator: config.GetString("hierarchy.separator")})
 		if err != nil {
 			return err
 		}
@@ -116,14 +117,16 @@ func findAllDescendants(ctx context.Context, store *dolt.DoltStore, dbPath strin
 // watchIssues polls for changes and re-displays (GH#654)
 // Uses polling instead of fsnotify because Dolt stores data in a server-side
 // database, not files — file watchers never fire.
-func watchIssues(ctx context.Context, store *dolt.DoltStore, filter types.IssueFilter, sortBy string, reverse bool) {
+func watchIssues(ctx context.Context, store *dolt.DoltStore, filter types.IssueFilter, sortKeys []types.SortKey) {
+	lib := libStore(store)
+
 	// Initial display
-	issues, err := store.SearchIssues(ctx, "", filter)
+	issues, err := lib.List(ctx, filter)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error querying issues: %v\n", err)
 		return
 	}
-	sortIssues(issues, sortBy, reverse)
+	sortIssues(issues, sortKeys)
 	displayPrettyList(issues, true)
 	lastSnapshot := issueSnapshot(issues)
 
@@ -134,6 +137,9 @@ func watchIssues(ctx context.Context, store *dolt.DoltStore, filter types.IssueF
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 	defer signal.Stop(sigChan)
 
+	// Every poll reuses the process-wide store and its bounded *sql.DB pool
+	// rather than reconnecting. Returning (rather than exiting) on shutdown
+	// lets PersistentPostRun close the store via CloseWithTimeout.
 	pollInterval := 2 * time.Second
 	ticker := time.NewTicker(pollInterval)
 	defer ticker.Stop()
@@ -143,13 +149,15 @@ func watchIssues(ctx context.Context, store *dolt.DoltStore, filter types.IssueF
 		case <-sigChan:
 			fmt.Fprintf(os.Stderr, "\nStopped watching.\n")
 			return
+		case <-ctx.Done():
+			return
 		case <-ticker.C:
-			issues, err := store.SearchIssues(ctx, "", filter)
+			issues, err := lib.List(ctx, filter)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error refreshing issues: %v\n", err)
 				continue
 			}
-			sortIssues(issues, sortBy, reverse)
+			sortIssues(issues, sortKeys)
 			snap := issueSnapshot(issues)
 			if snap != lastSnapshot {
 				lastSnapshot = snap
@@ -170,59 +178,104 @@ func issueSnapshot(issues []*types.Issue) string {
 	return b.String()
 }
 
-// sortIssues sorts a slice of issues by the specified field and direction
-func sortIssues(issues []*types.Issue, sortBy string, reverse bool) {
-	if sortBy == "" {
+// sortIssues sorts issues by the given keys, matching the SQL ORDER BY the
+// store builds for the same keys (see IssueFilter.Sort): unset closed_at and
+// assignee sort last in either direction, and id breaks ties. Sorting again
+// in Go keeps wisps merged into the results in order.
+func sortIssues(issues []*types.Issue, keys []types.SortKey) {
+	if len(keys) == 0 {
 		return
 	}
 
-	slices.SortFunc(issues, func(a, b *types.Issue) int {
-		var result int
-
-		switch sortBy {
-		case "priority":
-			// Lower priority numbers come first (P0 > P1 > P2 > P3 > P4)
-			result = cmp.Compare(a.Priority, b.Priority)
-		case "created":
-			// Default: newest first (descending)
-			result = b.CreatedAt.Compare(a.CreatedAt)
-		case "updated":
-			// Default: newest first (descending)
-			result = b.UpdatedAt.Compare(a.UpdatedAt)
-		case "closed":
-			// Default: newest first (descending)
-			// Handle nil ClosedAt values
-			if a.ClosedAt == nil && b.ClosedAt == nil {
-				result = 0
-			} else if a.ClosedAt == nil {
-				result = 1 // nil sorts last
-			} else if b.ClosedAt == nil {
-				result = -1 // non-nil sorts before nil
-			} else {
-				result = b.ClosedAt.Compare(*a.ClosedAt)
-			}
-		case "status":
-			result = cmp.Compare(a.Status, b.Status)
-		case "id":
-			result = cmp.Compare(a.ID, b.ID)
-		case "title":
-			result = cmp.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
-		case "type":
-			result = cmp.Compare(a.IssueType, b.IssueType)
-		case "assignee":
-			result = cmp.Compare(a.Assignee, b.Assignee)
-		default:
-			// Unknown sort field, no sorting
-			result = 0
-		}
-
-		if reverse {
-			return -result
-		}
-		return result
+	slices.SortStableFunc(issues, func(a, b *types.Issue) int {
+		hasID := false
+		for _, key := range keys {
+			if key.Field == "id" {
+				hasID = true
+			}
+			if result := compareIssuesBy(a, b, key); result != 0 {
+				return result
+			}
+		}
+		if hasID {
+			return 0
+		}
+		return cmp.Compare(a.ID, b.ID)
 	})
 }
 
+// compareIssuesBy compares two issues on a single sort key.
+func compareIssuesBy(a, b *types.Issue, key types.SortKey) int {
+	switch key.Field {
+	case "closed":
+		if a.ClosedAt == nil || b.ClosedAt == nil {
+			return compareUnsetLast(a.ClosedAt == nil, b.ClosedAt == nil)
+		}
+	case "assignee":
+		if a.Assignee == "" || b.Assignee == "" {
+			return compareUnsetLast(a.Assignee == "", b.Assignee == "")
+		}
+	}
+
+	var result int
+	switch key.Field {
+	case "priority":
+		result = cmp.Compare(a.Priority, b.Priority)
+	case "created":
+		result = a.CreatedAt.Compare(b.CreatedAt)
+	case "updated":
+		result = a.UpdatedAt.Compare(b.UpdatedAt)
+	case "closed":
+		result = a.ClosedAt.Compare(*b.ClosedAt)
+	case "status":
+		result = cmp.Compare(a.Status, b.Status)
+	case "id":
+		result = cmp.Compare(a.ID, b.ID)
+	case "title":
+		result = cmp.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+	case "type":
+		result = cmp.Compare(a.IssueType, b.IssueType)
+	case "assignee":
+		result = cmp.Compare(a.Assignee, b.Assignee)
+	}
+	if key.Desc {
+		return -result
+	}
+	return result
+}
+
+// compareUnsetLast orders set values before unset ones.
+func compareUnsetLast(aUnset, bUnset bool) int {
+	switch {
+	case aUnset == bUnset:
+		return 0
+	case aUnset:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// sortFlagUsage is the --sort help shared by list, search and query.
+const sortFlagUsage = "Sort keys, comma-separated, each field[:asc|desc] (e.g. priority:asc,updated:desc). Fields: priority, created, updated, closed, status, id, title, type, assignee"
+
+// sortKeysFromFlags parses --sort and applies --reverse, which flips the
+// direction of every key. Exits on an invalid --sort value.
+func sortKeysFromFlags(cmd *cobra.Command) []types.SortKey {
+	spec, _ := cmd.Flags().GetString("sort")
+	reverse, _ := cmd.Flags().GetBool("reverse")
+	keys, err := types.ParseSortKeys(spec)
+	if err != nil {
+		FatalError("%v", err)
+	}
+	if reverse {
+		for i := range keys {
+			keys[i].Desc = !keys[i].Desc
+		}
+	}
+	return keys
+}
+
 // knownListFlags maps bare words that users might pass as positional args
 // but are actually flag names. Each maps to a hint for the error message.
 var knownListFlags = map[string]string{
@@ -263,6 +316,8 @@ var listCmd = &cobra.Command{
 		issueType = utils.NormalizeIssueType(issueType) // Expand aliases (mr→merge-request, etc.)
 		limit, _ := cmd.Flags().GetInt("limit")
 		allFlag, _ := cmd.Flags().GetBool("all")
+		archivedFlag, _ := cmd.Flags().GetBool("archived")
+		asOfRef, _ := cmd.Flags().GetString("as-of")
 		formatStr, _ := cmd.Flags().GetString("format")
 		labels, _ := cmd.Flags().GetStringSlice("label")
 		labelsAny, _ := cmd.Flags().GetStringSlice("label-any")
@@ -272,8 +327,7 @@ var listCmd = &cobra.Command{
 		specPrefix, _ := cmd.Flags().GetString("spec")
 		idFilter, _ := cmd.Flags().GetString("id")
 		longFormat, _ := cmd.Flags().GetBool("long")
-		sortBy, _ := cmd.Flags().GetString("sort")
-		reverse, _ := cmd.Flags().GetBool("reverse")
+		sortKeys := sortKeysFromFlags(cmd)
 
 		// Pattern matching flags
 		titleContains, _ := cmd.Flags().GetString("title-contains")
@@ -361,6 +415,28 @@ var listCmd = &cobra.Command{
 		// Pager control (bd-jdz3)
 		noPager, _ := cmd.Flags().GetBool("no-pager")
 
+		// Column selection: --columns wins; list.columns config applies only
+		// when no other output format was explicitly requested, or for TSV.
+		columnsSpec, _ := cmd.Flags().GetString("columns")
+		if columnsSpec == "" && !longFormat && (formatStr == "" || formatStr == listFormatTSV) && !watchMode &&
+			!cmd.Flags().Changed("pretty") && !cmd.Flags().Changed("tree") {
+			columnsSpec = config.GetString("list.columns")
+		}
+		var columns []string
+		if columnsSpec != "" && !jsonOutput {
+			var err error
+			if columns, err = parseListColumns(columnsSpec); err != nil {
+				FatalError("invalid --columns: %v", err)
+			}
+			prettyFormat = false
+		}
+		if formatStr == listFormatTSV {
+			if len(columns) == 0 {
+				columns = defaultTSVColumns
+			}
+			prettyFormat = false
+		}
+
 		// Ready filter (bd-ihu31)
 		readyFlag, _ := cmd.Flags().GetBool("ready")
 
@@ -398,27 +474,11 @@ var listCmd = &cobra.Command{
 			effectiveLimit = 20 // Agent mode default
 		}
 
-		// Validate --sort field (bd-ttno)
-		if sortBy != "" {
-			validSortFields := map[string]bool{
-				"priority": true, "created": true, "updated": true, "closed": true,
-				"status": true, "id": true, "title": true, "type": true, "assignee": true,
-			}
-			if !validSortFields[sortBy] {
-				FatalError("invalid sort field %q (valid: priority, created, updated, closed, status, id, title, type, assignee)", sortBy)
-			}
-		}
-
-		// When --sort is specified, don't pass Limit to SQL — the hardcoded
-		// ORDER BY would truncate before Go-side sorting (GH#1237).
-		// Instead, apply limit in Go after sortIssues().
-		sqlLimit := effectiveLimit
-		if sortBy != "" {
-			sqlLimit = 0
-		}
-
+		// --sort is translated to the SQL ORDER BY, so LIMIT keeps the right
+		// rows (GH#1237); the Go-side sort below interleaves merged wisps.
 		filter := types.IssueFilter{
-			Limit: sqlLimit,
+			Limit: effectiveLimit,
+			Sort:  sortKeys,
 		}
 
 		// --ready flag: show only open issues (excludes hooked/in_progress/blocked/deferred) (bd-ihu31)
@@ -440,7 +500,8 @@ var listCmd = &cobra.Command{
 		}
 
 		// Default to non-closed/non-pinned issues unless --all, --pinned, or explicit --status (GH#788, bd-uhcg)
-		if status == "" && !allFlag && !readyFlag && !pinnedFlag {
+		// Archived issues are all closed, so --archived skips it too.
+		if status == "" && !allFlag && !readyFlag && !pinnedFlag && !archivedFlag {
 			filter.ExcludeStatus = []types.Status{types.StatusClosed, types.StatusPinned}
 		}
 		// Use Changed() to properly handle P0 (priority=0)
@@ -453,7 +514,7 @@ var listCmd = &cobra.Command{
 			filter.Priority = &priority
 		}
 		if assignee != "" {
-			filter.Assignee = &assignee
+			setAssigneeFilter(&filter, assignee)
 		}
 		if issueType != "" {
 			t := types.IssueType(issueType)
@@ -721,6 +782,12 @@ var listCmd = &cobra.Command{
 			}
 			filter.HasMetadataKey = hasMetadataKey
 		}
+		if where, _ := cmd.Flags().GetString("where"); where != "" {
+			if err := query.ValidateSQL(where); err != nil {
+				FatalErrorRespectJSON("invalid --where: %v", err)
+			}
+			filter.Expr = where
+		}
 
 		ctx := rootCtx
 
@@ -748,22 +815,36 @@ var listCmd = &cobra.Command{
 		}
 
 		// Direct mode
-		issues, err := activeStore.SearchIssues(ctx, "", filter)
+		var issues []*types.Issue
+		var err error
+		if archivedFlag {
+			if watchMode || asOfRef != "" {
+				FatalError("--archived cannot be combined with --watch or --as-of")
+			}
+			issues, err = activeStore.SearchArchivedIssues(ctx, "", filter)
+		} else if asOfRef != "" {
+			if watchMode {
+				FatalError("--as-of cannot be combined with --watch")
+			}
+			issues, err = activeStore.SearchIssuesAsOf(ctx, asOfRef, "", filter)
+		} else {
+			issues, err = libStore(activeStore).List(ctx, filter)
+		}
 		if err != nil {
 			FatalError("%v", err)
 		}
 
 		// Apply sorting
-		sortIssues(issues, sortBy, reverse)
+		sortIssues(issues, sortKeys)
 
-		// Apply limit after sorting when --sort deferred it from SQL (GH#1237)
-		if sortBy != "" && effectiveLimit > 0 && len(issues) > effectiveLimit {
+		// Issues and wisps are each limited in SQL; trim the merged result
+		if len(sortKeys) > 0 && effectiveLimit > 0 && len(issues) > effectiveLimit {
 			issues = issues[:effectiveLimit]
 		}
 
 		// Handle watch mode (GH#654) - must be before other output modes
 		if watchMode {
-			watchIssues(ctx, activeStore, filter, sortBy, reverse)
+			watchIssues(ctx, activeStore, filter, sortKeys)
 			return
 		}
 
@@ -801,6 +882,23 @@ var listCmd = &cobra.Command{
 			return
 		}
 
+		// Tab-separated values for cut/awk pipelines
+		if formatStr == listFormatTSV {
+			var labelsMap map[string][]string
+			if slices.Contains(columns, "labels") {
+				issueIDs := make([]string, len(issues))
+				for i, issue := range issues {
+					issueIDs[i] = issue.ID
+				}
+				// Best effort: display gracefully degrades with empty data
+				labelsMap, _ = activeStore.GetLabelsForIssues(ctx, issueIDs)
+			}
+			var buf strings.Builder
+			formatColumnTSV(&buf, issues, labelsMap, columns)
+			fmt.Print(buf.String())
+			return
+		}
+
 		// Handle format flag
 		if formatStr != "" {
 			if err := outputFormattedList(ctx, activeStore, issues, formatStr); err != nil {
@@ -873,7 +971,9 @@ var listCmd = &cobra.Command{
 
 		// Build output in buffer for pager support (bd-jdz3)
 		var buf strings.Builder
-		if ui.IsAgentMode() {
+		if len(columns) > 0 {
+			formatColumnTable(&buf, issues, labelsMap, columns, listOutputWidth(cmd))
+		} else if ui.IsAgentMode() {
 			// Agent mode: ultra-compact, no colors, no pager
 			for _, issue := range issues {
 				formatAgentIssue(&buf, issue, blockedByMap[issue.ID], blocksMap[issue.ID], parentMap[issue.ID])
@@ -927,11 +1027,15 @@ func init() {
 	listCmd.Flags().String("spec", "", "Filter by spec_id prefix")
 	listCmd.Flags().String("id", "", "Filter by specific issue IDs (comma-separated, e.g., bd-1,bd-5,bd-10)")
 	listCmd.Flags().IntP("limit", "n", 50, "Limit results (default 50, use 0 for unlimited)")
-	listCmd.Flags().String("format", "", "Output format: 'digraph' (for golang.org/x/tools/cmd/digraph), 'dot' (Graphviz), or Go template")
+	listCmd.Flags().String("format", "", "Output format: 'tsv' (tab-separated, honors --columns), 'digraph' (for golang.org/x/tools/cmd/digraph), 'dot' (Graphviz), or Go template")
 	listCmd.Flags().Bool("all", false, "Show all issues including closed (overrides default filter)")
+	listCmd.Flags().String("as-of", "", "List issues as they were at a commit, branch or tag (e.g. a 'bd tag' name)")
+	listCmd.Flags().Bool("archived", false, "List issues moved to the archive by 'bd archive' instead of active issues")
 	listCmd.Flags().Bool("long", false, "Show detailed multi-line output for each issue")
-	listCmd.Flags().String("sort", "", "Sort by field: priority, created, updated, closed, status, id, title, type, assignee")
+	listCmd.Flags().String("sort", "", sortFlagUsage)
 	listCmd.Flags().BoolP("reverse", "r", false, "Reverse sort order")
+	listCmd.Flags().String("columns", "", "Comma-separated columns to show, in order: id, status, priority, type, assignee, owner, labels, created, updated, title (default from list.columns config)")
+	listCmd.Flags().Int("width", 0, "Table width for --columns (default: COLUMNS, else the terminal, else 80; 0 disables truncation)")
 
 	// Pattern matching
 	listCmd.Flags().String("title-contains", "", "Filter by title substring (case-insensitive)")
@@ -997,6 +1101,7 @@ func init() {
 	// Metadata filtering (GH#1406)
 	listCmd.Flags().StringArray("metadata-field", nil, "Filter by metadata field (key=value, repeatable)")
 	listCmd.Flags().String("has-metadata-key", "", "Filter issues that have this metadata key set")
+	listCmd.Flags().String("where", "", "Filter by expression, e.g. 'status=open AND (priority<=1 OR label=urgent)' (same syntax as bd query)")
 
 	// Pager control (bd-jdz3)
 	listCmd.Flags().Bool("no-pager", false, "Disable pager output")
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// listColumn describes one selectable column for `bd list --columns`.
+type listColumn struct {
+	header string
+	value  func(issue *types.Issue, labels []string) string
+}
+
+// listColumns is the set of fields that can be shown with --columns.
+var listColumns = map[string]listColumn{
+	"id":       {"ID", func(i *types.Issue, _ []string) string { return i.ID }},
+	"status":   {"STATUS", func(i *types.Issue, _ []string) string { return string(i.Status) }},
+	"priority": {"PRI", func(i *types.Issue, _ []string) string { return fmt.Sprintf("P%d", i.Priority) }},
+	"type":     {"TYPE", func(i *types.Issue, _ []string) string { return string(i.IssueType) }},
+	"assignee": {"ASSIGNEE", func(i *types.Issue, _ []string) string { return i.Assignee }},
+	"owner":    {"OWNER", func(i *types.Issue, _ []string) string { return i.Owner }},
+	"labels":   {"LABELS", func(_ *types.Issue, labels []string) string { return strings.Join(labels, ",") }},
+	"created":  {"CREATED", func(i *types.Issue, _ []string) string { return formatHumanTime(i.CreatedAt) }},
+	"updated":  {"UPDATED", func(i *types.Issue, _ []string) string { return formatHumanTime(i.UpdatedAt) }},
+	"title":    {"TITLE", func(i *types.Issue, _ []string) string { return i.Title }},
+}
+
+// minTitleWidth keeps the title column readable on narrow terminals.
+const minTitleWidth = 10
+
+// parseListColumns parses a comma-separated column list, validating each name.
+func parseListColumns(spec string) ([]string, error) {
+	var cols []string
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(spec, ",") {
+		name := strings.ToLower(strings.TrimSpace(part))
+		if name == "" {
+			continue
+		}
+		if _, ok := listColumns[name]; !ok {
+			return nil, fmt.Errorf("unknown column %q (valid: %s)", name, strings.Join(validListColumns(), ", "))
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		cols = append(cols, name)
+	}
+	if len(cols) == 0 {
+		return nil, fmt.Errorf("no columns specified (valid: %s)", strings.Join(validListColumns(), ", "))
+	}
+	return cols, nil
+}
+
+func validListColumns() []string {
+	names := make([]string, 0, len(listColumns))
+	for name := range listColumns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// formatColumnTable renders issues as an aligned table with the given columns.
+// Column widths are computed from the result set, in terminal cells so CJK
+// and emoji titles align. If termWidth > 0, the title column is truncated
+// with an ellipsis so each row fits the terminal.
+func formatColumnTable(buf *strings.Builder, issues []*types.Issue, labelsMap map[string][]string, cols []string, termWidth int) {
+	rows := make([][]string, len(issues))
+	widths := make([]int, len(cols))
+	for c, name := range cols {
+		widths[c] = ui.DisplayWidth(listColumns[name].header)
+	}
+	for r, issue := range issues {
+		rows[r] = make([]string, len(cols))
+		for c, name := range cols {
+			v := listColumns[name].value(issue, labelsMap[issue.ID])
+			rows[r][c] = v
+			if n := ui.DisplayWidth(v); n > widths[c] {
+				widths[c] = n
+			}
+		}
+	}
+
+	// Shrink the title column to whatever the other columns leave over.
+	if termWidth > 0 {
+		for c, name := range cols {
+			if name != "title" {
+				continue
+			}
+			used := 2 * (len(cols) - 1) // column gaps
+			for o, w := range widths {
+				if o != c {
+					used += w
+				}
+			}
+			avail := termWidth - used
+			if avail < minTitleWidth {
+				avail = minTitleWidth
+			}
+			if widths[c] > avail {
+				widths[c] = avail
+			}
+		}
+	}
+
+	header := make([]string, len(cols))
+	for c, name := range cols {
+		header[c] = listColumns[name].header
+	}
+	buf.WriteString(ui.RenderBold(joinColumnRow(header, widths)))
+	buf.WriteString("\n")
+
+	for r, issue := range issues {
+		line := joinColumnRow(rows[r], widths)
+		if issue.Status == types.StatusClosed {
+			line = ui.RenderClosedLine(line)
+		}
+		buf.WriteString(line)
+		buf.WriteString("\n")
+	}
+}
+
+// joinColumnRow pads (or truncates) each cell to its column width.
+// The last column is not padded to avoid trailing whitespace.
+func joinColumnRow(cells []string, widths []int) string {
+	parts := make([]string, len(cells))
+	for c, cell := range cells {
+		cell = ui.Truncate(cell, widths[c])
+		if c < len(cells)-1 {
+			cell = ui.PadRight(cell, widths[c])
+		}
+		parts[c] = cell
+	}
+	return strings.Join(parts, "  ")
+}
+
+// listFormatTSV is the 'bd list --format' value for tab-separated output.
+const listFormatTSV = "tsv"
+
+// defaultTSVColumns are used by --format tsv when no columns are selected.
+var defaultTSVColumns = []string{"id", "status", "priority", "type", "assignee", "title"}
+
+// tsvColumnValues replace the table value of columns whose human form is
+// awkward in scripts: timestamps are RFC 3339 instead of "2 days ago".
+var tsvColumnValues = map[string]func(issue *types.Issue, labels []string) string{
+	"created": func(i *types.Issue, _ []string) string { return i.CreatedAt.UTC().Format(time.RFC3339) },
+	"updated": func(i *types.Issue, _ []string) string { return i.UpdatedAt.UTC().Format(time.RFC3339) },
+}
+
+// tsvEscaper keeps every record on one line with one tab per column gap:
+// backslashes, tabs and line breaks inside a field are written as \\, \t,
+// \n and \r.
+var tsvEscaper = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`)
+
+// formatColumnTSV renders issues as tab-separated values: one header line of
+// column names, then one line per issue, with no colors or padding.
+func formatColumnTSV(buf *strings.Builder, issues []*types.Issue, labelsMap map[string][]string, cols []string) {
+	buf.WriteString(strings.Join(cols, "\t"))
+	buf.WriteString("\n")
+	cells := make([]string, len(cols))
+	for _, issue := range issues {
+		for c, name := range cols {
+			value := listColumns[name].value
+			if v, ok := tsvColumnValues[name]; ok {
+				value = v
+			}
+			cells[c] = tsvEscaper.Replace(value(issue, labelsMap[issue.ID]))
+		}
+		buf.WriteString(strings.Join(cells, "\t"))
+		buf.WriteString("\n")
+	}
+}
+
+// listOutputWidth is the width --columns tables are fitted to: --width when
+// given (0 for no limit), else ui.DetectWidth. Pass --width in scripts so
+// output doesn't depend on the terminal.
+func listOutputWidth(cmd *cobra.Command) int {
+	if cmd.Flags().Changed("width") {
+		w, _ := cmd.Flags().GetInt("width")
+		return w
+	}
+	return ui.DetectWidth()
+}
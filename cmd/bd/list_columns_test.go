@@ -0,0 +1,136 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+func TestParseListColumns(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    []string
+		wantErr bool
+	}{
+		{"id,status,title", []string{"id", "status", "title"}, false},
+		{" ID , Title ", []string{"id", "title"}, false},
+		{"title,id,title", []string{"title", "id"}, false},
+		{"id,bogus", nil, true},
+		{",,", nil, true},
+	}
+	for _, tt := range tests {
+		got, err := parseListColumns(tt.spec)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseListColumns(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			continue
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("parseListColumns(%q) = %v, want %v", tt.spec, got, tt.want)
+		}
+	}
+}
+
+func TestFormatColumnTable(t *testing.T) {
+	issues := []*types.Issue{
+		{ID: "bd-1", Status: types.StatusOpen, Priority: 1, Assignee: "alice", Title: "Short"},
+		{ID: "bd-10", Status: types.StatusInProgress, Priority: 2, Title: "A much longer title that will not fit"},
+	}
+	cols := []string{"id", "assignee", "title"}
+
+	var buf strings.Builder
+	formatColumnTable(&buf, issues, nil, cols, 0)
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header + 2 rows, got %d lines:\n%s", len(lines), buf.String())
+	}
+	// Columns are aligned to the widest value in the result set
+	if !strings.HasPrefix(lines[1], "bd-1   alice     Short") {
+		t.Errorf("row not aligned: %q", lines[1])
+	}
+	if !strings.HasSuffix(lines[2], "A much longer title that will not fit") {
+		t.Errorf("title truncated without a terminal width: %q", lines[2])
+	}
+
+	buf.Reset()
+	formatColumnTable(&buf, issues, nil, cols, 30)
+	lines = strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	for _, line := range lines {
+		if n := len([]rune(line)); n > 30 {
+			t.Errorf("line exceeds terminal width (%d): %q", n, line)
+		}
+	}
+	if !strings.HasSuffix(lines[2], "…") {
+		t.Errorf("expected ellipsis on truncated title: %q", lines[2])
+	}
+}
+
+func TestFormatColumnTableWideTitles(t *testing.T) {
+	issues := []*types.Issue{
+		{ID: "bd-1", Status: types.StatusOpen, Title: "修复登录重定向问题并更新文档"},
+		{ID: "bd-2", Status: types.StatusOpen, Title: "🚀 Launch checklist 🚀🚀🚀🚀"},
+		{ID: "bd-3", Status: types.StatusOpen, Title: "ok"},
+	}
+	cols := []string{"id", "status", "title"}
+
+	var buf strings.Builder
+	formatColumnTable(&buf, issues, nil, cols, 24)
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected header + 3 rows, got %d lines:\n%s", len(lines), buf.String())
+	}
+	for _, line := range lines {
+		if !utf8.ValidString(line) {
+			t.Errorf("line splits a multibyte character: %q", line)
+		}
+		if w := ui.DisplayWidth(line); w > 24 {
+			t.Errorf("line is %d cells wide, want <= 24: %q", w, line)
+		}
+	}
+	for _, line := range lines[1:3] {
+		if !strings.HasSuffix(line, "…") {
+			t.Errorf("expected ellipsis on truncated title: %q", line)
+		}
+	}
+	// Titles start in the same terminal column on every row
+	if !strings.HasPrefix(lines[1], "bd-1  open    修") || !strings.HasPrefix(lines[3], "bd-3  open    ok") {
+		t.Errorf("rows not aligned:\n%s", buf.String())
+	}
+}
+
+func TestFormatColumnTSV(t *testing.T) {
+	updated := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	issues := []*types.Issue{
+		{ID: "bd-1", Status: types.StatusOpen, Priority: 1, Title: "Tab\there", UpdatedAt: updated},
+		{ID: "bd-2", Status: types.StatusClosed, Priority: 2, Title: "Line one\nline two with a \\ backslash", UpdatedAt: updated},
+	}
+	labelsMap := map[string][]string{"bd-1": {"a", "b"}}
+	cols := []string{"id", "priority", "labels", "updated", "title"}
+
+	var buf strings.Builder
+	formatColumnTSV(&buf, issues, labelsMap, cols)
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header + 2 rows, got %d lines:\n%s", len(lines), buf.String())
+	}
+	for _, line := range lines {
+		if n := len(strings.Split(line, "\t")); n != len(cols) {
+			t.Errorf("line has %d fields, want %d: %q", n, len(cols), line)
+		}
+	}
+	if lines[0] != "id\tpriority\tlabels\tupdated\ttitle" {
+		t.Errorf("header = %q", lines[0])
+	}
+	if want := "bd-1\tP1\ta,b\t2025-03-04T05:06:07Z\tTab\\there"; lines[1] != want {
+		t.Errorf("row = %q, want %q", lines[1], want)
+	}
+	if want := `bd-2	P2		2025-03-04T05:06:07Z	Line one\nline two with a \\ backslash`; lines[2] != want {
+		t.Errorf("row = %q, want %q", lines[2], want)
+	}
+	if strings.Contains(buf.String(), "\x1b[") {
+		t.Error("TSV output should have no ANSI styling")
+	}
+}
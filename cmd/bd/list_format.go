@@ -40,6 +40,22 @@ func renderStatusIcon(status types.Status) string {
 	return ui.RenderStatusIcon(string(status))
 }
 
+// seqTag returns " #N" for issues numbered by issue_seq, or "" otherwise.
+func seqTag(issue *types.Issue) string {
+	if issue.Seq <= 0 {
+		return ""
+	}
+	return fmt.Sprintf(" #%d", issue.Seq)
+}
+
+// mutedSeqTag is seqTag rendered muted, for lines that color their parts.
+func mutedSeqTag(issue *types.Issue) string {
+	if tag := seqTag(issue); tag != "" {
+		return ui.RenderMuted(tag)
+	}
+	return ""
+}
+
 // formatPrettyIssue formats a single issue for pretty output
 // Uses semantic colors: status icon colored, priority P0/P1 colored, rest neutral
 func formatPrettyIssue(issue *types.Issue) string {
@@ -62,13 +78,13 @@ func formatPrettyIssue(issue *types.Issue) string {
 	if issue.Status == types.StatusClosed {
 		return fmt.Sprintf("%s %s %s %s%s",
 			statusIcon,
-			ui.RenderMuted(issue.ID),
+			ui.RenderMuted(issue.ID+seqTag(issue)),
 			ui.RenderMuted(fmt.Sprintf("● P%d", issue.Priority)),
 			ui.RenderMuted(string(issue.IssueType)),
 			ui.RenderMuted(" "+issue.Title))
 	}
 
-	return fmt.Sprintf("%s %s %s %s%s", statusIcon, issue.ID, priorityTag, typeBadge, issue.Title)
+	return fmt.Sprintf("%s %s%s %s %s%s", statusIcon, issue.ID, mutedSeqTag(issue), priorityTag, typeBadge, issue.Title)
 }
 
 // formatPrettyIssueWithContext formats an issue with optional parent epic annotation
@@ -84,15 +100,16 @@ func formatPrettyIssueWithContext(issue *types.Issue, parentEpic string) string
 func formatIssueLong(buf *strings.Builder, issue *types.Issue, labels []string) {
 	status := string(issue.Status)
 	if status == "closed" {
-		line := fmt.Sprintf("%s%s [P%d] [%s] %s\n  %s",
-			pinIndicator(issue), issue.ID, issue.Priority,
+		line := fmt.Sprintf("%s%s%s [P%d] [%s] %s\n  %s",
+			pinIndicator(issue), issue.ID, seqTag(issue), issue.Priority,
 			issue.IssueType, status, issue.Title)
 		buf.WriteString(ui.RenderClosedLine(line))
 		buf.WriteString("\n")
 	} else {
-		buf.WriteString(fmt.Sprintf("%s%s [%s] [%s] %s\n",
+		buf.WriteString(fmt.Sprintf("%s%s%s [%s] [%s] %s\n",
 			pinIndicator(issue),
 			ui.RenderID(issue.ID),
+			mutedSeqTag(issue),
 			ui.RenderPriority(issue.Priority),
 			ui.RenderType(string(issue.IssueType)),
 			ui.RenderStatus(status)))
@@ -239,17 +256,18 @@ func formatIssueCompact(buf *strings.Builder, issue *types.Issue, labels []strin
 
 	if issue.Status == types.StatusClosed {
 		// Closed issues: entire line muted (fades visually)
-		line := fmt.Sprintf("%s %s%s [P%d] [%s]%s%s - %s%s",
-			statusIcon, pinIndicator(issue), issue.ID, issue.Priority,
+		line := fmt.Sprintf("%s %s%s%s [P%d] [%s]%s%s - %s%s",
+			statusIcon, pinIndicator(issue), issue.ID, seqTag(issue), issue.Priority,
 			issue.IssueType, assigneeStr, labelsStr, issue.Title, depInfo)
 		buf.WriteString(ui.RenderClosedLine(line))
 		buf.WriteString("\n")
 	} else {
 		// Active issues: status icon + semantic colors for priority/type
-		buf.WriteString(fmt.Sprintf("%s %s%s [%s] [%s]%s%s - %s%s\n",
+		buf.WriteString(fmt.Sprintf("%s %s%s%s [%s] [%s]%s%s - %s%s\n",
 			statusIcon,
 			pinIndicator(issue),
 			ui.RenderID(issue.ID),
+			mutedSeqTag(issue),
 			ui.RenderPriority(issue.Priority),
 			ui.RenderType(string(issue.IssueType)),
 			assigneeStr, labelsStr, issue.Title, depInfo))
@@ -186,10 +186,47 @@ func TestListSortIssues_ClosedNilLast(t *testing.T) {
 	open := &types.Issue{ID: "bd-3", ClosedAt: nil}
 
 	issues := []*types.Issue{open, closedOld, closedNew}
-	sortIssues(issues, "closed", false)
+	sortIssues(issues, []types.SortKey{{Field: "closed", Desc: true}})
 	if issues[0].ID != "bd-2" || issues[1].ID != "bd-1" || issues[2].ID != "bd-3" {
 		t.Fatalf("unexpected order: %s, %s, %s", issues[0].ID, issues[1].ID, issues[2].ID)
 	}
+
+	// Unset closed_at stays last when ascending too
+	sortIssues(issues, []types.SortKey{{Field: "closed"}})
+	if issues[0].ID != "bd-1" || issues[1].ID != "bd-2" || issues[2].ID != "bd-3" {
+		t.Fatalf("unexpected ascending order: %s, %s, %s", issues[0].ID, issues[1].ID, issues[2].ID)
+	}
+}
+
+func TestListSortIssues_MultipleKeys(t *testing.T) {
+	now := time.Now()
+	issues := []*types.Issue{
+		{ID: "bd-1", Priority: 2, Assignee: "", UpdatedAt: now},
+		{ID: "bd-2", Priority: 1, Assignee: "bob", UpdatedAt: now.Add(-time.Hour)},
+		{ID: "bd-3", Priority: 1, Assignee: "alice", UpdatedAt: now},
+		{ID: "bd-4", Priority: 2, Assignee: "alice", UpdatedAt: now},
+		{ID: "bd-5", Priority: 1, Assignee: "bob", UpdatedAt: now.Add(-time.Hour)},
+	}
+
+	sortIssues(issues, []types.SortKey{{Field: "priority"}, {Field: "updated", Desc: true}})
+	if got := sortedIDs(issues); got != "bd-3 bd-2 bd-5 bd-1 bd-4" {
+		t.Errorf("priority:asc,updated:desc order = %s", got)
+	}
+
+	// Unassigned sorts last in either direction
+	sortIssues(issues, []types.SortKey{{Field: "assignee", Desc: true}})
+	if got := sortedIDs(issues); got != "bd-2 bd-5 bd-3 bd-4 bd-1" {
+		t.Errorf("assignee:desc order = %s", got)
+	}
+}
+
+// sortedIDs joins issue IDs in slice order.
+func sortedIDs(issues []*types.Issue) string {
+	ids := make([]string, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.ID
+	}
+	return strings.Join(ids, " ")
 }
 
 func TestListDisplayPrettyList(t *testing.T) {
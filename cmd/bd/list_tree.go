@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/types"
 )
 
@@ -62,13 +63,12 @@ func buildIssueTreeWithDeps(issues []*types.Issue, allDeps map[string][]*types.D
 	}
 
 	// Fallback: check for hierarchical subtask IDs (e.g., "parent.1")
+	h := config.GetIDHierarchy()
 	for _, issue := range issues {
 		if isChild[issue.ID] {
 			continue // Already a child via dependency
 		}
-		if strings.Contains(issue.ID, ".") {
-			parts := strings.Split(issue.ID, ".")
-			parentID := strings.Join(parts[:len(parts)-1], ".")
+		if _, parentID, depth := h.Parse(issue.ID); depth > 0 {
 			if _, exists := issueMap[parentID]; exists {
 				childrenMap[parentID] = append(childrenMap[parentID], issue)
 				isChild[issue.ID] = true
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// logEntryJSON is the --json shape of a commit in bd log.
+type logEntryJSON struct {
+	Hash    string    `json:"hash"`
+	Author  string    `json:"author"`
+	Email   string    `json:"email"`
+	Date    time.Time `json:"date"`
+	Message string    `json:"message"`
+}
+
+var logCmd = &cobra.Command{
+	Use:     "log",
+	GroupID: "views",
+	Short:   "Show Dolt commit history, optionally filtered by message",
+	Long: `Show the Dolt commit history of the issue database, newest first.
+
+--grep filters commits by a regular expression (Go syntax) matched against
+the full commit message. Combine with 'bd issues-in-commit <hash>' to see
+which issues a commit touched.
+
+Examples:
+  bd log                         # Last 20 commits
+  bd log --grep 'bd: archive'    # Commits made by bd archive
+  bd log --grep '(?i)sync' -n 0  # Every commit mentioning sync, any case`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		pattern, _ := cmd.Flags().GetString("grep")
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		var re *regexp.Regexp
+		if pattern != "" {
+			var err error
+			re, err = regexp.Compile(pattern)
+			if err != nil {
+				FatalErrorRespectJSON("invalid --grep pattern %q: %v", pattern, err)
+			}
+		}
+
+		commits, err := store.LogMatching(rootCtx, re, limit)
+		if err != nil {
+			FatalErrorRespectJSON("failed to read log: %v", err)
+		}
+
+		if jsonOutput {
+			out := make([]logEntryJSON, 0, len(commits))
+			for _, c := range commits {
+				out = append(out, logEntryJSON{Hash: c.Hash, Author: c.Author, Email: c.Email, Date: c.Date, Message: c.Message})
+			}
+			outputJSON(out)
+			return
+		}
+
+		if len(commits) == 0 {
+			if pattern != "" {
+				fmt.Printf("No commits match %q\n", pattern)
+			} else {
+				fmt.Println("No commits")
+			}
+			return
+		}
+		for _, c := range commits {
+			subject, _, _ := strings.Cut(c.Message, "\n")
+			fmt.Printf("%s %s %s  %s\n",
+				ui.RenderAccent(shortHash(c.Hash)),
+				ui.RenderMuted(c.Date.Format("2006-01-02 15:04")),
+				ui.RenderMuted(c.Author),
+				subject)
+		}
+	},
+}
+
+var issuesInCommitCmd = &cobra.Command{
+	Use:     "issues-in-commit <hash>",
+	GroupID: "views",
+	Short:   "List the issues a Dolt commit changed",
+	Long: `List the issues added, modified or removed by a single Dolt commit.
+
+The commit may be a full hash, an unambiguous hash prefix, or a ref such as
+HEAD or HEAD~3. Uses the dolt_diff_issues system table, so only changes to
+the issues table itself are reported.
+
+Examples:
+  bd issues-in-commit HEAD       # What did the last commit change?
+  bd issues-in-commit 8f3k2a1    # Look up a commit from 'bd log'`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := rootCtx
+
+		hash, err := store.ResolveCommit(ctx, args[0])
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		entries, err := store.IssuesInCommit(ctx, hash)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"commit":  hash,
+				"changes": entries,
+			})
+			return
+		}
+
+		if len(entries) == 0 {
+			fmt.Printf("Commit %s changed no issues\n", shortHash(hash))
+			return
+		}
+		fmt.Printf("\n%s Commit %s changed %d issue(s)\n\n", ui.RenderAccent("📊"), ui.RenderMuted(shortHash(hash)), len(entries))
+		for _, entry := range entries {
+			switch entry.DiffType {
+			case "added":
+				title := ""
+				if entry.NewValue != nil {
+					title = entry.NewValue.Title
+				}
+				fmt.Printf("  %s %s: %s\n", ui.RenderPass("+"), entry.IssueID, title)
+			case "removed":
+				title := ""
+				if entry.OldValue != nil {
+					title = entry.OldValue.Title
+				}
+				fmt.Printf("  %s %s: %s\n", ui.RenderFail("-"), ui.RenderMuted(entry.IssueID), ui.RenderMuted(title))
+			default:
+				title := ""
+				if entry.NewValue != nil {
+					title = entry.NewValue.Title
+				}
+				fmt.Printf("  %s %s: %s\n", ui.RenderWarn("~"), entry.IssueID, title)
+			}
+		}
+		fmt.Println()
+	},
+}
+
+// shortHash abbreviates a commit hash for display.
+func shortHash(hash string) string {
+	if len(hash) > 8 {
+		return hash[:8]
+	}
+	return hash
+}
+
+func init() {
+	logCmd.Flags().String("grep", "", "Only show commits whose message matches this regular expression")
+	logCmd.Flags().IntP("limit", "n", 20, "Maximum number of commits to show (0 = all)")
+	rootCmd.AddCommand(logCmd)
+	rootCmd.AddCommand(issuesInCommitCmd)
+}
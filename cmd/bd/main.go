@@ -26,6 +26,7 @@ import (
 	"github.com/steveyegge/beads/internal/molecules"
 	"github.com/steveyegge/beads/internal/storage/dolt"
 	"github.com/steveyegge/beads/internal/telemetry"
+	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/utils"
 	"go.opentelemetry.io/otel/attribute"
 	oteltrace "go.opentelemetry.io/otel/trace"
@@ -64,6 +65,9 @@ var (
 	verboseFlag     bool // Enable verbose/debug output
 	quietFlag       bool // Suppress non-essential output
 
+	// Skip unknown keys when validating config files at startup
+	ignoreUnknownConfig bool
+
 	// Dolt auto-commit policy (flag/config). Values: off | on
 	doltAutoCommit string
 
@@ -77,6 +81,10 @@ var (
 	// This prevents a redundant auto-commit attempt in PersistentPostRun.
 	commandDidExplicitDoltCommit bool
 
+	// commandTxActive is set when PersistentPreRun opened a command transaction,
+	// so the command's writes are committed to Dolt once in PersistentPostRun.
+	commandTxActive bool
+
 	// commandDidWriteTipMetadata is set when a command records a tip as "shown" by writing
 	// metadata (tip_*_last_shown). This will be used to create a separate Dolt commit for
 	// tip writes, even when the main command is read-only.
@@ -94,19 +102,35 @@ var (
 // readOnlyCommands lists commands that only read from the database.
 // These commands open the store in read-only mode. See GH#804.
 var readOnlyCommands = map[string]bool{
-	"list":       true,
-	"ready":      true,
-	"show":       true,
-	"stats":      true,
-	"blocked":    true,
-	"count":      true,
-	"search":     true,
-	"graph":      true,
-	"duplicates": true,
-	"comments":   true, // list comments (not add)
-	"current":    true, // bd sync mode current
-	"backup":     true, // reads from Dolt, writes only to .beads/backup/
-	"export":     true, // reads from Dolt, writes JSONL to file/stdout
+	"list":             true,
+	"ready":            true,
+	"show":             true,
+	"stats":            true,
+	"blocked":          true,
+	"count":            true,
+	"search":           true,
+	"graph":            true,
+	"duplicates":       true,
+	"comments":         true, // list comments (not add)
+	"current":          true, // bd sync mode current
+	"backup":           true, // reads from Dolt, writes only to .beads/backup/
+	"export":           true, // reads from Dolt, writes JSONL to file/stdout
+	"log":              true,
+	"issues-in-commit": true,
+	"metrics":          true,
+	"serve":            true, // read-only HTTP API
+	"schema":           true,
+	"exists":           true,
+}
+
+// lightweightCommands are read-only commands used in shell prompts, status
+// bars and monitoring scrapes. They skip startup work that only matters when
+// showing or changing issue content: molecule template loading and the
+// multiple-database warning.
+var lightweightCommands = map[string]bool{
+	"count":   true,
+	"exists":  true,
+	"metrics": true,
 }
 
 // isReadOnlyCommand returns true if the command only reads from the database.
@@ -187,7 +211,11 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&doltAutoCommit, "dolt-auto-commit", "", "Dolt auto-commit policy (off|on|batch). 'on': commit after each write. 'batch': defer commits to bd dolt commit; uncommitted changes persist in the working set until then. SIGTERM/SIGHUP flush pending batch commits. Default: off. Override via config key dolt.auto-commit")
 	rootCmd.PersistentFlags().BoolVar(&profileEnabled, "profile", false, "Generate CPU profile for performance analysis")
 	rootCmd.PersistentFlags().BoolVarP(&verboseFlag, "verbose", "v", false, "Enable verbose/debug output")
-	rootCmd.PersistentFlags().BoolVarP(&quietFlag, "quiet", "q", false, "Suppress non-essential output (errors only)")
+	rootCmd.PersistentFlags().BoolVarP(&quietFlag, "quiet", "q", false, "Suppress progress notices and warnings (errors and requested output, including --json, still print)")
+	rootCmd.PersistentFlags().BoolVar(&ignoreUnknownConfig, "ignore-unknown-config", false, "Don't fail on unknown keys in config.yaml files (type errors are still reported)")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Show what update, close, reopen, assign, unassign or move would change without changing anything (commands that cannot preview refuse to run)")
+	rootCmd.PersistentFlags().DurationVar(&commandTimeoutFlag, "timeout", 0, "Cancel the command after this long (e.g. 30s, 10m; 0 disables). Overrides timeouts.<command> for push, pull, fetch, gc, export and import")
+	rootCmd.PersistentFlags().BoolVar(&absoluteTimes, "absolute", false, "Show full RFC3339 timestamps instead of relative times (e.g. \"2h ago\")")
 
 	// Add --version flag to root command (same behavior as version subcommand)
 	rootCmd.Flags().BoolP("version", "V", false, "Print version information")
@@ -229,6 +257,7 @@ var rootCmd = &cobra.Command{
 		// Reset per-command write tracking (used by Dolt auto-commit).
 		commandDidWrite.Store(false)
 		commandDidExplicitDoltCommit = false
+		commandTxActive = false
 		commandDidWriteTipMetadata = false
 		commandTipIDsShown = make(map[string]struct{})
 
@@ -262,6 +291,24 @@ var rootCmd = &cobra.Command{
 			FatalError("%v", err)
 		}
 
+		// Fail on config typos instead of silently ignoring them.
+		checkConfigFiles(cmd)
+
+		// Refuse --dry-run on commands that would write instead of previewing.
+		if err := checkDryRunSupported(cmd); err != nil {
+			FatalError("%v", err)
+		}
+
+		// Bound commands that can hang on a slow remote or disk (push, gc,
+		// export, ...) by timeouts.<name> or --timeout.
+		var timeoutCancel context.CancelFunc
+		rootCtx, timeoutCancel = withCommandTimeout(rootCtx, cmd)
+		signalCancel := rootCancel
+		rootCancel = func() {
+			timeoutCancel()
+			signalCancel()
+		}
+
 		// Apply viper configuration if flags weren't explicitly set
 		// Priority: flags > viper (config file + env vars) > defaults
 		// Do this BEFORE early-return so init/version/help respect config
@@ -327,6 +374,12 @@ var rootCmd = &cobra.Command{
 			FatalError("%v", err)
 		}
 
+		// Reject an invalid hierarchy.separator up front rather than
+		// silently generating child IDs with the default one.
+		if _, err := types.NewIDHierarchy(config.GetString("hierarchy.separator")); err != nil {
+			FatalError("hierarchy.separator: %v", err)
+		}
+
 		// GH#1093: Check noDbCommands BEFORE expensive operations
 		// to avoid spawning git subprocesses for simple commands
 		// like "bd version" that don't need database access.
@@ -510,6 +563,7 @@ var rootCmd = &cobra.Command{
 			doltCfg.ServerTLS = cfg.GetDoltServerTLS()
 		}
 		doltCfg.SyncGitRemote = config.GetString("sync.git-remote")
+		doltCfg.Remote = config.GetString("default.remote")
 
 		// Auto-start: enabled by default.
 		// Can be disabled by explicit config or env var.
@@ -566,15 +620,20 @@ var rootCmd = &cobra.Command{
 		if dbPath != "" {
 			beadsDir := filepath.Dir(dbPath)
 			hookRunner = hooks.NewRunner(filepath.Join(beadsDir, "hooks"))
+			configureWebhooks(hookRunner)
 		}
 
+		lightweight := lightweightCommands[cmd.Name()]
+
 		// Warn if multiple databases detected in directory hierarchy
-		warnMultipleDatabases(dbPath)
+		if !lightweight {
+			warnMultipleDatabases(dbPath)
+		}
 
 		// Load molecule templates from hierarchical catalog locations
 		// Templates are loaded after auto-import to ensure the database is up-to-date.
 		// Skip for import command to avoid conflicts during import operations.
-		if cmd.Name() != "import" && store != nil {
+		if cmd.Name() != "import" && !lightweight && store != nil {
 			beadsDir := filepath.Dir(dbPath)
 			loader := molecules.NewLoader(store)
 			if result, err := loader.LoadAll(rootCtx, beadsDir); err != nil {
@@ -584,6 +643,11 @@ var rootCmd = &cobra.Command{
 			}
 		}
 
+		// Open the command transaction last, so startup writes keep their own commits.
+		if !useReadOnly {
+			beginCommandTx(cmd)
+		}
+
 		// Sync all state to CommandContext for unified access.
 		syncCommandContext()
 
@@ -591,10 +655,15 @@ var rootCmd = &cobra.Command{
 		// after successful command execution, not in PreRun
 	},
 	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+		// Command transaction: one Dolt commit for every write the command made.
+		if err := commitCommandTx(cmd.Name()); err != nil {
+			FatalError("dolt commit failed: %v", err)
+		}
+
 		// Dolt auto-commit: after a successful write command (and after final flush),
 		// create a Dolt commit so changes don't remain only in the working set.
 		if commandDidWrite.Load() && !commandDidExplicitDoltCommit {
-			if err := maybeAutoCommit(rootCtx, doltAutoCommitParams{Command: cmd.Name()}); err != nil {
+			if err := maybeAutoCommit(rootCtx, doltAutoCommitParams{Command: cmd.Name(), MessageOverride: customCommitMessage()}); err != nil {
 				FatalError("dolt auto-commit failed: %v", err)
 			}
 		}
@@ -636,6 +705,10 @@ var rootCmd = &cobra.Command{
 			maybeAutoPush(rootCtx)
 		}
 
+		// Give in-flight webhook deliveries a short grace period. Placed after
+		// backup and push so deliveries overlap with that work.
+		flushWebhooks()
+
 		// Signal that store is closing (prevents background flush from accessing closed store)
 		storeMutex.Lock()
 		storeActive = false
@@ -686,6 +759,22 @@ func checkBlockedEnvVars() error {
 	return nil
 }
 
+// exitCodeInterrupted is the conventional exit status after SIGINT (128+2).
+const exitCodeInterrupted = 130
+
+var (
+	// shutdownRequested is set once a shutdown signal has been received.
+	shutdownRequested atomic.Bool
+
+	// shutdownGracePeriod bounds how long an interrupted command may keep
+	// running after rootCtx is canceled before bd closes its resources and
+	// exits anyway. Variable so tests can shorten it.
+	shutdownGracePeriod = 10 * time.Second
+
+	// shutdownExit is os.Exit, swappable in tests.
+	shutdownExit = os.Exit
+)
+
 // setupGracefulShutdown creates a context that cancels on SIGINT/SIGTERM/SIGHUP.
 // Before cancellation, it flushes pending batch commits so that accumulated
 // changes in the Dolt working set are not lost on graceful shutdown.
@@ -698,11 +787,7 @@ func setupGracefulShutdown() (context.Context, context.CancelFunc) {
 	go func() {
 		select {
 		case <-sigCh:
-			flushBatchCommitOnShutdown()
-			cancel()
-			// On second signal, force exit
-			<-sigCh
-			os.Exit(1)
+			handleShutdownSignal(sigCh, cancel)
 		case <-ctx.Done():
 			signal.Stop(sigCh)
 		}
@@ -711,6 +796,55 @@ func setupGracefulShutdown() (context.Context, context.CancelFunc) {
 	return ctx, cancel
 }
 
+// handleShutdownSignal runs after the first shutdown signal. Canceling rootCtx
+// makes the in-flight Dolt operation return (SQL transactions roll back; a
+// version commit already under way is finished by the store), after which the
+// command unwinds through PersistentPostRun and closes the store normally.
+// If it hasn't exited within shutdownGracePeriod, or a second signal arrives,
+// resources are closed here and the process exits non-zero.
+func handleShutdownSignal(sigCh <-chan os.Signal, cancel context.CancelFunc) {
+	shutdownRequested.Store(true)
+	flushBatchCommitOnShutdown()
+	cancel()
+
+	select {
+	case <-sigCh:
+	case <-time.After(shutdownGracePeriod):
+		fmt.Fprintf(os.Stderr, "\nWarning: command did not stop within %v of interrupt; exiting\n", shutdownGracePeriod)
+	}
+	rollbackCommandTx()
+	closeResourcesOnShutdown()
+	shutdownExit(exitCodeInterrupted)
+}
+
+// closeResourcesOnShutdown closes the global store (via CloseWithTimeout,
+// which also releases any auto-started server) if PersistentPostRun hasn't
+// already done so.
+func closeResourcesOnShutdown() {
+	storeMutex.Lock()
+	active := storeActive
+	st := store
+	storeActive = false
+	storeMutex.Unlock()
+
+	if active && st != nil {
+		_ = st.Close() // Best effort: each close is bounded by CloseWithTimeout
+	}
+}
+
+// exitAfterFatalError exits with code, first releasing resources if a
+// shutdown signal interrupted the command. Fatal errors skip
+// PersistentPostRun, which would otherwise close the store.
+func exitAfterFatalError(code int) {
+	reportCommandTimeout()
+	rollbackCommandTx()
+	if shutdownRequested.Load() {
+		closeResourcesOnShutdown()
+		code = exitCodeInterrupted
+	}
+	os.Exit(code)
+}
+
 // flushBatchCommitOnShutdown commits any pending batch changes before process exit.
 // This prevents data loss when SIGTERM/SIGHUP kills a process with uncommitted
 // batch writes sitting in the Dolt working set.
@@ -800,7 +934,16 @@ func main() {
 	rootCmd.InitDefaultHelpCmd()
 	registerHelpAllFlag()
 
+	// 'bd <view-name>' runs a saved view (see 'bd view')
+	if args, ok := viewShorthandArgs(os.Args[1:]); ok {
+		viewShorthand = true
+		rootCmd.SetArgs(args)
+	}
+
 	if err := rootCmd.Execute(); err != nil {
-		os.Exit(1)
+		exitAfterFatalError(1)
+	}
+	if shutdownRequested.Load() {
+		os.Exit(exitCodeInterrupted)
 	}
 }
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/utils"
+)
+
+var metaCmd = &cobra.Command{
+	Use:     "meta",
+	GroupID: "issues",
+	Short:   "Manage per-issue key/value metadata",
+	Long: `Store free-form key/value data on issues, such as IDs and URLs from
+external tools. Keys are letters, digits and . _ : / - (starting with a
+letter or digit), so integrations can namespace them ("jira.key",
+"ci:run/url"). Values are arbitrary text.
+
+Metadata is versioned with the issue, included in 'bd export' and restored
+by 'bd import'.
+
+Examples:
+  bd meta set bd-42 jira.key PROJ-1234
+  bd meta get bd-42 jira.key
+  bd meta ls bd-42
+  bd meta rm bd-42 jira.key`,
+}
+
+var metaSetCmd = &cobra.Command{
+	Use:   "set <issue-id> <key> <value>",
+	Short: "Set a metadata value on an issue",
+	Args:  cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("meta set")
+		issueID, key, value := resolveIssueArg(args[0]), args[1], args[2]
+		if err := dolt.ValidateMetaKey(key); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if err := store.SetMeta(rootCtx, issueID, key, value); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		commandDidWrite.Store(true)
+		if jsonOutput {
+			outputJSON(map[string]interface{}{"issue_id": issueID, "key": key, "value": value})
+			return
+		}
+		fmt.Printf("%s Set %s on %s\n", ui.RenderPass("✓"), key, issueID)
+	},
+}
+
+var metaGetCmd = &cobra.Command{
+	Use:   "get <issue-id> <key>",
+	Short: "Print a metadata value",
+	Long: `Print the value of a metadata key. Exits with status 1 when the key is
+not set, so scripts can test for it.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		issueID, key := resolveIssueArg(args[0]), args[1]
+		value, ok, err := store.GetMeta(rootCtx, issueID, key)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if !ok {
+			FatalErrorRespectJSON("%s has no meta key %q", issueID, key)
+		}
+		if jsonOutput {
+			outputJSON(map[string]interface{}{"issue_id": issueID, "key": key, "value": value})
+			return
+		}
+		fmt.Println(value)
+	},
+}
+
+var metaLsCmd = &cobra.Command{
+	Use:     "ls <issue-id>",
+	Aliases: []string{"list"},
+	Short:   "List metadata on an issue",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		issueID := resolveIssueArg(args[0])
+		meta, err := store.ListMeta(rootCtx, issueID)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if jsonOutput {
+			outputJSON(meta)
+			return
+		}
+		if len(meta) == 0 {
+			fmt.Printf("%s has no metadata\n", issueID)
+			return
+		}
+		keys := make([]string, 0, len(meta))
+		width := 0
+		for key := range meta {
+			keys = append(keys, key)
+			width = max(width, len(key))
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Printf("%-*s  %s\n", width, key, meta[key])
+		}
+	},
+}
+
+var metaRmCmd = &cobra.Command{
+	Use:     "rm <issue-id> <key>",
+	Aliases: []string{"unset"},
+	Short:   "Remove a metadata key from an issue",
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("meta rm")
+		issueID, key := resolveIssueArg(args[0]), args[1]
+		if _, ok, err := store.GetMeta(rootCtx, issueID, key); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		} else if !ok {
+			FatalErrorRespectJSON("%s has no meta key %q", issueID, key)
+		}
+		if err := store.DeleteMeta(rootCtx, issueID, key); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		commandDidWrite.Store(true)
+		if jsonOutput {
+			outputJSON(map[string]interface{}{"issue_id": issueID, "deleted": key})
+			return
+		}
+		fmt.Printf("%s Removed %s from %s\n", ui.RenderPass("✓"), key, issueID)
+	},
+}
+
+// resolveIssueArg resolves a possibly partial issue ID, exiting on failure.
+func resolveIssueArg(id string) string {
+	if store == nil {
+		FatalErrorWithHint("database not initialized",
+			"run 'bd doctor' to diagnose, or 'bd init' to create a new database")
+	}
+	fullID, err := utils.ResolvePartialID(rootCtx, store, id)
+	if err != nil {
+		FatalErrorRespectJSON("resolving %s: %v", id, err)
+	}
+	return fullID
+}
+
+func init() {
+	metaCmd.AddCommand(metaSetCmd)
+	metaCmd.AddCommand(metaGetCmd)
+	metaCmd.AddCommand(metaLsCmd)
+	metaCmd.AddCommand(metaRmCmd)
+	rootCmd.AddCommand(metaCmd)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+var metricsCmd = &cobra.Command{
+	Use:     "metrics",
+	GroupID: "views",
+	Short:   "Print issue metrics in Prometheus text format",
+	Long: `Print issue counts in the Prometheus text exposition format, for scraping
+by a node_exporter textfile collector or similar.
+
+The counts come from the same queries as 'bd status'. Metrics:
+  beads_issues_total, beads_issues_open, beads_issues_in_progress,
+  beads_issues_blocked, beads_issues_deferred, beads_issues_closed,
+  beads_issues_ready        Issue counts by state
+  beads_issues_orphans      Child issues whose parent is missing
+  beads_issues_overdue      Issues not closed and past their due date
+  beads_oldest_open_issue_age_seconds
+                            Age of the oldest issue not closed (0 if none)
+
+With --out, the file is written atomically so a collector never reads a
+partial file.
+
+Examples:
+  bd metrics
+  bd metrics --out /var/lib/node_exporter/textfile/beads.prom`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		out, _ := cmd.Flags().GetString("out")
+
+		stats, err := store.GetStatistics(rootCtx)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		health, err := store.GetHealthCounts(rootCtx)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		text := formatPrometheusMetrics(stats, health, time.Now())
+		if out == "" {
+			fmt.Print(text)
+			return
+		}
+		if err := atomicWriteFile(out, []byte(text)); err != nil {
+			FatalErrorRespectJSON("writing %s: %v", out, err)
+		}
+	},
+}
+
+// formatPrometheusMetrics renders stats and health as gauges in the
+// Prometheus text exposition format.
+func formatPrometheusMetrics(stats *types.Statistics, health *types.HealthCounts, now time.Time) string {
+	var oldestAge float64
+	if health.OldestOpen != nil {
+		oldestAge = max(now.Sub(*health.OldestOpen).Seconds(), 0)
+	}
+
+	var sb strings.Builder
+	gauge := func(name, help string, value float64) {
+		fmt.Fprintf(&sb, "# HELP %s %s\n# TYPE %s gauge\n%s %s\n", name, help, name, name, strconv.FormatFloat(value, 'f', -1, 64))
+	}
+	gauge("beads_issues_total", "Issues in the database.", float64(stats.TotalIssues))
+	gauge("beads_issues_open", "Issues with status open.", float64(stats.OpenIssues))
+	gauge("beads_issues_in_progress", "Issues with status in_progress.", float64(stats.InProgressIssues))
+	gauge("beads_issues_blocked", "Issues blocked by an open dependency.", float64(stats.BlockedIssues))
+	gauge("beads_issues_deferred", "Issues with status deferred.", float64(stats.DeferredIssues))
+	gauge("beads_issues_closed", "Issues with status closed.", float64(stats.ClosedIssues))
+	gauge("beads_issues_ready", "Open issues with no open blockers.", float64(stats.ReadyIssues))
+	gauge("beads_issues_orphans", "Child issues whose parent issue is missing.", float64(health.Orphans))
+	gauge("beads_issues_overdue", "Issues not closed and past their due date.", float64(health.Overdue))
+	gauge("beads_oldest_open_issue_age_seconds", "Age of the oldest issue that is not closed.", oldestAge)
+	return sb.String()
+}
+
+func init() {
+	metricsCmd.Flags().String("out", "", "Write metrics to this file atomically instead of stdout")
+	rootCmd.AddCommand(metricsCmd)
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestFormatPrometheusMetrics(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	oldest := now.Add(-90 * time.Minute)
+	stats := &types.Statistics{TotalIssues: 7, OpenIssues: 3, ClosedIssues: 4, BlockedIssues: 1, ReadyIssues: 2}
+	health := &types.HealthCounts{Orphans: 1, Overdue: 2, OldestOpen: &oldest}
+
+	out := formatPrometheusMetrics(stats, health, now)
+	for _, want := range []string{
+		"# TYPE beads_issues_open gauge\nbeads_issues_open 3\n",
+		"beads_issues_total 7\n",
+		"beads_issues_closed 4\n",
+		"beads_issues_blocked 1\n",
+		"beads_issues_orphans 1\n",
+		"beads_issues_overdue 2\n",
+		"beads_oldest_open_issue_age_seconds 5400\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	out = formatPrometheusMetrics(&types.Statistics{}, &types.HealthCounts{}, now)
+	if !strings.Contains(out, "beads_oldest_open_issue_age_seconds 0\n") {
+		t.Errorf("expected zero age with no open issues:\n%s", out)
+	}
+}
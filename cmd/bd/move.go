@@ -37,7 +37,8 @@ Note: Labels are copied. Comments and event history are not transferred.
 Examples:
   bd move hq-c21fj --to beads     # Move to beads by rig name
   bd move hq-q3tki --to gt-       # Move to gastown by prefix
-  bd move hq-1h2to --to gt        # Move to gastown (prefix without hyphen)`,
+  bd move hq-1h2to --to gt        # Move to gastown (prefix without hyphen)
+  bd move hq-c21fj --to beads --plan   # Write a reviewable plan; run with bd apply`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		CheckReadonly("move")
@@ -77,98 +78,35 @@ Examples:
 			fmt.Fprintf(os.Stderr, "%s Source issue %s is ephemeral (wisp). Moving ephemeral issues may not be appropriate.\n", ui.RenderWarn("⚠"), resolvedSourceID)
 		}
 
-		// Step 2: Find the town-level beads directory
-		townBeadsDir, err := findTownBeadsDir()
-		if err != nil {
-			FatalError("cannot move: %v", err)
-		}
-
-		// Step 3: Resolve the target rig's beads directory
-		targetBeadsDir, targetPrefix, err := routing.ResolveBeadsDirForRig(targetRig, townBeadsDir)
+		targetBeadsDir, err := resolveMoveTarget(resolvedSourceID, targetRig)
 		if err != nil {
 			FatalError("%v", err)
 		}
 
-		// Check we're not moving to the same rig
-		sourcePrefix := routing.ExtractPrefix(resolvedSourceID)
-		if sourcePrefix == targetPrefix {
-			FatalError("source issue %s is already in rig %q", resolvedSourceID, targetRig)
+		if dryRun {
+			printDryRun(dryRunMove(ctx, sourceStore, sourceIssue, targetRig, keepOpen, skipDeps))
+			return
 		}
 
-		// Step 4: Open storage for the target rig
-		// Use factory to respect backend configuration (bd-m2jr: SQLite fallback fix)
-		targetStore, err := dolt.NewFromConfig(ctx, targetBeadsDir)
-		if err != nil {
-			FatalError("failed to open target rig database: %v", err)
-		}
-		defer func() {
-			if err := targetStore.Close(); err != nil {
-				fmt.Fprintf(os.Stderr, "warning: failed to close target rig database: %v\n", err)
-			}
-		}()
-
-		// Step 5: Create the new issue in target rig (copy all fields)
-		newIssue := &types.Issue{
-			// Don't copy ID - let target rig generate new one
-			Title:              sourceIssue.Title,
-			Description:        sourceIssue.Description,
-			Design:             sourceIssue.Design,
-			AcceptanceCriteria: sourceIssue.AcceptanceCriteria,
-			Notes:              sourceIssue.Notes,
-			Status:             types.StatusOpen, // Always start as open
-			Priority:           sourceIssue.Priority,
-			IssueType:          sourceIssue.IssueType,
-			Assignee:           sourceIssue.Assignee,
-			ExternalRef:        sourceIssue.ExternalRef,
-			EstimatedMinutes:   sourceIssue.EstimatedMinutes,
-			SourceRepo:         sourceIssue.SourceRepo,
-			Ephemeral:          sourceIssue.Ephemeral,
-			MolType:            sourceIssue.MolType,
-			RoleType:           sourceIssue.RoleType,
-			Rig:                sourceIssue.Rig,
-			DueAt:              sourceIssue.DueAt,
-			DeferUntil:         sourceIssue.DeferUntil,
-			CreatedBy:          actor,
-		}
-
-		// Append moved note to description
-		if newIssue.Description != "" {
-			newIssue.Description += "\n\n"
-		}
-		newIssue.Description += fmt.Sprintf("(Moved from %s)", resolvedSourceID)
-
-		if err := targetStore.CreateIssue(ctx, newIssue, actor); err != nil {
-			FatalError("failed to create issue in target rig: %v", err)
-		}
-
-		newID := newIssue.ID
-
-		// Step 6: Copy labels if any
-		labels, err := sourceStore.GetLabels(ctx, resolvedSourceID)
-		if err == nil && len(labels) > 0 {
-			for _, label := range labels {
-				if err := targetStore.AddLabel(ctx, newID, label, actor); err != nil {
-					WarnError("failed to copy label %s: %v", label, err)
-				}
+		// Two-phase apply: record the move for review instead of executing it
+		if planMode, _ := cmd.Flags().GetBool("plan"); planMode {
+			if result.Routed {
+				FatalErrorRespectJSON("--plan does not support cross-rig source IDs: %s", resolvedSourceID)
 			}
-		}
-
-		// Step 7: Remap dependencies in the source store
-		// targetRig is used to create external references for cross-rig moves
-		var depsRemapped int
-		if !skipDeps {
-			depsRemapped, err = remapDependencies(ctx, sourceStore, resolvedSourceID, newID, targetRig, actor)
+			p, err := newOpPlan(planOpMove, []*types.Issue{sourceIssue})
 			if err != nil {
-				WarnError("failed to remap some dependencies: %v", err)
+				FatalErrorRespectJSON("%v", err)
 			}
+			p.Target = targetRig
+			p.KeepOpen = keepOpen
+			p.SkipDeps = skipDeps
+			emitPlan(p, fmt.Sprintf("move %s to rig %q", resolvedSourceID, targetRig))
+			return
 		}
 
-		// Step 8: Close the source issue (unless --keep-open)
-		if !keepOpen {
-			closeReason := fmt.Sprintf("Moved to %s", newID)
-			if err := sourceStore.CloseIssue(ctx, resolvedSourceID, closeReason, actor, ""); err != nil {
-				WarnError("failed to close source issue: %v", err)
-			}
+		newID, depsRemapped, err := moveIssue(ctx, sourceStore, sourceIssue, targetBeadsDir, targetRig, keepOpen, skipDeps)
+		if err != nil {
+			FatalError("%v", err)
 		}
 
 		// Output
@@ -191,6 +129,113 @@ Examples:
 	},
 }
 
+// resolveMoveTarget returns the beads directory of the rig an issue is being
+// moved to, refusing a move into the rig the issue is already in.
+func resolveMoveTarget(sourceID, targetRig string) (string, error) {
+	// Find the town-level beads directory
+	townBeadsDir, err := findTownBeadsDir()
+	if err != nil {
+		return "", fmt.Errorf("cannot move: %w", err)
+	}
+
+	// Resolve the target rig's beads directory
+	targetBeadsDir, targetPrefix, err := routing.ResolveBeadsDirForRig(targetRig, townBeadsDir)
+	if err != nil {
+		return "", err
+	}
+
+	// Check we're not moving to the same rig
+	if routing.ExtractPrefix(sourceID) == targetPrefix {
+		return "", fmt.Errorf("source issue %s is already in rig %q", sourceID, targetRig)
+	}
+	return targetBeadsDir, nil
+}
+
+// moveIssue copies sourceIssue into the target rig, remaps dependencies in
+// sourceStore and (unless keepOpen) closes the source. It returns the new ID
+// and the number of dependencies remapped. Failures after the copy exists are
+// reported as warnings, since the move has already happened.
+func moveIssue(ctx context.Context, sourceStore *dolt.DoltStore, sourceIssue *types.Issue, targetBeadsDir, targetRig string, keepOpen, skipDeps bool) (string, int, error) {
+	sourceID := sourceIssue.ID
+
+	// Open storage for the target rig
+	// Use factory to respect backend configuration (bd-m2jr: SQLite fallback fix)
+	targetStore, err := dolt.NewFromConfig(ctx, targetBeadsDir)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to open target rig database: %w", err)
+	}
+	defer func() {
+		if err := targetStore.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: failed to close target rig database: %v\n", err)
+		}
+	}()
+
+	// Create the new issue in target rig (copy all fields)
+	newIssue := &types.Issue{
+		// Don't copy ID - let target rig generate new one
+		Title:              sourceIssue.Title,
+		Description:        sourceIssue.Description,
+		Design:             sourceIssue.Design,
+		AcceptanceCriteria: sourceIssue.AcceptanceCriteria,
+		Notes:              sourceIssue.Notes,
+		Status:             types.StatusOpen, // Always start as open
+		Priority:           sourceIssue.Priority,
+		IssueType:          sourceIssue.IssueType,
+		Assignee:           sourceIssue.Assignee,
+		ExternalRef:        sourceIssue.ExternalRef,
+		EstimatedMinutes:   sourceIssue.EstimatedMinutes,
+		SourceRepo:         sourceIssue.SourceRepo,
+		Ephemeral:          sourceIssue.Ephemeral,
+		MolType:            sourceIssue.MolType,
+		RoleType:           sourceIssue.RoleType,
+		Rig:                sourceIssue.Rig,
+		DueAt:              sourceIssue.DueAt,
+		DeferUntil:         sourceIssue.DeferUntil,
+		CreatedBy:          actor,
+	}
+
+	// Append moved note to description
+	if newIssue.Description != "" {
+		newIssue.Description += "\n\n"
+	}
+	newIssue.Description += fmt.Sprintf("(Moved from %s)", sourceID)
+
+	if err := targetStore.CreateIssue(ctx, newIssue, actor); err != nil {
+		return "", 0, fmt.Errorf("failed to create issue in target rig: %w", err)
+	}
+
+	newID := newIssue.ID
+
+	// Copy labels if any
+	labels, err := sourceStore.GetLabels(ctx, sourceID)
+	if err == nil && len(labels) > 0 {
+		for _, label := range labels {
+			if err := targetStore.AddLabel(ctx, newID, label, actor); err != nil {
+				WarnError("failed to copy label %s: %v", label, err)
+			}
+		}
+	}
+
+	// Remap dependencies in the source store
+	// targetRig is used to create external references for cross-rig moves
+	var depsRemapped int
+	if !skipDeps {
+		depsRemapped, err = remapDependencies(ctx, sourceStore, sourceID, newID, targetRig, actor)
+		if err != nil {
+			WarnError("failed to remap some dependencies: %v", err)
+		}
+	}
+
+	// Close the source issue (unless --keep-open)
+	if !keepOpen {
+		closeReason := fmt.Sprintf("Moved to %s", newID)
+		if err := sourceStore.CloseIssue(ctx, sourceID, closeReason, actor, ""); err != nil {
+			WarnError("failed to close source issue: %v", err)
+		}
+	}
+	return newID, depsRemapped, nil
+}
+
 // remapDependencies updates all dependencies in the store that reference oldID to use newID.
 // For cross-rig moves (which is the only supported case), dependencies TO the old ID are
 // converted to external references. Dependencies FROM the old ID are removed since they
@@ -270,6 +315,7 @@ func init() {
 	moveCmd.Flags().String("to", "", "Target rig or prefix (required)")
 	moveCmd.Flags().Bool("keep-open", false, "Keep the source issue open (don't close it)")
 	moveCmd.Flags().Bool("skip-deps", false, "Skip dependency remapping")
+	moveCmd.Flags().Bool("plan", false, "Write a plan for review instead of moving (execute with bd apply <token>)")
 	moveCmd.ValidArgsFunction = issueIDCompletion
 	rootCmd.AddCommand(moveCmd)
 }
@@ -0,0 +1,440 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// Two-phase apply for risky bulk operations.
+//
+// A command run with --plan records what it would do in a plan file under
+// .beads/plans/ and prints a token instead of mutating anything. Reviewers
+// can inspect the plan (bd apply --show <token>), then `bd apply <token>`
+// re-validates it against the current database and executes it, in a single
+// transaction where the operation stays within one database.
+
+const (
+	planOpClose = "close"
+	planOpPurge = "purge"
+	planOpMove  = "move"
+
+	// planTTL is how long a plan stays applicable after it is written.
+	planTTL = 24 * time.Hour
+
+	planDirName = "plans"
+)
+
+var planTokenPattern = regexp.MustCompile(`^[0-9a-f]{12}$`)
+
+// planIssue is the snapshot of an issue taken when the plan was written.
+// Apply refuses to run if the issue has changed since.
+type planIssue struct {
+	ID        string       `json:"id"`
+	Title     string       `json:"title"`
+	Status    types.Status `json:"status"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+// opPlan is a recorded bulk operation awaiting `bd apply`.
+type opPlan struct {
+	Token     string      `json:"token"`
+	Operation string      `json:"operation"`
+	Actor     string      `json:"actor"`
+	CreatedAt time.Time   `json:"created_at"`
+	ExpiresAt time.Time   `json:"expires_at"`
+	Reason    string      `json:"reason,omitempty"`    // close
+	Force     bool        `json:"force,omitempty"`     // close
+	Target    string      `json:"target,omitempty"`    // move
+	KeepOpen  bool        `json:"keep_open,omitempty"` // move
+	SkipDeps  bool        `json:"skip_deps,omitempty"` // move
+	Issues    []planIssue `json:"issues"`
+}
+
+func (p *opPlan) issueIDs() []string {
+	ids := make([]string, len(p.Issues))
+	for i, pi := range p.Issues {
+		ids[i] = pi.ID
+	}
+	return ids
+}
+
+// planDir returns the directory holding plan files for the current workspace.
+func planDir() (string, error) {
+	beadsDir := beads.FindBeadsDir()
+	if beadsDir == "" {
+		return "", fmt.Errorf("no .beads directory found")
+	}
+	return filepath.Join(beadsDir, planDirName), nil
+}
+
+func newPlanToken() (string, error) {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating plan token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// newOpPlan snapshots the given issues into a plan for operation.
+func newOpPlan(operation string, issues []*types.Issue) (*opPlan, error) {
+	token, err := newPlanToken()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	p := &opPlan{
+		Token:     token,
+		Operation: operation,
+		Actor:     actor,
+		CreatedAt: now,
+		ExpiresAt: now.Add(planTTL),
+	}
+	for _, issue := range issues {
+		p.Issues = append(p.Issues, planIssue{
+			ID:        issue.ID,
+			Title:     issue.Title,
+			Status:    issue.Status,
+			UpdatedAt: issue.UpdatedAt.UTC(),
+		})
+	}
+	sort.Slice(p.Issues, func(i, j int) bool { return p.Issues[i].ID < p.Issues[j].ID })
+	return p, nil
+}
+
+// writeOpPlan persists a plan and prunes expired plans in the same directory.
+func writeOpPlan(dir string, p *opPlan) (string, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return "", fmt.Errorf("creating plan directory: %w", err)
+	}
+	pruneExpiredPlans(dir, time.Now())
+
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("encoding plan: %w", err)
+	}
+	path := filepath.Join(dir, p.Token+".json")
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return "", fmt.Errorf("writing plan: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return "", fmt.Errorf("writing plan: %w", err)
+	}
+	return path, nil
+}
+
+// loadOpPlan reads a plan by token. Expired plans are removed and rejected.
+func loadOpPlan(dir, token string) (*opPlan, error) {
+	if !planTokenPattern.MatchString(token) {
+		return nil, fmt.Errorf("invalid plan token %q", token)
+	}
+	path := filepath.Join(dir, token+".json")
+	data, err := os.ReadFile(path) //nolint:gosec // G304: token validated above
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("plan %s not found (it may have expired or already been applied)", token)
+		}
+		return nil, fmt.Errorf("reading plan %s: %w", token, err)
+	}
+	var p opPlan
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("parsing plan %s: %w", token, err)
+	}
+	if time.Now().After(p.ExpiresAt) {
+		_ = os.Remove(path)
+		return nil, fmt.Errorf("plan %s expired at %s", token, p.ExpiresAt.Local().Format(time.RFC3339))
+	}
+	return &p, nil
+}
+
+// pruneExpiredPlans removes plan files whose TTL has passed. Best effort.
+func pruneExpiredPlans(dir string, now time.Time) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		data, err := os.ReadFile(path) //nolint:gosec // G304: path is within the plan directory
+		if err != nil {
+			continue
+		}
+		var p opPlan
+		if json.Unmarshal(data, &p) != nil || now.After(p.ExpiresAt) {
+			_ = os.Remove(path)
+		}
+	}
+}
+
+// planDrift describes how an issue changed since the plan was written.
+type planDrift struct {
+	ID     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+// checkPlanDrift compares the plan snapshot against current issues.
+// current maps issue ID to its present state (missing = deleted).
+func checkPlanDrift(p *opPlan, current map[string]*types.Issue) []planDrift {
+	var drift []planDrift
+	for _, pi := range p.Issues {
+		issue, ok := current[pi.ID]
+		if !ok || issue == nil {
+			drift = append(drift, planDrift{ID: pi.ID, Reason: "no longer exists"})
+			continue
+		}
+		if issue.Status != pi.Status {
+			drift = append(drift, planDrift{ID: pi.ID, Reason: fmt.Sprintf("status changed %s → %s", pi.Status, issue.Status)})
+			continue
+		}
+		if !issue.UpdatedAt.UTC().Equal(pi.UpdatedAt) {
+			drift = append(drift, planDrift{ID: pi.ID, Reason: "modified since plan was written"})
+		}
+	}
+	return drift
+}
+
+// emitPlan writes the plan and reports the token to the user.
+func emitPlan(p *opPlan, summary string) {
+	dir, err := planDir()
+	if err != nil {
+		FatalErrorRespectJSON("cannot write plan: %v", err)
+	}
+	path, err := writeOpPlan(dir, p)
+	if err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+
+	if jsonOutput {
+		outputJSON(map[string]interface{}{
+			"token":      p.Token,
+			"operation":  p.Operation,
+			"issues":     p.issueIDs(),
+			"path":       path,
+			"expires_at": p.ExpiresAt,
+		})
+		return
+	}
+
+	fmt.Printf("%s Plan %s written: %s\n", ui.RenderAccent("▸"), ui.RenderBold(p.Token), summary)
+	for _, pi := range p.Issues {
+		fmt.Printf("  %s %s\n", pi.ID, pi.Title)
+	}
+	fmt.Printf("\nReview:  bd apply --show %s\n", p.Token)
+	fmt.Printf("Execute: bd apply %s\n", p.Token)
+	fmt.Printf("(expires %s)\n", p.ExpiresAt.Local().Format("2006-01-02 15:04"))
+}
+
+var applyCmd = &cobra.Command{
+	Use:     "apply <token>",
+	GroupID: "maint",
+	Short:   "Execute a plan written by a --plan bulk operation",
+	Long: `Execute a plan previously written by a bulk operation run with --plan.
+
+Two-phase apply gives reviewers a chance to inspect destructive changes
+before they happen:
+
+  bd close bd-a bd-b bd-c --plan    # writes a plan, prints a token
+  bd apply --show <token>           # inspect the plan
+  bd apply <token>                  # execute it atomically
+
+Supported operations: bulk close (bd close --plan), ephemeral sweep
+(bd purge --plan) and cross-rig move (bd move --plan).
+
+At apply time every issue in the plan is re-checked against the database.
+If any issue was deleted, changed status, or was modified since the plan
+was written, the plan is refused and nothing is changed. Plans are stored
+in .beads/plans/ and expire after 24 hours; applied plans are removed.
+
+A move spans two databases, so it cannot run in one transaction: the copy
+is created in the target rig first, and the source-side dependency remap
+and close follow, exactly as 'bd move' does.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		token := args[0]
+		show, _ := cmd.Flags().GetBool("show")
+
+		dir, err := planDir()
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		p, err := loadOpPlan(dir, token)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		if show {
+			if jsonOutput {
+				outputJSON(p)
+				return
+			}
+			fmt.Printf("Plan %s: %s %d issue(s)\n", p.Token, p.Operation, len(p.Issues))
+			fmt.Printf("  Created: %s by %s\n", p.CreatedAt.Local().Format(time.RFC3339), p.Actor)
+			fmt.Printf("  Expires: %s\n", p.ExpiresAt.Local().Format(time.RFC3339))
+			if p.Reason != "" {
+				fmt.Printf("  Reason:  %s\n", p.Reason)
+			}
+			if p.Target != "" {
+				fmt.Printf("  Target:  %s\n", p.Target)
+			}
+			for _, pi := range p.Issues {
+				fmt.Printf("  %s [%s] %s\n", pi.ID, pi.Status, pi.Title)
+			}
+			return
+		}
+
+		CheckReadonly("apply")
+		ctx := rootCtx
+
+		current := make(map[string]*types.Issue, len(p.Issues))
+		for _, pi := range p.Issues {
+			if issue, err := store.GetIssue(ctx, pi.ID); err == nil && issue != nil {
+				current[pi.ID] = issue
+			}
+		}
+		if drift := checkPlanDrift(p, current); len(drift) > 0 {
+			if jsonOutput {
+				outputJSON(map[string]interface{}{
+					"applied": false,
+					"token":   p.Token,
+					"drift":   drift,
+				})
+				os.Exit(1)
+			}
+			fmt.Fprintf(os.Stderr, "Error: plan %s no longer matches the database:\n", p.Token)
+			for _, d := range drift {
+				fmt.Fprintf(os.Stderr, "  %s: %s\n", d.ID, d.Reason)
+			}
+			fmt.Fprintf(os.Stderr, "Re-run the original command with --plan to write a fresh plan.\n")
+			os.Exit(1)
+		}
+
+		var applied int
+		switch p.Operation {
+		case planOpClose:
+			applied, err = applyClosePlan(ctx, p, current)
+		case planOpPurge:
+			applied, err = applyPurgePlan(ctx, p)
+		case planOpMove:
+			applied, err = applyMovePlan(ctx, p, current)
+		default:
+			err = fmt.Errorf("unsupported plan operation %q", p.Operation)
+		}
+		if err != nil {
+			FatalErrorRespectJSON("applying plan %s: %v", p.Token, err)
+		}
+
+		_ = os.Remove(filepath.Join(dir, p.Token+".json"))
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"applied":   true,
+				"token":     p.Token,
+				"operation": p.Operation,
+				"count":     applied,
+				"issues":    p.issueIDs(),
+			})
+			return
+		}
+		fmt.Printf("%s Applied plan %s: %s %d issue(s)\n", ui.RenderPass("✓"), p.Token, p.Operation, applied)
+	},
+}
+
+// applyClosePlan re-runs the close guards and closes every issue in one
+// transaction, so either all issues close or none do.
+func applyClosePlan(ctx context.Context, p *opPlan, current map[string]*types.Issue) (int, error) {
+	for _, id := range p.issueIDs() {
+		issue := current[id]
+		if err := validateIssueClosable(id, issue, p.Force); err != nil {
+			return 0, err
+		}
+		if p.Force {
+			continue
+		}
+		if issue.IssueType == types.TypeEpic {
+			if n := countEpicOpenChildren(ctx, id); n > 0 {
+				return 0, fmt.Errorf("cannot close epic %s: %d open child issue(s)", id, n)
+			}
+		}
+		if err := checkGateSatisfaction(issue); err != nil {
+			return 0, fmt.Errorf("cannot close %s: %s", id, err)
+		}
+		blocked, blockers, err := store.IsBlocked(ctx, id)
+		if err != nil {
+			return 0, fmt.Errorf("checking blockers for %s: %w", id, err)
+		}
+		if blocked && len(blockers) > 0 {
+			return 0, fmt.Errorf("cannot close %s: blocked by open issues %v", id, blockers)
+		}
+	}
+
+	reason := p.Reason
+	if reason == "" {
+		reason = "Closed"
+	}
+	commitMsg := fmt.Sprintf("bd: apply plan %s (close %d issues)", p.Token, len(p.Issues))
+	err := transact(ctx, store, commitMsg, func(tx storage.Transaction) error {
+		for _, id := range p.issueIDs() {
+			if err := tx.CloseIssue(ctx, id, reason, actor, ""); err != nil {
+				return fmt.Errorf("closing %s: %w", id, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(p.Issues), nil
+}
+
+// applyPurgePlan deletes the planned ephemeral beads in one batch.
+func applyPurgePlan(ctx context.Context, p *opPlan) (int, error) {
+	result, err := store.DeleteIssues(ctx, p.issueIDs(), false, true, false)
+	if err != nil {
+		return 0, err
+	}
+	commandDidWrite.Store(true)
+	return result.DeletedCount, nil
+}
+
+// applyMovePlan moves the planned issue to the recorded target rig.
+func applyMovePlan(ctx context.Context, p *opPlan, current map[string]*types.Issue) (int, error) {
+	for _, id := range p.issueIDs() {
+		targetBeadsDir, err := resolveMoveTarget(id, p.Target)
+		if err != nil {
+			return 0, err
+		}
+		newID, _, err := moveIssue(ctx, store, current[id], targetBeadsDir, p.Target, p.KeepOpen, p.SkipDeps)
+		if err != nil {
+			return 0, err
+		}
+		commandDidWrite.Store(true)
+		if !jsonOutput {
+			fmt.Printf("  %s → %s\n", id, newID)
+		}
+	}
+	return len(p.Issues), nil
+}
+
+func init() {
+	applyCmd.Flags().Bool("show", false, "Show the plan without applying it")
+	rootCmd.AddCommand(applyCmd)
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestOpPlanRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	updated := time.Date(2026, 3, 1, 12, 0, 0, 123456000, time.UTC)
+	issues := []*types.Issue{
+		{ID: "bd-b", Title: "Second", Status: types.StatusOpen, UpdatedAt: updated},
+		{ID: "bd-a", Title: "First", Status: types.StatusInProgress, UpdatedAt: updated},
+	}
+
+	p, err := newOpPlan(planOpClose, issues)
+	if err != nil {
+		t.Fatalf("newOpPlan: %v", err)
+	}
+	p.Reason = "obsolete"
+	if !planTokenPattern.MatchString(p.Token) {
+		t.Fatalf("token %q does not match expected format", p.Token)
+	}
+
+	if _, err := writeOpPlan(dir, p); err != nil {
+		t.Fatalf("writeOpPlan: %v", err)
+	}
+	loaded, err := loadOpPlan(dir, p.Token)
+	if err != nil {
+		t.Fatalf("loadOpPlan: %v", err)
+	}
+
+	if loaded.Operation != planOpClose || loaded.Reason != "obsolete" {
+		t.Errorf("unexpected plan: %+v", loaded)
+	}
+	if got := strings.Join(loaded.issueIDs(), ","); got != "bd-a,bd-b" {
+		t.Errorf("issue IDs = %s, want sorted bd-a,bd-b", got)
+	}
+	if !loaded.Issues[0].UpdatedAt.Equal(updated) {
+		t.Errorf("updated_at not preserved: %v", loaded.Issues[0].UpdatedAt)
+	}
+}
+
+func TestOpPlanRoundTrip_Move(t *testing.T) {
+	dir := t.TempDir()
+	p, err := newOpPlan(planOpMove, []*types.Issue{{ID: "hq-abc", Title: "Move me", Status: types.StatusOpen}})
+	if err != nil {
+		t.Fatalf("newOpPlan: %v", err)
+	}
+	p.Target = "beads"
+	p.KeepOpen = true
+	if _, err := writeOpPlan(dir, p); err != nil {
+		t.Fatalf("writeOpPlan: %v", err)
+	}
+	loaded, err := loadOpPlan(dir, p.Token)
+	if err != nil {
+		t.Fatalf("loadOpPlan: %v", err)
+	}
+	if loaded.Operation != planOpMove || loaded.Target != "beads" || !loaded.KeepOpen || loaded.SkipDeps {
+		t.Errorf("move options not preserved: %+v", loaded)
+	}
+}
+
+func TestLoadOpPlan_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	if _, err := loadOpPlan(dir, "../etc/passwd"); err == nil {
+		t.Error("expected error for invalid token")
+	}
+	if _, err := loadOpPlan(dir, "0123456789ab"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	p, err := newOpPlan(planOpPurge, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.ExpiresAt = time.Now().Add(-time.Minute)
+	if _, err := writeOpPlan(dir, p); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadOpPlan(dir, p.Token); err == nil || !strings.Contains(err.Error(), "expired") {
+		t.Errorf("expected expired error, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, p.Token+".json")); !os.IsNotExist(err) {
+		t.Error("expired plan file should be removed")
+	}
+}
+
+func TestPruneExpiredPlans(t *testing.T) {
+	dir := t.TempDir()
+	fresh, _ := newOpPlan(planOpClose, nil)
+	stale, _ := newOpPlan(planOpClose, nil)
+	stale.ExpiresAt = time.Now().Add(-time.Hour)
+	for _, p := range []*opPlan{fresh, stale} {
+		if _, err := writeOpPlan(dir, p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pruneExpiredPlans(dir, time.Now())
+
+	if _, err := os.Stat(filepath.Join(dir, fresh.Token+".json")); err != nil {
+		t.Errorf("fresh plan should be kept: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, stale.Token+".json")); !os.IsNotExist(err) {
+		t.Error("stale plan should be pruned")
+	}
+}
+
+func TestCheckPlanDrift(t *testing.T) {
+	updated := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	p, _ := newOpPlan(planOpClose, []*types.Issue{
+		{ID: "bd-same", Status: types.StatusOpen, UpdatedAt: updated},
+		{ID: "bd-gone", Status: types.StatusOpen, UpdatedAt: updated},
+		{ID: "bd-status", Status: types.StatusOpen, UpdatedAt: updated},
+		{ID: "bd-edited", Status: types.StatusOpen, UpdatedAt: updated},
+	})
+
+	current := map[string]*types.Issue{
+		"bd-same":   {ID: "bd-same", Status: types.StatusOpen, UpdatedAt: updated},
+		"bd-status": {ID: "bd-status", Status: types.StatusClosed, UpdatedAt: updated},
+		"bd-edited": {ID: "bd-edited", Status: types.StatusOpen, UpdatedAt: updated.Add(time.Second)},
+	}
+
+	drift := checkPlanDrift(p, current)
+	got := map[string]string{}
+	for _, d := range drift {
+		got[d.ID] = d.Reason
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected 3 drifted issues, got %v", got)
+	}
+	if _, ok := got["bd-same"]; ok {
+		t.Error("unchanged issue should not drift")
+	}
+	if !strings.Contains(got["bd-gone"], "no longer exists") {
+		t.Errorf("bd-gone reason = %q", got["bd-gone"])
+	}
+	if !strings.Contains(got["bd-status"], "status changed") {
+		t.Errorf("bd-status reason = %q", got["bd-status"])
+	}
+	if !strings.Contains(got["bd-edited"], "modified") {
+		t.Errorf("bd-edited reason = %q", got["bd-edited"])
+	}
+}
@@ -29,7 +29,8 @@ EXAMPLES:
   bd purge --force                   # Delete all closed ephemeral beads
   bd purge --older-than 7d --force   # Only purge items closed 7+ days ago
   bd purge --pattern "*-wisp-*"      # Only purge matching ID pattern
-  bd purge --dry-run                 # Detailed preview with stats`,
+  bd purge --dry-run                 # Detailed preview with stats
+  bd purge --plan                    # Write a reviewable plan; run with bd apply`,
 	Run: func(cmd *cobra.Command, args []string) {
 		CheckReadonly("purge")
 
@@ -119,6 +120,16 @@ EXAMPLES:
 			issueIDs[i] = issue.ID
 		}
 
+		// Two-phase apply: record the sweep for review instead of executing it
+		if planMode, _ := cmd.Flags().GetBool("plan"); planMode {
+			p, err := newOpPlan(planOpPurge, closedIssues)
+			if err != nil {
+				FatalError("%v", err)
+			}
+			emitPlan(p, fmt.Sprintf("purge %d closed ephemeral bead(s)", len(closedIssues)))
+			return
+		}
+
 		// Dry-run: show stats preview
 		if dryRun {
 			result, err := store.DeleteIssues(ctx, issueIDs, false, false, true)
@@ -251,5 +262,6 @@ func init() {
 	purgeCmd.Flags().Bool("dry-run", false, "Preview what would be purged with stats")
 	purgeCmd.Flags().String("older-than", "", "Only purge beads closed more than N ago (e.g., 7d, 2w, 30)")
 	purgeCmd.Flags().String("pattern", "", "Only purge beads matching ID glob pattern (e.g., *-wisp-*)")
+	purgeCmd.Flags().Bool("plan", false, "Write a plan for review instead of purging (execute with bd apply <token>)")
 	rootCmd.AddCommand(purgeCmd)
 }
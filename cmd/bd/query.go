@@ -88,8 +88,7 @@ Examples:
 		limit, _ := cmd.Flags().GetInt("limit")
 		allFlag, _ := cmd.Flags().GetBool("all")
 		longFormat, _ := cmd.Flags().GetBool("long")
-		sortBy, _ := cmd.Flags().GetString("sort")
-		reverse, _ := cmd.Flags().GetBool("reverse")
+		sortKeys := sortKeysFromFlags(cmd)
 		parseOnly, _ := cmd.Flags().GetBool("parse-only")
 
 		// Parse the query
@@ -131,6 +130,7 @@ Examples:
 		// If we need predicate filtering, we may need to fetch more results
 		// to ensure we get enough after filtering
 		searchFilter := result.Filter
+		searchFilter.Sort = sortKeys
 		if result.RequiresPredicate && limit > 0 {
 			// Fetch more to account for predicate filtering
 			searchFilter.Limit = limit * 3
@@ -173,7 +173,7 @@ Examples:
 		}
 
 		// Apply sorting
-		sortIssues(issues, sortBy, reverse)
+		sortIssues(issues, sortKeys)
 
 		// Output results
 		if jsonOutput {
@@ -308,7 +308,7 @@ func init() {
 	queryCmd.Flags().IntP("limit", "n", 50, "Limit results (default: 50, 0 = unlimited)")
 	queryCmd.Flags().BoolP("all", "a", false, "Include closed issues (default: exclude closed)")
 	queryCmd.Flags().Bool("long", false, "Show detailed multi-line output for each issue")
-	queryCmd.Flags().String("sort", "", "Sort by field: priority, created, updated, closed, status, id, title, type, assignee")
+	queryCmd.Flags().String("sort", "", sortFlagUsage)
 	queryCmd.Flags().BoolP("reverse", "r", false, "Reverse sort order")
 	queryCmd.Flags().Bool("parse-only", false, "Only parse the query and show the AST (for debugging)")
 
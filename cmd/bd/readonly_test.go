@@ -42,6 +42,8 @@ func TestReadonlyModeBlocksWrites(t *testing.T) {
 		{"rename-prefix"},
 		{"validate --fix-all"},
 		{"jira sync"},
+		{"tag"},
+		{"tag rm"},
 	}
 
 	for _, tc := range tests {
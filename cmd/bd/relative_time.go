@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// absoluteTimes disables relative timestamps in human output (--absolute).
+var absoluteTimes bool
+
+// humanizeDuration renders an age as a compact relative time ("5m ago",
+// "2h ago", "3d ago", "2w ago"). Negative durations are future times ("in 2h").
+func humanizeDuration(d time.Duration) string {
+	future := d < 0
+	if future {
+		d = -d
+	}
+	if d < time.Minute {
+		return "just now"
+	}
+
+	const (
+		day   = 24 * time.Hour
+		week  = 7 * day
+		month = 30 * day
+		year  = 365 * day
+	)
+	var s string
+	switch {
+	case d < time.Hour:
+		s = fmt.Sprintf("%dm", int(d/time.Minute))
+	case d < day:
+		s = fmt.Sprintf("%dh", int(d/time.Hour))
+	case d < week:
+		s = fmt.Sprintf("%dd", int(d/day))
+	case d < month:
+		s = fmt.Sprintf("%dw", int(d/week))
+	case d < year:
+		s = fmt.Sprintf("%dmo", int(d/month))
+	default:
+		s = fmt.Sprintf("%dy", int(d/year))
+	}
+	if future {
+		return "in " + s
+	}
+	return s + " ago"
+}
+
+// formatHumanTime formats a timestamp for human output: relative by default,
+// full RFC3339 with --absolute. JSON output never goes through this.
+func formatHumanTime(t time.Time) string {
+	if absoluteTimes {
+		return t.Format(time.RFC3339)
+	}
+	return humanizeDuration(time.Since(t))
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHumanizeDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "just now"},
+		{59 * time.Second, "just now"},
+		{time.Minute, "1m ago"},
+		{59 * time.Minute, "59m ago"},
+		{time.Hour, "1h ago"},
+		{23*time.Hour + 59*time.Minute, "23h ago"},
+		{24 * time.Hour, "1d ago"},
+		{6 * 24 * time.Hour, "6d ago"},
+		{7 * 24 * time.Hour, "1w ago"},
+		{29 * 24 * time.Hour, "4w ago"},
+		{30 * 24 * time.Hour, "1mo ago"},
+		{400 * 24 * time.Hour, "1y ago"},
+		{-2 * time.Hour, "in 2h"},
+		{-30 * time.Second, "just now"},
+	}
+	for _, tt := range tests {
+		if got := humanizeDuration(tt.d); got != tt.want {
+			t.Errorf("humanizeDuration(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestFormatHumanTime_Absolute(t *testing.T) {
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	old := absoluteTimes
+	defer func() { absoluteTimes = old }()
+
+	absoluteTimes = true
+	if got := formatHumanTime(ts); got != "2026-01-02T03:04:05Z" {
+		t.Errorf("formatHumanTime with --absolute = %q, want RFC3339", got)
+	}
+
+	absoluteTimes = false
+	if got := formatHumanTime(time.Now().Add(-3 * time.Hour)); got != "3h ago" {
+		t.Errorf("formatHumanTime = %q, want %q", got, "3h ago")
+	}
+}
@@ -5,39 +5,71 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/hooks"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/storage/dolt"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
+	"github.com/steveyegge/beads/internal/utils"
 )
 
 var renameCmd = &cobra.Command{
-	Use:   "rename <old-id> <new-id>",
-	Short: "Rename an issue ID",
-	Long: `Rename an issue from one ID to another.
+	Use:   "rename <old-id> <new-id> | <id> --title <new-title>",
+	Short: "Rename an issue ID or title",
+	Long: `Rename an issue from one ID to another, or give it a new title.
 
-This updates:
+With two arguments, the issue ID is renamed. This updates:
 - The issue's primary ID
 - All references in other issues (descriptions, titles, notes, etc.)
 - Dependencies pointing to/from this issue
 - Labels, comments, and events
 
+With --title, the issue keeps its ID and gets the new title. The change is
+committed as "rename <id>: old → new" and, unless --no-comment is given, a
+comment recording the previous title is added to the issue.
+
 Examples:
-  bd rename bd-w382l bd-dolt     # Rename to memorable ID
-  bd rename gt-abc123 gt-auth    # Use descriptive ID
+  bd rename bd-w382l bd-dolt                       # Rename to memorable ID
+  bd rename gt-abc123 gt-auth                      # Use descriptive ID
+  bd rename bd-abc --title "Fix login redirect"    # New title
 
 Note: The new ID must use a valid prefix for this database.`,
-	Args: cobra.ExactArgs(2),
+	Args: func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("title") {
+			if len(args) != 1 {
+				return fmt.Errorf("with --title, give only the issue ID (got %d arguments)", len(args))
+			}
+			return nil
+		}
+		if len(args) != 2 {
+			return fmt.Errorf("requires <old-id> <new-id>, or <id> --title <new-title> (got %d arguments)", len(args))
+		}
+		return nil
+	},
 	RunE: runRename,
 }
 
+// renameIDPattern is the format a new issue ID must have (prefix-suffix).
+var renameIDPattern = regexp.MustCompile(`^[a-z]+-[a-zA-Z0-9._-]+$`)
+
 func init() {
+	renameCmd.Flags().String("title", "", "Give the issue a new title instead of renaming its ID")
+	renameCmd.Flags().Bool("no-comment", false, "With --title, don't add a comment recording the previous title")
+	renameCmd.ValidArgsFunction = issueIDCompletion
 	rootCmd.AddCommand(renameCmd)
 }
 
 func runRename(cmd *cobra.Command, args []string) error {
+	if cmd.Flags().Changed("title") {
+		newTitle, _ := cmd.Flags().GetString("title")
+		noComment, _ := cmd.Flags().GetBool("no-comment")
+		return runRenameTitle(args[0], newTitle, !noComment)
+	}
+
 	oldID := args[0]
 	newID := args[1]
 
@@ -47,9 +79,8 @@ func runRename(cmd *cobra.Command, args []string) error {
 	}
 
 	// Basic ID format validation
-	idPattern := regexp.MustCompile(`^[a-z]+-[a-zA-Z0-9._-]+$`)
-	if !idPattern.MatchString(newID) {
-		return fmt.Errorf("invalid new ID format %q: must be prefix-suffix (e.g., bd-dolt)", newID)
+	if !renameIDPattern.MatchString(newID) {
+		return fmt.Errorf("invalid new ID format %q: must be prefix-suffix (e.g., bd-dolt); use --title to change the title", newID)
 	}
 
 	ctx := context.Background()
@@ -93,6 +124,78 @@ func runRename(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// runRenameTitle changes an issue's title in a single Dolt commit whose
+// message names the old and new title, optionally leaving a comment behind.
+func runRenameTitle(idArg, newTitle string, addComment bool) error {
+	CheckReadonly("rename")
+	newTitle = strings.TrimSpace(newTitle)
+	if newTitle == "" {
+		return fmt.Errorf("title cannot be empty")
+	}
+	if err := types.ValidateTitleLength(newTitle); err != nil {
+		return err
+	}
+
+	ctx := rootCtx
+	if err := ensureStoreActive(); err != nil {
+		return fmt.Errorf("failed to get storage: %w", err)
+	}
+	id, err := utils.ResolvePartialID(ctx, store, idArg)
+	if err != nil {
+		return err
+	}
+	issue, err := store.GetIssue(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get issue %s: %w", id, err)
+	}
+	if err := validateIssueUpdatable(id, issue); err != nil {
+		return err
+	}
+
+	oldTitle := issue.Title
+	if oldTitle == newTitle {
+		if jsonOutput {
+			outputJSON(map[string]interface{}{"id": id, "title": newTitle, "old_title": oldTitle, "changed": false})
+		} else {
+			fmt.Printf("Title of %s is already %q\n", id, newTitle)
+		}
+		return nil
+	}
+
+	if err := renameIssueTitle(ctx, store, id, oldTitle, newTitle, addComment); err != nil {
+		return err
+	}
+
+	if updated, _ := store.GetIssue(ctx, id); updated != nil && hookRunner != nil { // Best effort: hook only
+		hookRunner.Run(hooks.EventUpdate, updated)
+	}
+
+	if jsonOutput {
+		outputJSON(map[string]interface{}{"id": id, "title": newTitle, "old_title": oldTitle, "changed": true})
+		return nil
+	}
+	fmt.Printf("%s Renamed %s: %s → %s\n", ui.RenderPass("✓"), id, oldTitle, ui.RenderAccent(newTitle))
+	return nil
+}
+
+// renameIssueTitle writes the title change (and the optional comment) in one
+// transaction committed as "bd: rename <id>: old → new".
+func renameIssueTitle(ctx context.Context, s *dolt.DoltStore, id, oldTitle, newTitle string, addComment bool) error {
+	commitMsg := fmt.Sprintf("bd: rename %s: %s → %s", id, oldTitle, newTitle)
+	return transact(ctx, s, commitMsg, func(tx storage.Transaction) error {
+		if err := tx.UpdateIssue(ctx, id, map[string]interface{}{"title": newTitle}, actor); err != nil {
+			return fmt.Errorf("rename %s: %w", id, err)
+		}
+		if addComment {
+			note := fmt.Sprintf("Renamed from %q to %q", oldTitle, newTitle)
+			if _, err := tx.ImportIssueComment(ctx, id, actor, note, time.Now()); err != nil {
+				return fmt.Errorf("comment on %s: %w", id, err)
+			}
+		}
+		return nil
+	})
+}
+
 // updateReferencesInAllIssues updates text references to the old ID in all issues
 func updateReferencesInAllIssues(ctx context.Context, store *dolt.DoltStore, oldID, newID, actor string) error {
 	// Get all issues
@@ -0,0 +1,64 @@
+//go:build cgo
+
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestRenameIssueTitle(t *testing.T) {
+	tmpDir := t.TempDir()
+	s := newTestStore(t, filepath.Join(tmpDir, ".beads", "beads.db"))
+	ctx := context.Background()
+
+	issue := &types.Issue{Title: "Old title", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := s.CreateIssue(ctx, issue, "test"); err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+	before, err := s.GetIssue(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetIssue: %v", err)
+	}
+
+	if err := renameIssueTitle(ctx, s, issue.ID, "Old title", "New title", true); err != nil {
+		t.Fatalf("renameIssueTitle: %v", err)
+	}
+
+	after, err := s.GetIssue(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetIssue: %v", err)
+	}
+	if after.Title != "New title" {
+		t.Errorf("Title = %q, want %q", after.Title, "New title")
+	}
+	if after.UpdatedAt.Before(before.UpdatedAt) {
+		t.Errorf("UpdatedAt went backwards: %v < %v", after.UpdatedAt, before.UpdatedAt)
+	}
+
+	comments, err := s.GetIssueComments(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetIssueComments: %v", err)
+	}
+	if len(comments) != 1 || !strings.Contains(comments[0].Text, `"Old title"`) {
+		t.Errorf("comments = %+v, want one noting the previous title", comments)
+	}
+}
+
+func TestRenameIDPattern(t *testing.T) {
+	for arg, isID := range map[string]bool{
+		"bd-dolt":          true,
+		"gt-auth":          true,
+		"Fix login":        false,
+		"Refactor":         false,
+		"bd-abc with text": false,
+	} {
+		if got := renameIDPattern.MatchString(arg); got != isID {
+			t.Errorf("renameIDPattern.MatchString(%q) = %v, want %v", arg, got, isID)
+		}
+	}
+}
@@ -33,6 +33,10 @@ This is more explicit than 'bd update --status open' and emits a Reopened event.
 			FatalErrorWithHint("database not initialized",
 				"run 'bd doctor' to diagnose, or 'bd init' to create a new database")
 		}
+		if dryRun {
+			printDryRun(dryRunReopen(ctx, args))
+			return
+		}
 		for _, id := range args {
 			fullID, err := utils.ResolvePartialID(ctx, store, id)
 			if err != nil {
@@ -253,11 +253,12 @@ Also triggers Dolt push/pull if a remote is configured.`,
 				continue
 			}
 
-			jsonlPath := filepath.Join(absPath, ".beads", "issues.jsonl")
+			// Each repo's own export.jsonl-path decides where its JSONL lives
+			jsonlPath := config.JSONLPathFromDir(filepath.Join(absPath, ".beads"))
 			info, err := os.Stat(jsonlPath)
 			if err != nil {
 				if verbose {
-					fmt.Fprintf(os.Stderr, "Skipping %s: no issues.jsonl found\n", repoPath)
+					fmt.Fprintf(os.Stderr, "Skipping %s: no %s found\n", repoPath, filepath.Base(jsonlPath))
 				}
 				continue
 			}
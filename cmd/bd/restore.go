@@ -22,7 +22,8 @@ When an issue is compacted, its description and notes are truncated.
 This command queries Dolt's history tables to find the pre-compaction
 version and displays the full issue content.
 
-This is read-only and does not modify the database.`,
+This is read-only and does not modify the database. To move an archived
+issue back into the active table, use 'bd unarchive'.`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		issueID := args[0]
@@ -33,6 +34,9 @@ This is read-only and does not modify the database.`,
 		if err != nil {
 			if errors.Is(err, storage.ErrNotFound) {
 				fmt.Fprintf(os.Stderr, "Error: issue '%s' not found\n", issueID)
+				if archived, _ := store.IsArchived(ctx, issueID); archived {
+					fmt.Fprintf(os.Stderr, "Hint: %s is archived; bring it back with 'bd unarchive %s'\n", issueID, issueID)
+				}
 			} else {
 				fmt.Fprintf(os.Stderr, "Error: issue '%s' not found: %v\n", issueID, err)
 			}
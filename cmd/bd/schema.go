@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var schemaCmd = &cobra.Command{
+	Use:     "schema",
+	GroupID: "maint",
+	Short:   "Show the live database schema",
+	Long: `Show the columns of the issues table and the tables attached to it
+(labels, dependencies, comments, events, issue_meta, issue_links,
+attachments), the recorded schema version, and the registered migrations.
+
+This reads the database as it is, so it answers "why doesn't my field exist"
+without reading migration source: a column missing here was never added to
+this database. Migrations are idempotent and run when bd opens a database
+whose schema version is behind this binary's; they are not recorded one by
+one, so all of them show as applied once the version is current.
+
+Examples:
+  bd schema
+  bd schema --json | jq '.tables[] | select(.name == "issues") | .columns[].name'`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if store == nil {
+			FatalErrorWithHint("database not initialized",
+				"run 'bd doctor' to diagnose, or 'bd init' to create a new database")
+		}
+		info, err := store.DescribeSchema(rootCtx)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if jsonOutput {
+			outputJSON(info)
+			return
+		}
+		printSchemaInfo(info)
+	},
+}
+
+func printSchemaInfo(info *dolt.SchemaInfo) {
+	version := fmt.Sprintf("Schema version: %d", info.SchemaVersion)
+	if info.SchemaVersion < info.ExpectedVersion {
+		version += ui.RenderWarn(fmt.Sprintf(" (this bd expects %d)", info.ExpectedVersion))
+	}
+	fmt.Println(version)
+
+	for _, table := range info.Tables {
+		fmt.Println()
+		if !table.Exists {
+			fmt.Printf("%s %s\n", ui.RenderBold(table.Name), ui.RenderMuted("(missing)"))
+			continue
+		}
+		fmt.Printf("%s %s\n", ui.RenderBold(table.Name), ui.RenderMuted(fmt.Sprintf("(%d columns)", len(table.Columns))))
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, c := range table.Columns {
+			var attrs []string
+			if !c.Nullable {
+				attrs = append(attrs, "NOT NULL")
+			}
+			if c.Key != "" {
+				attrs = append(attrs, c.Key)
+			}
+			if c.Default != nil {
+				attrs = append(attrs, "DEFAULT "+*c.Default)
+			}
+			if c.Extra != "" {
+				attrs = append(attrs, c.Extra)
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", c.Name, c.Type, strings.Join(attrs, " "))
+		}
+		_ = w.Flush()
+	}
+
+	fmt.Printf("\nMigrations (%d):\n", len(info.Migrations))
+	for _, m := range info.Migrations {
+		mark := ui.RenderPass("✓")
+		if !m.Applied {
+			mark = ui.RenderWarn("○")
+		}
+		fmt.Printf("  %s %s\n", mark, m.Name)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(schemaCmd)
+}
@@ -61,8 +61,7 @@ Examples:
 		labels, _ := cmd.Flags().GetStringSlice("label")
 		labelsAny, _ := cmd.Flags().GetStringSlice("label-any")
 		longFormat, _ := cmd.Flags().GetBool("long")
-		sortBy, _ := cmd.Flags().GetString("sort")
-		reverse, _ := cmd.Flags().GetBool("reverse")
+		sortKeys := sortKeysFromFlags(cmd)
 
 		// Date range flags
 		createdAfter, _ := cmd.Flags().GetString("created-after")
@@ -93,6 +92,7 @@ Examples:
 		// Build filter
 		filter := types.IssueFilter{
 			Limit: limit,
+			Sort:  sortKeys,
 		}
 
 		if status != "" && status != "all" {
@@ -107,7 +107,7 @@ Examples:
 		}
 
 		if assignee != "" {
-			filter.Assignee = &assignee
+			setAssigneeFilter(&filter, assignee)
 		}
 
 		if issueType != "" {
@@ -241,7 +241,7 @@ Examples:
 		}
 
 		// Apply sorting
-		sortIssues(issues, sortBy, reverse)
+		sortIssues(issues, sortKeys)
 
 		if jsonOutput {
 			// Get labels and dependency counts
@@ -351,7 +351,7 @@ func init() {
 	searchCmd.Flags().StringSlice("label-any", []string{}, "Filter by labels (OR: must have AT LEAST ONE)")
 	searchCmd.Flags().IntP("limit", "n", 50, "Limit results (default: 50)")
 	searchCmd.Flags().Bool("long", false, "Show detailed multi-line output for each issue")
-	searchCmd.Flags().String("sort", "", "Sort by field: priority, created, updated, closed, status, id, title, type, assignee")
+	searchCmd.Flags().String("sort", "", sortFlagUsage)
 	searchCmd.Flags().BoolP("reverse", "r", false, "Reverse sort order")
 
 	// Date range flags
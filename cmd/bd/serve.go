@@ -0,0 +1,323 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/query"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/validation"
+)
+
+var serveCmd = &cobra.Command{
+	Use:     "serve",
+	GroupID: "advanced",
+	Short:   "Serve issues over a read-only HTTP API",
+	Long: `Start an HTTP server that exposes the issue database read-only, for
+dashboards and other tools that should not talk to Dolt directly.
+
+Endpoints (all return JSON):
+  GET /issues        List issues. Query parameters:
+                       status, priority, type, assignee  exact match
+                       label       must have this label (repeatable)
+                       label-any   comma list; must have at least one
+                       q           text search, as in 'bd search'
+                       sort        as in 'bd list --sort'
+                       limit       page size, capped by --max-rows
+                       cursor      next_cursor from the previous page
+                     Returns {"issues": [...], "next_cursor": "..."}; the
+                     cursor is omitted on the last page.
+  GET /issues/{id}   One issue with labels, dependencies, comments, links
+                     and attachments
+  GET /stats         The summary shown by 'bd status'
+  GET /healthz       Liveness check; does not touch the database
+
+The database is opened read-only, so the server never blocks writers. It
+stops on SIGINT or SIGTERM after in-flight requests finish.
+
+Each request gets --request-timeout to finish its queries. At most
+--max-inflight requests run at once so slow queries cannot exhaust the
+connection pool; further requests get 429 Too Many Requests.
+
+Examples:
+  bd serve
+  bd serve --addr :8080 --request-timeout 10s --max-inflight 8 --max-rows 200
+  curl 'localhost:8080/issues?status=open&sort=priority,updated:desc'`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		addr, _ := cmd.Flags().GetString("addr")
+		timeout, _ := cmd.Flags().GetDuration("request-timeout")
+		maxInflight, _ := cmd.Flags().GetInt("max-inflight")
+		maxRows, _ := cmd.Flags().GetInt("max-rows")
+		if timeout <= 0 {
+			FatalErrorRespectJSON("--request-timeout must be positive")
+		}
+		if maxInflight <= 0 {
+			FatalErrorRespectJSON("--max-inflight must be positive")
+		}
+		if maxRows < 0 {
+			FatalErrorRespectJSON("--max-rows must not be negative")
+		}
+		if store == nil {
+			FatalErrorWithHint("database not initialized",
+				"run 'bd doctor' to diagnose, or 'bd init' to create a new database")
+		}
+
+		srv := &http.Server{
+			Addr:              addr,
+			Handler:           newServeHandler(store, serveLimits{Timeout: timeout, MaxInflight: maxInflight, MaxRows: maxRows}),
+			ReadHeaderTimeout: timeout,
+			ReadTimeout:       timeout,
+			WriteTimeout:      timeout,
+		}
+		ln, err := net.Listen("tcp", addr)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		fmt.Fprintf(os.Stderr, "Serving issues read-only on http://%s (Ctrl+C to stop)\n", ln.Addr())
+
+		errCh := make(chan error, 1)
+		go func() { errCh <- srv.Serve(ln) }()
+
+		select {
+		case err := <-errCh:
+			FatalErrorRespectJSON("server stopped: %v", err)
+		case <-rootCtx.Done():
+		}
+
+		// Let in-flight requests finish, within a window that ends before
+		// shutdownGracePeriod forces the process to exit.
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownGracePeriod/2)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			fmt.Fprintf(os.Stderr, "Shutdown: %v\n", err)
+		}
+		fmt.Fprintf(os.Stderr, "Stopped serving.\n")
+	},
+}
+
+// serveLimits bounds the work a single bd serve process accepts.
+type serveLimits struct {
+	Timeout     time.Duration // per-request deadline for store queries
+	MaxInflight int           // concurrent API requests before answering 429
+	MaxRows     int           // largest /issues page; 0 means no cap
+}
+
+// serveIssuesPage is the /issues response body.
+type serveIssuesPage struct {
+	Issues     []*types.Issue `json:"issues"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+// newServeHandler returns the read-only HTTP API backed by s.
+func newServeHandler(s *dolt.DoltStore, limits serveLimits) http.Handler {
+	api := http.NewServeMux()
+	api.HandleFunc("GET /issues", func(w http.ResponseWriter, r *http.Request) {
+		query, filter, err := issueFilterFromQuery(r.URL.Query())
+		if err != nil {
+			writeServeError(w, http.StatusBadRequest, err)
+			return
+		}
+		offset, err := parseServeCursor(r.URL.Query().Get("cursor"))
+		if err != nil {
+			writeServeError(w, http.StatusBadRequest, err)
+			return
+		}
+		pageSize := filter.Limit
+		if limits.MaxRows > 0 && (pageSize == 0 || pageSize > limits.MaxRows) {
+			pageSize = limits.MaxRows
+		}
+		// Fetch one row past the page to learn whether another page exists.
+		// Pages are stable because every sort ends in an id tiebreak.
+		filter.Limit = 0
+		if pageSize > 0 {
+			filter.Limit = offset + pageSize + 1
+		}
+		issues, err := s.SearchIssues(r.Context(), query, filter)
+		if err != nil {
+			writeServeStoreError(w, err)
+			return
+		}
+		// Issues and wisps are each sorted and limited in SQL; merge them
+		sortIssues(issues, filter.Sort)
+		writeServeJSON(w, http.StatusOK, paginateIssues(issues, offset, pageSize))
+	})
+	api.HandleFunc("GET /issues/{id}", func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		issue, err := s.GetIssue(ctx, r.PathValue("id"))
+		if errors.Is(err, storage.ErrNotFound) {
+			writeServeError(w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			writeServeStoreError(w, err)
+			return
+		}
+		// Same shape as 'bd show --json'; related data is best effort there too.
+		details := &types.IssueDetails{Issue: *issue}
+		details.Labels = issue.Labels
+		details.Dependencies, _ = s.GetDependenciesWithMetadata(ctx, issue.ID)
+		details.Dependents, _ = s.GetDependentsWithMetadata(ctx, issue.ID)
+		details.Comments, _ = s.GetIssueComments(ctx, issue.ID)
+		details.Links, _ = s.GetIssueLinks(ctx, issue.ID)
+		details.Attachments, _ = s.GetAttachments(ctx, issue.ID)
+		writeServeJSON(w, http.StatusOK, details)
+	})
+	api.HandleFunc("GET /stats", func(w http.ResponseWriter, r *http.Request) {
+		stats, err := s.GetStatistics(r.Context())
+		if err != nil {
+			writeServeStoreError(w, err)
+			return
+		}
+		writeServeJSON(w, http.StatusOK, stats)
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeServeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	mux.Handle("/", limitServeRequests(api, limits))
+	return mux
+}
+
+// limitServeRequests rejects requests beyond limits.MaxInflight with 429
+// rather than queueing them, and gives each admitted request limits.Timeout.
+func limitServeRequests(next http.Handler, limits serveLimits) http.Handler {
+	inflight := make(chan struct{}, max(limits.MaxInflight, 1))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case inflight <- struct{}{}:
+			defer func() { <-inflight }()
+		default:
+			w.Header().Set("Retry-After", "1")
+			writeServeError(w, http.StatusTooManyRequests, errors.New("too many requests in flight"))
+			return
+		}
+		if limits.Timeout > 0 {
+			ctx, cancel := context.WithTimeout(r.Context(), limits.Timeout)
+			defer cancel()
+			r = r.WithContext(ctx)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// paginateIssues returns the page of issues starting at offset. issues may
+// hold one row past the page, which only signals that a next page exists.
+func paginateIssues(issues []*types.Issue, offset, pageSize int) serveIssuesPage {
+	page := serveIssuesPage{Issues: []*types.Issue{}}
+	if offset >= len(issues) {
+		return page
+	}
+	issues = issues[offset:]
+	if pageSize > 0 && len(issues) > pageSize {
+		issues = issues[:pageSize]
+		page.NextCursor = strconv.Itoa(offset + pageSize)
+	}
+	page.Issues = issues
+	return page
+}
+
+// parseServeCursor decodes a next_cursor value; the empty cursor is the
+// first page.
+func parseServeCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	offset, err := strconv.Atoi(cursor)
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	return offset, nil
+}
+
+// issueFilterFromQuery translates /issues query parameters into a search
+// query and filter, rejecting values 'bd list' would also reject.
+func issueFilterFromQuery(q url.Values) (string, types.IssueFilter, error) {
+	var filter types.IssueFilter
+	if v := q.Get("status"); v != "" {
+		status := types.Status(v)
+		filter.Status = &status
+	}
+	if v := q.Get("priority"); v != "" {
+		p, err := validation.ValidatePriority(v)
+		if err != nil {
+			return "", filter, err
+		}
+		filter.Priority = &p
+	}
+	if v := q.Get("type"); v != "" {
+		issueType := types.IssueType(v).Normalize()
+		filter.IssueType = &issueType
+	}
+	if v := q.Get("assignee"); v != "" {
+		filter.Assignee = &v
+	}
+	filter.Labels = q["label"]
+	if v := q.Get("label-any"); v != "" {
+		filter.LabelsAny = strings.Split(v, ",")
+	}
+	if v := q.Get("where"); v != "" {
+		if err := query.ValidateSQL(v); err != nil {
+			return "", filter, fmt.Errorf("invalid where: %w", err)
+		}
+		filter.Expr = v
+	}
+	if v := q.Get("sort"); v != "" {
+		keys, err := types.ParseSortKeys(v)
+		if err != nil {
+			return "", filter, err
+		}
+		filter.Sort = keys
+	}
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return "", filter, fmt.Errorf("invalid limit %q", v)
+		}
+		filter.Limit = n
+	}
+	return q.Get("q"), filter, nil
+}
+
+func writeServeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v) // Best effort: the client may have gone away
+}
+
+func writeServeError(w http.ResponseWriter, code int, err error) {
+	writeServeJSON(w, code, map[string]string{"error": err.Error()})
+}
+
+// writeServeStoreError reports a failed store query, distinguishing requests
+// that ran out of time from other failures.
+func writeServeStoreError(w http.ResponseWriter, err error) {
+	if errors.Is(err, context.DeadlineExceeded) {
+		writeServeError(w, http.StatusServiceUnavailable, errors.New("request timed out"))
+		return
+	}
+	writeServeError(w, http.StatusInternalServerError, err)
+}
+
+func init() {
+	serveCmd.Flags().String("addr", "localhost:8080", "Address to listen on")
+	serveCmd.Flags().Duration("request-timeout", 30*time.Second, "Maximum time to read a request, run its queries and write the response")
+	// Below the store's default pool of 10 connections, leaving room for
+	// requests that issue several queries.
+	serveCmd.Flags().Int("max-inflight", 4, "Maximum concurrent API requests; more get 429")
+	serveCmd.Flags().Int("max-rows", 500, "Maximum issues per /issues page (0 for no cap)")
+	rootCmd.AddCommand(serveCmd)
+}
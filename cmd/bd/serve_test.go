@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestIssueFilterFromQuery(t *testing.T) {
+	q, err := url.ParseQuery("status=open&priority=P1&type=enhancement&assignee=alice&label=a&label=b&label-any=x,y&sort=priority,updated:desc&limit=5&q=login&where=priority%3C%3D1+OR+label%3Durgent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	query, filter, err := issueFilterFromQuery(q)
+	if err != nil {
+		t.Fatalf("issueFilterFromQuery: %v", err)
+	}
+	if query != "login" {
+		t.Errorf("query = %q, want login", query)
+	}
+	if filter.Status == nil || *filter.Status != types.StatusOpen {
+		t.Errorf("status = %v, want open", filter.Status)
+	}
+	if filter.Priority == nil || *filter.Priority != 1 {
+		t.Errorf("priority = %v, want 1", filter.Priority)
+	}
+	if filter.IssueType == nil || *filter.IssueType != types.TypeFeature {
+		t.Errorf("type = %v, want feature", filter.IssueType)
+	}
+	if filter.Assignee == nil || *filter.Assignee != "alice" {
+		t.Errorf("assignee = %v, want alice", filter.Assignee)
+	}
+	if len(filter.Labels) != 2 || len(filter.LabelsAny) != 2 {
+		t.Errorf("labels = %v, label-any = %v", filter.Labels, filter.LabelsAny)
+	}
+	if len(filter.Sort) != 2 || filter.Sort[1] != (types.SortKey{Field: "updated", Desc: true}) {
+		t.Errorf("sort = %v", filter.Sort)
+	}
+	if filter.Limit != 5 {
+		t.Errorf("limit = %d, want 5", filter.Limit)
+	}
+	if filter.Expr != "priority<=1 OR label=urgent" {
+		t.Errorf("where = %q", filter.Expr)
+	}
+
+	for _, bad := range []string{"priority=high", "limit=-1", "limit=x", "sort=bogus", "where=password%3Dx"} {
+		q, _ := url.ParseQuery(bad)
+		if _, _, err := issueFilterFromQuery(q); err == nil {
+			t.Errorf("%s: expected error", bad)
+		}
+	}
+}
+
+func TestServeHandlerRejectsBadRequests(t *testing.T) {
+	h := newServeHandler(nil, serveLimits{MaxInflight: 1})
+	for _, tc := range []struct {
+		method, path string
+		want         int
+	}{
+		{http.MethodGet, "/issues?priority=high", http.StatusBadRequest},
+		{http.MethodPost, "/issues", http.StatusMethodNotAllowed},
+		{http.MethodGet, "/issues?cursor=abc", http.StatusBadRequest},
+		{http.MethodGet, "/nope", http.StatusNotFound},
+		{http.MethodGet, "/healthz", http.StatusOK},
+	} {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(tc.method, tc.path, nil))
+		if rec.Code != tc.want {
+			t.Errorf("%s %s = %d, want %d", tc.method, tc.path, rec.Code, tc.want)
+		}
+	}
+}
+
+func TestLimitServeRequests(t *testing.T) {
+	release := make(chan struct{})
+	entered := make(chan struct{})
+	var sawDeadline bool
+	slow := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, sawDeadline = r.Context().Deadline()
+		close(entered)
+		<-release
+	})
+	h := limitServeRequests(slow, serveLimits{MaxInflight: 1, Timeout: time.Minute})
+
+	done := make(chan struct{})
+	go func() {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/stats", nil).WithContext(context.Background()))
+		close(done)
+	}()
+	<-entered
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/stats", nil))
+	if rec.Code != http.StatusTooManyRequests {
+		t.Errorf("second request = %d, want 429", rec.Code)
+	}
+	if rec.Header().Get("Retry-After") == "" {
+		t.Error("429 response has no Retry-After header")
+	}
+	close(release)
+	<-done
+	if !sawDeadline {
+		t.Error("admitted request has no deadline")
+	}
+}
+
+func TestPaginateIssues(t *testing.T) {
+	var issues []*types.Issue
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		issues = append(issues, &types.Issue{ID: id})
+	}
+
+	page := paginateIssues(issues[:3], 0, 2)
+	if got := sortedIDs(page.Issues); got != "a b" || page.NextCursor != "2" {
+		t.Errorf("first page = %s cursor %q", got, page.NextCursor)
+	}
+	offset, err := parseServeCursor(page.NextCursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page = paginateIssues(issues, offset, 2)
+	if got := sortedIDs(page.Issues); got != "c d" || page.NextCursor != "4" {
+		t.Errorf("second page = %s cursor %q", got, page.NextCursor)
+	}
+	page = paginateIssues(issues, 4, 2)
+	if got := sortedIDs(page.Issues); got != "e" || page.NextCursor != "" {
+		t.Errorf("last page = %s cursor %q", got, page.NextCursor)
+	}
+	page = paginateIssues(issues, 9, 2)
+	if page.Issues == nil || len(page.Issues) != 0 {
+		t.Errorf("page past the end = %v, want empty", page.Issues)
+	}
+	if page = paginateIssues(issues, 0, 0); len(page.Issues) != 5 || page.NextCursor != "" {
+		t.Errorf("uncapped page = %d issues cursor %q", len(page.Issues), page.NextCursor)
+	}
+}
@@ -14,7 +14,6 @@ import (
 
 var showCmd = &cobra.Command{
 	Use:     "show [id...] [--id=<id>...] [--current]",
-	Aliases: []string{"view"},
 	GroupID: "issues",
 	Short:   "Show issue details",
 	Args:    cobra.ArbitraryArgs, // Allow zero positional args when --id is used
@@ -24,6 +23,8 @@ var showCmd = &cobra.Command{
 		longMode, _ := cmd.Flags().GetBool("long")
 		showRefs, _ := cmd.Flags().GetBool("refs")
 		showChildren, _ := cmd.Flags().GetBool("children")
+		showDepsTree, _ := cmd.Flags().GetBool("deps-tree")
+		depsTreeDepth, _ := cmd.Flags().GetInt("depth")
 		asOfRef, _ := cmd.Flags().GetString("as-of")
 		idFlags, _ := cmd.Flags().GetStringArray("id")
 		localTime, _ := cmd.Flags().GetBool("local-time")
@@ -108,6 +109,12 @@ var showCmd = &cobra.Command{
 			return
 		}
 
+		// Handle --deps-tree flag: show the transitive blocking closure
+		if showDepsTree {
+			showIssueDepsTree(ctx, args, depsTreeDepth)
+			return
+		}
+
 		// Direct mode - use routed resolution for cross-repo lookups
 		allDetails := []interface{}{}
 		foundCount := 0
@@ -139,10 +146,18 @@ var showCmd = &cobra.Command{
 				continue
 			}
 
+			// Reload with labels and comments, as library callers see it
+			issue, err = libStore(issueStore).Show(ctx, result.ResolvedID)
+			if err != nil {
+				result.Close()
+				fmt.Fprintf(os.Stderr, "Error fetching %s: %v\n", id, err)
+				continue
+			}
+
 			if jsonOutput {
 				// Include labels, dependencies (with metadata), dependents (with metadata), and comments in JSON output
 				details := &types.IssueDetails{Issue: *issue}
-				details.Labels, _ = issueStore.GetLabels(ctx, issue.ID) // Best effort: show issue even if label fetch fails
+				details.Labels = issue.Labels
 
 				// Get dependencies with metadata (dependency_type field)
 				details.Dependencies, _ = issueStore.GetDependenciesWithMetadata(ctx, issue.ID) // Best effort: show issue even if deps unavailable
@@ -152,7 +167,9 @@ var showCmd = &cobra.Command{
 				}
 				details.Dependents, _ = issueStore.GetDependentsWithMetadata(ctx, issue.ID) // Best effort: show issue even if dependents unavailable
 
-				details.Comments, _ = issueStore.GetIssueComments(ctx, issue.ID) // Best effort: show issue even if comments unavailable
+				details.Comments = issue.Comments
+				details.Links, _ = issueStore.GetIssueLinks(ctx, issue.ID)        // Best effort: show issue even if links unavailable
+				details.Attachments, _ = issueStore.GetAttachments(ctx, issue.ID) // Best effort: show issue even if attachments unavailable
 
 				// Epic progress: count children status for epic issues
 				if issue.IssueType == types.TypeEpic && details.Dependents != nil {
@@ -223,8 +240,7 @@ var showCmd = &cobra.Command{
 			}
 
 			// Show labels
-			labels, _ := issueStore.GetLabels(ctx, issue.ID) // Best effort: show issue even if label fetch fails
-			if len(labels) > 0 {
+			if labels := issue.Labels; len(labels) > 0 {
 				fmt.Printf("\n%s %s\n", ui.RenderBold("LABELS:"), strings.Join(labels, ", "))
 			}
 
@@ -352,9 +368,25 @@ var showCmd = &cobra.Command{
 				}
 			}
 
+			// Show commit and URL links (see 'bd link')
+			links, _ := issueStore.GetIssueLinks(ctx, issue.ID) // Best effort: show issue even if links unavailable
+			if len(links) > 0 {
+				fmt.Printf("\n%s\n", ui.RenderBold("LINKS"))
+				for _, link := range links {
+					fmt.Println(formatLinkLine(link))
+				}
+			}
+
+			attachments, _ := issueStore.GetAttachments(ctx, issue.ID) // Best effort: show issue even if attachments unavailable
+			if len(attachments) > 0 {
+				fmt.Printf("\n%s\n", ui.RenderBold("ATTACHMENTS"))
+				for _, line := range formatAttachmentLines(attachments) {
+					fmt.Println("  " + line)
+				}
+			}
+
 			// Show comments
-			comments, _ := issueStore.GetIssueComments(ctx, issue.ID) // Best effort: show issue even if comments unavailable
-			if len(comments) > 0 {
+			if comments := issue.Comments; len(comments) > 0 {
 				fmt.Printf("\n%s\n", ui.RenderBold("COMMENTS"))
 				for _, comment := range comments {
 					fmt.Printf("  %s %s\n", ui.RenderMuted(formatTime(comment.CreatedAt)), comment.Author)
@@ -404,7 +436,9 @@ func init() {
 	showCmd.Flags().Bool("long", false, "Show all available fields (extended metadata, agent identity, gate fields, etc.)")
 	showCmd.Flags().Bool("refs", false, "Show issues that reference this issue (reverse lookup)")
 	showCmd.Flags().Bool("children", false, "Show only the children of this issue")
-	showCmd.Flags().String("as-of", "", "Show issue as it existed at a specific commit hash or branch (requires Dolt)")
+	showCmd.Flags().Bool("deps-tree", false, "Show everything this issue is transitively blocked by and blocks, as a tree with each issue's status")
+	showCmd.Flags().Int("depth", 10, "Maximum depth for --deps-tree")
+	showCmd.Flags().String("as-of", "", "Show issue as it existed at a specific commit hash, branch or tag (requires Dolt)")
 	showCmd.Flags().StringArray("id", nil, "Issue ID (use for IDs that look like flags, e.g., --id=gt--xyz)")
 	showCmd.Flags().Bool("local-time", false, "Show timestamps in local time instead of UTC")
 	showCmd.Flags().BoolP("watch", "w", false, "Watch for changes and auto-refresh display")
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// depsTreeMaxNodes caps how many issues one --deps-tree direction lists, so
+// a densely linked graph can't flood the terminal.
+const depsTreeMaxNodes = 500
+
+// depsTreeNode is one issue in 'bd show --deps-tree'. A node is expanded at
+// most once; later occurrences are marked Seen (or Cycle, when the issue is
+// already on the path from the root) and listed without children.
+type depsTreeNode struct {
+	ID             string               `json:"id"`
+	Title          string               `json:"title"`
+	Status         types.Status         `json:"status"`
+	Priority       int                  `json:"priority"`
+	DependencyType types.DependencyType `json:"dependency_type,omitempty"`
+	Cycle          bool                 `json:"cycle,omitempty"`
+	Seen           bool                 `json:"seen,omitempty"`
+	Truncated      bool                 `json:"truncated,omitempty"` // Has more deps past --depth or the node cap
+	Children       []*depsTreeNode      `json:"children,omitempty"`
+}
+
+// depsTreeResult is the --deps-tree output for one issue.
+type depsTreeResult struct {
+	Root      *depsTreeNode   `json:"root"`
+	BlockedBy []*depsTreeNode `json:"blocked_by"`
+	Blocks    []*depsTreeNode `json:"blocks"`
+}
+
+// depsFetcher returns the issues directly linked to id in one direction.
+type depsFetcher func(ctx context.Context, id string) ([]*types.IssueWithDependencyMetadata, error)
+
+// isBlockingDepType reports whether a dependency holds up completion:
+// the types that affect ready work, minus the parent-child hierarchy.
+func isBlockingDepType(t types.DependencyType) bool {
+	return t != types.DepParentChild && t.AffectsReadyWork()
+}
+
+type depsTreeBuilder struct {
+	fetch    depsFetcher
+	maxDepth int
+	maxNodes int
+	nodes    int
+	expanded map[string]bool
+	onPath   map[string]bool
+	cycles   bool
+	capped   bool
+}
+
+// buildDepsTree walks the blocking closure of rootID in one direction,
+// depth-first, and returns the root's children.
+func buildDepsTree(ctx context.Context, fetch depsFetcher, rootID string, maxDepth, maxNodes int) ([]*depsTreeNode, *depsTreeBuilder, error) {
+	b := &depsTreeBuilder{
+		fetch:    fetch,
+		maxDepth: maxDepth,
+		maxNodes: maxNodes,
+		expanded: make(map[string]bool),
+		onPath:   make(map[string]bool),
+	}
+	root := &depsTreeNode{ID: rootID}
+	if err := b.expand(ctx, root, 0); err != nil {
+		return nil, nil, err
+	}
+	return root.Children, b, nil
+}
+
+func (b *depsTreeBuilder) expand(ctx context.Context, node *depsTreeNode, depth int) error {
+	linked, err := b.fetch(ctx, node.ID)
+	if err != nil {
+		return fmt.Errorf("getting dependencies of %s: %w", node.ID, err)
+	}
+	var deps []*types.IssueWithDependencyMetadata
+	for _, dep := range linked {
+		if isBlockingDepType(dep.DependencyType) {
+			deps = append(deps, dep)
+		}
+	}
+	if len(deps) == 0 {
+		return nil
+	}
+	if depth >= b.maxDepth {
+		node.Truncated = true
+		return nil
+	}
+	sort.Slice(deps, func(i, j int) bool { return deps[i].ID < deps[j].ID })
+
+	b.expanded[node.ID] = true
+	b.onPath[node.ID] = true
+	defer delete(b.onPath, node.ID)
+
+	for _, dep := range deps {
+		if b.nodes >= b.maxNodes {
+			node.Truncated = true
+			b.capped = true
+			return nil
+		}
+		b.nodes++
+		child := &depsTreeNode{
+			ID:             dep.ID,
+			Title:          dep.Title,
+			Status:         dep.Status,
+			Priority:       dep.Priority,
+			DependencyType: dep.DependencyType,
+		}
+		node.Children = append(node.Children, child)
+		switch {
+		case b.onPath[dep.ID]:
+			child.Cycle = true
+			b.cycles = true
+		case b.expanded[dep.ID]:
+			child.Seen = true
+		default:
+			if err := b.expand(ctx, child, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// showIssueDepsTree prints the transitive blocked-by and blocks closure of
+// each issue as an indented tree with every node's status.
+func showIssueDepsTree(ctx context.Context, args []string, maxDepth int) {
+	if maxDepth < 1 {
+		FatalErrorRespectJSON("--depth must be at least 1")
+	}
+
+	var results []*depsTreeResult
+	cycles, capped := false, false
+	for idx, id := range args {
+		result, err := resolveAndGetIssueWithRouting(ctx, store, id)
+		if err != nil {
+			if result != nil {
+				result.Close()
+			}
+			fmt.Fprintf(os.Stderr, "Error fetching %s: %v\n", id, err)
+			continue
+		}
+		if result == nil || result.Issue == nil {
+			if result != nil {
+				result.Close()
+			}
+			fmt.Fprintf(os.Stderr, "Issue %s not found\n", id)
+			continue
+		}
+
+		tree, err := issueDepsTree(ctx, result.Store, result.Issue, maxDepth)
+		result.Close()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error building dependency tree for %s: %v\n", id, err)
+			continue
+		}
+		results = append(results, tree.result)
+		cycles = cycles || tree.cycles
+		capped = capped || tree.capped
+
+		if jsonOutput {
+			continue
+		}
+		if idx > 0 {
+			fmt.Println("\n" + ui.RenderMuted(strings.Repeat("-", 60)))
+		}
+		fmt.Println(formatDepsTreeLine(tree.result.Root))
+		renderDepsTreeSection("BLOCKED BY", tree.result.BlockedBy)
+		renderDepsTreeSection("BLOCKS", tree.result.Blocks)
+	}
+
+	if jsonOutput {
+		if results == nil {
+			results = []*depsTreeResult{}
+		}
+		outputJSON(results)
+		return
+	}
+	if cycles {
+		fmt.Printf("\n%s Dependency cycle found (marked ↻); list all cycles with: bd dep cycles\n", ui.RenderWarn("⚠"))
+	}
+	if capped {
+		fmt.Printf("\n%s Stopped after %d issues per direction; use --depth to narrow the tree\n", ui.RenderWarn("⚠"), depsTreeMaxNodes)
+	}
+}
+
+type issueDepsTreeResult struct {
+	result *depsTreeResult
+	cycles bool
+	capped bool
+}
+
+func issueDepsTree(ctx context.Context, issueStore *dolt.DoltStore, issue *types.Issue, maxDepth int) (*issueDepsTreeResult, error) {
+	blockedBy, up, err := buildDepsTree(ctx, issueStore.GetDependenciesWithMetadata, issue.ID, maxDepth, depsTreeMaxNodes)
+	if err != nil {
+		return nil, err
+	}
+	blocks, down, err := buildDepsTree(ctx, issueStore.GetDependentsWithMetadata, issue.ID, maxDepth, depsTreeMaxNodes)
+	if err != nil {
+		return nil, err
+	}
+	if blockedBy == nil {
+		blockedBy = []*depsTreeNode{}
+	}
+	if blocks == nil {
+		blocks = []*depsTreeNode{}
+	}
+	return &issueDepsTreeResult{
+		result: &depsTreeResult{
+			Root:      &depsTreeNode{ID: issue.ID, Title: issue.Title, Status: issue.Status, Priority: issue.Priority},
+			BlockedBy: blockedBy,
+			Blocks:    blocks,
+		},
+		cycles: up.cycles || down.cycles,
+		capped: up.capped || down.capped,
+	}, nil
+}
+
+func renderDepsTreeSection(heading string, nodes []*depsTreeNode) {
+	fmt.Printf("\n%s\n", ui.RenderBold(heading))
+	if len(nodes) == 0 {
+		fmt.Println(ui.RenderMuted("  (none)"))
+		return
+	}
+	var b strings.Builder
+	writeDepsTree(&b, nodes, "  ")
+	fmt.Print(b.String())
+}
+
+// writeDepsTree writes nodes with box-drawing connectors, children indented
+// under their parent.
+func writeDepsTree(b *strings.Builder, nodes []*depsTreeNode, prefix string) {
+	for i, node := range nodes {
+		last := i == len(nodes)-1
+		connector, childPrefix := "├── ", prefix+"│   "
+		if last {
+			connector, childPrefix = "└── ", prefix+"    "
+		}
+		b.WriteString(prefix + connector + formatDepsTreeLine(node) + "\n")
+		writeDepsTree(b, node.Children, childPrefix)
+	}
+}
+
+func formatDepsTreeLine(node *depsTreeNode) string {
+	line := fmt.Sprintf("%s %s: %s [P%d] (%s)", getStatusEmoji(node.Status), renderStatusID(node.ID, node.Status), node.Title, node.Priority, node.Status)
+	if node.DependencyType != "" && node.DependencyType != types.DepBlocks {
+		line += ui.RenderMuted(fmt.Sprintf(" [%s]", node.DependencyType))
+	}
+	switch {
+	case node.Cycle:
+		line += ui.RenderWarn(" ↻ cycle")
+	case node.Seen:
+		line += ui.RenderMuted(" (shown above)")
+	case node.Truncated:
+		line += ui.RenderWarn(" …")
+	}
+	return line
+}
+
+// renderStatusID colors an issue ID by its status, as 'bd dep tree' does.
+func renderStatusID(id string, status types.Status) string {
+	switch status {
+	case types.StatusOpen:
+		return ui.StatusOpenStyle.Render(id)
+	case types.StatusInProgress:
+		return ui.StatusInProgressStyle.Render(id)
+	case types.StatusBlocked:
+		return ui.StatusBlockedStyle.Render(id)
+	case types.StatusClosed:
+		return ui.StatusClosedStyle.Render(id)
+	default:
+		return id
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// fakeDepsGraph maps an issue to the issues it depends on, with the
+// dependency type.
+type fakeDepsGraph map[string]map[string]types.DependencyType
+
+func (g fakeDepsGraph) fetch(_ context.Context, id string) ([]*types.IssueWithDependencyMetadata, error) {
+	var out []*types.IssueWithDependencyMetadata
+	for dep, depType := range g[id] {
+		out = append(out, &types.IssueWithDependencyMetadata{
+			Issue:          types.Issue{ID: dep, Title: "Issue " + dep, Status: types.StatusOpen, Priority: 2},
+			DependencyType: depType,
+		})
+	}
+	return out, nil
+}
+
+// flattenDepsTree renders nodes as "id[marker]" in depth-first order.
+func flattenDepsTree(nodes []*depsTreeNode) []string {
+	var out []string
+	for _, n := range nodes {
+		s := n.ID
+		switch {
+		case n.Cycle:
+			s += "(cycle)"
+		case n.Seen:
+			s += "(seen)"
+		case n.Truncated:
+			s += "(…)"
+		}
+		out = append(out, s)
+		out = append(out, flattenDepsTree(n.Children)...)
+	}
+	return out
+}
+
+func TestBuildDepsTree(t *testing.T) {
+	graph := fakeDepsGraph{
+		"a": {"b": types.DepBlocks, "c": types.DepBlocks, "epic": types.DepParentChild, "doc": types.DepRelated},
+		"b": {"d": types.DepBlocks},
+		"c": {"d": types.DepWaitsFor},
+		"d": {"a": types.DepBlocks, "e": types.DepBlocks},
+		"e": {"f": types.DepBlocks},
+	}
+	ctx := context.Background()
+
+	nodes, b, err := buildDepsTree(ctx, graph.fetch, "a", 10, 100)
+	if err != nil {
+		t.Fatalf("buildDepsTree: %v", err)
+	}
+	// Parent-child and related links are not blocking; d is reached twice
+	// (diamond) and leads back to a (cycle)
+	want := "b d a(cycle) e f c d(seen)"
+	if got := strings.Join(flattenDepsTree(nodes), " "); got != want {
+		t.Errorf("tree = %q, want %q", got, want)
+	}
+	if !b.cycles || b.capped {
+		t.Errorf("cycles=%v capped=%v, want cycles and no cap", b.cycles, b.capped)
+	}
+
+	nodes, _, err = buildDepsTree(ctx, graph.fetch, "a", 2, 100)
+	if err != nil {
+		t.Fatalf("buildDepsTree (depth 2): %v", err)
+	}
+	want = "b d(…) c d(…)" // d was never expanded, so neither copy is "seen"
+	if got := strings.Join(flattenDepsTree(nodes), " "); got != want {
+		t.Errorf("depth 2 tree = %q, want %q", got, want)
+	}
+
+	nodes, b, err = buildDepsTree(ctx, graph.fetch, "a", 10, 3)
+	if err != nil {
+		t.Fatalf("buildDepsTree (capped): %v", err)
+	}
+	if got := len(flattenDepsTree(nodes)); got != 3 || !b.capped {
+		t.Errorf("capped tree has %d nodes (capped=%v), want 3 and capped", got, b.capped)
+	}
+}
+
+func TestWriteDepsTree(t *testing.T) {
+	nodes := []*depsTreeNode{
+		{ID: "b", Status: types.StatusOpen, Children: []*depsTreeNode{{ID: "d", Status: types.StatusClosed}}},
+		{ID: "c", Status: types.StatusOpen},
+	}
+	var sb strings.Builder
+	writeDepsTree(&sb, nodes, "")
+	lines := strings.Split(strings.TrimSuffix(sb.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3:\n%s", len(lines), sb.String())
+	}
+	for i, prefix := range []string{"├── ", "│   └── ", "└── "} {
+		if !strings.HasPrefix(lines[i], prefix) {
+			t.Errorf("line %d = %q, want prefix %q", i, lines[i], prefix)
+		}
+	}
+}
@@ -70,7 +70,7 @@ func formatIssueHeader(issue *types.Issue) string {
 	}
 
 	// Build header: STATUS_ICON ID · Title   [Priority · STATUS]
-	idStyled := ui.RenderAccent(issue.ID)
+	idStyled := ui.RenderAccent(issue.ID) + mutedSeqTag(issue)
 	return fmt.Sprintf("%s %s%s · %s%s   [%s · %s]",
 		statusIcon, idStyled, typeBadge, issue.Title, tierEmoji, priorityTag, statusStr)
 }
@@ -78,7 +78,7 @@ func formatIssueHeader(issue *types.Issue) string {
 // formatIssueMetadata returns the metadata line(s) with grouped info
 // Format: Owner: user · Type: task
 //
-//	Created: 2026-01-06 · Updated: 2026-01-08
+//	Created: 3d ago · Updated: 2h ago
 func formatIssueMetadata(issue *types.Issue) string {
 	var lines []string
 
@@ -107,8 +107,8 @@ func formatIssueMetadata(issue *types.Issue) string {
 
 	// Line 2: Created · Updated · Due/Defer
 	timeParts := []string{}
-	timeParts = append(timeParts, fmt.Sprintf("Created: %s", issue.CreatedAt.Format("2006-01-02")))
-	timeParts = append(timeParts, fmt.Sprintf("Updated: %s", issue.UpdatedAt.Format("2006-01-02")))
+	timeParts = append(timeParts, fmt.Sprintf("Created: %s", formatHumanTime(issue.CreatedAt)))
+	timeParts = append(timeParts, fmt.Sprintf("Updated: %s", formatHumanTime(issue.UpdatedAt)))
 
 	if issue.DueAt != nil {
 		timeParts = append(timeParts, fmt.Sprintf("Due: %s", issue.DueAt.Format("2006-01-02")))
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+// stubShutdown swaps the exit hook and grace period for the duration of a test.
+func stubShutdown(t *testing.T, grace time.Duration) <-chan int {
+	t.Helper()
+	origExit, origGrace := shutdownExit, shutdownGracePeriod
+	exitCodes := make(chan int, 1)
+	shutdownExit = func(code int) { exitCodes <- code }
+	shutdownGracePeriod = grace
+	t.Cleanup(func() {
+		shutdownExit, shutdownGracePeriod = origExit, origGrace
+		shutdownRequested.Store(false)
+	})
+	return exitCodes
+}
+
+func TestHandleShutdownSignal_CancelsInFlightCommit(t *testing.T) {
+	exitCodes := stubShutdown(t, 5*time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A long-running commit that only returns once its context is canceled.
+	commitErr := make(chan error, 1)
+	go func() {
+		<-ctx.Done()
+		commitErr <- ctx.Err()
+	}()
+
+	sigCh := make(chan os.Signal, 1)
+	go handleShutdownSignal(sigCh, cancel)
+
+	select {
+	case err := <-commitErr:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("commit returned %v, want context.Canceled", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("in-flight commit was not canceled")
+	}
+	if !shutdownRequested.Load() {
+		t.Error("shutdownRequested not set")
+	}
+
+	// The handler waits for the command to unwind; a second signal stops the wait.
+	select {
+	case code := <-exitCodes:
+		t.Fatalf("exited (%d) before grace period or second signal", code)
+	case <-time.After(50 * time.Millisecond):
+	}
+	sigCh <- os.Interrupt
+
+	select {
+	case code := <-exitCodes:
+		if code != exitCodeInterrupted {
+			t.Errorf("exit code = %d, want %d", code, exitCodeInterrupted)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("second signal did not force exit")
+	}
+}
+
+func TestHandleShutdownSignal_GracePeriodExpires(t *testing.T) {
+	exitCodes := stubShutdown(t, 50*time.Millisecond)
+
+	_, cancel := context.WithCancel(context.Background())
+	go handleShutdownSignal(make(chan os.Signal), cancel)
+
+	select {
+	case code := <-exitCodes:
+		if code != exitCodeInterrupted {
+			t.Errorf("exit code = %d, want %d", code, exitCodeInterrupted)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("handler did not exit after grace period")
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// SLABucket reports SLA breaches for one priority (see sla.by-priority).
+type SLABucket struct {
+	Priority  int      `json:"priority"`
+	Threshold string   `json:"threshold"`
+	Open      int      `json:"open"`
+	Breaching int      `json:"breaching"`
+	IssueIDs  []string `json:"breaching_ids,omitempty"`
+}
+
+// computeSLABuckets counts non-closed issues per priority whose age since
+// created_at exceeds that priority's SLA. Priorities without an SLA are
+// omitted. Buckets are ordered by priority.
+func computeSLABuckets(issues []*types.Issue, slas map[int]time.Duration, now time.Time) []SLABucket {
+	byPriority := make(map[int]*SLABucket, len(slas))
+	for p, d := range slas {
+		byPriority[p] = &SLABucket{Priority: p, Threshold: formatSLAThreshold(d)}
+	}
+
+	for _, issue := range issues {
+		if issue.Status == types.StatusClosed {
+			continue
+		}
+		bucket, ok := byPriority[issue.Priority]
+		if !ok {
+			continue
+		}
+		bucket.Open++
+		if now.Sub(issue.CreatedAt) > slas[issue.Priority] {
+			bucket.Breaching++
+			bucket.IssueIDs = append(bucket.IssueIDs, issue.ID)
+		}
+	}
+
+	buckets := make([]SLABucket, 0, len(byPriority))
+	for _, b := range byPriority {
+		sort.Strings(b.IssueIDs)
+		buckets = append(buckets, *b)
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Priority < buckets[j].Priority })
+	return buckets
+}
+
+// formatSLAThreshold renders whole days as "Nd" and anything else as a Go duration.
+func formatSLAThreshold(d time.Duration) string {
+	if d%(24*time.Hour) == 0 {
+		return fmt.Sprintf("%dd", d/(24*time.Hour))
+	}
+	return d.String()
+}
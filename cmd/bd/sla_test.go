@@ -0,0 +1,47 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestComputeSLABuckets(t *testing.T) {
+	now := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+	slas := map[int]time.Duration{0: 24 * time.Hour, 1: 72 * time.Hour}
+
+	issues := []*types.Issue{
+		// P0: one just past the 1d threshold, one just inside it
+		{ID: "bd-p0old", Priority: 0, Status: types.StatusOpen, CreatedAt: now.Add(-24*time.Hour - time.Minute)},
+		{ID: "bd-p0new", Priority: 0, Status: types.StatusInProgress, CreatedAt: now.Add(-24*time.Hour + time.Minute)},
+		// Exactly at the threshold is not a breach
+		{ID: "bd-p0edge", Priority: 0, Status: types.StatusOpen, CreatedAt: now.Add(-24 * time.Hour)},
+		// Closed issues never breach
+		{ID: "bd-p0done", Priority: 0, Status: types.StatusClosed, CreatedAt: now.Add(-30 * 24 * time.Hour)},
+		// P1: straddling 3d
+		{ID: "bd-p1old", Priority: 1, Status: types.StatusBlocked, CreatedAt: now.Add(-73 * time.Hour)},
+		{ID: "bd-p1new", Priority: 1, Status: types.StatusOpen, CreatedAt: now.Add(-71 * time.Hour)},
+		// P2 has no SLA
+		{ID: "bd-p2", Priority: 2, Status: types.StatusOpen, CreatedAt: now.Add(-365 * 24 * time.Hour)},
+	}
+
+	got := computeSLABuckets(issues, slas, now)
+	want := []SLABucket{
+		{Priority: 0, Threshold: "1d", Open: 3, Breaching: 1, IssueIDs: []string{"bd-p0old"}},
+		{Priority: 1, Threshold: "3d", Open: 2, Breaching: 1, IssueIDs: []string{"bd-p1old"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("computeSLABuckets() =\n  %+v\nwant\n  %+v", got, want)
+	}
+}
+
+func TestFormatSLAThreshold(t *testing.T) {
+	if got := formatSLAThreshold(48 * time.Hour); got != "2d" {
+		t.Errorf("formatSLAThreshold(48h) = %q, want 2d", got)
+	}
+	if got := formatSLAThreshold(36 * time.Hour); got != "36h0m0s" {
+		t.Errorf("formatSLAThreshold(36h) = %q, want 36h0m0s", got)
+	}
+}
@@ -1,37 +1,58 @@
 package main
 
 import (
+	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 var sqlCmd = &cobra.Command{
 	Use:     "sql <query>",
 	GroupID: "maint",
 	Short:   "Execute raw SQL against the beads database",
-	Long: `Execute a raw SQL query against the underlying database (SQLite or Dolt).
+	Long: `Execute a raw SQL query against the underlying Dolt database.
 
 Useful for debugging, maintenance, and working around bugs in higher-level commands.
 
 Examples:
   bd sql 'SELECT COUNT(*) FROM issues'
   bd sql 'SELECT id, title FROM issues WHERE status = "open" LIMIT 5'
-  bd sql 'DELETE FROM dirty_issues WHERE issue_id = "bd-abc123"'
   bd sql --csv 'SELECT id, title, status FROM issues'
+  bd sql --write 'DELETE FROM labels WHERE label = "obsolete"'
 
-The query is passed directly to the database. SELECT queries return results as a
-table (or JSON/CSV with --json/--csv). Non-SELECT queries (INSERT, UPDATE, DELETE)
-report the number of rows affected.
+Only one statement is accepted per call. Without --write it must be a read
+(SELECT, WITH, SHOW, DESCRIBE or EXPLAIN); it runs in a read-only
+transaction and returns results as a table (or JSON/CSV with --json/--csv).
+Dolt procedures that change history, such as DOLT_RESET, count as writes.
+
+With --write any statement is accepted. bd asks for confirmation (--yes skips
+the prompt and is required when stdin is not a terminal), runs the statement
+in a transaction, reports the number of rows affected, and records a Dolt
+commit for it.
 
 WARNING: Direct database access bypasses the storage layer. Use with caution.`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		query := args[0]
 		csvOutput, _ := cmd.Flags().GetBool("csv")
+		allowWrite, _ := cmd.Flags().GetBool("write")
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		keyword, err := parseSQLStatement(query)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		isRead := sqlReadKeywords[keyword] && !sqlReadSideEffects.MatchString(query)
+		if !isRead && !allowWrite {
+			FatalErrorWithHint(fmt.Sprintf("bd sql only runs read queries by default; %s may modify the database", keyword),
+				"pass --write to run it as a confirmed, committed write")
+		}
 
 		if store == nil {
 			FatalErrorRespectJSON("no database connection available (run 'bd doctor' to diagnose, or 'bd init' to create a new database)")
@@ -44,17 +65,16 @@ WARNING: Direct database access bypasses the storage layer. Use with caution.`,
 
 		ctx := rootCtx
 
-		// Detect if it's a read query (SELECT, EXPLAIN, PRAGMA, SHOW, DESCRIBE, WITH)
-		trimmed := strings.TrimSpace(strings.ToUpper(query))
-		isRead := strings.HasPrefix(trimmed, "SELECT") ||
-			strings.HasPrefix(trimmed, "EXPLAIN") ||
-			strings.HasPrefix(trimmed, "PRAGMA") ||
-			strings.HasPrefix(trimmed, "SHOW") ||
-			strings.HasPrefix(trimmed, "DESCRIBE") ||
-			strings.HasPrefix(trimmed, "WITH")
-
 		if isRead {
-			rows, err := db.QueryContext(ctx, query)
+			// The keyword check is the contract; a read-only transaction backs
+			// it up for statements like WITH ... DELETE that start innocently.
+			tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+			if err != nil {
+				FatalErrorRespectJSON("starting read-only transaction: %v", err)
+			}
+			defer func() { _ = tx.Rollback() }() // Nothing to keep
+
+			rows, err := tx.QueryContext(ctx, query)
 			if err != nil {
 				FatalErrorRespectJSON("query error: %v", err)
 			}
@@ -182,15 +202,37 @@ WARNING: Direct database access bypasses the storage layer. Use with caution.`,
 
 			fmt.Printf("(%d rows)\n", len(allRows))
 		} else {
-			// Write query
 			CheckReadonly("sql")
+			if !yes {
+				if !term.IsTerminal(int(os.Stdin.Fd())) {
+					FatalErrorRespectJSON("refusing to run %s without confirmation; pass --yes when stdin is not a terminal", keyword)
+				}
+				if !confirmPrompt(fmt.Sprintf("Run this %s statement and commit the result?", keyword)) {
+					fmt.Fprintf(os.Stderr, "Aborted.\n")
+					return
+				}
+			}
 
-			result, err := db.ExecContext(ctx, query)
+			tx, err := db.BeginTx(ctx, nil)
 			if err != nil {
+				FatalErrorRespectJSON("starting transaction: %v", err)
+			}
+			result, err := tx.ExecContext(ctx, query)
+			if err != nil {
+				_ = tx.Rollback()
 				FatalErrorRespectJSON("exec error: %v", err)
 			}
-
 			affected, _ := result.RowsAffected()
+			if err := tx.Commit(); err != nil {
+				FatalErrorRespectJSON("committing: %v", err)
+			}
+			commandDidWrite.Store(true)
+
+			// Raw SQL may touch any table, config included
+			if err := store.CommitWithConfig(ctx, sqlCommitMessage(query)); err != nil {
+				FatalErrorRespectJSON("statement applied but Dolt commit failed: %v", err)
+			}
+			commandDidExplicitDoltCommit = true
 
 			if jsonOutput {
 				outputJSON(map[string]interface{}{
@@ -204,8 +246,115 @@ WARNING: Direct database access bypasses the storage layer. Use with caution.`,
 	},
 }
 
+// sqlReadKeywords are the leading keywords bd sql runs without --write.
+var sqlReadKeywords = map[string]bool{
+	"SELECT":   true,
+	"WITH":     true,
+	"SHOW":     true,
+	"DESCRIBE": true,
+	"DESC":     true,
+	"EXPLAIN":  true,
+}
+
+// sqlReadSideEffects matches Dolt functions that change branches, history
+// or remotes; they can be called from an otherwise read-looking SELECT.
+// SELECT ... INTO OUTFILE writes a file on the server, so it counts too.
+var sqlReadSideEffects = regexp.MustCompile(`(?i)\bdolt_(add|backup|branch|checkout|cherry_pick|clean|clone|commit|fetch|gc|merge|pull|purge_dropped_databases|push|rebase|remote|reset|revert|stash|tag|undrop)\s*\(|\binto\s+(outfile|dumpfile)\b`)
+
+// parseSQLStatement returns the upper-cased leading keyword of query after
+// checking it holds exactly one statement. Quotes, backticks and comments
+// are skipped, so semicolons inside them don't count; a single trailing
+// semicolon is allowed. MySQL executable comments (/*! ... */) are
+// rejected because the server runs what they contain.
+func parseSQLStatement(query string) (string, error) {
+	var keyword strings.Builder
+	keywordDone, ended := false, false
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '-' && strings.HasPrefix(query[i:], "-- "), c == '#':
+			if j := strings.IndexByte(query[i:], '\n'); j >= 0 {
+				i += j
+			} else {
+				i = len(query)
+			}
+			continue
+		case strings.HasPrefix(query[i:], "/*!"):
+			return "", fmt.Errorf("executable comments (/*! ... */) are not allowed")
+		case strings.HasPrefix(query[i:], "/*"):
+			j := strings.Index(query[i+2:], "*/")
+			if j < 0 {
+				return "", fmt.Errorf("unterminated comment")
+			}
+			i += j + 3
+			continue
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if keyword.Len() > 0 {
+				keywordDone = true
+			}
+			continue
+		}
+
+		if ended {
+			return "", fmt.Errorf("only one SQL statement is allowed per call")
+		}
+		switch c {
+		case ';':
+			ended = true
+			keywordDone = true
+		case '\'', '"', '`':
+			j := i + 1
+			for ; j < len(query); j++ {
+				if query[j] == '\\' && c != '`' {
+					j++
+					continue
+				}
+				if query[j] == c {
+					if j+1 < len(query) && query[j+1] == c { // doubled quote
+						j++
+						continue
+					}
+					break
+				}
+			}
+			if j >= len(query) {
+				return "", fmt.Errorf("unterminated %c quote", c)
+			}
+			i = j
+			keywordDone = true
+		case '(':
+			if keyword.Len() > 0 {
+				keywordDone = true
+			} // Otherwise a parenthesized statement: the keyword follows
+		default:
+			isWord := c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+			if !keywordDone && isWord {
+				keyword.WriteByte(c)
+			} else {
+				keywordDone = true
+			}
+		}
+	}
+	if keyword.Len() == 0 {
+		return "", fmt.Errorf("empty SQL statement")
+	}
+	return strings.ToUpper(keyword.String()), nil
+}
+
+// sqlCommitMessage is the Dolt commit message for a bd sql --write
+// statement: the statement on one line, shortened to fit a log line.
+func sqlCommitMessage(query string) string {
+	msg := strings.Join(strings.Fields(query), " ")
+	if len(msg) > 72 {
+		msg = msg[:69] + "..."
+	}
+	return "bd sql: " + msg
+}
+
 func init() {
 	sqlCmd.Flags().Bool("csv", false, "Output results in CSV format")
+	sqlCmd.Flags().Bool("write", false, "Allow statements that modify the database (confirmed, then committed)")
+	sqlCmd.Flags().BoolP("yes", "y", false, "Skip the --write confirmation prompt")
 
 	// Register as a read-only command for SELECT queries.
 	// Write queries will be caught by CheckReadonly.
@@ -174,3 +174,55 @@ func TestSqlCommandInit(t *testing.T) {
 		t.Error("sql command not registered with rootCmd")
 	}
 }
+
+func TestParseSQLStatement(t *testing.T) {
+	tests := []struct {
+		query   string
+		want    string
+		wantErr string
+	}{
+		{query: "SELECT 1", want: "SELECT"},
+		{query: "  select id from issues;  ", want: "SELECT"},
+		{query: "-- note\n/* block */ SHOW TABLES", want: "SHOW"},
+		{query: "(SELECT 1) UNION (SELECT 2)", want: "SELECT"},
+		{query: "SELECT 'a;b', \"c;d\", `e;f` FROM t", want: "SELECT"},
+		{query: "SELECT 'it''s; fine'", want: "SELECT"},
+		{query: "delete from labels", want: "DELETE"},
+		{query: "SELECT 1; DELETE FROM issues", wantErr: "only one SQL statement"},
+		{query: "SELECT 1;;", wantErr: "only one SQL statement"},
+		{query: "SELECT 1 /*!; DROP TABLE issues */", wantErr: "executable comments"},
+		{query: "SELECT 'open", wantErr: "unterminated"},
+		{query: "  -- nothing\n", wantErr: "empty SQL statement"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got, err := parseSQLStatement(tt.query)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseSQLStatement(%q) error = %v, want %q", tt.query, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSQLStatement(%q): %v", tt.query, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseSQLStatement(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLReadSideEffects(t *testing.T) {
+	for query, want := range map[string]bool{
+		"SELECT * FROM dolt_log":                              false,
+		"SELECT * FROM dolt_diff('HEAD~1', 'HEAD', 'issues')": false,
+		"SELECT DOLT_RESET('--hard')":                         true,
+		"select dolt_commit ('-am', 'x')":                     true,
+		"SELECT id FROM issues INTO OUTFILE '/tmp/x'":         true,
+	} {
+		if got := sqlReadSideEffects.MatchString(query); got != want {
+			t.Errorf("sqlReadSideEffects.MatchString(%q) = %v, want %v", query, got, want)
+		}
+	}
+}
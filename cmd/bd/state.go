@@ -179,14 +179,9 @@ The --reason flag provides context for the event bead (recommended).`,
 		}
 
 		var eventID string
-		// Get next child ID for the event
-		childID, err := store.GetNextChildID(ctx, fullID)
-		if err != nil {
-			FatalErrorRespectJSON("generating child ID: %v", err)
-		}
-
+		// The event takes the next child ID of the issue
 		event := &types.Issue{
-			ID:          childID,
+			ChildOf:     fullID,
 			Title:       eventTitle,
 			Description: eventDesc,
 			Status:      types.StatusClosed, // Events are immediately closed
@@ -197,6 +192,7 @@ The --reason flag provides context for the event bead (recommended).`,
 		if err := store.CreateIssue(ctx, event, actor); err != nil {
 			FatalErrorRespectJSON("creating event: %v", err)
 		}
+		childID := event.ID
 
 		// Add parent-child dependency
 		dep := &types.Dependency{
@@ -2,8 +2,10 @@ package main
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/types"
 	"github.com/steveyegge/beads/internal/ui"
 )
@@ -12,6 +14,7 @@ import (
 type StatusOutput struct {
 	Summary        *types.Statistics      `json:"summary"`
 	RecentActivity *RecentActivitySummary `json:"recent_activity,omitempty"`
+	SLA            []SLABucket            `json:"sla,omitempty"`
 }
 
 // RecentActivitySummary represents activity from git history
@@ -50,11 +53,15 @@ Examples:
   bd status --no-activity      # Skip git activity (faster)
   bd status --json             # JSON format output
   bd status --assigned         # Show issues assigned to current user
+  bd stats --sla               # Count open issues breaching sla.by-priority
+  bd status --include-archived # Count issues moved out by bd archive as closed
   bd stats                     # Alias for bd status`,
 	Run: func(cmd *cobra.Command, args []string) {
 		showAll, _ := cmd.Flags().GetBool("all")
 		showAssigned, _ := cmd.Flags().GetBool("assigned")
 		noActivity, _ := cmd.Flags().GetBool("no-activity")
+		showSLA, _ := cmd.Flags().GetBool("sla")
+		includeArchived, _ := cmd.Flags().GetBool("include-archived")
 		jsonFormat, _ := cmd.Flags().GetBool("json")
 
 		// Override global jsonOutput if --json flag is set
@@ -82,15 +89,32 @@ Examples:
 			}
 		}
 
+		// Archived issues are all closed; fold them into the totals on request
+		if includeArchived && !showAssigned {
+			archived, err := store.CountArchivedIssues(ctx)
+			if err != nil {
+				FatalErrorRespectJSON("%v", err)
+			}
+			stats.ArchivedIssues = archived
+			stats.TotalIssues += archived
+			stats.ClosedIssues += archived
+		}
+
 		// Get recent activity from git history (last 24 hours) unless --no-activity
 		var recentActivity *RecentActivitySummary
 		if !noActivity {
 			recentActivity = getGitActivity(24)
 		}
 
+		var slaBuckets []SLABucket
+		if showSLA {
+			slaBuckets = getSLABuckets()
+		}
+
 		output := &StatusOutput{
 			Summary:        stats,
 			RecentActivity: recentActivity,
+			SLA:            slaBuckets,
 		}
 
 		// JSON output
@@ -110,13 +134,16 @@ Examples:
 		fmt.Printf("  Ready to Work:          %s\n", ui.RenderPass(fmt.Sprintf("%d", stats.ReadyIssues)))
 
 		// Extended statistics (only show if non-zero)
-		hasExtended := stats.PinnedIssues > 0 ||
+		hasExtended := stats.PinnedIssues > 0 || stats.ArchivedIssues > 0 ||
 			stats.EpicsEligibleForClosure > 0 || stats.AverageLeadTime > 0
 		if hasExtended {
 			fmt.Printf("\nExtended:\n")
 			if stats.PinnedIssues > 0 {
 				fmt.Printf("  Pinned:                 %d\n", stats.PinnedIssues)
 			}
+			if stats.ArchivedIssues > 0 {
+				fmt.Printf("  Archived (in Closed):   %d\n", stats.ArchivedIssues)
+			}
 			if stats.EpicsEligibleForClosure > 0 {
 				fmt.Printf("  Epics Ready to Close:   %s\n", ui.RenderPass(fmt.Sprintf("%d", stats.EpicsEligibleForClosure)))
 			}
@@ -135,6 +162,20 @@ Examples:
 			fmt.Printf("  Issues Updated:         %d\n", recentActivity.IssuesUpdated)
 		}
 
+		if showSLA {
+			fmt.Printf("\nSLA (age since created):\n")
+			for _, b := range slaBuckets {
+				label := fmt.Sprintf("P%d (> %s):", b.Priority, b.Threshold)
+				breaching := fmt.Sprintf("%d of %d open", b.Breaching, b.Open)
+				if b.Breaching > 0 {
+					breaching = ui.RenderFail(breaching)
+				} else {
+					breaching = ui.RenderPass(breaching)
+				}
+				fmt.Printf("  %-24s%s\n", label, breaching)
+			}
+		}
+
 		// Show hint for more details
 		fmt.Printf("\nFor more details, use 'bd list' to see individual issues.\n")
 		fmt.Println()
@@ -151,6 +192,23 @@ func getGitActivity(_ int) *RecentActivitySummary {
 	return nil
 }
 
+// getSLABuckets evaluates sla.by-priority against all non-closed issues.
+func getSLABuckets() []SLABucket {
+	slas, err := config.SLAByPriority()
+	if err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+	if len(slas) == 0 {
+		FatalErrorWithHint("no SLA configured",
+			"set sla.by-priority in .beads/config.yaml, e.g.:\n  sla:\n    by-priority:\n      critical: 1d\n      high: 3d")
+	}
+	issues, err := store.SearchIssues(rootCtx, "", types.IssueFilter{ExcludeStatus: []types.Status{types.StatusClosed}})
+	if err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+	return computeSLABuckets(issues, slas, time.Now())
+}
+
 // getAssignedStatistics returns statistics for issues assigned to a specific user
 func getAssignedStatistics(assignee string) *types.Statistics {
 	if store == nil {
@@ -206,6 +264,8 @@ func init() {
 	statusCmd.Flags().Bool("all", false, "Show all issues (default behavior)")
 	statusCmd.Flags().Bool("assigned", false, "Show issues assigned to current user")
 	statusCmd.Flags().Bool("no-activity", false, "Skip git activity tracking (faster)")
+	statusCmd.Flags().Bool("include-archived", false, "Include archived issues (see bd archive) in the total and closed counts")
+	statusCmd.Flags().Bool("sla", false, "Report open issues exceeding the per-priority age SLA (sla.by-priority)")
 	// Note: --json flag is defined as a persistent flag in main.go, not here
 	rootCmd.AddCommand(statusCmd)
 }
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var tagCmd = &cobra.Command{
+	Use:     "tag <name> [commit]",
+	GroupID: "sync",
+	Short:   "Tag a commit as a release or snapshot (requires Dolt backend)",
+	Long: `Create a Dolt tag at a commit, or HEAD if no commit is given.
+
+Tags mark points in the issue history such as "sprint-12-start" so the
+backlog can be compared across releases. Tag names work anywhere a ref
+is accepted, including 'bd list --as-of', 'bd show --as-of' and 'bd diff'.
+
+Tags are never moved: creating a tag whose name is already taken fails.
+Remove the old tag first with 'bd tag rm'.
+
+Examples:
+  bd tag sprint-12-start                 # Tag HEAD
+  bd tag v1.2 8f3k2a1 -m "1.2 release"   # Tag a specific commit
+  bd tag ls                              # List tags
+  bd tag rm sprint-12-start              # Delete a tag
+  bd list --as-of sprint-12-start        # Backlog as it was at the tag
+  bd diff sprint-12-start HEAD           # What changed since then`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("tag")
+		message, _ := cmd.Flags().GetString("message")
+		ref := ""
+		if len(args) == 2 {
+			ref = args[1]
+		}
+
+		tag, err := store.CreateTag(rootCtx, args[0], ref, message)
+		if err != nil {
+			FatalErrorRespectJSON("failed to create tag: %v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(tag)
+			return
+		}
+		fmt.Printf("Created tag %s at %s\n", ui.RenderAccent(tag.Name), ui.RenderMuted(shortHash(tag.Hash)))
+	},
+}
+
+var tagListCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List tags",
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		tags, err := store.ListTags(rootCtx)
+		if err != nil {
+			FatalErrorRespectJSON("failed to list tags: %v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(tags)
+			return
+		}
+		if len(tags) == 0 {
+			fmt.Println("No tags")
+			return
+		}
+
+		fmt.Printf("\n%s Tags:\n\n", ui.RenderAccent("🏷"))
+		for _, t := range tags {
+			fmt.Printf("  %s %s %s", ui.RenderAccent(t.Name), ui.RenderMuted(shortHash(t.Hash)), ui.RenderMuted(t.Date.Format("2006-01-02")))
+			if t.Message != "" {
+				fmt.Printf("  %s", t.Message)
+			}
+			fmt.Println()
+		}
+		fmt.Println()
+	},
+}
+
+var tagRemoveCmd = &cobra.Command{
+	Use:     "rm <name>",
+	Aliases: []string{"delete"},
+	Short:   "Delete a tag",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("tag rm")
+		if err := store.DeleteTag(rootCtx, args[0]); err != nil {
+			FatalErrorRespectJSON("failed to delete tag: %v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"deleted": args[0],
+			})
+			return
+		}
+		fmt.Printf("Deleted tag %s\n", ui.RenderAccent(args[0]))
+	},
+}
+
+func init() {
+	tagCmd.Flags().StringP("message", "m", "", "Tag message")
+	tagCmd.AddCommand(tagListCmd)
+	tagCmd.AddCommand(tagRemoveCmd)
+	rootCmd.AddCommand(tagCmd)
+}
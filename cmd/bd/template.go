@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/formula"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/storage/dolt"
@@ -198,7 +199,8 @@ func loadDescendants(ctx context.Context, s *dolt.DoltStore, subgraph *TemplateS
 // findHierarchicalChildren finds issues with IDs that match the pattern parentID.N
 // This catches hierarchical children that may be missing parent-child dependencies.
 func findHierarchicalChildren(ctx context.Context, s *dolt.DoltStore, parentID string) ([]*types.Issue, error) {
-	pattern := parentID + "."
+	h := s.IDHierarchy()
+	pattern := parentID + h.Separator()
 	candidates, err := s.SearchIssues(ctx, "", types.IssueFilter{IDPrefix: pattern})
 	if err != nil {
 		return nil, err
@@ -206,7 +208,7 @@ func findHierarchicalChildren(ctx context.Context, s *dolt.DoltStore, parentID s
 
 	var children []*types.Issue
 	for _, issue := range candidates {
-		_, directParentID, depth := types.ParseHierarchicalID(issue.ID)
+		_, directParentID, depth := h.Parse(issue.ID)
 		if depth > 0 && directParentID == parentID {
 			children = append(children, issue)
 		}
@@ -437,8 +439,8 @@ func generateBondedID(oldID string, rootID string, opts CloneOptions) (string, e
 // This ensures child IDs remain unique when bonding.
 func extractIDSuffix(id string) string {
 	// First try to get the part after the last dot (for hierarchical IDs)
-	if lastDot := strings.LastIndex(id, "."); lastDot >= 0 {
-		return id[lastDot+1:]
+	if lastSep := strings.LastIndex(id, config.GetIDHierarchy().Separator()); lastSep >= 0 {
+		return id[lastSep+1:]
 	}
 	// Otherwise, get the part after the last dash (for prefix-hash IDs)
 	if lastDash := strings.LastIndex(id, "-"); lastDash >= 0 {
@@ -456,7 +458,7 @@ func getRelativeID(oldID, rootID string) string {
 		return ""
 	}
 	// Check if oldID starts with rootID followed by a dot
-	prefix := rootID + "."
+	prefix := rootID + config.GetIDHierarchy().Separator()
 	if strings.HasPrefix(oldID, prefix) {
 		return oldID[len(prefix):]
 	}
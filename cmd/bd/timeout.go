@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+)
+
+// commandTimeoutFlag is --timeout. It only applies when set explicitly;
+// otherwise the command's timeouts.<name> config key (if any) is used.
+var commandTimeoutFlag time.Duration
+
+// timeoutCommands are the commands with a timeouts.<name> config key. They
+// talk to remotes or walk the whole database, so they are the ones that can
+// hang in automation. Keyed by command name, so 'bd dolt push' uses
+// timeouts.push.
+var timeoutCommands = []string{"push", "pull", "fetch", "gc", "export", "import"}
+
+// commandTimeoutError is the context cause when a command runs out of time.
+type commandTimeoutError struct {
+	command string // e.g. "bd dolt push"
+	timeout time.Duration
+	source  string // "--timeout" or the config key
+}
+
+func (e *commandTimeoutError) Error() string {
+	return fmt.Sprintf("%s timed out after %v (set by %s; use --timeout to override, 0 disables)", e.command, e.timeout, e.source)
+}
+
+// commandTimeout returns the timeout for cmd and where it came from, or 0
+// when the command has none.
+func commandTimeout(cmd *cobra.Command) (time.Duration, string) {
+	if f := cmd.Root().PersistentFlags().Lookup("timeout"); f != nil && f.Changed {
+		return commandTimeoutFlag, "--timeout"
+	}
+	for _, name := range timeoutCommands {
+		if cmd.Name() == name {
+			key := "timeouts." + name
+			return config.GetDuration(key), key
+		}
+	}
+	return 0, ""
+}
+
+// withCommandTimeout bounds ctx by cmd's timeout. The returned cancel must
+// be called; with no timeout it only releases the derived context.
+func withCommandTimeout(ctx context.Context, cmd *cobra.Command) (context.Context, context.CancelFunc) {
+	timeout, source := commandTimeout(cmd)
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	cause := &commandTimeoutError{command: cmd.CommandPath(), timeout: timeout, source: source}
+	return context.WithTimeoutCause(ctx, timeout, cause)
+}
+
+// commandTimedOut returns the timeout error if ctx ended because the command
+// ran out of time.
+func commandTimedOut(ctx context.Context) *commandTimeoutError {
+	if ctx == nil {
+		return nil
+	}
+	var timeoutErr *commandTimeoutError
+	if errors.As(context.Cause(ctx), &timeoutErr) {
+		return timeoutErr
+	}
+	return nil
+}
+
+// reportCommandTimeout names the command that timed out, so a bare "context
+// deadline exceeded" from deep in a push or export is not the last word.
+func reportCommandTimeout() {
+	if timeoutErr := commandTimedOut(rootCtx); timeoutErr != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", timeoutErr)
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+)
+
+// slowStore stands in for a store whose remote never answers.
+type slowStore struct{ delay time.Duration }
+
+func (s slowStore) Push(ctx context.Context) error {
+	select {
+	case <-time.After(s.delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// newTimeoutTestCommands builds 'bd dolt push' and 'bd list' with their own
+// --timeout flag, so tests don't touch rootCmd's parsed state.
+func newTimeoutTestCommands(t *testing.T) (push, list *cobra.Command) {
+	t.Helper()
+	config.ResetForTesting()
+	t.Cleanup(func() { config.ResetForTesting() })
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("config.Initialize: %v", err)
+	}
+	root := &cobra.Command{Use: "bd"}
+	root.PersistentFlags().DurationVar(&commandTimeoutFlag, "timeout", 0, "")
+	t.Cleanup(func() { commandTimeoutFlag = 0 })
+	dolt := &cobra.Command{Use: "dolt"}
+	push = &cobra.Command{Use: "push"}
+	list = &cobra.Command{Use: "list"}
+	dolt.AddCommand(push)
+	root.AddCommand(dolt, list)
+	return push, list
+}
+
+func TestWithCommandTimeout_FiresOnSlowStore(t *testing.T) {
+	push, _ := newTimeoutTestCommands(t)
+	config.Set("timeouts.push", "20ms")
+
+	ctx, cancel := withCommandTimeout(context.Background(), push)
+	defer cancel()
+
+	start := time.Now()
+	err := slowStore{delay: 5 * time.Second}.Push(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Push returned %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("timeout took %v to fire", elapsed)
+	}
+
+	timeoutErr := commandTimedOut(ctx)
+	if timeoutErr == nil {
+		t.Fatal("commandTimedOut returned nil after the deadline")
+	}
+	if msg := timeoutErr.Error(); !strings.HasPrefix(msg, "bd dolt push timed out after 20ms") || !strings.Contains(msg, "timeouts.push") {
+		t.Errorf("timeout error = %q, want it to name the command and config key", msg)
+	}
+}
+
+func TestCommandTimeout(t *testing.T) {
+	push, list := newTimeoutTestCommands(t)
+
+	if got, source := commandTimeout(push); got != 10*time.Minute || source != "timeouts.push" {
+		t.Errorf("push: got (%v, %q), want (10m, timeouts.push)", got, source)
+	}
+	if got, _ := commandTimeout(list); got != 0 {
+		t.Errorf("list: got %v, want no timeout", got)
+	}
+
+	// --timeout applies to every command and 0 turns the timeout off
+	if err := push.Root().PersistentFlags().Set("timeout", "0"); err != nil {
+		t.Fatalf("set --timeout: %v", err)
+	}
+	if got, source := commandTimeout(push); got != 0 || source != "--timeout" {
+		t.Errorf("push with --timeout 0: got (%v, %q), want (0, --timeout)", got, source)
+	}
+	ctx, cancel := withCommandTimeout(context.Background(), push)
+	defer cancel()
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("--timeout 0 should leave the context without a deadline")
+	}
+	if commandTimedOut(ctx) != nil {
+		t.Error("commandTimedOut reported a timeout on a live context")
+	}
+}
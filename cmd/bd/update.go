@@ -84,6 +84,9 @@ create, update, show, or close operation).`,
 			if title == "" {
 				FatalErrorRespectJSON("title cannot be empty")
 			}
+			if err := types.ValidateTitleLength(title); err != nil {
+				FatalErrorRespectJSON("%v", err)
+			}
 			updates["title"] = title
 		}
 		if cmd.Flags().Changed("assignee") {
@@ -274,6 +277,11 @@ create, update, show, or close operation).`,
 
 		ctx := rootCtx
 
+		if dryRun {
+			printDryRun(dryRunUpdate(ctx, args, updates, claimFlag))
+			return
+		}
+
 		updatedIssues := []*types.Issue{}
 		var firstUpdatedID string // Track first successful update for last-touched
 		for _, id := range args {
@@ -347,7 +355,7 @@ create, update, show, or close operation).`,
 				regularUpdates["notes"] = combined
 			}
 			if len(regularUpdates) > 0 {
-				if err := issueStore.UpdateIssue(ctx, result.ResolvedID, regularUpdates, actor); err != nil {
+				if _, err := libStore(issueStore).Update(ctx, result.ResolvedID, regularUpdates); err != nil {
 					fmt.Fprintf(os.Stderr, "Error updating %s: %v\n", id, err)
 					result.Close()
 					continue
@@ -564,6 +572,7 @@ func init() {
 	updateCmd.Flags().String("title", "", "New title")
 	updateCmd.Flags().StringP("type", "t", "", "New type (bug|feature|task|epic|chore|decision); custom types require types.custom config")
 	registerCommonIssueFlags(updateCmd)
+	registerCommitMessageFlag(updateCmd, "Dolt commit message for this update (default: generated)")
 	updateCmd.Flags().String("spec-id", "", "Link to specification document")
 	updateCmd.Flags().String("acceptance-criteria", "", "DEPRECATED: use --acceptance")
 	_ = updateCmd.Flags().MarkHidden("acceptance-criteria") // Only fails if flag missing (caught in tests)
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/export"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+var verifyCmd = &cobra.Command{
+	Use:     "verify --jsonl [file]",
+	GroupID: "sync",
+	Short:   "Check that the JSONL export and the database agree",
+	Long: `Compare the JSONL export against the Dolt database issue by issue.
+
+With --jsonl, reads the configured JSONL file (export.jsonl-path, default
+.beads/issues.jsonl) or the given file, and reports:
+  - issues only in the JSONL file
+  - issues only in the database
+  - issues present in both whose fields differ (title, status, labels, ...)
+
+The JSONL and the database drift apart when someone edits the JSONL by hand
+or an export was skipped. The exit status is 1 when they differ.
+
+With --fix, reconcile them using --prefer to pick the source of truth:
+  --prefer dolt    Rewrite the JSONL file from the database
+  --prefer jsonl   Upsert the JSONL issues into the database and delete
+                   database issues missing from the file, then commit
+
+Examples:
+  bd verify --jsonl
+  bd verify --jsonl --json
+  bd verify --jsonl --fix --prefer dolt
+  bd verify --jsonl backup.jsonl --fix --prefer jsonl`,
+	Args: cobra.MaximumNArgs(1),
+	Run:  runVerify,
+}
+
+var (
+	verifyJSONL  bool
+	verifyFix    bool
+	verifyPrefer string
+)
+
+func init() {
+	verifyCmd.Flags().BoolVar(&verifyJSONL, "jsonl", false, "Compare the JSONL export with the database")
+	verifyCmd.Flags().BoolVar(&verifyFix, "fix", false, "Reconcile differences (requires --prefer)")
+	verifyCmd.Flags().StringVar(&verifyPrefer, "prefer", "", "Source of truth for --fix: dolt or jsonl")
+	rootCmd.AddCommand(verifyCmd)
+}
+
+// jsonlFieldDiff lists the fields that differ for one issue.
+type jsonlFieldDiff struct {
+	ID     string   `json:"id"`
+	Fields []string `json:"fields"`
+}
+
+// jsonlDivergence is the result of comparing a JSONL file with the database.
+type jsonlDivergence struct {
+	OnlyInJSONL []string         `json:"only_in_jsonl"`
+	OnlyInDolt  []string         `json:"only_in_dolt"`
+	Mismatched  []jsonlFieldDiff `json:"mismatched"`
+}
+
+func (d jsonlDivergence) empty() bool {
+	return len(d.OnlyInJSONL) == 0 && len(d.OnlyInDolt) == 0 && len(d.Mismatched) == 0
+}
+
+func runVerify(cmd *cobra.Command, args []string) {
+	if !verifyJSONL {
+		FatalErrorWithHint("nothing to verify", "use 'bd verify --jsonl' to compare the JSONL export with the database")
+	}
+	if verifyFix && verifyPrefer != "dolt" && verifyPrefer != "jsonl" {
+		FatalErrorRespectJSON("--fix requires --prefer dolt or --prefer jsonl")
+	}
+	if !verifyFix && verifyPrefer != "" {
+		FatalErrorRespectJSON("--prefer is only used with --fix")
+	}
+	if verifyFix {
+		CheckReadonly("verify --fix")
+	}
+
+	ctx := rootCtx
+	var jsonlPath string
+	if len(args) > 0 {
+		jsonlPath = args[0]
+	} else {
+		beadsDir := beads.FindBeadsDir()
+		if beadsDir == "" {
+			FatalErrorRespectJSON("no .beads directory found — run 'bd init' first")
+		}
+		jsonlPath = config.JSONLPath(beadsDir)
+	}
+
+	fileIssues, err := readJSONLIssues(jsonlPath)
+	if err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+	dbIssues, depCounts, commentCounts, err := loadExportIssues(ctx, false, false, false)
+	if err != nil {
+		FatalErrorRespectJSON("%v", err)
+	}
+
+	div := compareJSONLIssues(fileIssues, dbIssues)
+	fixed := false
+	if verifyFix && !div.empty() {
+		switch verifyPrefer {
+		case "dolt":
+			err = rewriteJSONL(jsonlPath, dbIssues, depCounts, commentCounts)
+		case "jsonl":
+			err = applyJSONLToDolt(fileIssues, div.OnlyInDolt, jsonlPath)
+		}
+		if err != nil {
+			FatalErrorRespectJSON("reconcile failed: %v", err)
+		}
+		fixed = true
+	}
+
+	if jsonOutput {
+		outputJSON(map[string]interface{}{
+			"jsonl":         jsonlPath,
+			"in_sync":       div.empty(),
+			"only_in_jsonl": div.OnlyInJSONL,
+			"only_in_dolt":  div.OnlyInDolt,
+			"mismatched":    div.Mismatched,
+			"fixed":         fixed,
+			"prefer":        verifyPrefer,
+		})
+	} else {
+		printJSONLDivergence(jsonlPath, len(dbIssues), div, fixed)
+	}
+	if !div.empty() && !fixed {
+		os.Exit(1)
+	}
+}
+
+func printJSONLDivergence(jsonlPath string, dbCount int, div jsonlDivergence, fixed bool) {
+	if div.empty() {
+		fmt.Printf("%s %s matches the database (%d issues)\n", ui.RenderPass("✓"), jsonlPath, dbCount)
+		return
+	}
+
+	total := len(div.OnlyInJSONL) + len(div.OnlyInDolt) + len(div.Mismatched)
+	fmt.Printf("%s %s and the database differ (%d issue(s))\n", ui.RenderFail("✗"), jsonlPath, total)
+	if len(div.OnlyInJSONL) > 0 {
+		fmt.Printf("  Only in JSONL: %s\n", strings.Join(div.OnlyInJSONL, ", "))
+	}
+	if len(div.OnlyInDolt) > 0 {
+		fmt.Printf("  Only in Dolt:  %s\n", strings.Join(div.OnlyInDolt, ", "))
+	}
+	if len(div.Mismatched) > 0 {
+		fmt.Println("  Field mismatches:")
+		for _, m := range div.Mismatched {
+			fmt.Printf("    %s: %s\n", m.ID, strings.Join(m.Fields, ", "))
+		}
+	}
+
+	switch {
+	case fixed && verifyPrefer == "dolt":
+		fmt.Printf("\nRewrote %s from the database.\n", jsonlPath)
+	case fixed:
+		fmt.Printf("\nApplied %s to the database.\n", jsonlPath)
+	default:
+		fmt.Println(ui.RenderMuted("\nReconcile with 'bd verify --jsonl --fix --prefer dolt' (rewrite the file) or '--prefer jsonl' (update the database)."))
+	}
+}
+
+// compareJSONLIssues compares issues read from JSONL with the database's,
+// by ID. Returned ID lists are sorted.
+func compareJSONLIssues(fileIssues, dbIssues []*types.Issue) jsonlDivergence {
+	dbByID := make(map[string]*types.Issue, len(dbIssues))
+	for _, issue := range dbIssues {
+		dbByID[issue.ID] = issue
+	}
+
+	div := jsonlDivergence{OnlyInJSONL: []string{}, OnlyInDolt: []string{}, Mismatched: []jsonlFieldDiff{}}
+	seen := make(map[string]bool, len(fileIssues))
+	for _, fileIssue := range fileIssues {
+		seen[fileIssue.ID] = true
+		dbIssue, ok := dbByID[fileIssue.ID]
+		if !ok {
+			div.OnlyInJSONL = append(div.OnlyInJSONL, fileIssue.ID)
+			continue
+		}
+		if fields := issueFieldDiffs(fileIssue, dbIssue); len(fields) > 0 {
+			div.Mismatched = append(div.Mismatched, jsonlFieldDiff{ID: fileIssue.ID, Fields: fields})
+		}
+	}
+	for _, issue := range dbIssues {
+		if !seen[issue.ID] {
+			div.OnlyInDolt = append(div.OnlyInDolt, issue.ID)
+		}
+	}
+
+	sort.Strings(div.OnlyInJSONL)
+	sort.Strings(div.OnlyInDolt)
+	sort.Slice(div.Mismatched, func(i, j int) bool { return div.Mismatched[i].ID < div.Mismatched[j].ID })
+	return div
+}
+
+// issueFieldDiffs returns the JSON names of the user-visible fields that
+// differ between a and b. Timestamps are compared to the second, since the
+// JSONL round-trip does not preserve the database's precision.
+func issueFieldDiffs(a, b *types.Issue) []string {
+	var fields []string
+	diff := func(name string, differ bool) {
+		if differ {
+			fields = append(fields, name)
+		}
+	}
+	diff("title", a.Title != b.Title)
+	diff("description", a.Description != b.Description)
+	diff("design", a.Design != b.Design)
+	diff("acceptance_criteria", a.AcceptanceCriteria != b.AcceptanceCriteria)
+	diff("notes", a.Notes != b.Notes)
+	diff("status", a.Status != b.Status)
+	diff("priority", a.Priority != b.Priority)
+	diff("issue_type", a.IssueType != b.IssueType)
+	diff("assignee", a.Assignee != b.Assignee)
+	diff("owner", a.Owner != b.Owner)
+	diff("close_reason", a.CloseReason != b.CloseReason)
+	diff("closed_at", !sameTime(a.ClosedAt, b.ClosedAt))
+	diff("due_at", !sameTime(a.DueAt, b.DueAt))
+	diff("defer_until", !sameTime(a.DeferUntil, b.DeferUntil))
+	diff("external_ref", derefString(a.ExternalRef) != derefString(b.ExternalRef))
+	diff("labels", !slices.Equal(sortedCopy(a.Labels), sortedCopy(b.Labels)))
+	diff("dependencies", !slices.Equal(dependencyKeys(a.Dependencies), dependencyKeys(b.Dependencies)))
+	diff("meta", !maps.Equal(a.Meta, b.Meta))
+	diff("attachments", !slices.Equal(attachmentKeys(a.Attachments), attachmentKeys(b.Attachments)))
+	return fields
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Truncate(time.Second).Equal(b.Truncate(time.Second))
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func sortedCopy(ss []string) []string {
+	out := append([]string(nil), ss...)
+	sort.Strings(out)
+	return out
+}
+
+func dependencyKeys(deps []*types.Dependency) []string {
+	keys := make([]string, 0, len(deps))
+	for _, d := range deps {
+		keys = append(keys, string(d.Type)+":"+d.DependsOnID)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func attachmentKeys(attachments []*types.Attachment) []string {
+	keys := make([]string, 0, len(attachments))
+	for _, a := range attachments {
+		keys = append(keys, a.Name+"\x00"+a.Location+"\x00"+a.SHA256)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// rewriteJSONL replaces the JSONL file with the database's issues. It writes
+// a temp file next to path and renames it into place, so an interrupted
+// rewrite never leaves a truncated file behind.
+func rewriteJSONL(path string, issues []*types.Issue, depCounts map[string]*types.DependencyCounts, commentCounts map[string]int) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create JSONL directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, ".verify-tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	if _, err := export.WriteJSONL(tmp, issues, depCounts, commentCounts); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to sync temp file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to close temp file: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	return nil
+}
+
+// applyJSONLToDolt upserts the JSONL issues and deletes database issues the
+// file no longer has, in one transaction and one Dolt commit.
+func applyJSONLToDolt(fileIssues []*types.Issue, onlyInDolt []string, jsonlPath string) error {
+	msg := fmt.Sprintf("bd verify: applied %d issues from %s, deleted %d", len(fileIssues), filepath.Base(jsonlPath), len(onlyInDolt))
+	err := store.ReconcileIssues(rootCtx, fileIssues, onlyInDolt, getActorWithGit(), storage.BatchCreateOptions{
+		OrphanHandling:       storage.OrphanAllow,
+		SkipPrefixValidation: true,
+	}, msg)
+	if err != nil {
+		return err
+	}
+	commandDidWrite.Store(true)
+	return nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestCompareJSONLIssues(t *testing.T) {
+	closed := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	closedPrecise := closed.Add(400 * time.Millisecond)
+
+	fileIssues := []*types.Issue{
+		{ID: "bd-1", Title: "Same", Status: types.StatusClosed, ClosedAt: &closed, Labels: []string{"b", "a"}},
+		{ID: "bd-2", Title: "Edited by hand", Status: types.StatusOpen, Priority: 1},
+		{ID: "bd-4", Title: "Only in file"},
+	}
+	dbIssues := []*types.Issue{
+		{ID: "bd-1", Title: "Same", Status: types.StatusClosed, ClosedAt: &closedPrecise, Labels: []string{"a", "b"}},
+		{ID: "bd-2", Title: "Original", Status: types.StatusOpen, Priority: 2,
+			Dependencies: []*types.Dependency{{IssueID: "bd-2", DependsOnID: "bd-1", Type: types.DepBlocks}}},
+		{ID: "bd-3", Title: "Only in db"},
+	}
+
+	div := compareJSONLIssues(fileIssues, dbIssues)
+	if !slices.Equal(div.OnlyInJSONL, []string{"bd-4"}) {
+		t.Errorf("OnlyInJSONL = %v, want [bd-4]", div.OnlyInJSONL)
+	}
+	if !slices.Equal(div.OnlyInDolt, []string{"bd-3"}) {
+		t.Errorf("OnlyInDolt = %v, want [bd-3]", div.OnlyInDolt)
+	}
+	if len(div.Mismatched) != 1 || div.Mismatched[0].ID != "bd-2" {
+		t.Fatalf("Mismatched = %+v, want only bd-2", div.Mismatched)
+	}
+	if want := []string{"title", "priority", "dependencies"}; !slices.Equal(div.Mismatched[0].Fields, want) {
+		t.Errorf("bd-2 fields = %v, want %v", div.Mismatched[0].Fields, want)
+	}
+
+	if div := compareJSONLIssues(dbIssues[:1], dbIssues[:1]); !div.empty() {
+		t.Errorf("identical sets reported divergence: %+v", div)
+	}
+}
@@ -0,0 +1,374 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/debug"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// viewsFileName is the git-tracked file (in .beads/) that carries saved views
+// alongside the JSONL export so the team shares them.
+const viewsFileName = "views.jsonl"
+
+// viewNamePattern restricts view names to things that are safe to type as
+// 'bd <name>' and cannot be mistaken for flags.
+var viewNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// viewMeFlags are list flags whose value "me" is replaced by the current actor.
+var viewMeFlags = map[string]bool{"--assignee": true, "-a": true}
+
+// viewShorthand is set when a view is run as 'bd <name>' instead of
+// 'bd view run <name>', so a missing view reads as an unknown command.
+var viewShorthand bool
+
+var viewCmd = &cobra.Command{
+	Use:     "view [id...]",
+	GroupID: "views",
+	Short:   "Show issues, or save and run named list filters",
+	Long: `With issue IDs, 'bd view' is the same as 'bd show'.
+
+The subcommands manage saved views: named sets of 'bd list' filters that
+triagers would otherwise retype. Views are stored in the database and
+written to .beads/views.jsonl by 'bd export --jsonl' so they travel with
+the repo; 'bd import' loads them back.
+
+The value "me" for --assignee is resolved to the current actor each time
+the view runs, so one shared view works for everyone.
+
+Examples:
+  bd view save triage --status open --priority 0 --assignee me
+  bd view run triage
+  bd triage                       # Shorthand for 'bd view run triage'
+  bd triage --json                # Extra list flags are appended
+  bd view ls
+  bd view delete triage`,
+	Args:              cobra.ArbitraryArgs,
+	ValidArgsFunction: issueIDCompletion,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 && !cmd.Flags().Changed("id") && !cmd.Flags().Changed("current") {
+			_ = cmd.Help()
+			return
+		}
+		showCmd.Run(cmd, args)
+	},
+}
+
+var viewSaveCmd = &cobra.Command{
+	Use:   "save <name> [list flags...]",
+	Short: "Save list filters as a named view",
+	Long: `Save 'bd list' filter flags under a name. Saving an existing name
+replaces its filters.
+
+Examples:
+  bd view save triage --status open --priority 0 --assignee me
+  bd view save mine --assignee me --sort updated`,
+	DisableFlagParsing: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 || args[0] == "-h" || args[0] == "--help" {
+			_ = cmd.Help()
+			return
+		}
+		CheckReadonly("view save")
+		name, listArgs := args[0], args[1:]
+		if err := validateViewName(name); err != nil {
+			FatalError("%v", err)
+		}
+		if err := validateViewArgs(listArgs); err != nil {
+			FatalError("invalid filters for view %q: %v", name, err)
+		}
+		requireViewStore()
+
+		view := &types.SavedView{Name: name, Args: listArgs, CreatedBy: getActorWithGit()}
+		if err := store.SaveView(rootCtx, view); err != nil {
+			FatalError("%v", err)
+		}
+		commandDidWrite.Store(true)
+		fmt.Printf("%s Saved view %s: bd list %s\n", ui.RenderPass("✓"), name, strings.Join(listArgs, " "))
+	},
+}
+
+var viewRunCmd = &cobra.Command{
+	Use:   "run <name> [list flags...]",
+	Short: "Run a saved view",
+	Long: `Run a saved view as 'bd list' with its stored filters. Any extra flags are
+appended, so they can refine or override the saved ones.
+
+'bd <name>' is shorthand for 'bd view run <name>' when <name> is not a
+built-in command.`,
+	DisableFlagParsing: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 || args[0] == "-h" || args[0] == "--help" {
+			_ = cmd.Help()
+			return
+		}
+		requireViewStore()
+
+		name := args[0]
+		view, err := store.GetView(rootCtx, name)
+		if err != nil {
+			FatalError("%v", err)
+		}
+		if view == nil {
+			if viewShorthand {
+				FatalError("unknown command %q for %q\nRun '%s --help' for usage.", name, rootCmd.Name(), rootCmd.Name())
+			}
+			FatalErrorWithHint(fmt.Sprintf("no view named %q", name), "list saved views with: bd view ls")
+		}
+
+		listArgs := resolveViewArgs(append(append([]string{}, view.Args...), args[1:]...), getActorWithGit())
+		if err := listCmd.ParseFlags(listArgs); err != nil {
+			FatalError("view %q: %v", name, err)
+		}
+		positional := listCmd.Flags().Args()
+		if err := listCmd.ValidateArgs(positional); err != nil {
+			FatalError("view %q: %v", name, err)
+		}
+		listCmd.Run(listCmd, positional)
+	},
+}
+
+var viewLsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List saved views",
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		requireViewStore()
+		views, err := store.ListViews(rootCtx)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if jsonOutput {
+			if views == nil {
+				views = []*types.SavedView{}
+			}
+			outputJSON(views)
+			return
+		}
+		if len(views) == 0 {
+			fmt.Println("No saved views (create one with: bd view save <name> [list flags...])")
+			return
+		}
+		width := 0
+		for _, v := range views {
+			if len(v.Name) > width {
+				width = len(v.Name)
+			}
+		}
+		for _, v := range views {
+			fmt.Printf("%-*s  bd list %s\n", width, v.Name, strings.Join(v.Args, " "))
+		}
+	},
+}
+
+var viewDeleteCmd = &cobra.Command{
+	Use:     "delete <name>",
+	Aliases: []string{"rm"},
+	Short:   "Delete a saved view",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		CheckReadonly("view delete")
+		requireViewStore()
+		name := args[0]
+		view, err := store.GetView(rootCtx, name)
+		if err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		if view == nil {
+			FatalErrorRespectJSON("no view named %q", name)
+		}
+		if err := store.DeleteView(rootCtx, name); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+		commandDidWrite.Store(true)
+		if jsonOutput {
+			outputJSON(map[string]interface{}{"deleted": name})
+			return
+		}
+		fmt.Printf("%s Deleted view %s\n", ui.RenderPass("✓"), name)
+	},
+}
+
+func requireViewStore() {
+	if store == nil {
+		FatalErrorWithHint("database not initialized",
+			"run 'bd doctor' to diagnose, or 'bd init' to create a new database")
+	}
+}
+
+// validateViewName rejects names that are malformed or would be shadowed by
+// a built-in command when used as 'bd <name>'.
+func validateViewName(name string) error {
+	if !viewNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid view name %q: use letters, digits, '-' and '_'", name)
+	}
+	if c, _, err := rootCmd.Find([]string{name}); err == nil && c != rootCmd {
+		return fmt.Errorf("view name %q conflicts with the 'bd %s' command", name, c.Name())
+	}
+	return nil
+}
+
+// validateViewArgs checks that args parse as 'bd list' flags.
+func validateViewArgs(args []string) error {
+	if err := listCmd.ParseFlags(args); err != nil {
+		return err
+	}
+	return listCmd.ValidateArgs(listCmd.Flags().Args())
+}
+
+// resolveViewArgs replaces "me" for assignee flags with the current actor.
+func resolveViewArgs(args []string, currentActor string) []string {
+	out := make([]string, len(args))
+	copy(out, args)
+	for i, arg := range out {
+		if viewMeFlags[arg] && i+1 < len(out) && out[i+1] == "me" {
+			out[i+1] = currentActor
+			continue
+		}
+		if flag, value, ok := strings.Cut(arg, "="); ok && viewMeFlags[flag] && value == "me" {
+			out[i] = flag + "=" + currentActor
+		}
+	}
+	return out
+}
+
+// viewShorthandArgs rewrites 'bd <name> ...' to 'bd view run <name> ...' when
+// <name> is not a command and we're inside a beads repository.
+func viewShorthandArgs(args []string) ([]string, bool) {
+	if len(args) == 0 || !viewNamePattern.MatchString(args[0]) {
+		return args, false
+	}
+	// Cobra adds its completion commands lazily during Execute
+	switch args[0] {
+	case "completion", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return args, false
+	}
+	if c, _, err := rootCmd.Find(args); err == nil && c != rootCmd {
+		return args, false
+	}
+	if beads.FindBeadsDir() == "" {
+		return args, false
+	}
+	return append([]string{"view", "run"}, args...), true
+}
+
+// writeViewsJSONL writes all saved views to .beads/views.jsonl. The file is
+// only created once a view exists. Returns the number of views written.
+func writeViewsJSONL(ctx context.Context, beadsDir string) (int, error) {
+	views, err := store.ListViews(ctx)
+	if err != nil {
+		return 0, err
+	}
+	path := filepath.Join(beadsDir, viewsFileName)
+	if len(views) == 0 {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return 0, nil
+		}
+	}
+
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp) //nolint:gosec // path is inside .beads/
+	if err != nil {
+		return 0, fmt.Errorf("failed to create %s: %w", viewsFileName, err)
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, v := range views {
+		if err := enc.Encode(v); err != nil {
+			_ = f.Close()
+			_ = os.Remove(tmp)
+			return 0, fmt.Errorf("failed to write view %s: %w", v.Name, err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return 0, fmt.Errorf("failed to write %s: %w", viewsFileName, err)
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return 0, fmt.Errorf("failed to close %s: %w", viewsFileName, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return 0, fmt.Errorf("failed to replace %s: %w", viewsFileName, err)
+	}
+	return len(views), nil
+}
+
+// exportSavedViews writes views.jsonl next to the repo's JSONL export.
+// Failures are warnings: the issue export itself already succeeded.
+func exportSavedViews(ctx context.Context) {
+	beadsDir := beads.FindBeadsDir()
+	if beadsDir == "" || store == nil {
+		return
+	}
+	n, err := writeViewsJSONL(ctx, beadsDir)
+	if err != nil {
+		debug.Warnf("failed to export saved views: %v\n", err)
+		return
+	}
+	if n > 0 {
+		fmt.Fprintf(os.Stderr, "Exported %d view(s) to %s\n", n, filepath.Join(beadsDir, viewsFileName))
+	}
+}
+
+// importViewsJSONL loads .beads/views.jsonl (if present) into the views table,
+// replacing views with the same name. Returns the number of views imported.
+func importViewsJSONL(ctx context.Context, beadsDir string) (int, error) {
+	path := filepath.Join(beadsDir, viewsFileName)
+	f, err := os.Open(path) //nolint:gosec // path is inside .beads/
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to open %s: %w", viewsFileName, err)
+	}
+	defer f.Close()
+
+	count := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var view types.SavedView
+		if err := json.Unmarshal([]byte(line), &view); err != nil {
+			return count, fmt.Errorf("failed to parse view from %s: %w", viewsFileName, err)
+		}
+		if err := validateViewName(view.Name); err != nil {
+			debug.Warnf("skipping view: %v\n", err)
+			continue
+		}
+		if err := store.SaveView(ctx, &view); err != nil {
+			return count, err
+		}
+		count++
+	}
+	if err := scanner.Err(); err != nil {
+		return count, fmt.Errorf("failed to read %s: %w", viewsFileName, err)
+	}
+	return count, nil
+}
+
+func init() {
+	// 'bd view <id>' keeps working as an alias for 'bd show'
+	viewCmd.Flags().AddFlagSet(showCmd.Flags())
+
+	viewCmd.AddCommand(viewSaveCmd)
+	viewCmd.AddCommand(viewRunCmd)
+	viewCmd.AddCommand(viewLsCmd)
+	viewCmd.AddCommand(viewDeleteCmd)
+	rootCmd.AddCommand(viewCmd)
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolveViewArgs(t *testing.T) {
+	args := []string{"--status", "open", "--assignee", "me", "-a=me", "--title", "me"}
+	got := resolveViewArgs(args, "alice")
+	want := []string{"--status", "open", "--assignee", "alice", "-a=alice", "--title", "me"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("resolveViewArgs = %v, want %v", got, want)
+	}
+	if args[3] != "me" {
+		t.Errorf("resolveViewArgs modified its input: %v", args)
+	}
+}
+
+func TestValidateViewName(t *testing.T) {
+	for _, name := range []string{"triage", "my-bugs", "p0_open"} {
+		if err := validateViewName(name); err != nil {
+			t.Errorf("validateViewName(%q) = %v, want nil", name, err)
+		}
+	}
+	for _, name := range []string{"", "-x", "has space", "a/b", "list", "show"} {
+		if err := validateViewName(name); err == nil {
+			t.Errorf("validateViewName(%q) = nil, want error", name)
+		}
+	}
+}
+
+func TestViewShorthandArgs_KnownCommands(t *testing.T) {
+	for _, args := range [][]string{
+		{"list", "--status", "open"},
+		{"--json", "triage"},
+		{"__complete", "li"},
+		{"completion", "bash"},
+		{},
+	} {
+		if got, ok := viewShorthandArgs(args); ok {
+			t.Errorf("viewShorthandArgs(%v) rewrote to %v", args, got)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/debug"
+	"github.com/steveyegge/beads/internal/hooks"
+	"github.com/steveyegge/beads/internal/types"
+	"github.com/steveyegge/beads/internal/ui"
+)
+
+// webhookConfigKeys maps hook events to the config key holding their webhook URL.
+var webhookConfigKeys = map[string]string{
+	hooks.EventCreate: "hooks.on-create",
+	hooks.EventClose:  "hooks.on-close",
+}
+
+// configureWebhooks registers webhook URLs from config on the hook runner.
+func configureWebhooks(r *hooks.Runner) {
+	if r == nil {
+		return
+	}
+	for event, key := range webhookConfigKeys {
+		r.SetWebhook(event, config.GetString(key))
+	}
+	if timeout := config.GetDuration("hooks.timeout"); timeout > 0 {
+		r.SetWebhookTimeout(timeout)
+	}
+}
+
+// webhookExitGrace bounds how long a command's exit waits for in-flight
+// webhook deliveries. It is deliberately much shorter than hooks.timeout so a
+// slow endpoint never holds up the command.
+const webhookExitGrace = 500 * time.Millisecond
+
+// flushWebhooks waits up to webhookExitGrace for in-flight webhook deliveries
+// so fast ones aren't cut off by process exit, and reports failures.
+func flushWebhooks() {
+	if hookRunner == nil {
+		return
+	}
+	for _, err := range hookRunner.WaitWebhooks(webhookExitGrace) {
+		debug.Warnf("%v\n", err)
+	}
+}
+
+var hooksTestCmd = &cobra.Command{
+	Use:   "test <event>",
+	Short: "Send a sample webhook payload for an event",
+	Long: `Send a sample payload to the webhook configured for an event.
+
+Webhooks are configured in config.yaml:
+  hooks:
+    on-create: https://example.com/beads/create
+    on-close: https://example.com/beads/close
+    timeout: 5s
+
+After a successful commit, bd POSTs a JSON payload with the issue ID,
+old/new status, and actor. Delivery is asynchronous and never blocks the
+command: bd waits at most 500ms for it before exiting, and failures are
+reported as warnings (suppressed by --quiet).
+
+Examples:
+  bd hooks test close
+  bd hooks test create --url http://localhost:9000/hook`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		event := args[0]
+		key, ok := webhookConfigKeys[event]
+		if !ok {
+			FatalErrorRespectJSON("unknown webhook event %q (valid: %s, %s)", event, hooks.EventCreate, hooks.EventClose)
+		}
+
+		url, _ := cmd.Flags().GetString("url")
+		if url == "" {
+			url = config.GetString(key)
+		}
+		if url == "" {
+			FatalErrorWithHint(fmt.Sprintf("no webhook configured for %s", event),
+				fmt.Sprintf("set it with: bd config set %s <url>", key))
+		}
+
+		sample := &types.Issue{ID: "bd-sample", Title: "Sample webhook payload", Status: types.StatusOpen}
+		oldStatus := types.Status("")
+		if event == hooks.EventClose {
+			sample.Status = types.StatusClosed
+			oldStatus = types.StatusOpen
+		}
+
+		runner := hooks.NewRunner("")
+		timeout := config.GetDuration("hooks.timeout")
+		if timeout <= 0 {
+			timeout = hooks.DefaultWebhookTimeout
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		start := time.Now()
+		payload := hooks.NewWebhookPayload(event, sample, oldStatus, getActorWithGit())
+		if err := runner.PostWebhook(ctx, url, payload); err != nil {
+			FatalErrorRespectJSON("%v", err)
+		}
+
+		if jsonOutput {
+			outputJSON(map[string]interface{}{
+				"event":       event,
+				"url":         url,
+				"delivered":   true,
+				"duration_ms": time.Since(start).Milliseconds(),
+			})
+			return
+		}
+		fmt.Printf("%s Delivered sample %s payload to %s (%s)\n",
+			ui.RenderPass("✓"), event, url, time.Since(start).Round(time.Millisecond))
+	},
+}
+
+func init() {
+	hooksTestCmd.Flags().String("url", "", "Send to this URL instead of the configured one")
+	hooksCmd.AddCommand(hooksTestCmd)
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AutoArchiveAfter returns close.auto-archive-after: how long a closed issue
+// stays in the active issues table before 'bd archive --run' may move it to
+// issues_archive. Values are Go durations or whole days/weeks ("30d", "2w").
+// Zero means auto-archiving is off. Example config.yaml:
+//
+//	close:
+//	  auto-archive-after: 30d
+func AutoArchiveAfter() (time.Duration, error) {
+	return parseAutoArchiveAfter(GetString("close.auto-archive-after"))
+}
+
+func parseAutoArchiveAfter(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "0" {
+		return 0, nil
+	}
+	d, err := ParseSLADuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("close.auto-archive-after: %w", err)
+	}
+	return d, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/spf13/viper"
 	"github.com/steveyegge/beads/internal/debug"
+	"github.com/steveyegge/beads/internal/types"
 	"gopkg.in/yaml.v3"
 )
 
@@ -22,6 +23,18 @@ var overriddenKeys = map[string]bool{}
 // Initialize sets up the viper configuration singleton
 // Should be called once at application startup
 func Initialize() error {
+	return initialize("")
+}
+
+// InitializeFromDir is Initialize for the workspace at beadsDir instead of
+// the one found from the working directory or $BEADS_DIR: its config.yaml
+// and config.local.yaml are the project layer, above the user-level files.
+// Used by the beads library, whose callers open a workspace by path.
+func InitializeFromDir(beadsDir string) error {
+	return initialize(beadsDir)
+}
+
+func initialize(beadsDir string) error {
 	v = viper.New()
 
 	// Set config type to yaml (we only load config.yaml, not config.json)
@@ -39,12 +52,14 @@ func Initialize() error {
 	// config existed — e.g., the idle-monitor daemon with BEADS_DIR set (GH#2375).
 	var configPaths []string     // ordered lowest priority first
 	var primaryConfigPath string // project-level config (for config.local.yaml and SaveConfigValue)
+	layers = nil
 
 	// 3. Legacy: ~/.beads/config.yaml (lowest priority)
 	if homeDir, err := os.UserHomeDir(); err == nil {
 		p := filepath.Join(homeDir, ".beads", "config.yaml")
 		if _, err := os.Stat(p); err == nil {
 			configPaths = append(configPaths, p)
+			layers = append(layers, ConfigLayer{Scope: ScopeUserLegacy, Path: p})
 		}
 	}
 
@@ -53,9 +68,84 @@ func Initialize() error {
 		p := filepath.Join(configDir, "bd", "config.yaml")
 		if _, err := os.Stat(p); err == nil {
 			configPaths = append(configPaths, p)
+			layers = append(layers, ConfigLayer{Scope: ScopeUser, Path: p})
 		}
 	}
 
+	if beadsDir != "" {
+		// 1. Project: the workspace the caller named. It replaces the CWD
+		// walk and BEADS_DIR, which may point at a different workspace.
+		p := filepath.Join(beadsDir, "config.yaml")
+		if _, err := os.Stat(p); err == nil {
+			configPaths = append(configPaths, p)
+			layers = append(layers, ConfigLayer{Scope: ScopeRepo, Path: p})
+			primaryConfigPath = p
+		}
+	} else {
+		configPaths, primaryConfigPath = findProjectConfigs(configPaths)
+	}
+
+	// Automatic environment variable binding
+	// Environment variables take precedence over config file
+	// E.g., BD_JSON, BD_NO_DAEMON, BD_ACTOR, BD_DB
+	v.SetEnvPrefix("BD")
+
+	// Replace hyphens and dots with underscores for env var mapping
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
+	v.AutomaticEnv()
+
+	setDefaults(v)
+
+	// Additional environment variables (not prefixed with BD_)
+	_ = v.BindEnv("identity", "BEADS_IDENTITY") // BindEnv only fails with zero args, which can't happen here
+
+	// Load config files: lowest priority first, each MergeInConfig overwrites
+	if len(configPaths) > 0 {
+		v.SetConfigFile(configPaths[0])
+		if err := v.ReadInConfig(); err != nil {
+			return fmt.Errorf("error reading config file: %w", err)
+		}
+		debug.Logf("Debug: loaded config from %s\n", configPaths[0])
+
+		for _, p := range configPaths[1:] {
+			v.SetConfigFile(p)
+			if err := v.MergeInConfig(); err != nil {
+				return fmt.Errorf("error merging config file %s: %w", p, err)
+			}
+			debug.Logf("Debug: merged config from %s\n", p)
+		}
+
+		// Restore primary config path as ConfigFileUsed (used by SaveConfigValue,
+		// ResolveExternalProjectPath, etc.)
+		v.SetConfigFile(primaryConfigPath)
+
+		// Merge local config overrides if present (config.local.yaml)
+		// This allows machine-specific settings without polluting tracked config
+		localConfigPath := filepath.Join(filepath.Dir(primaryConfigPath), "config.local.yaml")
+		if _, err := os.Stat(localConfigPath); err == nil {
+			v.SetConfigFile(localConfigPath)
+			if err := v.MergeInConfig(); err != nil {
+				return fmt.Errorf("error merging local config file: %w", err)
+			}
+			layers = append(layers, ConfigLayer{Scope: ScopeLocal, Path: localConfigPath})
+			debug.Logf("Debug: merged local config from %s\n", localConfigPath)
+			// Restore primary as ConfigFileUsed
+			v.SetConfigFile(primaryConfigPath)
+		}
+	} else {
+		// No config.yaml found - use defaults and environment variables
+		debug.Logf("Debug: no config.yaml found; using defaults and environment variables\n")
+	}
+
+	return nil
+}
+
+// findProjectConfigs appends the project config.yaml found by walking up
+// from the working directory and, above it, $BEADS_DIR/config.yaml to
+// configPaths. Returns the paths and the primary (highest priority) one.
+func findProjectConfigs(configPaths []string) ([]string, string) {
+	var primaryConfigPath string
+
 	// 1. Project: walk up from CWD to find .beads/config.yaml
 	cwd, err := os.Getwd()
 	if err == nil {
@@ -90,6 +180,7 @@ func Initialize() error {
 					}
 				}
 				configPaths = append(configPaths, p)
+				layers = append(layers, ConfigLayer{Scope: ScopeRepo, Path: p})
 				primaryConfigPath = p
 				break
 			}
@@ -103,139 +194,150 @@ func Initialize() error {
 			// Avoid duplicate if BEADS_DIR points to same config as CWD walk
 			if primaryConfigPath == "" || filepath.Clean(p) != filepath.Clean(primaryConfigPath) {
 				configPaths = append(configPaths, p)
+				layers = append(layers, ConfigLayer{Scope: ScopeBeadsDir, Path: p})
 			}
 			primaryConfigPath = p
 		}
 	}
 
-	// Automatic environment variable binding
-	// Environment variables take precedence over config file
-	// E.g., BD_JSON, BD_NO_DAEMON, BD_ACTOR, BD_DB
-	v.SetEnvPrefix("BD")
-
-	// Replace hyphens and dots with underscores for env var mapping
-	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
-	v.AutomaticEnv()
+	return configPaths, primaryConfigPath
+}
 
+// setDefaults registers the default value of every known key on cv.
+func setDefaults(cv *viper.Viper) {
 	// Set defaults for all flags
-	v.SetDefault("json", false)
-	v.SetDefault("events-export", false)
-	v.SetDefault("no-db", false)
-	v.SetDefault("db", "")
-	v.SetDefault("actor", "")
-	v.SetDefault("issue-prefix", "")
-	// Additional environment variables (not prefixed with BD_)
-	_ = v.BindEnv("identity", "BEADS_IDENTITY") // BindEnv only fails with zero args, which can't happen here
-	v.SetDefault("identity", "")
+	cv.SetDefault("json", false)
+	cv.SetDefault("events-export", false)
+	cv.SetDefault("no-db", false)
+	cv.SetDefault("db", "")
+	cv.SetDefault("actor", "")
+	cv.SetDefault("issue-prefix", "")
+	cv.SetDefault("identity", "")
 
 	// Dolt configuration defaults
 	// Controls whether beads should automatically create Dolt commits after write commands.
 	// Values: off | on
-	v.SetDefault("dolt.auto-commit", "on")
+	cv.SetDefault("dolt.auto-commit", "on")
+	// Squash unpushed auto-commits into one on `bd dolt push`.
+	cv.SetDefault("dolt.squash-on-push", false)
 
 	// Routing configuration defaults
-	v.SetDefault("routing.mode", "")
-	v.SetDefault("routing.default", ".")
-	v.SetDefault("routing.maintainer", ".")
-	v.SetDefault("routing.contributor", "~/.beads-planning")
+	cv.SetDefault("routing.mode", "")
+	cv.SetDefault("routing.default", ".")
+	cv.SetDefault("routing.maintainer", ".")
+	cv.SetDefault("routing.contributor", "~/.beads-planning")
 
 	// Sync configuration defaults (bd-4u8)
-	v.SetDefault("sync.require_confirmation_on_mass_delete", false)
+	cv.SetDefault("sync.require_confirmation_on_mass_delete", false)
 
 	// Federation configuration (optional Dolt remote)
-	v.SetDefault("federation.remote", "")      // e.g., dolthub://org/beads, gs://bucket/beads, s3://bucket/beads, http://host:port/db
-	v.SetDefault("federation.name", "")        // Remote name (default: "origin"). Use "central" to prevent auto-push.
-	v.SetDefault("federation.sovereignty", "") // T1 | T2 | T3 | T4 (empty = no restriction)
+	cv.SetDefault("federation.remote", "")      // e.g., dolthub://org/beads, gs://bucket/beads, s3://bucket/beads, http://host:port/db
+	cv.SetDefault("federation.name", "")        // Remote name (default: "origin"). Use "central" to prevent auto-push.
+	cv.SetDefault("federation.sovereignty", "") // T1 | T2 | T3 | T4 (empty = no restriction)
 
 	// Push configuration defaults
-	v.SetDefault("no-push", false)
+	cv.SetDefault("no-push", false)
+	cv.SetDefault("default.remote", "") // Dolt remote used by push/pull/auto-push (empty = "origin")
+	cv.SetDefault("push.remote", "")    // Overrides default.remote for bd dolt push and auto-push
+	cv.SetDefault("push.branch", "")    // Remote branch for bd dolt push and auto-push (empty = current branch)
 
 	// Create command defaults
-	v.SetDefault("create.require-description", false)
+	cv.SetDefault("create.require-description", false)
+	// Title for placeholder parents made by `bd create --create-parent`.
+	// {id} is the placeholder's ID, {child} the ID that required it.
+	cv.SetDefault("create.parent-title-template", "Placeholder parent for {child}")
+	// Applied by `bd create` when the matching flag is not given; empty = built-in default.
+	cv.SetDefault("create.default-priority", "") // 0-4 or P0-P4
+	cv.SetDefault("create.default-status", "")   // Any status but closed
+	cv.SetDefault("create.default-labels", []string{})
+	// How long `bd create --idempotency-key` remembers a key.
+	cv.SetDefault("create.idempotency-ttl", "24h")
+
+	// Archive defaults
+	// Count issues moved to issues_archive by `bd archive` as existing when
+	// checking for orphaned dependencies, so doctor neither flags nor removes them.
+	cv.SetDefault("archive.resolve-references", true)
+
+	// Close defaults
+	// How long a closed issue stays in the active table before
+	// `bd archive --run` may move it to issues_archive. Empty = never.
+	cv.SetDefault("close.auto-archive-after", "")
+
+	// Export configuration defaults
+	// JSONL export path, relative to .beads/ or absolute. Empty = issues.jsonl.
+	cv.SetDefault("export.jsonl-path", "")
+
+	// List command defaults
+	// Comma-separated columns for bd list (e.g., "id,status,priority,title").
+	// Empty means the default tree/compact output.
+	cv.SetDefault("list.columns", "")
+
+	// SLA configuration defaults
+	// Maximum open-issue age per priority (e.g., critical: 1d, high: 3d).
+	// Empty means no SLA; see SLAByPriority.
+	cv.SetDefault("sla.by-priority", map[string]string{})
 
 	// Validation configuration defaults (bd-t7jq)
 	// Values: "warn" | "error" | "none"
 	// - "none": no validation (default, backwards compatible)
 	// - "warn": validate and print warnings but proceed
 	// - "error": validate and fail on missing sections
-	v.SetDefault("validation.on-create", "none")
-	v.SetDefault("validation.on-sync", "none")
+	cv.SetDefault("validation.on-create", "none")
+	cv.SetDefault("validation.on-sync", "none")
 
 	// Metadata schema validation (GH#1416 Phase 2)
 	// - "none": no metadata schema validation (default)
 	// - "warn": validate and print warnings but proceed
 	// - "error": validate and reject invalid metadata
-	v.SetDefault("validation.metadata.mode", "none")
+	cv.SetDefault("validation.metadata.mode", "none")
 
 	// Hierarchy configuration defaults (GH#995)
 	// Maximum nesting depth for hierarchical IDs (e.g., bd-abc.1.2.3)
 	// Default matches types.MaxHierarchyDepth constant
-	v.SetDefault("hierarchy.max-depth", 3)
+	cv.SetDefault("hierarchy.max-depth", 3)
+	// Separator between a parent ID and a child number (bd-abc.1)
+	// Default matches types.DefaultHierarchySeparator constant
+	cv.SetDefault("hierarchy.separator", ".")
 
 	// Git configuration defaults (GH#600)
-	v.SetDefault("git.author", "")         // Override commit author (e.g., "beads-bot <beads@example.com>")
-	v.SetDefault("git.no-gpg-sign", false) // Disable GPG signing for beads commits
+	cv.SetDefault("git.author", "")         // Override commit author (e.g., "beads-bot <beads@example.com>")
+	cv.SetDefault("git.no-gpg-sign", false) // Disable GPG signing for beads commits
 
 	// Directory-aware label scoping (GH#541)
 	// Maps directory patterns to labels for automatic filtering in monorepos
-	v.SetDefault("directory.labels", map[string]string{})
+	cv.SetDefault("directory.labels", map[string]string{})
 
 	// Backup configuration defaults (JSONL export to .beads/backup/)
-	v.SetDefault("backup.enabled", false)
-	v.SetDefault("backup.interval", "15m")
-	v.SetDefault("backup.git-push", false)
-	v.SetDefault("backup.git-repo", "")
+	cv.SetDefault("backup.enabled", false)
+	cv.SetDefault("backup.interval", "15m")
+	cv.SetDefault("backup.git-push", false)
+	cv.SetDefault("backup.git-repo", "")
+
+	// Webhook configuration defaults
+	// URLs POSTed to after a successful create/close commit (empty = disabled)
+	cv.SetDefault("hooks.on-create", "")
+	cv.SetDefault("hooks.on-close", "")
+	cv.SetDefault("hooks.timeout", "5s")
+
+	// Per-command timeouts (see --timeout); "0" disables
+	cv.SetDefault("timeouts.push", "10m")
+	cv.SetDefault("timeouts.pull", "10m")
+	cv.SetDefault("timeouts.fetch", "10m")
+	cv.SetDefault("timeouts.gc", "30m")
+	cv.SetDefault("timeouts.export", "10m")
+	cv.SetDefault("timeouts.import", "10m")
 
 	// AI configuration defaults
-	v.SetDefault("ai.model", "claude-haiku-4-5-20251001")
+	cv.SetDefault("ai.model", "claude-haiku-4-5-20251001")
 
 	// Output configuration (GH#1384)
 	// Controls title display in command feedback messages.
 	// 0 = hide title, N > 0 = truncate to N chars with "…"
-	v.SetDefault("output.title-length", 255)
+	cv.SetDefault("output.title-length", 255)
 
 	// External projects for cross-project dependency resolution (bd-h807)
 	// Maps project names to paths for resolving external: blocked_by references
-	v.SetDefault("external_projects", map[string]string{})
-
-	// Load config files: lowest priority first, each MergeInConfig overwrites
-	if len(configPaths) > 0 {
-		v.SetConfigFile(configPaths[0])
-		if err := v.ReadInConfig(); err != nil {
-			return fmt.Errorf("error reading config file: %w", err)
-		}
-		debug.Logf("Debug: loaded config from %s\n", configPaths[0])
-
-		for _, p := range configPaths[1:] {
-			v.SetConfigFile(p)
-			if err := v.MergeInConfig(); err != nil {
-				return fmt.Errorf("error merging config file %s: %w", p, err)
-			}
-			debug.Logf("Debug: merged config from %s\n", p)
-		}
-
-		// Restore primary config path as ConfigFileUsed (used by SaveConfigValue,
-		// ResolveExternalProjectPath, etc.)
-		v.SetConfigFile(primaryConfigPath)
-
-		// Merge local config overrides if present (config.local.yaml)
-		// This allows machine-specific settings without polluting tracked config
-		localConfigPath := filepath.Join(filepath.Dir(primaryConfigPath), "config.local.yaml")
-		if _, err := os.Stat(localConfigPath); err == nil {
-			v.SetConfigFile(localConfigPath)
-			if err := v.MergeInConfig(); err != nil {
-				return fmt.Errorf("error merging local config file: %w", err)
-			}
-			debug.Logf("Debug: merged local config from %s\n", localConfigPath)
-			// Restore primary as ConfigFileUsed
-			v.SetConfigFile(primaryConfigPath)
-		}
-	} else {
-		// No config.yaml found - use defaults and environment variables
-		debug.Logf("Debug: no config.yaml found; using defaults and environment variables\n")
-	}
-
-	return nil
+	cv.SetDefault("external_projects", map[string]string{})
 }
 
 // ResetForTesting clears the config state, allowing Initialize() to be called again.
@@ -244,6 +346,7 @@ func Initialize() error {
 func ResetForTesting() {
 	v = nil
 	overriddenKeys = map[string]bool{}
+	layers = nil
 }
 
 // ConfigSource represents where a configuration value came from
@@ -581,6 +684,33 @@ func GetStringMapString(key string) map[string]string {
 	return v.GetStringMapString(key)
 }
 
+// DefaultJSONLFile is the JSONL export filename used when export.jsonl-path is unset.
+const DefaultJSONLFile = "issues.jsonl"
+
+// JSONLPath returns the JSONL export path for a beads directory, from the
+// export.jsonl-path config key. Relative values are resolved against
+// beadsDir; absolute values are used as-is. Defaults to <beadsDir>/issues.jsonl.
+func JSONLPath(beadsDir string) string {
+	return resolveJSONLPath(beadsDir, GetString("export.jsonl-path"))
+}
+
+// JSONLPathFromDir is JSONLPath for another workspace: export.jsonl-path is
+// read from <beadsDir>/config.yaml rather than the loaded configuration.
+func JSONLPathFromDir(beadsDir string) string {
+	return resolveJSONLPath(beadsDir, GetStringFromDir(beadsDir, "export.jsonl-path"))
+}
+
+func resolveJSONLPath(beadsDir, configured string) string {
+	p := strings.TrimSpace(configured)
+	if p == "" {
+		return filepath.Join(beadsDir, DefaultJSONLFile)
+	}
+	if filepath.IsAbs(p) {
+		return filepath.Clean(p)
+	}
+	return filepath.Join(beadsDir, p)
+}
+
 // GetDirectoryLabels returns labels for the current working directory based on config.
 // It checks directory.labels config for matching patterns.
 // Returns nil if no labels are configured for the current directory.
@@ -611,6 +741,27 @@ func GetDirectoryLabels() []string {
 	return nil
 }
 
+// GetIDHierarchy returns the hierarchical ID scheme for hierarchy.separator.
+// An invalid separator is rejected at startup, so it falls back to the
+// default here.
+func GetIDHierarchy() types.IDHierarchy {
+	h, err := types.NewIDHierarchy(GetString("hierarchy.separator"))
+	if err != nil {
+		return types.IDHierarchy{}
+	}
+	return h
+}
+
+// GetIDHierarchyFromDir is GetIDHierarchy for <beadsDir>/config.yaml, read
+// without global viper state (see GetStringFromDir).
+func GetIDHierarchyFromDir(beadsDir string) types.IDHierarchy {
+	h, err := types.NewIDHierarchy(GetStringFromDir(beadsDir, "hierarchy.separator"))
+	if err != nil {
+		return types.IDHierarchy{}
+	}
+	return h
+}
+
 // MultiRepoConfig contains configuration for multi-repo support
 type MultiRepoConfig struct {
 	Primary    string   // Primary repo path (where canonical issues live)
@@ -163,6 +163,45 @@ actor: configuser
 	}
 }
 
+func TestInitializeFromDir(t *testing.T) {
+	// Isolate from environment variables
+	restore := envSnapshot(t)
+	defer restore()
+
+	// The caller's working directory has its own workspace
+	cwdDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(cwdDir, ".beads"), 0750); err != nil {
+		t.Fatalf("failed to create .beads directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(cwdDir, ".beads", "config.yaml"), []byte("actor: cwduser\n"), 0600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	t.Chdir(cwdDir)
+
+	beadsDir := filepath.Join(t.TempDir(), ".beads")
+	if err := os.MkdirAll(beadsDir, 0750); err != nil {
+		t.Fatalf("failed to create .beads directory: %v", err)
+	}
+	configContent := `
+actor: diruser
+close:
+  auto-archive-after: 30d
+`
+	if err := os.WriteFile(filepath.Join(beadsDir, "config.yaml"), []byte(configContent), 0600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	if err := InitializeFromDir(beadsDir); err != nil {
+		t.Fatalf("InitializeFromDir() returned error: %v", err)
+	}
+	if got := GetString("actor"); got != "diruser" {
+		t.Errorf("GetString(actor) = %q, want \"diruser\"", got)
+	}
+	if got := GetString("close.auto-archive-after"); got != "30d" {
+		t.Errorf("GetString(close.auto-archive-after) = %q, want \"30d\"", got)
+	}
+}
+
 func TestLocalConfigOverride(t *testing.T) {
 	// Isolate from environment variables
 	restore := envSnapshot(t)
@@ -703,6 +742,50 @@ external_projects:
 	}
 }
 
+func TestJSONLPath(t *testing.T) {
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() returned error: %v", err)
+	}
+	defer Set("export.jsonl-path", "")
+
+	beadsDir := filepath.Join(t.TempDir(), ".beads")
+	absPath := filepath.Join(t.TempDir(), "exports", "team.jsonl")
+
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"default", "", filepath.Join(beadsDir, "issues.jsonl")},
+		{"renamed", "beads.jsonl", filepath.Join(beadsDir, "beads.jsonl")},
+		{"relative subdir", "exports/issues.jsonl", filepath.Join(beadsDir, "exports", "issues.jsonl")},
+		{"absolute", absPath, absPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Set("export.jsonl-path", tt.value)
+			if got := JSONLPath(beadsDir); got != tt.want {
+				t.Errorf("JSONLPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONLPathFromDir(t *testing.T) {
+	beadsDir := t.TempDir()
+	if got, want := JSONLPathFromDir(beadsDir), filepath.Join(beadsDir, "issues.jsonl"); got != want {
+		t.Errorf("JSONLPathFromDir() without config = %q, want %q", got, want)
+	}
+
+	cfg := "export:\n  jsonl-path: exports/team.jsonl\n"
+	if err := os.WriteFile(filepath.Join(beadsDir, "config.yaml"), []byte(cfg), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := JSONLPathFromDir(beadsDir), filepath.Join(beadsDir, "exports", "team.jsonl"); got != want {
+		t.Errorf("JSONLPathFromDir() = %q, want %q", got, want)
+	}
+}
+
 func TestGetIdentityFromConfig(t *testing.T) {
 	// Create a temporary directory for config file
 	tmpDir := t.TempDir()
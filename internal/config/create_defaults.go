@@ -0,0 +1,106 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// CreateDefaultPriority returns create.default-priority, the priority bd
+// create uses when --priority is not given. ok is false when it is unset.
+func CreateDefaultPriority() (priority int, ok bool, err error) {
+	raw := strings.TrimSpace(GetString("create.default-priority"))
+	if raw == "" {
+		return 0, false, nil
+	}
+	priority, err = parseCreatePriority(raw)
+	if err != nil {
+		return 0, false, fmt.Errorf("create.default-priority: %w", err)
+	}
+	return priority, true, nil
+}
+
+// CreateDefaultStatus returns create.default-status, the status bd create
+// uses when --status is not given, or "" when it is unset.
+func CreateDefaultStatus() (types.Status, error) {
+	raw := strings.TrimSpace(GetString("create.default-status"))
+	if raw == "" {
+		return "", nil
+	}
+	if err := checkCreateStatus(raw); err != nil {
+		return "", fmt.Errorf("create.default-status: %w", err)
+	}
+	return types.Status(raw), nil
+}
+
+// CreateDefaultLabels returns create.default-labels, the labels bd create
+// adds when neither --labels nor --label is given. Accepts a YAML list or a
+// comma-separated string (as BD_CREATE_DEFAULT_LABELS).
+func CreateDefaultLabels() []string {
+	var labels []string
+	for _, item := range GetStringSlice("create.default-labels") {
+		for _, label := range strings.Split(item, ",") {
+			if label = strings.TrimSpace(label); label != "" {
+				labels = append(labels, label)
+			}
+		}
+	}
+	return labels
+}
+
+// parseCreatePriority accepts the same forms as --priority: 0-4 or P0-P4.
+func parseCreatePriority(raw string) (int, error) {
+	p, err := strconv.Atoi(strings.TrimPrefix(strings.TrimPrefix(raw, "P"), "p"))
+	if err != nil || p < 0 || p > 4 {
+		return 0, fmt.Errorf("invalid priority %q (expected 0-4 or P0-P4)", raw)
+	}
+	return p, nil
+}
+
+// checkCreateStatus accepts the statuses a new issue may start in: the
+// built-in ones except closed, and custom statuses from status.custom in
+// config.yaml. Custom statuses kept only in the database are not visible
+// here and are checked by the store when the issue is created.
+func checkCreateStatus(raw string) error {
+	status := types.Status(raw)
+	if status == types.StatusClosed {
+		return fmt.Errorf("new issues cannot start closed")
+	}
+	if !status.IsValidWithCustom(GetCustomStatusesFromYAML()) {
+		return fmt.Errorf("invalid status %q (expected open, in_progress, blocked, deferred, pinned, hooked or a status.custom value)", raw)
+	}
+	return nil
+}
+
+// valueChecks validate the values of keys whose type alone doesn't say
+// enough. They run after the type check passes.
+var valueChecks = map[string]func(value interface{}) error{
+	"create.default-priority": func(value interface{}) error {
+		_, err := parseCreatePriority(strings.TrimSpace(fmt.Sprint(value)))
+		return err
+	},
+	"create.default-status": func(value interface{}) error {
+		return checkCreateStatus(strings.TrimSpace(fmt.Sprint(value)))
+	},
+	"create.default-labels": func(value interface{}) error {
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil // a comma-separated string
+		}
+		for _, item := range items {
+			if !isScalar(item) || strings.TrimSpace(fmt.Sprint(item)) == "" {
+				return fmt.Errorf("labels must be non-empty strings")
+			}
+		}
+		return nil
+	},
+	"hierarchy.separator": func(value interface{}) error {
+		return types.ValidateHierarchySeparator(fmt.Sprint(value))
+	},
+	"close.auto-archive-after": func(value interface{}) error {
+		_, err := parseAutoArchiveAfter(fmt.Sprint(value))
+		return err
+	},
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Severity levels accepted under doctor.severity.
+const (
+	DoctorSeverityIgnore = "ignore" // hide the check's findings; never fails
+	DoctorSeverityWarn   = "warn"   // report findings as warnings; never fails, even with --strict
+	DoctorSeverityFail   = "fail"   // any finding fails the run
+)
+
+// DoctorSeverity returns the per-check severity overrides from
+// doctor.severity, keyed by check slug as printed by 'bd doctor --list-checks'.
+// Example config.yaml:
+//
+//	doctor:
+//	  severity:
+//	    orphaned-dependencies: warn
+//	    dependency-cycles: fail
+func DoctorSeverity() (map[string]string, error) {
+	raw := GetStringMapString("doctor.severity")
+	levels := make(map[string]string, len(raw))
+
+	// Sorted so the first error reported is deterministic
+	keys := make([]string, 0, len(raw))
+	for k := range raw {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		level := strings.ToLower(strings.TrimSpace(raw[key]))
+		switch level {
+		case DoctorSeverityIgnore, DoctorSeverityWarn, DoctorSeverityFail:
+		default:
+			return nil, fmt.Errorf("doctor.severity.%s: invalid severity %q (use ignore, warn or fail)", key, raw[key])
+		}
+		levels[strings.ToLower(strings.TrimSpace(key))] = level
+	}
+	return levels, nil
+}
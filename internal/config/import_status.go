@@ -0,0 +1,21 @@
+package config
+
+import "strings"
+
+// ImportStatusMap returns import.status-map, which folds statuses from
+// other trackers into beads statuses during 'bd import'. Keys are matched
+// case-insensitively; values must be beads statuses. Example config.yaml:
+//
+//	import:
+//	  status-map:
+//	    resolved: closed
+//	    "won't fix": closed
+//	    todo: open
+func ImportStatusMap() map[string]string {
+	raw := GetStringMapString("import.status-map")
+	mapping := make(map[string]string, len(raw))
+	for source, target := range raw {
+		mapping[strings.ToLower(strings.TrimSpace(source))] = strings.TrimSpace(target)
+	}
+	return mapping
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"os"
+	"sort"
+
+	"github.com/spf13/viper"
+)
+
+// Config file scopes, lowest priority first. Initialize merges the files it
+// finds in this order, so a key set in a later scope wins.
+const (
+	ScopeUserLegacy = "user-legacy" // ~/.beads/config.yaml
+	ScopeUser       = "user"        // ~/.config/bd/config.yaml
+	ScopeRepo       = "repo"        // .beads/config.yaml found by walking up from the cwd
+	ScopeBeadsDir   = "beads-dir"   // $BEADS_DIR/config.yaml
+	ScopeLocal      = "local"       // config.local.yaml next to the repo config
+)
+
+// ConfigLayer is one config file loaded by Initialize.
+type ConfigLayer struct {
+	Scope string `json:"scope"`
+	Path  string `json:"path"`
+}
+
+// layers records the files loaded by Initialize, lowest priority first.
+var layers []ConfigLayer
+
+// Layers returns the config files loaded by Initialize, lowest priority first.
+func Layers() []ConfigLayer {
+	return append([]ConfigLayer(nil), layers...)
+}
+
+// ResolvedValue is a config value together with where it came from.
+// File and Scope are set only when Source is SourceConfigFile and the key
+// was read from a file (rather than set at runtime).
+type ResolvedValue struct {
+	Key    string       `json:"key"`
+	Value  interface{}  `json:"value"`
+	Source ConfigSource `json:"source"`
+	Scope  string       `json:"scope,omitempty"`
+	File   string       `json:"file,omitempty"`
+}
+
+// Resolve returns the effective value of key and its source, following the
+// precedence env var > config files (see Layers) > default. Flags are not
+// visible here; callers that parse flags should check them first.
+func Resolve(key string) ResolvedValue {
+	key = normalizeYamlKey(key)
+	r := ResolvedValue{Key: key, Source: GetValueSource(key)}
+	if v == nil {
+		return r
+	}
+	r.Value = v.Get(key)
+	if r.Source == SourceConfigFile {
+		if layer, ok := layerDefining(key); ok {
+			r.Scope = layer.Scope
+			r.File = layer.Path
+		}
+	}
+	return r
+}
+
+// ResolveAll resolves every key known to the config (defaults, files and
+// bound env vars), sorted by key.
+func ResolveAll() []ResolvedValue {
+	if v == nil {
+		return nil
+	}
+	keys := v.AllKeys()
+	sort.Strings(keys)
+	out := make([]ResolvedValue, 0, len(keys))
+	for _, key := range keys {
+		out = append(out, Resolve(key))
+	}
+	return out
+}
+
+// layerDefining returns the highest-priority loaded file that sets key.
+func layerDefining(key string) (ConfigLayer, bool) {
+	for i := len(layers) - 1; i >= 0; i-- {
+		if fileDefines(layers[i].Path, key) {
+			return layers[i], true
+		}
+	}
+	return ConfigLayer{}, false
+}
+
+func fileDefines(path, key string) bool {
+	if _, err := os.Stat(path); err != nil {
+		return false
+	}
+	fv := viper.New()
+	fv.SetConfigType("yaml")
+	fv.SetConfigFile(path)
+	if err := fv.ReadInConfig(); err != nil {
+		return false
+	}
+	return fv.InConfig(key)
+}
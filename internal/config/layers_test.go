@@ -0,0 +1,92 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeLayerFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		t.Fatalf("failed to create %s: %v", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
+
+func TestResolvePrecedence(t *testing.T) {
+	restore := envSnapshot(t)
+	defer restore()
+
+	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tmp, "xdg"))
+	userPath := filepath.Join(tmp, "xdg", "bd", "config.yaml")
+	writeLayerFile(t, userPath, "actor: user-actor\ngit:\n  author: user-author\nlist:\n  columns: id,title\n")
+
+	repo := filepath.Join(tmp, "repo")
+	repoPath := filepath.Join(repo, ".beads", "config.yaml")
+	writeLayerFile(t, repoPath, "actor: repo-actor\ngit:\n  author: repo-author\n")
+	localPath := filepath.Join(repo, ".beads", "config.local.yaml")
+	writeLayerFile(t, localPath, "git:\n  author: local-author\n")
+	t.Chdir(repo)
+	t.Setenv("BD_ACTOR", "env-actor")
+
+	ResetForTesting()
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() returned error: %v", err)
+	}
+	t.Cleanup(ResetForTesting)
+
+	got := Layers()
+	wantScopes := []string{ScopeUser, ScopeRepo, ScopeLocal}
+	if len(got) != len(wantScopes) {
+		t.Fatalf("Layers() = %+v, want scopes %v", got, wantScopes)
+	}
+	for i, scope := range wantScopes {
+		if got[i].Scope != scope {
+			t.Errorf("Layers()[%d].Scope = %q, want %q", i, got[i].Scope, scope)
+		}
+	}
+
+	tests := []struct {
+		key    string
+		value  interface{}
+		source ConfigSource
+		file   string
+	}{
+		{"actor", "env-actor", SourceEnvVar, ""},
+		{"git.author", "local-author", SourceConfigFile, localPath},
+		{"list.columns", "id,title", SourceConfigFile, userPath},
+		{"dolt.auto-commit", "on", SourceDefault, ""},
+	}
+	for _, tt := range tests {
+		r := Resolve(tt.key)
+		if r.Value != tt.value || r.Source != tt.source || r.File != tt.file {
+			t.Errorf("Resolve(%q) = {%v %s %q}, want {%v %s %q}", tt.key, r.Value, r.Source, r.File, tt.value, tt.source, tt.file)
+		}
+	}
+}
+
+func TestResolveAllSorted(t *testing.T) {
+	restore := envSnapshot(t)
+	defer restore()
+
+	t.Chdir(t.TempDir())
+	ResetForTesting()
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() returned error: %v", err)
+	}
+	t.Cleanup(ResetForTesting)
+
+	all := ResolveAll()
+	if len(all) == 0 {
+		t.Fatal("ResolveAll() returned no keys")
+	}
+	for i := 1; i < len(all); i++ {
+		if all[i-1].Key > all[i].Key {
+			t.Fatalf("ResolveAll() not sorted: %q before %q", all[i-1].Key, all[i].Key)
+		}
+	}
+}
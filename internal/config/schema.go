@@ -0,0 +1,305 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// KeyType is the expected type of a config.yaml value.
+type KeyType string
+
+const (
+	TypeString   KeyType = "string"
+	TypeBool     KeyType = "bool"
+	TypeInt      KeyType = "int"
+	TypeDuration KeyType = "duration" // e.g. "15m"; "0" is allowed where it disables a timer
+	TypeList     KeyType = "list"     // YAML sequence (or whitespace-separated string)
+	TypeMap      KeyType = "map"      // Arbitrary sub-keys with scalar values
+)
+
+// schemaTypes lists keys whose type can't be inferred from their default,
+// and keys read from config.yaml that have no default at all. Every other
+// key with a v.SetDefault in Initialize is known, typed by its default.
+var schemaTypes = map[string]KeyType{
+	// Startup and identity
+	"no-db":                 TypeBool,
+	"readonly":              TypeBool,
+	"no-git-ops":            TypeBool,
+	"ignore-unknown-config": TypeBool,
+
+	// Sync and multi-repo
+	"sync.git-remote":  TypeString,
+	"repos.primary":    TypeString,
+	"repos.additional": TypeList,
+
+	// Dolt server and push
+	"dolt.auto-start":         TypeBool,
+	"dolt.auto-push":          TypeBool,
+	"dolt.auto-push-interval": TypeDuration,
+	"dolt.shared-server":      TypeBool,
+	"dolt.idle-timeout":       TypeDuration,
+	"dolt.host":               TypeString,
+	"dolt.port":               TypeInt,
+	"dolt.user":               TypeString,
+	"dolt.database":           TypeString,
+	"dolt.data-dir":           TypeString,
+
+	// Durations whose defaults are strings
+	"backup.interval":        TypeDuration,
+	"hooks.timeout":          TypeDuration,
+	"create.idempotency-ttl": TypeDuration,
+	"timeouts.push":          TypeDuration,
+	"timeouts.pull":          TypeDuration,
+	"timeouts.fetch":         TypeDuration,
+	"timeouts.gc":            TypeDuration,
+	"timeouts.export":        TypeDuration,
+	"timeouts.import":        TypeDuration,
+
+	// Maps
+	"doctor.severity":            TypeMap,
+	"validation.metadata.fields": TypeMap,
+	"import.status-map":          TypeMap,
+
+	"ai.api_key": TypeString,
+}
+
+// retiredKeys were accepted by earlier releases and no longer have any
+// effect. They are tolerated so old config files keep loading.
+var retiredKeys = map[string]bool{
+	"sync-branch":       true,
+	"sync.branch":       true,
+	"sync.mode":         true,
+	"no-daemon":         true,
+	"auto-start-daemon": true,
+	"flush-debounce":    true,
+	"no-auto-flush":     true,
+	"no-auto-import":    true,
+	"lock-timeout":      true,
+}
+
+// ConfigIssue is a problem found in a config file by ValidateFiles.
+type ConfigIssue struct {
+	File     string  `json:"file"`
+	Key      string  `json:"key"`
+	Unknown  bool    `json:"unknown,omitempty"`
+	Expected KeyType `json:"expected,omitempty"`
+	Got      string  `json:"got,omitempty"`
+	Suggest  string  `json:"suggest,omitempty"`
+	Reason   string  `json:"reason,omitempty"` // Why a well-typed value was rejected
+}
+
+func (i ConfigIssue) String() string {
+	if i.Unknown {
+		msg := fmt.Sprintf("%s: unknown key %q", i.File, i.Key)
+		if i.Suggest != "" {
+			msg += fmt.Sprintf(" (did you mean %q?)", i.Suggest)
+		}
+		return msg
+	}
+	if i.Reason != "" {
+		return fmt.Sprintf("%s: %s: %s", i.File, i.Key, i.Reason)
+	}
+	return fmt.Sprintf("%s: %s: expected %s, got %s", i.File, i.Key, i.Expected, i.Got)
+}
+
+// KnownKeys returns every config.yaml key bd understands with its type.
+// Map-typed keys accept any sub-key.
+func KnownKeys() map[string]KeyType {
+	defaults := viper.New()
+	setDefaults(defaults)
+	known := make(map[string]KeyType)
+	for _, key := range defaults.AllKeys() {
+		known[key] = inferType(defaults.Get(key))
+	}
+	for key := range YamlOnlyKeys {
+		if _, ok := known[key]; !ok {
+			known[key] = TypeString
+		}
+	}
+	for key, t := range schemaTypes {
+		known[key] = t
+	}
+	return known
+}
+
+func inferType(value interface{}) KeyType {
+	switch value.(type) {
+	case bool:
+		return TypeBool
+	case int, int64:
+		return TypeInt
+	case map[string]string, map[string]interface{}:
+		return TypeMap
+	case []string, []interface{}:
+		return TypeList
+	default:
+		return TypeString
+	}
+}
+
+// ValidateFiles checks every loaded config file (see Layers) against the
+// known keys and their types. Unknown keys are skipped when ignoreUnknown
+// is set; type errors are always reported.
+func ValidateFiles(ignoreUnknown bool) []ConfigIssue {
+	known := KnownKeys()
+	var issues []ConfigIssue
+	for _, layer := range layers {
+		fv := viper.New()
+		fv.SetConfigType("yaml")
+		fv.SetConfigFile(layer.Path)
+		if err := fv.ReadInConfig(); err != nil {
+			issues = append(issues, ConfigIssue{File: layer.Path, Key: "(file)", Expected: "valid YAML", Got: err.Error()})
+			continue
+		}
+		issues = append(issues, validateKeys(layer.Path, fv, known, ignoreUnknown)...)
+	}
+	return issues
+}
+
+func validateKeys(file string, fv *viper.Viper, known map[string]KeyType, ignoreUnknown bool) []ConfigIssue {
+	keys := fv.AllKeys()
+	sort.Strings(keys)
+	var issues []ConfigIssue
+	for _, key := range keys {
+		value := fv.Get(key)
+		t, ok := known[key]
+		if !ok {
+			if enclosingMapKey(key, known) != "" || retiredKeys[key] || ignoreUnknown {
+				continue
+			}
+			issues = append(issues, ConfigIssue{File: file, Key: key, Unknown: true, Suggest: suggestKey(key, known)})
+			continue
+		}
+		if !valueHasType(value, t) {
+			issues = append(issues, ConfigIssue{File: file, Key: key, Expected: t, Got: describeValue(value)})
+			continue
+		}
+		if check, ok := valueChecks[key]; ok && value != nil && fmt.Sprint(value) != "" {
+			if err := check(value); err != nil {
+				issues = append(issues, ConfigIssue{File: file, Key: key, Got: describeValue(value), Reason: err.Error()})
+			}
+		}
+	}
+	return issues
+}
+
+// enclosingMapKey returns the map-typed known key that key is nested under,
+// e.g. "directory.labels" for "directory.labels.frontend".
+func enclosingMapKey(key string, known map[string]KeyType) string {
+	for i := strings.LastIndex(key, "."); i > 0; i = strings.LastIndex(key[:i], ".") {
+		if known[key[:i]] == TypeMap {
+			return key[:i]
+		}
+	}
+	return ""
+}
+
+func valueHasType(value interface{}, t KeyType) bool {
+	if value == nil {
+		return true
+	}
+	switch t {
+	case TypeBool:
+		switch x := value.(type) {
+		case bool:
+			return true
+		case string:
+			switch strings.ToLower(strings.TrimSpace(x)) {
+			case "yes", "no", "on", "off":
+				return true
+			}
+			_, err := strconv.ParseBool(strings.TrimSpace(x))
+			return err == nil
+		case int:
+			return x == 0 || x == 1
+		}
+		return false
+	case TypeInt:
+		switch x := value.(type) {
+		case int, int64:
+			return true
+		case string:
+			_, err := strconv.Atoi(strings.TrimSpace(x))
+			return err == nil
+		}
+		return false
+	case TypeDuration:
+		switch x := value.(type) {
+		case int, int64:
+			return true
+		case string:
+			_, err := time.ParseDuration(strings.TrimSpace(x))
+			return err == nil
+		}
+		return false
+	case TypeList:
+		switch value.(type) {
+		case []interface{}, []string, string:
+			return true
+		}
+		return false
+	case TypeMap:
+		switch value.(type) {
+		case map[string]interface{}, map[string]string:
+			return true
+		}
+		return false
+	default:
+		return isScalar(value)
+	}
+}
+
+func isScalar(value interface{}) bool {
+	switch value.(type) {
+	case map[string]interface{}, map[string]string, []interface{}, []string:
+		return false
+	}
+	return true
+}
+
+func describeValue(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}, map[string]string:
+		return "a map"
+	case []interface{}, []string:
+		return "a list"
+	}
+	return fmt.Sprintf("%q", fmt.Sprint(value))
+}
+
+// suggestKey returns the known key closest to key, or "" if nothing is
+// within two edits.
+func suggestKey(key string, known map[string]KeyType) string {
+	best, bestDist := "", 3
+	for candidate := range known {
+		if d := editDistance(key, candidate); d < bestDist || (d == bestDist && candidate < best) {
+			best, bestDist = candidate, d
+		}
+	}
+	return best
+}
+
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateFiles(t *testing.T) {
+	restore := envSnapshot(t)
+	defer restore()
+
+	repo := t.TempDir()
+	path := filepath.Join(repo, ".beads", "config.yaml")
+	writeLayerFile(t, path, `actr: me
+sync-branch: beads-sync
+hierarchy:
+  max-depth: deep
+backup:
+  enabled: "yes"
+  interval: soon
+dolt:
+  port: "3307"
+directory:
+  labels:
+    packages/frontend: frontend
+repos:
+  additional:
+    - ~/planning
+`)
+	t.Chdir(repo)
+
+	ResetForTesting()
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() returned error: %v", err)
+	}
+	t.Cleanup(ResetForTesting)
+
+	issues := ValidateFiles(false)
+	want := map[string]ConfigIssue{
+		"actr":                {File: path, Key: "actr", Unknown: true, Suggest: "actor"},
+		"backup.interval":     {File: path, Key: "backup.interval", Expected: TypeDuration, Got: `"soon"`},
+		"hierarchy.max-depth": {File: path, Key: "hierarchy.max-depth", Expected: TypeInt, Got: `"deep"`},
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("ValidateFiles(false) = %v, want %d issues", issues, len(want))
+	}
+	for _, issue := range issues {
+		if issue != want[issue.Key] {
+			t.Errorf("issue for %q = %+v, want %+v", issue.Key, issue, want[issue.Key])
+		}
+	}
+
+	issues = ValidateFiles(true)
+	if len(issues) != 2 {
+		t.Fatalf("ValidateFiles(true) = %v, want only the 2 type errors", issues)
+	}
+	for _, issue := range issues {
+		if issue.Unknown {
+			t.Errorf("ValidateFiles(true) reported unknown key %q", issue.Key)
+		}
+	}
+}
+
+func TestKnownKeysCoversDefaults(t *testing.T) {
+	known := KnownKeys()
+	for key, want := range map[string]KeyType{
+		"json":                 TypeBool,
+		"hierarchy.max-depth":  TypeInt,
+		"backup.interval":      TypeDuration,
+		"directory.labels":     TypeMap,
+		"repos.additional":     TypeList,
+		"validation.on-create": TypeString,
+	} {
+		if got := known[key]; got != want {
+			t.Errorf("KnownKeys()[%q] = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestValidateFilesCreateDefaults(t *testing.T) {
+	restore := envSnapshot(t)
+	defer restore()
+
+	repo := t.TempDir()
+	path := filepath.Join(repo, ".beads", "config.yaml")
+	writeLayerFile(t, path, `create:
+  default-priority: P7
+  default-status: closed
+  default-labels:
+    - triage
+    - ""
+`)
+	t.Chdir(repo)
+
+	ResetForTesting()
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() returned error: %v", err)
+	}
+	t.Cleanup(ResetForTesting)
+
+	issues := ValidateFiles(false)
+	if len(issues) != 3 {
+		t.Fatalf("ValidateFiles(false) = %v, want 3 issues", issues)
+	}
+	for _, issue := range issues {
+		if issue.Reason == "" {
+			t.Errorf("issue for %q has no reason: %+v", issue.Key, issue)
+		}
+	}
+}
+
+func TestCreateDefaults(t *testing.T) {
+	restore := envSnapshot(t)
+	defer restore()
+
+	repo := t.TempDir()
+	writeLayerFile(t, filepath.Join(repo, ".beads", "config.yaml"), `create:
+  default-priority: P1
+  default-status: in_progress
+  default-labels: [triage, "needs review"]
+`)
+	t.Chdir(repo)
+
+	ResetForTesting()
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() returned error: %v", err)
+	}
+	t.Cleanup(ResetForTesting)
+
+	if issues := ValidateFiles(false); len(issues) != 0 {
+		t.Fatalf("ValidateFiles(false) = %v, want none", issues)
+	}
+	if p, ok, err := CreateDefaultPriority(); err != nil || !ok || p != 1 {
+		t.Errorf("CreateDefaultPriority() = %d, %v, %v; want 1, true, nil", p, ok, err)
+	}
+	if s, err := CreateDefaultStatus(); err != nil || s != "in_progress" {
+		t.Errorf("CreateDefaultStatus() = %q, %v; want in_progress", s, err)
+	}
+	if got := strings.Join(CreateDefaultLabels(), "|"); got != "triage|needs review" {
+		t.Errorf("CreateDefaultLabels() = %q, want triage|needs review", got)
+	}
+
+	t.Setenv("BD_CREATE_DEFAULT_LABELS", "a, b")
+	if got := strings.Join(CreateDefaultLabels(), "|"); got != "a|b" {
+		t.Errorf("CreateDefaultLabels() from env = %q, want a|b", got)
+	}
+}
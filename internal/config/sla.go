@@ -0,0 +1,85 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// slaPriorityNames maps the named priority keys accepted under sla.by-priority.
+var slaPriorityNames = map[string]int{
+	"critical": 0,
+	"high":     1,
+	"medium":   2,
+	"low":      3,
+	"backlog":  4,
+}
+
+// SLAByPriority returns the age SLA per priority from sla.by-priority.
+// Keys are 0-4, p0-p4, or critical/high/medium/low/backlog; values are Go
+// durations or whole days/weeks ("1d", "2w"). Priorities without an entry
+// have no SLA. Example config.yaml:
+//
+//	sla:
+//	  by-priority:
+//	    critical: 1d
+//	    high: 3d
+func SLAByPriority() (map[int]time.Duration, error) {
+	raw := GetStringMapString("sla.by-priority")
+	slas := make(map[int]time.Duration, len(raw))
+
+	// Sorted so the first error reported is deterministic
+	keys := make([]string, 0, len(raw))
+	for k := range raw {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		priority, err := parseSLAPriority(key)
+		if err != nil {
+			return nil, err
+		}
+		d, err := ParseSLADuration(raw[key])
+		if err != nil {
+			return nil, fmt.Errorf("sla.by-priority.%s: %w", key, err)
+		}
+		slas[priority] = d
+	}
+	return slas, nil
+}
+
+func parseSLAPriority(key string) (int, error) {
+	k := strings.ToLower(strings.TrimSpace(key))
+	if p, ok := slaPriorityNames[k]; ok {
+		return p, nil
+	}
+	if p, err := strconv.Atoi(strings.TrimPrefix(k, "p")); err == nil && p >= 0 && p <= 4 {
+		return p, nil
+	}
+	return 0, fmt.Errorf("sla.by-priority: invalid priority %q (use 0-4, p0-p4, or critical/high/medium/low/backlog)", key)
+}
+
+// ParseSLADuration parses an SLA threshold: a Go duration ("36h") or a whole
+// number of days or weeks ("1d", "2w"). The result must be positive.
+func ParseSLADuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	var d time.Duration
+	if n, err := strconv.Atoi(strings.TrimSuffix(s, "d")); err == nil && strings.HasSuffix(s, "d") {
+		d = time.Duration(n) * 24 * time.Hour
+	} else if n, err := strconv.Atoi(strings.TrimSuffix(s, "w")); err == nil && strings.HasSuffix(s, "w") {
+		d = time.Duration(n) * 7 * 24 * time.Hour
+	} else {
+		parsed, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q (e.g. 36h, 1d, 2w)", s)
+		}
+		d = parsed
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("duration must be positive, got %q", s)
+	}
+	return d, nil
+}
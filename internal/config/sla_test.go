@@ -0,0 +1,96 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSLADuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"1d", 24 * time.Hour, false},
+		{"2w", 14 * 24 * time.Hour, false},
+		{"36h", 36 * time.Hour, false},
+		{" 90m ", 90 * time.Minute, false},
+		{"0d", 0, true},
+		{"-1h", 0, true},
+		{"soon", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseSLADuration(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSLADuration(%q) err = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseSLADuration(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSLAByPriority(t *testing.T) {
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() returned error: %v", err)
+	}
+	defer Set("sla.by-priority", map[string]string{})
+
+	Set("sla.by-priority", map[string]string{"critical": "1d", "p1": "3d", "2": "2w"})
+	slas, err := SLAByPriority()
+	if err != nil {
+		t.Fatalf("SLAByPriority() error: %v", err)
+	}
+	want := map[int]time.Duration{0: 24 * time.Hour, 1: 72 * time.Hour, 2: 14 * 24 * time.Hour}
+	if len(slas) != len(want) {
+		t.Fatalf("SLAByPriority() = %v, want %v", slas, want)
+	}
+	for p, d := range want {
+		if slas[p] != d {
+			t.Errorf("SLA for P%d = %v, want %v", p, slas[p], d)
+		}
+	}
+
+	Set("sla.by-priority", map[string]string{"p9": "1d"})
+	if _, err := SLAByPriority(); err == nil {
+		t.Error("expected error for invalid priority key")
+	}
+}
+
+func TestAutoArchiveAfter(t *testing.T) {
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize() returned error: %v", err)
+	}
+	defer Set("close.auto-archive-after", "")
+
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"", 0, false},
+		{"0", 0, false},
+		{"30d", 30 * 24 * time.Hour, false},
+		{"2w", 14 * 24 * time.Hour, false},
+		{"12h", 12 * time.Hour, false},
+		{"-1d", 0, true},
+		{"soon", 0, true},
+	}
+	for _, tt := range tests {
+		Set("close.auto-archive-after", tt.in)
+		got, err := AutoArchiveAfter()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("AutoArchiveAfter(%q) err = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("AutoArchiveAfter(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	if err := validateYamlConfigValue("close.auto-archive-after", "often"); err == nil {
+		t.Error("validateYamlConfigValue should reject a malformed duration")
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/steveyegge/beads/internal/types"
 )
 
 // YamlOnlyKeys are configuration keys that must be stored in config.yaml
@@ -20,8 +22,9 @@ import (
 // at startup, not from the database).
 var YamlOnlyKeys = map[string]bool{
 	// Bootstrap flags (affect how bd starts)
-	"no-db": true,
-	"json":  true,
+	"no-db":                 true,
+	"json":                  true,
+	"ignore-unknown-config": true, // Skip unknown keys in config validation
 
 	// Database and identity
 	"db":       true,
@@ -32,6 +35,9 @@ var YamlOnlyKeys = map[string]bool{
 	"git.author":      true,
 	"git.no-gpg-sign": true,
 	"no-push":         true,
+	"default.remote":  true, // Dolt remote for push/pull (read when the store is opened)
+	"push.remote":     true, // Push target remote for bd dolt push and auto-push
+	"push.branch":     true, // Push target branch for bd dolt push and auto-push
 	"no-git-ops":      true, // Disable git ops in bd prime session close protocol (GH#593)
 
 	// Sync settings
@@ -45,7 +51,24 @@ var YamlOnlyKeys = map[string]bool{
 	"routing.contributor": true,
 
 	// Create command settings
-	"create.require-description": true,
+	"create.require-description":   true,
+	"create.parent-title-template": true,
+	"create.default-priority":      true,
+	"create.default-status":        true,
+	"create.default-labels":        true,
+	"create.idempotency-ttl":       true,
+
+	// Import settings
+	"import.status-map": true,
+
+	// Doctor settings (doctor.suppress.* stays in the database)
+	"doctor.severity": true,
+
+	// Archive settings
+	"archive.resolve-references": true,
+
+	// Close settings
+	"close.auto-archive-after": true,
 
 	// Validation settings (bd-t7jq)
 	// Values: "warn" | "error" | "none"
@@ -54,6 +77,7 @@ var YamlOnlyKeys = map[string]bool{
 
 	// Hierarchy settings (GH#995)
 	"hierarchy.max-depth": true,
+	"hierarchy.separator": true,
 
 	// Backup settings (must be in yaml so GetValueSource can detect overrides)
 	"backup.enabled":  true,
@@ -62,8 +86,9 @@ var YamlOnlyKeys = map[string]bool{
 	"backup.git-repo": true,
 
 	// Dolt server settings
-	"dolt.idle-timeout":  true, // Idle auto-stop timeout (default "30m", "0" disables)
-	"dolt.shared-server": true, // Shared Dolt server at ~/.beads/shared-server/ (GH#2377)
+	"dolt.idle-timeout":   true, // Idle auto-stop timeout (default "30m", "0" disables)
+	"dolt.squash-on-push": true, // Squash unpushed commits on bd dolt push
+	"dolt.shared-server":  true, // Shared Dolt server at ~/.beads/shared-server/ (GH#2377)
 }
 
 // IsYamlOnlyKey returns true if the given key should be stored in config.yaml
@@ -75,7 +100,7 @@ func IsYamlOnlyKey(key string) bool {
 	}
 
 	// Check prefix matches for nested keys
-	prefixes := []string{"routing.", "sync.", "git.", "directory.", "repos.", "external_projects.", "validation.", "hierarchy.", "ai.", "backup.", "dolt.", "federation."}
+	prefixes := []string{"routing.", "sync.", "git.", "directory.", "repos.", "external_projects.", "validation.", "hierarchy.", "ai.", "backup.", "dolt.", "federation.", "hooks.", "list.", "export.", "sla.", "doctor.severity.", "import.status-map."}
 	for _, prefix := range prefixes {
 		if strings.HasPrefix(key, prefix) {
 			return true
@@ -291,6 +316,16 @@ func validateYamlConfigValue(key, value string) error {
 		if depth < 1 {
 			return fmt.Errorf("hierarchy.max-depth must be at least 1, got %d", depth)
 		}
+	case "hierarchy.separator":
+		if err := types.ValidateHierarchySeparator(value); err != nil {
+			return fmt.Errorf("hierarchy.separator: %w", err)
+		}
+	case "close.auto-archive-after":
+		if value != "" {
+			if _, err := parseAutoArchiveAfter(value); err != nil {
+				return err
+			}
+		}
 	case "dolt.idle-timeout":
 		// "0" disables, otherwise must be a valid Go duration
 		if value != "0" {
@@ -42,6 +42,22 @@ func Printf(format string, args ...interface{}) {
 	}
 }
 
+// Warnf prints a "Warning: " line to stderr unless quiet mode is enabled.
+// Use it for problems that don't stop the command, such as a failed
+// background push; errors that do stop it bypass quiet mode.
+func Warnf(format string, args ...interface{}) {
+	if !quietMode {
+		fmt.Fprintf(os.Stderr, "Warning: "+format, args...)
+	}
+}
+
+// Noticef prints a progress or status notice ("Pushing to origin/main...")
+// unless quiet mode is enabled. Requested output, including --json, must
+// not go through it.
+func Noticef(format string, args ...interface{}) {
+	printNormal(format, args...)
+}
+
 // printNormal prints output unless quiet mode is enabled
 // Use this for normal informational output that should be suppressed in quiet mode
 func printNormal(format string, args ...interface{}) {
@@ -283,3 +283,37 @@ func TestPrintlnNormal(t *testing.T) {
 		})
 	}
 }
+
+func TestWarnfAndNoticefRespectQuiet(t *testing.T) {
+	capture := func(target **os.File, fn func()) string {
+		old := *target
+		r, w, _ := os.Pipe()
+		*target = w
+		fn()
+		w.Close()
+		*target = old
+		var buf bytes.Buffer
+		io.Copy(&buf, r)
+		return buf.String()
+	}
+
+	oldQuiet := quietMode
+	defer func() { quietMode = oldQuiet }()
+
+	for _, quiet := range []bool{false, true} {
+		quietMode = quiet
+		warn := capture(&os.Stderr, func() { Warnf("push failed: %s\n", "timeout") })
+		notice := capture(&os.Stdout, func() { Noticef("Pushing to %s...\n", "origin/main") })
+
+		wantWarn, wantNotice := "Warning: push failed: timeout\n", "Pushing to origin/main...\n"
+		if quiet {
+			wantWarn, wantNotice = "", ""
+		}
+		if warn != wantWarn {
+			t.Errorf("quiet=%v: Warnf() output = %q, want %q", quiet, warn, wantWarn)
+		}
+		if notice != wantNotice {
+			t.Errorf("quiet=%v: Noticef() output = %q, want %q", quiet, notice, wantNotice)
+		}
+	}
+}
@@ -519,6 +519,7 @@ func Start(beadsDir string) (*State, error) {
 				return nil, fmt.Errorf("waiting for server start lock: %w", err)
 			}
 			defer func() { _ = lockfile.FlockUnlock(lockF) }()
+			_ = lockfile.WriteOwner(lockF) // Best effort: lets bd doctor name the owner
 
 			// Lock acquired — check if server is now running
 			state, err := IsRunning(beadsDir)
@@ -534,6 +535,7 @@ func Start(beadsDir string) (*State, error) {
 		}
 	} else {
 		defer func() { _ = lockfile.FlockUnlock(lockF) }()
+		_ = lockfile.WriteOwner(lockF) // Best effort: lets bd doctor name the owner
 	}
 
 	// Re-check after acquiring lock (double-check pattern)
@@ -0,0 +1,189 @@
+// Package export loads issues the way 'bd export' does and writes them as
+// JSONL, CSV or markdown. It is shared by the bd CLI and the public beads
+// library, so both produce the same records.
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// Export formats accepted by 'bd export --format' and beads.Store.Export.
+const (
+	FormatJSONL    = "jsonl"
+	FormatCSV      = "csv"
+	FormatMarkdown = "markdown"
+)
+
+// Store is the part of the storage layer an export reads. *dolt.DoltStore
+// satisfies it.
+type Store interface {
+	SearchIssues(ctx context.Context, query string, filter types.IssueFilter) ([]*types.Issue, error)
+	GetInfraTypes(ctx context.Context) map[string]bool
+	GetLabelsForIssues(ctx context.Context, issueIDs []string) (map[string][]string, error)
+	GetDependencyRecordsForIssues(ctx context.Context, issueIDs []string) (map[string][]*types.Dependency, error)
+	GetCommentCounts(ctx context.Context, issueIDs []string) (map[string]int, error)
+	GetDependencyCounts(ctx context.Context, issueIDs []string) (map[string]*types.DependencyCounts, error)
+	GetMetaForIssues(ctx context.Context, issueIDs []string) (map[string]map[string]string, error)
+	GetAttachmentsForIssues(ctx context.Context, issueIDs []string) (map[string][]*types.Attachment, error)
+}
+
+// Options selects the issues LoadIssues returns.
+type Options struct {
+	All          bool // Include templates and infra types
+	IncludeInfra bool // Include infra types (agents, rigs, roles, messages)
+
+	// Filter, when set, drops issues before their relational data is loaded
+	// (e.g. 'bd export --scrub').
+	Filter func([]*types.Issue) []*types.Issue
+}
+
+// Snapshot is the loaded export: the issues, with labels, dependencies,
+// meta and attachments populated, and their counts.
+type Snapshot struct {
+	Issues        []*types.Issue
+	DepCounts     map[string]*types.DependencyCounts
+	CommentCounts map[string]int
+}
+
+// LoadIssues fetches the issues and wisps 'bd export' writes. Infra types and
+// templates are left out unless opts.All (or opts.IncludeInfra, for infra
+// types) is set. An empty database gives an empty Snapshot.
+func LoadIssues(ctx context.Context, store Store, opts Options) (*Snapshot, error) {
+	// Export all statuses (this is a backup tool)
+	filter := types.IssueFilter{Limit: 0}
+
+	// Exclude infra types by default (agents, rigs, roles, messages)
+	if !opts.All && !opts.IncludeInfra {
+		var infraTypes []string
+		for t := range store.GetInfraTypes(ctx) {
+			infraTypes = append(infraTypes, t)
+		}
+		if len(infraTypes) == 0 {
+			infraTypes = dolt.DefaultInfraTypes()
+		}
+		for _, t := range infraTypes {
+			filter.ExcludeTypes = append(filter.ExcludeTypes, types.IssueType(t))
+		}
+	}
+
+	// Exclude templates by default
+	if !opts.All {
+		isTemplate := false
+		filter.IsTemplate = &isTemplate
+	}
+
+	issues, err := store.SearchIssues(ctx, "", filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search issues: %w", err)
+	}
+
+	// Also fetch wisps (ephemeral beads) using the store's ephemeral routing.
+	// SearchIssues with Ephemeral=true queries the wisps table directly.
+	ephemeral := true
+	wispFilter := filter
+	wispFilter.Ephemeral = &ephemeral
+	wispIssues, err := store.SearchIssues(ctx, "", wispFilter)
+	if err == nil && len(wispIssues) > 0 {
+		issues = append(issues, wispIssues...)
+	}
+
+	if opts.Filter != nil {
+		issues = opts.Filter(issues)
+	}
+	if len(issues) == 0 {
+		return &Snapshot{}, nil
+	}
+
+	// Bulk-load relational data
+	issueIDs := make([]string, len(issues))
+	for i, issue := range issues {
+		issueIDs[i] = issue.ID
+	}
+
+	labelsMap, _ := store.GetLabelsForIssues(ctx, issueIDs)
+	allDeps, _ := store.GetDependencyRecordsForIssues(ctx, issueIDs)
+	commentCounts, _ := store.GetCommentCounts(ctx, issueIDs)
+	depCounts, _ := store.GetDependencyCounts(ctx, issueIDs)
+	metaMap, _ := store.GetMetaForIssues(ctx, issueIDs)
+	attachmentsMap, _ := store.GetAttachmentsForIssues(ctx, issueIDs)
+
+	for _, issue := range issues {
+		issue.Labels = labelsMap[issue.ID]
+		issue.Dependencies = allDeps[issue.ID]
+		issue.Meta = metaMap[issue.ID]
+		issue.Attachments = attachmentsMap[issue.ID]
+	}
+
+	return &Snapshot{Issues: issues, DepCounts: depCounts, CommentCounts: commentCounts}, nil
+}
+
+// Write writes the snapshot in format and returns the number of issues
+// written.
+func (s *Snapshot) Write(w io.Writer, format string) (int, error) {
+	switch format {
+	case FormatJSONL, "":
+		return WriteJSONL(w, s.Issues, s.DepCounts, s.CommentCounts)
+	case FormatCSV:
+		return WriteCSV(w, s.Issues)
+	case FormatMarkdown:
+		return WriteMarkdown(w, s.Issues)
+	default:
+		return 0, fmt.Errorf("invalid export format %q (valid: %s, %s, %s)", format, FormatJSONL, FormatCSV, FormatMarkdown)
+	}
+}
+
+// WriteJSONL writes one IssueWithCounts JSON object per line.
+func WriteJSONL(w io.Writer, issues []*types.Issue, depCounts map[string]*types.DependencyCounts, commentCounts map[string]int) (int, error) {
+	count := 0
+	for _, issue := range issues {
+		counts := depCounts[issue.ID]
+		if counts == nil {
+			counts = &types.DependencyCounts{}
+		}
+
+		// Sanitize zero-value timestamps that can't be marshaled to JSON.
+		// NULL datetime columns scanned as time.Time{} (year 0001) cause
+		// MarshalJSON to fail with "year outside of range [0,9999]". (GH#2488)
+		SanitizeZeroTime(issue)
+
+		record := &types.IssueWithCounts{
+			Issue:           issue,
+			DependencyCount: counts.DependencyCount,
+			DependentCount:  counts.DependentCount,
+			CommentCount:    commentCounts[issue.ID],
+		}
+
+		data, err := json.Marshal(record)
+		if err != nil {
+			return count, fmt.Errorf("failed to marshal issue %s: %w", issue.ID, err)
+		}
+		if _, err := w.Write(data); err != nil {
+			return count, fmt.Errorf("failed to write: %w", err)
+		}
+		if _, err := w.Write([]byte{'\n'}); err != nil {
+			return count, fmt.Errorf("failed to write newline: %w", err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// SanitizeZeroTime replaces Go zero-value time.Time fields with Unix epoch.
+// NULL datetime columns in Dolt scan as time.Time{} (year 0001-01-01), which
+// causes json.Marshal to fail with "year outside of range [0,9999]". (GH#2488)
+func SanitizeZeroTime(issue *types.Issue) {
+	epoch := time.Unix(0, 0).UTC()
+	if issue.CreatedAt.IsZero() {
+		issue.CreatedAt = epoch
+	}
+	if issue.UpdatedAt.IsZero() {
+		issue.UpdatedAt = epoch
+	}
+}
@@ -0,0 +1,169 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// fakeStore serves issues from memory, applying the filter fields
+// LoadIssues sets.
+type fakeStore struct {
+	issues []*types.Issue
+	labels map[string][]string
+	deps   map[string][]*types.Dependency
+}
+
+func (f *fakeStore) SearchIssues(_ context.Context, _ string, filter types.IssueFilter) ([]*types.Issue, error) {
+	var out []*types.Issue
+	for _, issue := range f.issues {
+		if (filter.Ephemeral != nil && *filter.Ephemeral) != issue.Ephemeral {
+			continue
+		}
+		if filter.IsTemplate != nil && *filter.IsTemplate != issue.IsTemplate {
+			continue
+		}
+		excluded := false
+		for _, t := range filter.ExcludeTypes {
+			excluded = excluded || issue.IssueType == t
+		}
+		if !excluded {
+			copied := *issue
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeStore) GetInfraTypes(context.Context) map[string]bool {
+	return map[string]bool{"agent": true}
+}
+
+func (f *fakeStore) GetLabelsForIssues(context.Context, []string) (map[string][]string, error) {
+	return f.labels, nil
+}
+
+func (f *fakeStore) GetDependencyRecordsForIssues(context.Context, []string) (map[string][]*types.Dependency, error) {
+	return f.deps, nil
+}
+
+func (f *fakeStore) GetCommentCounts(context.Context, []string) (map[string]int, error) {
+	return map[string]int{"bd-1": 2}, nil
+}
+
+func (f *fakeStore) GetDependencyCounts(context.Context, []string) (map[string]*types.DependencyCounts, error) {
+	return map[string]*types.DependencyCounts{"bd-2": {DependencyCount: 1}}, nil
+}
+
+func (f *fakeStore) GetMetaForIssues(context.Context, []string) (map[string]map[string]string, error) {
+	return nil, nil
+}
+
+func (f *fakeStore) GetAttachmentsForIssues(context.Context, []string) (map[string][]*types.Attachment, error) {
+	return nil, nil
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		issues: []*types.Issue{
+			{ID: "bd-1", Title: "Fix login", Status: types.StatusOpen, IssueType: types.TypeBug},
+			{ID: "bd-2", Title: "Write docs", Status: types.StatusClosed, IssueType: types.TypeTask},
+			{ID: "bd-3", Title: "Agent", IssueType: "agent"},
+			{ID: "bd-4", Title: "Template", IssueType: types.TypeTask, IsTemplate: true},
+			{ID: "bd-wisp-5", Title: "Wisp", IssueType: types.TypeTask, Ephemeral: true},
+		},
+		labels: map[string][]string{"bd-1": {"auth"}},
+		deps: map[string][]*types.Dependency{
+			"bd-2": {{IssueID: "bd-2", DependsOnID: "bd-1", Type: types.DepBlocks}},
+		},
+	}
+}
+
+func snapshotIDs(s *Snapshot) string {
+	ids := make([]string, len(s.Issues))
+	for i, issue := range s.Issues {
+		ids[i] = issue.ID
+	}
+	return strings.Join(ids, " ")
+}
+
+func TestLoadIssues(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeStore()
+
+	snap, err := LoadIssues(ctx, store, Options{})
+	if err != nil {
+		t.Fatalf("LoadIssues: %v", err)
+	}
+	// Infra types and templates are left out; wisps are included
+	if got, want := snapshotIDs(snap), "bd-1 bd-2 bd-wisp-5"; got != want {
+		t.Errorf("issues = %q, want %q", got, want)
+	}
+	if got := snap.Issues[0].Labels; len(got) != 1 || got[0] != "auth" {
+		t.Errorf("bd-1 labels = %v, want [auth]", got)
+	}
+	if got := snap.Issues[1].Dependencies; len(got) != 1 || got[0].DependsOnID != "bd-1" {
+		t.Errorf("bd-2 dependencies = %v, want one on bd-1", got)
+	}
+
+	snap, err = LoadIssues(ctx, store, Options{All: true})
+	if err != nil {
+		t.Fatalf("LoadIssues (all): %v", err)
+	}
+	if got, want := snapshotIDs(snap), "bd-1 bd-2 bd-3 bd-4 bd-wisp-5"; got != want {
+		t.Errorf("all issues = %q, want %q", got, want)
+	}
+
+	snap, err = LoadIssues(ctx, store, Options{Filter: func(issues []*types.Issue) []*types.Issue { return nil }})
+	if err != nil {
+		t.Fatalf("LoadIssues (filtered): %v", err)
+	}
+	if len(snap.Issues) != 0 {
+		t.Errorf("filtered snapshot has %d issues, want 0", len(snap.Issues))
+	}
+}
+
+func TestSnapshotWrite(t *testing.T) {
+	snap, err := LoadIssues(context.Background(), newFakeStore(), Options{})
+	if err != nil {
+		t.Fatalf("LoadIssues: %v", err)
+	}
+
+	var buf bytes.Buffer
+	n, err := snap.Write(&buf, FormatJSONL)
+	if err != nil || n != 3 {
+		t.Fatalf("Write jsonl = (%d, %v), want 3 issues", n, err)
+	}
+	var first types.IssueWithCounts
+	if err := json.Unmarshal(bytes.SplitN(buf.Bytes(), []byte("\n"), 2)[0], &first); err != nil {
+		t.Fatalf("first JSONL line: %v", err)
+	}
+	if first.ID != "bd-1" || first.CommentCount != 2 || first.CreatedAt.IsZero() {
+		t.Errorf("first record = %s (comments %d, created %v), want bd-1 with 2 comments and a sanitized timestamp",
+			first.ID, first.CommentCount, first.CreatedAt)
+	}
+
+	buf.Reset()
+	if _, err := snap.Write(&buf, FormatCSV); err != nil {
+		t.Fatalf("Write csv: %v", err)
+	}
+	if lines := strings.Count(buf.String(), "\n"); lines != 4 {
+		t.Errorf("CSV has %d lines, want header plus 3 rows", lines)
+	}
+
+	buf.Reset()
+	if _, err := snap.Write(&buf, FormatMarkdown); err != nil {
+		t.Fatalf("Write markdown: %v", err)
+	}
+	if !strings.HasPrefix(buf.String(), "## Fix login\n") {
+		t.Errorf("markdown starts %q, want the first issue's title", buf.String()[:20])
+	}
+
+	if _, err := snap.Write(&buf, "yaml"); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
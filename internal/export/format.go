@@ -0,0 +1,127 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// CSVHeader lists the columns of a CSV export. Labels and
+// dependencies are comma-separated inside their cell; dependencies are
+// written as type:id, the form 'bd create -f' accepts.
+var CSVHeader = []string{
+	"id", "title", "status", "priority", "issue_type", "assignee", "parent",
+	"labels", "dependencies", "created_at", "updated_at", "closed_at", "description",
+}
+
+// WriteCSV writes issues as CSV with a header row.
+func WriteCSV(w io.Writer, issues []*types.Issue) (int, error) {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(CSVHeader); err != nil {
+		return 0, fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, issue := range issues {
+		closedAt := ""
+		if issue.ClosedAt != nil {
+			closedAt = issue.ClosedAt.UTC().Format(time.RFC3339)
+		}
+		record := []string{
+			issue.ID,
+			issue.Title,
+			string(issue.Status),
+			strconv.Itoa(issue.Priority),
+			string(issue.IssueType),
+			issue.Assignee,
+			exportParentID(issue),
+			strings.Join(issue.Labels, ","),
+			strings.Join(exportDepSpecs(issue), ","),
+			exportTime(issue.CreatedAt),
+			exportTime(issue.UpdatedAt),
+			closedAt,
+			issue.Description,
+		}
+		if err := cw.Write(record); err != nil {
+			return 0, fmt.Errorf("failed to write CSV row for %s: %w", issue.ID, err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return 0, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return len(issues), nil
+}
+
+// WriteMarkdown writes issues in the layout 'bd create -f' reads:
+// an H2 title per issue followed by H3 sections. The ID, status and
+// comments sections are informational and ignored on re-creation.
+func WriteMarkdown(w io.Writer, issues []*types.Issue) (int, error) {
+	var b strings.Builder
+	for i, issue := range issues {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "## %s\n", issue.Title)
+		writeMarkdownSection(&b, "ID", issue.ID)
+		writeMarkdownSection(&b, "Status", string(issue.Status))
+		writeMarkdownSection(&b, "Priority", strconv.Itoa(issue.Priority))
+		writeMarkdownSection(&b, "Type", string(issue.IssueType))
+		writeMarkdownSection(&b, "Assignee", issue.Assignee)
+		writeMarkdownSection(&b, "Labels", strings.Join(issue.Labels, ", "))
+		writeMarkdownSection(&b, "Dependencies", strings.Join(exportDepSpecs(issue), ", "))
+		writeMarkdownSection(&b, "Description", issue.Description)
+		writeMarkdownSection(&b, "Design", issue.Design)
+		writeMarkdownSection(&b, "Acceptance Criteria", issue.AcceptanceCriteria)
+		var comments []string
+		for _, c := range issue.Comments {
+			comments = append(comments, fmt.Sprintf("- **%s** (%s): %s", c.Author, c.CreatedAt.UTC().Format("2006-01-02"), c.Text))
+		}
+		writeMarkdownSection(&b, "Comments", strings.Join(comments, "\n"))
+	}
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return 0, fmt.Errorf("failed to write markdown: %w", err)
+	}
+	return len(issues), nil
+}
+
+func writeMarkdownSection(b *strings.Builder, name, content string) {
+	if strings.TrimSpace(content) == "" {
+		return
+	}
+	fmt.Fprintf(b, "\n### %s\n%s\n", name, strings.TrimSpace(content))
+}
+
+// exportDepSpecs renders an issue's outgoing dependencies as type:id.
+func exportDepSpecs(issue *types.Issue) []string {
+	var specs []string
+	for _, dep := range issue.Dependencies {
+		if dep.IssueID == issue.ID {
+			specs = append(specs, string(dep.Type)+":"+dep.DependsOnID)
+		}
+	}
+	return specs
+}
+
+// exportParentID returns the issue's parent from a parent-child dependency,
+// falling back to its hierarchical ID.
+func exportParentID(issue *types.Issue) string {
+	for _, dep := range issue.Dependencies {
+		if dep.Type == types.DepParentChild && dep.IssueID == issue.ID {
+			return dep.DependsOnID
+		}
+	}
+	_, parent, _ := config.GetIDHierarchy().Parse(issue.ID)
+	return parent
+}
+
+func exportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
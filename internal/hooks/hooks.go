@@ -28,6 +28,7 @@ const (
 type Runner struct {
 	hooksDir string
 	timeout  time.Duration
+	webhooks webhookState
 }
 
 // NewRunner creates a new hook runner.
@@ -0,0 +1,187 @@
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// DefaultWebhookTimeout bounds a single webhook delivery.
+const DefaultWebhookTimeout = 5 * time.Second
+
+// WebhookPayload is the JSON body POSTed to a configured webhook URL.
+type WebhookPayload struct {
+	Event     string    `json:"event"`
+	IssueID   string    `json:"issue_id"`
+	Title     string    `json:"title,omitempty"`
+	OldStatus string    `json:"old_status,omitempty"`
+	NewStatus string    `json:"new_status"`
+	Actor     string    `json:"actor,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// NewWebhookPayload builds the payload for an issue transition.
+// oldStatus is empty for create events.
+func NewWebhookPayload(event string, issue *types.Issue, oldStatus types.Status, actor string) WebhookPayload {
+	return WebhookPayload{
+		Event:     event,
+		IssueID:   issue.ID,
+		Title:     issue.Title,
+		OldStatus: string(oldStatus),
+		NewStatus: string(issue.Status),
+		Actor:     actor,
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+// webhookState holds webhook configuration and in-flight deliveries.
+// Kept separate from Runner's script fields so the zero value is usable.
+type webhookState struct {
+	mu      sync.Mutex
+	urls    map[string]string // event -> URL
+	timeout time.Duration
+	wg      sync.WaitGroup
+	errs    []error
+}
+
+// SetWebhook registers a URL to POST to after the given event.
+// An empty URL removes any webhook for the event.
+func (r *Runner) SetWebhook(event, url string) {
+	r.webhooks.mu.Lock()
+	defer r.webhooks.mu.Unlock()
+	if r.webhooks.urls == nil {
+		r.webhooks.urls = make(map[string]string)
+	}
+	if url == "" {
+		delete(r.webhooks.urls, event)
+		return
+	}
+	r.webhooks.urls[event] = url
+}
+
+// SetWebhookTimeout overrides the per-delivery timeout (DefaultWebhookTimeout).
+func (r *Runner) SetWebhookTimeout(timeout time.Duration) {
+	r.webhooks.mu.Lock()
+	defer r.webhooks.mu.Unlock()
+	r.webhooks.timeout = timeout
+}
+
+// WebhookURL returns the URL configured for an event, or "" if none.
+func (r *Runner) WebhookURL(event string) string {
+	r.webhooks.mu.Lock()
+	defer r.webhooks.mu.Unlock()
+	return r.webhooks.urls[event]
+}
+
+// FireWebhook POSTs the event payload to the configured URL, if any.
+// Runs asynchronously so the triggering command is never blocked; call
+// WaitWebhooks before process exit to let in-flight deliveries finish.
+func (r *Runner) FireWebhook(event string, issue *types.Issue, oldStatus types.Status, actor string) {
+	url := r.WebhookURL(event)
+	if url == "" || issue == nil {
+		return
+	}
+
+	payload := NewWebhookPayload(event, issue, oldStatus, actor)
+	r.webhooks.wg.Add(1)
+	go func() {
+		defer r.webhooks.wg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), r.webhookTimeout())
+		defer cancel()
+		if err := r.PostWebhook(ctx, url, payload); err != nil {
+			r.webhooks.mu.Lock()
+			r.webhooks.errs = append(r.webhooks.errs, err)
+			r.webhooks.mu.Unlock()
+		}
+	}()
+}
+
+// WaitWebhooks waits up to maxWait (at most the webhook timeout; <= 0 means
+// the full timeout) for in-flight deliveries and returns any delivery errors
+// collected so far. Deliveries still running when the wait expires are
+// abandoned.
+func (r *Runner) WaitWebhooks(maxWait time.Duration) []error {
+	if timeout := r.webhookTimeout(); maxWait <= 0 || maxWait > timeout {
+		maxWait = timeout
+	}
+	done := make(chan struct{})
+	go func() {
+		r.webhooks.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(maxWait):
+	}
+
+	r.webhooks.mu.Lock()
+	defer r.webhooks.mu.Unlock()
+	errs := r.webhooks.errs
+	r.webhooks.errs = nil
+	return errs
+}
+
+// PostWebhook delivers a payload synchronously. Non-2xx responses are errors.
+func (r *Runner) PostWebhook(ctx context.Context, url string, payload WebhookPayload) (retErr error) {
+	// Webhooks are fire-and-forget like script hooks, so they get a root span.
+	tracer := otel.Tracer("github.com/steveyegge/beads/hooks")
+	ctx, span := tracer.Start(ctx, "hook.webhook",
+		trace.WithAttributes(
+			attribute.String("hook.event", payload.Event),
+			attribute.String("hook.url", url),
+			attribute.String("bd.issue_id", payload.IssueID),
+		),
+	)
+	defer func() {
+		if retErr != nil {
+			span.RecordError(retErr)
+			span.SetStatus(codes.Error, retErr.Error())
+		}
+		span.End()
+	}()
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("webhook %s: marshal payload: %w", payload.Event, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook %s: %w", payload.Event, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "beads-webhook")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook %s to %s failed: %w", payload.Event, url, err)
+	}
+	defer resp.Body.Close()
+
+	span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook %s to %s failed: HTTP %d", payload.Event, url, resp.StatusCode)
+	}
+	return nil
+}
+
+func (r *Runner) webhookTimeout() time.Duration {
+	r.webhooks.mu.Lock()
+	defer r.webhooks.mu.Unlock()
+	if r.webhooks.timeout > 0 {
+		return r.webhooks.timeout
+	}
+	return DefaultWebhookTimeout
+}
@@ -0,0 +1,135 @@
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestFireWebhook_PostsPayload(t *testing.T) {
+	received := make(chan WebhookPayload, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Content-Type = %q, want application/json", ct)
+		}
+		var p WebhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			t.Errorf("decode payload: %v", err)
+		}
+		received <- p
+	}))
+	defer server.Close()
+
+	runner := NewRunner(t.TempDir())
+	runner.SetWebhook(EventClose, server.URL)
+
+	issue := &types.Issue{ID: "bd-abc", Title: "Fix it", Status: types.StatusClosed}
+	runner.FireWebhook(EventClose, issue, types.StatusInProgress, "alice")
+
+	if errs := runner.WaitWebhooks(0); len(errs) != 0 {
+		t.Fatalf("unexpected webhook errors: %v", errs)
+	}
+
+	select {
+	case p := <-received:
+		if p.Event != EventClose || p.IssueID != "bd-abc" || p.Actor != "alice" {
+			t.Errorf("unexpected payload: %+v", p)
+		}
+		if p.OldStatus != "in_progress" || p.NewStatus != "closed" {
+			t.Errorf("status transition = %q -> %q, want in_progress -> closed", p.OldStatus, p.NewStatus)
+		}
+	default:
+		t.Fatal("webhook was not delivered")
+	}
+}
+
+func TestFireWebhook_NoURLConfigured(t *testing.T) {
+	runner := NewRunner(t.TempDir())
+	issue := &types.Issue{ID: "bd-abc", Status: types.StatusOpen}
+
+	// Should be a no-op without a configured URL
+	runner.FireWebhook(EventCreate, issue, "", "alice")
+	if errs := runner.WaitWebhooks(0); len(errs) != 0 {
+		t.Fatalf("unexpected webhook errors: %v", errs)
+	}
+}
+
+func TestFireWebhook_ReportsHTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	runner := NewRunner(t.TempDir())
+	runner.SetWebhook(EventCreate, server.URL)
+	runner.FireWebhook(EventCreate, &types.Issue{ID: "bd-abc", Status: types.StatusOpen}, "", "alice")
+
+	errs := runner.WaitWebhooks(0)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 webhook error, got %d: %v", len(errs), errs)
+	}
+	if !strings.Contains(errs[0].Error(), "HTTP 500") {
+		t.Errorf("error = %v, want HTTP 500", errs[0])
+	}
+}
+
+func TestFireWebhook_TimeoutDoesNotBlock(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	runner := NewRunner(t.TempDir())
+	runner.SetWebhook(EventClose, server.URL)
+	runner.SetWebhookTimeout(100 * time.Millisecond)
+
+	start := time.Now()
+	runner.FireWebhook(EventClose, &types.Issue{ID: "bd-abc", Status: types.StatusClosed}, types.StatusOpen, "alice")
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("FireWebhook blocked for %v", elapsed)
+	}
+
+	runner.WaitWebhooks(0)
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("WaitWebhooks did not respect timeout, took %v", elapsed)
+	}
+}
+
+func TestWaitWebhooks_MaxWaitCapsTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	runner := NewRunner(t.TempDir())
+	runner.SetWebhook(EventClose, server.URL)
+
+	// The default 5s delivery timeout must not hold up a short exit wait
+	runner.FireWebhook(EventClose, &types.Issue{ID: "bd-abc", Status: types.StatusClosed}, types.StatusOpen, "alice")
+	start := time.Now()
+	runner.WaitWebhooks(100 * time.Millisecond)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("WaitWebhooks(100ms) took %v", elapsed)
+	}
+}
+
+func TestPostWebhook_InvalidURL(t *testing.T) {
+	runner := NewRunner(t.TempDir())
+	payload := NewWebhookPayload(EventCreate, &types.Issue{ID: "bd-abc"}, "", "alice")
+	if err := runner.PostWebhook(context.Background(), "http://127.0.0.1:0/hook", payload); err == nil {
+		t.Fatal("expected error for unreachable URL")
+	}
+}
@@ -0,0 +1,121 @@
+package idgen
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ULIDLength is the length of a ULID: 10 characters of millisecond
+// timestamp followed by 16 characters of randomness.
+const ULIDLength = 26
+
+// crockfordAlphabet is Crockford's base32 alphabet, lowercased to match the
+// rest of bd's IDs. Its characters are in ASCII order, so ULIDs compare as
+// strings in timestamp order.
+const crockfordAlphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+
+// ulidState makes ULIDs from this process monotonic: within one millisecond
+// (or when the clock steps backwards) the random part is incremented instead
+// of redrawn.
+var ulidState struct {
+	sync.Mutex
+	lastMs   uint64
+	lastRand [10]byte
+}
+
+// NewULID returns a lowercase ULID for t. ULIDs sort by time, and ULIDs
+// generated by one process always sort in generation order.
+func NewULID(t time.Time) (string, error) {
+	ms := uint64(t.UnixMilli()) //nolint:gosec // G115: pre-1970 clocks are not supported
+	if ms >= 1<<48 {
+		return "", fmt.Errorf("timestamp %v is outside the ULID range", t)
+	}
+
+	ulidState.Lock()
+	defer ulidState.Unlock()
+	if ms <= ulidState.lastMs {
+		ms = ulidState.lastMs
+		if !incrementULIDRandom(&ulidState.lastRand) {
+			return "", fmt.Errorf("ULID random part overflowed within one millisecond")
+		}
+	} else {
+		if _, err := rand.Read(ulidState.lastRand[:]); err != nil {
+			return "", fmt.Errorf("failed to read ULID randomness: %w", err)
+		}
+		ulidState.lastMs = ms
+	}
+
+	var out [ULIDLength]byte
+	for i := 9; i >= 0; i-- {
+		out[i] = crockfordAlphabet[ms&31]
+		ms >>= 5
+	}
+	// 80 random bits make exactly 16 five-bit characters
+	var bits uint64
+	var nbits uint
+	pos := 10
+	for _, b := range ulidState.lastRand {
+		bits = bits<<8 | uint64(b)
+		nbits += 8
+		for nbits >= 5 {
+			nbits -= 5
+			out[pos] = crockfordAlphabet[(bits>>nbits)&31]
+			pos++
+		}
+	}
+	return string(out[:]), nil
+}
+
+// incrementULIDRandom adds one to the big-endian random part, reporting
+// false on overflow.
+func incrementULIDRandom(r *[10]byte) bool {
+	for i := len(r) - 1; i >= 0; i-- {
+		r[i]++
+		if r[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateULIDID creates a time-sortable ID such as
+// "bd-01j9z3k4m5n6p7q8r9s0t1v2w3" for an issue created at t.
+func GenerateULIDID(prefix string, t time.Time) (string, error) {
+	ulid, err := NewULID(t)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s", prefix, ulid), nil
+}
+
+// IsULID reports whether s is a ULID in either case.
+func IsULID(s string) bool {
+	if len(s) != ULIDLength {
+		return false
+	}
+	// The first character holds the top 3 bits of the 48-bit timestamp
+	if c := s[0]; c < '0' || c > '7' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		if !isCrockford(c) {
+			return false
+		}
+	}
+	return true
+}
+
+func isCrockford(c byte) bool {
+	for i := 0; i < len(crockfordAlphabet); i++ {
+		if crockfordAlphabet[i] == c {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package idgen
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewULID(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	id, err := NewULID(at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsULID(id) {
+		t.Fatalf("NewULID = %q, not a ULID", id)
+	}
+	if id != strings.ToLower(id) {
+		t.Errorf("NewULID = %q, want lowercase", id)
+	}
+
+	// Later timestamps sort after earlier ones
+	later, err := NewULID(at.Add(time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if later <= id {
+		t.Errorf("ULID for a later time %q should sort after %q", later, id)
+	}
+	if later[:10] == id[:10] {
+		t.Errorf("timestamp parts should differ: %q vs %q", later, id)
+	}
+}
+
+func TestNewULID_MonotonicWithinMillisecond(t *testing.T) {
+	at := time.Now().Add(time.Hour) // ahead of any ULID made so far
+	var ids []string
+	for i := 0; i < 100; i++ {
+		id, err := NewULID(at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	if !sort.StringsAreSorted(ids) {
+		t.Error("ULIDs from the same millisecond should sort in generation order")
+	}
+	for i := 1; i < len(ids); i++ {
+		if ids[i] == ids[i-1] {
+			t.Fatalf("duplicate ULID %q", ids[i])
+		}
+	}
+
+	// A clock that steps backwards still yields increasing IDs
+	earlier, err := NewULID(at.Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if earlier <= ids[len(ids)-1] {
+		t.Errorf("ULID after a clock step back %q should sort after %q", earlier, ids[len(ids)-1])
+	}
+}
+
+func TestGenerateULIDID(t *testing.T) {
+	id, err := GenerateULIDID("bd", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	suffix, ok := strings.CutPrefix(id, "bd-")
+	if !ok || !IsULID(suffix) {
+		t.Errorf("GenerateULIDID = %q, want bd-<ulid>", id)
+	}
+}
+
+func TestIsULID(t *testing.T) {
+	for _, s := range []string{"01j9z3k4m5n6p7q8r9s0t1v2w3", "01J9Z3K4M5N6P7Q8R9S0T1V2W3", "7zzzzzzzzzzzzzzzzzzzzzzzzz"} {
+		if !IsULID(s) {
+			t.Errorf("IsULID(%q) = false, want true", s)
+		}
+	}
+	for _, s := range []string{
+		"",
+		"a3f8e9",                      // hash suffix
+		"01j9z3k4m5n6p7q8r9s0t1v2w",   // too short
+		"01j9z3k4m5n6p7q8r9s0t1v2w3x", // too long
+		"81j9z3k4m5n6p7q8r9s0t1v2w3",  // timestamp overflow
+		"01j9z3k4m5n6p7q8r9s0t1v2wu",  // u is not Crockford base32
+		"01j9z3k4m5n6p7q8r9s0t1v2w.",
+	} {
+		if IsULID(s) {
+			t.Errorf("IsULID(%q) = true, want false", s)
+		}
+	}
+}
@@ -36,3 +36,10 @@ func FlockExclusiveBlocking(f *os.File) error {
 func FlockUnlock(f *os.File) error {
 	return unix.Flock(int(f.Fd()), unix.LOCK_UN)
 }
+
+// ProcessAlive reports whether a process with the given PID exists.
+// EPERM means it exists but belongs to another user.
+func ProcessAlive(pid int) bool {
+	err := unix.Kill(pid, 0)
+	return err == nil || err == unix.EPERM
+}
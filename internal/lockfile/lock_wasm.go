@@ -32,3 +32,9 @@ func FlockExclusiveBlocking(f *os.File) error {
 func FlockUnlock(f *os.File) error {
 	return nil
 }
+
+// ProcessAlive reports whether a process with the given PID exists.
+// WASM is single-process, so any recorded owner is assumed alive.
+func ProcessAlive(pid int) bool {
+	return true
+}
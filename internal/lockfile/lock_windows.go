@@ -73,3 +73,13 @@ func FlockUnlock(f *os.File) error {
 		ol,
 	)
 }
+
+// ProcessAlive reports whether a process with the given PID exists.
+func ProcessAlive(pid int) bool {
+	h, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, uint32(pid)) //nolint:gosec // G115: PIDs fit in uint32
+	if err != nil {
+		return false
+	}
+	_ = windows.CloseHandle(h)
+	return true
+}
@@ -0,0 +1,36 @@
+package lockfile
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// WriteOwner records the current process ID in a held lock file so that
+// diagnostics can tell a crashed owner from a live one.
+func WriteOwner(f *os.File) error {
+	if err := f.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := f.WriteAt([]byte(fmt.Sprintf("%d\n", os.Getpid())), 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReadOwnerPID returns the PID recorded in a lock file by WriteOwner.
+// Returns ok=false if the file can't be read or holds no PID (older lock
+// files contain arbitrary content).
+func ReadOwnerPID(path string) (pid int, ok bool) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: caller-controlled lock path
+	if err != nil {
+		return 0, false
+	}
+	line, _, _ := strings.Cut(strings.TrimSpace(string(data)), "\n")
+	pid, err = strconv.Atoi(strings.TrimSpace(line))
+	if err != nil || pid <= 0 {
+		return 0, false
+	}
+	return pid, true
+}
@@ -0,0 +1,295 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage"
+)
+
+// SQLTables names the tables a compiled filter expression refers to, so the
+// same expression can run against issues or wisps.
+type SQLTables struct {
+	Main         string // "issues" or "wisps"
+	Labels       string // "labels" or "wisp_labels"
+	Dependencies string // "dependencies" or "wisp_dependencies"
+}
+
+// IssuesSQLTables is the SQLTables for the issues table.
+var IssuesSQLTables = SQLTables{Main: "issues", Labels: "labels", Dependencies: "dependencies"}
+
+// sqlKind groups whitelisted fields by how their comparisons compile.
+type sqlKind int
+
+const (
+	sqlExact     sqlKind = iota // case-sensitive equality (mol_type)
+	sqlFold                     // case-insensitive equality (assignee, owner)
+	sqlLower                    // value lowercased, then equality (status)
+	sqlType                     // issue_type, isolated in a subquery
+	sqlPrefix                   // equality, or prefix match with a trailing * (id, spec)
+	sqlContains                 // case-insensitive substring (title, description, notes)
+	sqlInt                      // integer comparison (priority)
+	sqlTime                     // timestamp comparison (created, updated, closed)
+	sqlBool                     // boolean flag (pinned, ephemeral, template)
+	sqlLabel                    // label membership
+	sqlParent                   // parent-child membership
+	sqlMetaKey                  // metadata key presence (has_metadata_key)
+	sqlMetaField                // metadata value equality (metadata.<key>)
+)
+
+type sqlField struct {
+	column string
+	kind   sqlKind
+	// none lets "field=none" (or "", "null") match an empty value.
+	none bool
+}
+
+// sqlFields is the whitelist of fields a filter expression may compile to.
+// Column names only ever come from here; values are always bound as args.
+var sqlFields = map[string]sqlField{
+	"id":          {column: "id", kind: sqlPrefix},
+	"spec":        {column: "spec_id", kind: sqlPrefix},
+	"spec_id":     {column: "spec_id", kind: sqlPrefix},
+	"status":      {column: "status", kind: sqlLower},
+	"type":        {column: "issue_type", kind: sqlType},
+	"assignee":    {column: "assignee", kind: sqlFold, none: true},
+	"owner":       {column: "owner", kind: sqlFold},
+	"mol_type":    {column: "mol_type", kind: sqlExact},
+	"priority":    {column: "priority", kind: sqlInt},
+	"title":       {column: "title", kind: sqlContains},
+	"description": {column: "description", kind: sqlContains, none: true},
+	"desc":        {column: "description", kind: sqlContains, none: true},
+	"notes":       {column: "notes", kind: sqlContains},
+	"created":     {column: "created_at", kind: sqlTime},
+	"created_at":  {column: "created_at", kind: sqlTime},
+	"updated":     {column: "updated_at", kind: sqlTime},
+	"updated_at":  {column: "updated_at", kind: sqlTime},
+	"closed":      {column: "closed_at", kind: sqlTime},
+	"closed_at":   {column: "closed_at", kind: sqlTime},
+	"pinned":      {column: "pinned", kind: sqlBool},
+	"ephemeral":   {column: "ephemeral", kind: sqlBool},
+	"template":    {column: "is_template", kind: sqlBool},
+	"label":       {kind: sqlLabel, none: true},
+	"labels":      {kind: sqlLabel, none: true},
+	"parent":      {kind: sqlParent},
+
+	"has_metadata_key": {kind: sqlMetaKey},
+}
+
+// SQLFields returns the field names filter expressions accept, sorted.
+// Any metadata.<key> field is accepted as well.
+func SQLFields() []string {
+	names := make([]string, 0, len(sqlFields))
+	for name := range sqlFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CompileSQL parses a filter expression such as
+// "status=open AND (priority<=1 OR label=urgent)" and compiles it to a
+// parenthesized WHERE clause fragment with ? placeholders and its args.
+// Comparisons mean the same as in 'bd query'. Only whitelisted fields and
+// the six comparison operators are accepted, so the clause never contains
+// text from the expression itself.
+func CompileSQL(expr string, now time.Time, tables SQLTables) (string, []interface{}, error) {
+	node, err := Parse(expr)
+	if err != nil {
+		return "", nil, err
+	}
+	c := &sqlCompiler{now: now, tables: tables}
+	clause, err := c.compile(node)
+	if err != nil {
+		return "", nil, err
+	}
+	return clause, c.args, nil
+}
+
+// ValidateSQL reports whether expr would compile, for checking user input
+// before it reaches the store.
+func ValidateSQL(expr string) error {
+	_, _, err := CompileSQL(expr, time.Now(), IssuesSQLTables)
+	return err
+}
+
+type sqlCompiler struct {
+	now    time.Time
+	tables SQLTables
+	args   []interface{}
+}
+
+func (c *sqlCompiler) compile(node Node) (string, error) {
+	switch n := node.(type) {
+	case *ComparisonNode:
+		return c.compileComparison(n)
+	case *AndNode:
+		return c.compileBinary(n.Left, n.Right, "AND")
+	case *OrNode:
+		return c.compileBinary(n.Left, n.Right, "OR")
+	case *NotNode:
+		operand, err := c.compile(n.Operand)
+		if err != nil {
+			return "", err
+		}
+		return "(NOT " + operand + ")", nil
+	default:
+		return "", fmt.Errorf("unexpected node type: %T", node)
+	}
+}
+
+func (c *sqlCompiler) compileBinary(left, right Node, op string) (string, error) {
+	l, err := c.compile(left)
+	if err != nil {
+		return "", err
+	}
+	r, err := c.compile(right)
+	if err != nil {
+		return "", err
+	}
+	return "(" + l + " " + op + " " + r + ")", nil
+}
+
+// compileComparison compiles one comparison. Every clause evaluates to true
+// or false, never NULL, so NOT inverts it the way 'bd query' does.
+func (c *sqlCompiler) compileComparison(comp *ComparisonNode) (string, error) {
+	field, ok := sqlFields[comp.Field]
+	if !ok && strings.HasPrefix(comp.Field, "metadata.") {
+		field, ok = sqlField{kind: sqlMetaField}, true
+	}
+	if !ok {
+		return "", fmt.Errorf("unknown field %q (valid fields: %s)", comp.Field, strings.Join(SQLFields(), ", "))
+	}
+	if field.kind != sqlInt && field.kind != sqlTime && comp.Op != OpEquals && comp.Op != OpNotEquals {
+		return "", fmt.Errorf("%s does not support %s operator", comp.Field, comp.Op.String())
+	}
+
+	isNone := field.none && isNoneValue(comp.Value)
+	var clause string
+	switch field.kind {
+	case sqlExact:
+		clause = c.bind("COALESCE("+field.column+", '') = ?", comp.Value)
+	case sqlFold:
+		if isNone {
+			clause = "COALESCE(" + field.column + ", '') = ''"
+		} else {
+			clause = c.bind("LOWER(COALESCE("+field.column+", '')) = ?", strings.ToLower(comp.Value))
+		}
+	case sqlLower:
+		clause = c.bind("COALESCE("+field.column+", '') = ?", strings.ToLower(comp.Value))
+	case sqlType:
+		// Subquery mirrors the IssueType filter, which avoids a Dolt
+		// mergeJoinIter panic when combined with other indexed predicates.
+		clause = c.bind("id IN (SELECT id FROM "+c.tables.Main+" WHERE issue_type = ?)", strings.ToLower(comp.Value))
+	case sqlPrefix:
+		if prefix, ok := strings.CutSuffix(comp.Value, "*"); ok {
+			clause = c.bind("COALESCE("+field.column+", '') LIKE ?", escapeLike(prefix)+"%")
+		} else {
+			clause = c.bind("COALESCE("+field.column+", '') = ?", comp.Value)
+		}
+	case sqlContains:
+		if isNone {
+			clause = "COALESCE(" + field.column + ", '') = ''"
+		} else {
+			clause = c.bind("LOWER(COALESCE("+field.column+", '')) LIKE ?", "%"+escapeLike(strings.ToLower(comp.Value))+"%")
+		}
+	case sqlInt:
+		n, err := strconv.Atoi(comp.Value)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s: %s", comp.Field, comp.Value)
+		}
+		return "(" + c.bind("COALESCE("+field.column+", 0) "+comp.Op.String()+" ?", n) + ")", nil
+	case sqlTime:
+		return c.compileTime(comp, field.column)
+	case sqlBool:
+		b, err := parseBoolValue(comp.Value)
+		if err != nil {
+			return "", err
+		}
+		clause = c.bind("COALESCE("+field.column+", 0) = ?", b)
+	case sqlLabel:
+		if isNone {
+			clause = "id NOT IN (SELECT issue_id FROM " + c.tables.Labels + ")"
+		} else {
+			clause = c.bind("id IN (SELECT issue_id FROM "+c.tables.Labels+" WHERE LOWER(label) = ?)", strings.ToLower(comp.Value))
+		}
+	case sqlParent:
+		clause = c.bind("id IN (SELECT issue_id FROM "+c.tables.Dependencies+" WHERE type = 'parent-child' AND depends_on_id = ?)", comp.Value)
+	case sqlMetaKey:
+		if err := storage.ValidateMetadataKey(comp.Value); err != nil {
+			return "", err
+		}
+		clause = c.bind("JSON_EXTRACT(metadata, ?) IS NOT NULL", "$."+comp.Value)
+	case sqlMetaField:
+		key := strings.TrimPrefix(comp.Field, "metadata.")
+		if err := storage.ValidateMetadataKey(key); err != nil {
+			return "", err
+		}
+		clause = c.bind("COALESCE(JSON_UNQUOTE(JSON_EXTRACT(metadata, ?)), '') = ?", "$."+key, comp.Value)
+	default:
+		return "", fmt.Errorf("field %s cannot be compiled", comp.Field)
+	}
+
+	if comp.Op == OpNotEquals {
+		return "(NOT (" + clause + "))", nil
+	}
+	return "(" + clause + ")", nil
+}
+
+// compileTime compiles a timestamp comparison. As in 'bd query', a
+// duration such as 7d means "7 days ago" and = compares calendar days.
+func (c *sqlCompiler) compileTime(comp *ComparisonNode, column string) (string, error) {
+	e := &Evaluator{now: c.now}
+	t, err := e.parseTimeValue(comp)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s time: %w", comp.Field, err)
+	}
+	// Unset timestamps (an open issue's closed_at) never match.
+	notNull := column + " IS NOT NULL AND "
+	switch comp.Op {
+	case OpEquals, OpNotEquals:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		clause := c.bind(column+" >= ? AND "+column+" < ?", sqlTimeArg(day), sqlTimeArg(day.AddDate(0, 0, 1)))
+		if comp.Op == OpNotEquals {
+			clause = "NOT (" + clause + ")"
+		}
+		return "(" + notNull + clause + ")", nil
+	default:
+		return "(" + c.bind(notNull+column+" "+comp.Op.String()+" ?", sqlTimeArg(t)) + ")", nil
+	}
+}
+
+// bind appends args and returns clause unchanged, so callers can write the
+// clause and its values side by side.
+func (c *sqlCompiler) bind(clause string, args ...interface{}) string {
+	c.args = append(c.args, args...)
+	return clause
+}
+
+// sqlTimeArg formats t the way the store's other date filters bind it.
+func sqlTimeArg(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func isNoneValue(s string) bool {
+	return s == "" || strings.EqualFold(s, "none") || strings.EqualFold(s, "null")
+}
+
+func parseBoolValue(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "true", "yes", "1":
+		return true, nil
+	case "false", "no", "0":
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid boolean value: %s", s)
+	}
+}
+
+// escapeLike escapes LIKE wildcards so values match literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,242 @@
+package query
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCompileSQL(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		expr   string
+		clause string
+		args   []interface{}
+	}{
+		{
+			name:   "simple equality",
+			expr:   "status=open",
+			clause: "(COALESCE(status, '') = ?)",
+			args:   []interface{}{"open"},
+		},
+		{
+			name:   "AND binds tighter than OR",
+			expr:   "status=open OR status=blocked AND priority<2",
+			clause: "((COALESCE(status, '') = ?) OR ((COALESCE(status, '') = ?) AND (COALESCE(priority, 0) < ?)))",
+			args:   []interface{}{"open", "blocked", 2},
+		},
+		{
+			name:   "parentheses override precedence",
+			expr:   "(status=open OR status=blocked) AND priority>=3",
+			clause: "(((COALESCE(status, '') = ?) OR (COALESCE(status, '') = ?)) AND (COALESCE(priority, 0) >= ?))",
+			args:   []interface{}{"open", "blocked", 3},
+		},
+		{
+			name:   "NOT applies to the next term only",
+			expr:   "NOT status=closed AND priority=1",
+			clause: "((NOT (COALESCE(status, '') = ?)) AND (COALESCE(priority, 0) = ?))",
+			args:   []interface{}{"closed", 1},
+		},
+		{
+			name:   "not equals",
+			expr:   "assignee!=alice",
+			clause: "(NOT (LOWER(COALESCE(assignee, '')) = ?))",
+			args:   []interface{}{"alice"},
+		},
+		{
+			name:   "assignee none",
+			expr:   "assignee=none",
+			clause: "(COALESCE(assignee, '') = '')",
+		},
+		{
+			name:   "type uses subquery",
+			expr:   "type=Bug",
+			clause: "(id IN (SELECT id FROM issues WHERE issue_type = ?))",
+			args:   []interface{}{"bug"},
+		},
+		{
+			name:   "quoted title is a contains match",
+			expr:   `title="login page"`,
+			clause: "(LOWER(COALESCE(title, '')) LIKE ?)",
+			args:   []interface{}{"%login page%"},
+		},
+		{
+			name:   "id wildcard",
+			expr:   `id="bd-*"`,
+			clause: "(COALESCE(id, '') LIKE ?)",
+			args:   []interface{}{"bd-%"},
+		},
+		{
+			name:   "label",
+			expr:   "label=Urgent",
+			clause: "(id IN (SELECT issue_id FROM labels WHERE LOWER(label) = ?))",
+			args:   []interface{}{"urgent"},
+		},
+		{
+			name:   "label none",
+			expr:   "label=none",
+			clause: "(id NOT IN (SELECT issue_id FROM labels))",
+		},
+		{
+			name:   "parent",
+			expr:   "parent=bd-1",
+			clause: "(id IN (SELECT issue_id FROM dependencies WHERE type = 'parent-child' AND depends_on_id = ?))",
+			args:   []interface{}{"bd-1"},
+		},
+		{
+			name:   "bool flag",
+			expr:   "template=yes",
+			clause: "(COALESCE(is_template, 0) = ?)",
+			args:   []interface{}{true},
+		},
+		{
+			name:   "duration means ago",
+			expr:   "updated>7d",
+			clause: "(updated_at IS NOT NULL AND updated_at > ?)",
+			args:   []interface{}{"2025-06-08T12:00:00Z"},
+		},
+		{
+			name:   "date equality is a whole day",
+			expr:   `closed="2025-06-01"`,
+			clause: "(closed_at IS NOT NULL AND closed_at >= ? AND closed_at < ?)",
+			args:   []interface{}{"2025-06-01T00:00:00Z", "2025-06-02T00:00:00Z"},
+		},
+		{
+			name:   "metadata field",
+			expr:   "metadata.team=core",
+			clause: "(COALESCE(JSON_UNQUOTE(JSON_EXTRACT(metadata, ?)), '') = ?)",
+			args:   []interface{}{"$.team", "core"},
+		},
+		{
+			name:   "has metadata key",
+			expr:   "has_metadata_key=team",
+			clause: "(JSON_EXTRACT(metadata, ?) IS NOT NULL)",
+			args:   []interface{}{"$.team"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clause, args, err := CompileSQL(tt.expr, now, IssuesSQLTables)
+			if err != nil {
+				t.Fatalf("CompileSQL(%q) error: %v", tt.expr, err)
+			}
+			if clause != tt.clause {
+				t.Errorf("clause = %s\nwant     %s", clause, tt.clause)
+			}
+			if len(args) != len(tt.args) || (len(args) > 0 && !reflect.DeepEqual(args, tt.args)) {
+				t.Errorf("args = %#v, want %#v", args, tt.args)
+			}
+		})
+	}
+}
+
+func TestCompileSQL_Tables(t *testing.T) {
+	wisps := SQLTables{Main: "wisps", Labels: "wisp_labels", Dependencies: "wisp_dependencies"}
+	clause, _, err := CompileSQL("type=task OR label=x OR parent=bd-1", time.Now(), wisps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, table := range []string{"FROM wisps ", "FROM wisp_labels ", "FROM wisp_dependencies "} {
+		if !strings.Contains(clause, table) {
+			t.Errorf("clause %q does not reference %q", clause, table)
+		}
+	}
+	if strings.Contains(clause, "FROM labels") || strings.Contains(clause, "FROM dependencies") {
+		t.Errorf("clause %q references issues tables", clause)
+	}
+}
+
+// TestCompileSQL_Injection checks that hostile values end up as bound args
+// and never in the clause, and that anything outside the grammar is rejected.
+func TestCompileSQL_Injection(t *testing.T) {
+	for _, expr := range []string{
+		`title="x' OR '1'='1"`,
+		`title="x\"; DROP TABLE issues; --"`,
+		`assignee='bob\' OR 1=1 --'`,
+		`metadata.team="a') OR 1=1 --"`,
+		`label="%' UNION SELECT * FROM config --"`,
+	} {
+		t.Run(expr, func(t *testing.T) {
+			clause, args, err := CompileSQL(expr, time.Now(), IssuesSQLTables)
+			if err != nil {
+				t.Fatalf("CompileSQL error: %v", err)
+			}
+			for _, bad := range []string{"'1'", "DROP", "UNION", "--", "1=1"} {
+				if strings.Contains(clause, bad) {
+					t.Errorf("clause %q contains %q from the value", clause, bad)
+				}
+			}
+			if strings.Count(clause, "?") != len(args) {
+				t.Errorf("clause %q has %d placeholders for %d args", clause, strings.Count(clause, "?"), len(args))
+			}
+		})
+	}
+
+	for _, expr := range []string{
+		"status=open; DROP TABLE issues",
+		"status=open -- comment",
+		"1=1",
+		"id=x OR 1=1",
+		"status=open UNION SELECT",
+		"`status`=open",
+		"issues.status=open",
+		"password=x",
+		"metadata.a;b=x",
+		"has_metadata_key=\"a') OR ('1\"",
+		"status LIKE open",
+		"status=open)",
+		"(status=open",
+		"",
+	} {
+		t.Run("reject "+expr, func(t *testing.T) {
+			if _, _, err := CompileSQL(expr, time.Now(), IssuesSQLTables); err == nil {
+				t.Errorf("CompileSQL(%q) should fail", expr)
+			}
+		})
+	}
+}
+
+func TestCompileSQL_Quoting(t *testing.T) {
+	tests := []struct {
+		expr string
+		arg  interface{}
+	}{
+		{`title="it's done"`, "%it's done%"},
+		{`title='say "hi"'`, `%say "hi"%`},
+		{`title="back\\slash"`, `%back\\slash%`},
+		{`title="100%_off"`, `%100\%\_off%`},
+		{`id=bd-abc.1`, "bd-abc.1"},
+		{`assignee="Alice Smith"`, "alice smith"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, args, err := CompileSQL(tt.expr, time.Now(), IssuesSQLTables)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(args) != 1 || args[0] != tt.arg {
+				t.Errorf("args = %#v, want [%#v]", args, tt.arg)
+			}
+		})
+	}
+}
+
+func TestCompileSQL_Operators(t *testing.T) {
+	for _, expr := range []string{"status>open", "title<x", "label>=a", "pinned<true", "parent>bd-1"} {
+		if _, _, err := CompileSQL(expr, time.Now(), IssuesSQLTables); err == nil {
+			t.Errorf("CompileSQL(%q) should reject the operator", expr)
+		}
+	}
+	for _, expr := range []string{"priority>1", "priority!=0", "created<=30d", `closed!="2025-01-01"`} {
+		if _, _, err := CompileSQL(expr, time.Now(), IssuesSQLTables); err != nil {
+			t.Errorf("CompileSQL(%q) error: %v", expr, err)
+		}
+	}
+	if err := ValidateSQL("priority=high"); err == nil {
+		t.Error("ValidateSQL should reject a non-numeric priority")
+	}
+}
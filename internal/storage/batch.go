@@ -1,6 +1,12 @@
 // Package storage defines the interface for issue storage backends.
 package storage
 
+import (
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
 // OrphanHandling specifies how to handle issues with missing parent references.
 type OrphanHandling string
 
@@ -22,4 +28,13 @@ type BatchCreateOptions struct {
 	OrphanHandling OrphanHandling
 	// SkipPrefixValidation skips prefix validation for existing IDs (used during import)
 	SkipPrefixValidation bool
+	// IdempotencyKey, when set, is recorded for the last issue created, in the
+	// same transaction (bd create --idempotency-key). If an unexpired entry
+	// for the key exists, nothing is created and ErrIdempotencyKeyUsed is
+	// returned. The entry expires after IdempotencyTTL.
+	IdempotencyKey string
+	IdempotencyTTL time.Duration
+	// Hierarchy generates and parses child IDs. Stores fill it from their
+	// hierarchy.separator; the zero value uses the default separator.
+	Hierarchy types.IDHierarchy
 }
@@ -0,0 +1,542 @@
+package dolt
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// archiveColumns is every column shared by issues and issues_archive.
+// issueSelectColumns omits closed_by_session, which must survive a round trip.
+const archiveColumns = issueSelectColumns + `, closed_by_session`
+
+var archiveFilterTables = filterTables{main: "issues_archive", labels: "labels", dependencies: "dependencies"}
+
+// archivedRelations holds the rows that cascade away when an issue leaves the
+// issues table. They are stored with the archived row and put back on restore.
+type archivedRelations struct {
+	Labels       []string            `json:"labels,omitempty"`
+	Dependencies []*types.Dependency `json:"dependencies,omitempty"`
+	Comments     []*types.Comment    `json:"comments,omitempty"`
+	Events       []*types.Event      `json:"events,omitempty"`
+	Meta         map[string]string   `json:"meta,omitempty"`
+	Links        []*types.IssueLink  `json:"links,omitempty"`
+	Attachments  []*types.Attachment `json:"attachments,omitempty"`
+
+	// ChildCounter is the parent's child_counters.last_child. Restoring it
+	// keeps a restored parent from handing out child IDs already in use.
+	ChildCounter        int                          `json:"child_counter,omitempty"`
+	IssueSnapshots      []archivedIssueSnapshot      `json:"issue_snapshots,omitempty"`
+	CompactionSnapshots []archivedCompactionSnapshot `json:"compaction_snapshots,omitempty"`
+}
+
+// archivedIssueSnapshot is an issue_snapshots row (pre-compaction content).
+type archivedIssueSnapshot struct {
+	ID              string    `json:"id"`
+	SnapshotTime    time.Time `json:"snapshot_time"`
+	CompactionLevel int       `json:"compaction_level"`
+	OriginalSize    int       `json:"original_size"`
+	CompressedSize  int       `json:"compressed_size"`
+	OriginalContent string    `json:"original_content"`
+	ArchivedEvents  *string   `json:"archived_events,omitempty"`
+}
+
+// archivedCompactionSnapshot is a compaction_snapshots row.
+type archivedCompactionSnapshot struct {
+	ID              string    `json:"id"`
+	CompactionLevel int       `json:"compaction_level"`
+	SnapshotJSON    []byte    `json:"snapshot_json"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// ArchiveClosedIssues moves closed issues whose closed_at is before the cutoff
+// from issues into issues_archive in a single transaction and Dolt commit.
+// Pinned issues and templates are never archived. With dryRun the matching
+// IDs are returned without changing anything.
+func (s *DoltStore) ArchiveClosedIssues(ctx context.Context, before time.Time, dryRun bool) ([]string, error) {
+	commitMsg := fmt.Sprintf("bd: archive %%d closed issue(s) closed before %s", before.UTC().Format("2006-01-02"))
+	return s.archiveIssuesWhere(ctx, "closed_at IS NOT NULL AND closed_at < ?", before.UTC(), commitMsg, dryRun)
+}
+
+// ArchiveEligibleIssues moves closed issues whose archive_after is at or
+// before now into issues_archive, the sweep behind 'bd archive --run'.
+// archive_after is set on close when close.auto-archive-after is configured,
+// so recently closed issues stay in the active table until they come due.
+// Pinned issues and templates are never archived.
+func (s *DoltStore) ArchiveEligibleIssues(ctx context.Context, now time.Time, dryRun bool) ([]string, error) {
+	return s.archiveIssuesWhere(ctx, "archive_after IS NOT NULL AND archive_after <= ?", now.UTC(),
+		"bd: archive %d closed issue(s) past archive_after", dryRun)
+}
+
+// archiveIssuesWhere archives the closed, unpinned, non-template issues that
+// also match cond (a constant clause with one placeholder for arg). commitMsg
+// is a format string for the number of archived issues.
+func (s *DoltStore) archiveIssuesWhere(ctx context.Context, cond string, arg interface{}, commitMsg string, dryRun bool) ([]string, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // No-op after successful commit
+
+	//nolint:gosec // G201: cond is a constant clause chosen by the caller
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+		SELECT id FROM issues
+		WHERE status = ? AND %s
+		  AND (pinned = 0 OR pinned IS NULL) AND (is_template = 0 OR is_template IS NULL)
+		ORDER BY id
+	`, cond), types.StatusClosed, arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find archivable issues: %w", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed to scan issue id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to find archivable issues: %w", err)
+	}
+	if dryRun || len(ids) == 0 {
+		return ids, nil
+	}
+
+	now := time.Now().UTC()
+	for _, id := range ids {
+		rel, err := loadArchivedRelations(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		relJSON, err := json.Marshal(rel)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode relations of %s: %w", id, err)
+		}
+		//nolint:gosec // G201: archiveColumns is a constant column list
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`
+			INSERT INTO issues_archive (%s, archived_at, archived_relations)
+			SELECT %s, ?, ? FROM issues WHERE id = ?
+		`, archiveColumns, archiveColumns), now, string(relJSON), id); err != nil {
+			return nil, fmt.Errorf("failed to archive %s: %w", id, err)
+		}
+		// Labels, outgoing dependencies, comments, events, the child counter
+		// and compaction snapshots cascade
+		if _, err := tx.ExecContext(ctx, "DELETE FROM issues WHERE id = ?", id); err != nil {
+			return nil, fmt.Errorf("failed to remove archived %s from issues: %w", id, err)
+		}
+	}
+
+	// GH#2455: Stage only the tables we modified, then commit without -A.
+	for _, table := range []string{"issues", "issues_archive", "dependencies", "labels", "comments", "events", "child_counters", "issue_snapshots", "compaction_snapshots", "issue_meta", "issue_links", "attachments"} {
+		_, _ = tx.ExecContext(ctx, "CALL DOLT_ADD(?)", table)
+	}
+	if err := s.versionCommit(ctx, tx, fmt.Sprintf(commitMsg, len(ids))); err != nil {
+		return nil, fmt.Errorf("dolt commit: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, wrapTransactionError("commit archive", err)
+	}
+	s.invalidateBlockedIDsCache()
+	return ids, nil
+}
+
+// archiveAfter returns when an issue closed at closedAt becomes eligible for
+// ArchiveEligibleIssues, or nil when close.auto-archive-after is unset.
+// Malformed values never get this far: config validation rejects them.
+func archiveAfter(closedAt time.Time) interface{} {
+	d, err := config.AutoArchiveAfter()
+	if err != nil || d <= 0 {
+		return nil
+	}
+	return closedAt.Add(d)
+}
+
+// manageArchiveAfter keeps archive_after in step with closed_at on status
+// updates: it is set when an update closes an issue and cleared when one is
+// reopened, so a reopened issue is not swept.
+func manageArchiveAfter(oldIssue *types.Issue, updates map[string]interface{}, setClauses []string, args []interface{}) ([]string, []interface{}) {
+	newStatus, ok := closedAtTransition(updates)
+	if !ok {
+		return setClauses, args
+	}
+	if newStatus == string(types.StatusClosed) {
+		setClauses = append(setClauses, "archive_after = ?")
+		args = append(args, archiveAfter(time.Now().UTC()))
+	} else if oldIssue.Status == types.StatusClosed {
+		setClauses = append(setClauses, "archive_after = ?")
+		args = append(args, nil)
+	}
+	return setClauses, args
+}
+
+// RestoreArchivedIssue moves an archived issue back into issues together with
+// the rows that cascaded away when it was archived: labels, dependencies,
+// comments, events, its child counter and its compaction snapshots.
+func (s *DoltStore) RestoreArchivedIssue(ctx context.Context, id string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // No-op after successful commit
+
+	var relJSON sql.NullString
+	err = tx.QueryRowContext(ctx, "SELECT archived_relations FROM issues_archive WHERE id = ?", id).Scan(&relJSON)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: archived issue %s", storage.ErrNotFound, id)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read archived issue %s: %w", id, err)
+	}
+	var rel archivedRelations
+	if relJSON.Valid && relJSON.String != "" {
+		if err := json.Unmarshal([]byte(relJSON.String), &rel); err != nil {
+			return fmt.Errorf("failed to decode relations of %s: %w", id, err)
+		}
+	}
+
+	var active int
+	if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM issues WHERE id = ?", id).Scan(&active); err != nil {
+		return fmt.Errorf("failed to check issue %s: %w", id, err)
+	}
+	if active > 0 {
+		return fmt.Errorf("cannot restore %s: an active issue with that ID already exists", id)
+	}
+
+	//nolint:gosec // G201: archiveColumns is a constant column list
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		INSERT INTO issues (%s) SELECT %s FROM issues_archive WHERE id = ?
+	`, archiveColumns, archiveColumns), id); err != nil {
+		return fmt.Errorf("failed to restore %s: %w", id, err)
+	}
+	for _, label := range rel.Labels {
+		if _, err := tx.ExecContext(ctx, "INSERT IGNORE INTO labels (issue_id, label) VALUES (?, ?)", id, label); err != nil {
+			return fmt.Errorf("failed to restore label %s on %s: %w", label, id, err)
+		}
+	}
+	for _, dep := range rel.Dependencies {
+		metadata := dep.Metadata
+		if metadata == "" {
+			metadata = "{}"
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT IGNORE INTO dependencies (issue_id, depends_on_id, type, created_at, created_by, metadata, thread_id)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, id, dep.DependsOnID, dep.Type, dep.CreatedAt.UTC(), dep.CreatedBy, metadata, dep.ThreadID); err != nil {
+			return fmt.Errorf("failed to restore dependency %s → %s: %w", id, dep.DependsOnID, err)
+		}
+	}
+	for _, c := range rel.Comments {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT IGNORE INTO comments (id, issue_id, author, text, created_at) VALUES (?, ?, ?, ?, ?)
+		`, c.ID, id, c.Author, c.Text, c.CreatedAt.UTC()); err != nil {
+			return fmt.Errorf("failed to restore comment on %s: %w", id, err)
+		}
+	}
+	for _, e := range rel.Events {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT IGNORE INTO events (id, issue_id, event_type, actor, old_value, new_value, comment, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		`, e.ID, id, e.EventType, e.Actor, e.OldValue, e.NewValue, e.Comment, e.CreatedAt.UTC()); err != nil {
+			return fmt.Errorf("failed to restore event on %s: %w", id, err)
+		}
+	}
+	for key, value := range rel.Meta {
+		if _, err := tx.ExecContext(ctx, "INSERT IGNORE INTO issue_meta (issue_id, `key`, value) VALUES (?, ?, ?)", id, key, value); err != nil {
+			return fmt.Errorf("failed to restore meta %s on %s: %w", key, id, err)
+		}
+	}
+	for _, l := range rel.Links {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT IGNORE INTO issue_links (id, issue_id, kind, target, link_type, created_by, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, l.ID, id, l.Kind, l.Target, l.Type, l.CreatedBy, l.CreatedAt.UTC()); err != nil {
+			return fmt.Errorf("failed to restore link on %s: %w", id, err)
+		}
+	}
+	for _, a := range rel.Attachments {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT IGNORE INTO attachments (issue_id, name, url_or_path, size, sha256, created_by, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, id, a.Name, a.Location, a.Size, a.SHA256, a.CreatedBy, a.CreatedAt.UTC()); err != nil {
+			return fmt.Errorf("failed to restore attachment %s on %s: %w", a.Name, id, err)
+		}
+	}
+	if rel.ChildCounter > 0 {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO child_counters (parent_id, last_child) VALUES (?, ?)
+			ON DUPLICATE KEY UPDATE last_child = GREATEST(last_child, VALUES(last_child))
+		`, id, rel.ChildCounter); err != nil {
+			return fmt.Errorf("failed to restore child counter of %s: %w", id, err)
+		}
+	}
+	for _, snap := range rel.IssueSnapshots {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT IGNORE INTO issue_snapshots (id, issue_id, snapshot_time, compaction_level, original_size, compressed_size, original_content, archived_events)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		`, snap.ID, id, snap.SnapshotTime.UTC(), snap.CompactionLevel, snap.OriginalSize, snap.CompressedSize, snap.OriginalContent, snap.ArchivedEvents); err != nil {
+			return fmt.Errorf("failed to restore snapshot of %s: %w", id, err)
+		}
+	}
+	for _, snap := range rel.CompactionSnapshots {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT IGNORE INTO compaction_snapshots (id, issue_id, compaction_level, snapshot_json, created_at) VALUES (?, ?, ?, ?, ?)
+		`, snap.ID, id, snap.CompactionLevel, snap.SnapshotJSON, snap.CreatedAt.UTC()); err != nil {
+			return fmt.Errorf("failed to restore compaction snapshot of %s: %w", id, err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM issues_archive WHERE id = ?", id); err != nil {
+		return fmt.Errorf("failed to remove %s from archive: %w", id, err)
+	}
+
+	for _, table := range []string{"issues", "issues_archive", "dependencies", "labels", "comments", "events", "child_counters", "issue_snapshots", "compaction_snapshots", "issue_meta", "issue_links", "attachments"} {
+		_, _ = tx.ExecContext(ctx, "CALL DOLT_ADD(?)", table)
+	}
+	commitMsg := fmt.Sprintf("bd: restore %s from archive", id)
+	if err := s.versionCommit(ctx, tx, commitMsg); err != nil {
+		return fmt.Errorf("dolt commit: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return wrapTransactionError("commit restore", err)
+	}
+	s.invalidateBlockedIDsCache()
+	return nil
+}
+
+// SearchArchivedIssues finds archived issues matching query and filter, most
+// recently closed first. Label and dependency filters match nothing, since
+// those rows travel inside archived_relations rather than their own tables.
+func (s *DoltStore) SearchArchivedIssues(ctx context.Context, query string, filter types.IssueFilter) ([]*types.Issue, error) {
+	whereClauses, args, err := buildIssueFilterClauses(query, filter, archiveFilterTables, s.hierarchy)
+	if err != nil {
+		return nil, err
+	}
+	whereSQL := ""
+	if len(whereClauses) > 0 {
+		whereSQL = "WHERE " + strings.Join(whereClauses, " AND ")
+	}
+	limitSQL := ""
+	if filter.Limit > 0 {
+		limitSQL = fmt.Sprintf(" LIMIT %d", filter.Limit)
+	}
+
+	orderBySQL := buildOrderBy(filter.Sort, "ORDER BY closed_at DESC, id ASC")
+
+	//nolint:gosec // G201: whereSQL contains column comparisons with ?, orderBySQL is built from a fixed column map, limitSQL is a safe integer
+	rows, err := s.queryContext(ctx, fmt.Sprintf(`
+		SELECT %s FROM issues_archive
+		%s
+		%s
+		%s
+	`, issueSelectColumns, whereSQL, orderBySQL, limitSQL), args...)
+	if err != nil {
+		if isTableNotExistError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to search archived issues: %w", err)
+	}
+	defer rows.Close()
+
+	var issues []*types.Issue
+	for rows.Next() {
+		issue, err := scanIssueFrom(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan archived issue: %w", err)
+		}
+		issues = append(issues, issue)
+	}
+	return issues, rows.Err()
+}
+
+// CountArchivedIssues returns the number of archived issues, or 0 when the
+// archive table does not exist yet.
+func (s *DoltStore) CountArchivedIssues(ctx context.Context) (int, error) {
+	var n int
+	err := s.withRetry(ctx, func() error {
+		return s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM issues_archive").Scan(&n)
+	})
+	if err != nil {
+		if isTableNotExistError(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to count archived issues: %w", err)
+	}
+	return n, nil
+}
+
+// IsArchived reports whether id is in the archive.
+func (s *DoltStore) IsArchived(ctx context.Context, id string) (bool, error) {
+	var n int
+	err := s.withRetry(ctx, func() error {
+		return s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM issues_archive WHERE id = ?", id).Scan(&n)
+	})
+	if err != nil {
+		if isTableNotExistError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check archive for %s: %w", id, err)
+	}
+	return n > 0, nil
+}
+
+// loadArchivedRelations reads the rows that will cascade away with issue id.
+func loadArchivedRelations(ctx context.Context, tx *sql.Tx, id string) (*archivedRelations, error) {
+	var rel archivedRelations
+
+	labelRows, err := tx.QueryContext(ctx, "SELECT label FROM labels WHERE issue_id = ? ORDER BY label", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read labels of %s: %w", id, err)
+	}
+	for labelRows.Next() {
+		var label string
+		if err := labelRows.Scan(&label); err != nil {
+			_ = labelRows.Close()
+			return nil, fmt.Errorf("failed to scan label of %s: %w", id, err)
+		}
+		rel.Labels = append(rel.Labels, label)
+	}
+	_ = labelRows.Close()
+
+	depRows, err := tx.QueryContext(ctx, `
+		SELECT issue_id, depends_on_id, type, created_at, created_by, metadata, thread_id
+		FROM dependencies WHERE issue_id = ?
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dependencies of %s: %w", id, err)
+	}
+	rel.Dependencies, err = scanDependencyRows(depRows)
+	_ = depRows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	commentRows, err := tx.QueryContext(ctx, `
+		SELECT id, issue_id, author, text, created_at FROM comments WHERE issue_id = ? ORDER BY created_at, id
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read comments of %s: %w", id, err)
+	}
+	rel.Comments, err = scanComments(commentRows)
+	_ = commentRows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	eventRows, err := tx.QueryContext(ctx, `
+		SELECT id, issue_id, event_type, actor, old_value, new_value, comment, created_at
+		FROM events WHERE issue_id = ? ORDER BY created_at, id
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read events of %s: %w", id, err)
+	}
+	rel.Events, err = scanEvents(eventRows)
+	_ = eventRows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	metaRows, err := tx.QueryContext(ctx, "SELECT `key`, value FROM issue_meta WHERE issue_id = ?", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read meta of %s: %w", id, err)
+	}
+	for metaRows.Next() {
+		var key, value string
+		if err := metaRows.Scan(&key, &value); err != nil {
+			_ = metaRows.Close()
+			return nil, fmt.Errorf("failed to scan meta of %s: %w", id, err)
+		}
+		if rel.Meta == nil {
+			rel.Meta = make(map[string]string)
+		}
+		rel.Meta[key] = value
+	}
+	_ = metaRows.Close()
+
+	linkRows, err := tx.QueryContext(ctx, `
+		SELECT id, issue_id, kind, target, link_type, COALESCE(created_by, ''), created_at
+		FROM issue_links WHERE issue_id = ? ORDER BY created_at, id
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read links of %s: %w", id, err)
+	}
+	for linkRows.Next() {
+		var l types.IssueLink
+		if err := linkRows.Scan(&l.ID, &l.IssueID, &l.Kind, &l.Target, &l.Type, &l.CreatedBy, &l.CreatedAt); err != nil {
+			_ = linkRows.Close()
+			return nil, fmt.Errorf("failed to scan link of %s: %w", id, err)
+		}
+		rel.Links = append(rel.Links, &l)
+	}
+	_ = linkRows.Close()
+
+	attachmentRows, err := tx.QueryContext(ctx, `
+		SELECT issue_id, name, url_or_path, size, sha256, COALESCE(created_by, ''), created_at
+		FROM attachments WHERE issue_id = ? ORDER BY name
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attachments of %s: %w", id, err)
+	}
+	for attachmentRows.Next() {
+		a, err := scanAttachment(attachmentRows)
+		if err != nil {
+			_ = attachmentRows.Close()
+			return nil, err
+		}
+		rel.Attachments = append(rel.Attachments, a)
+	}
+	_ = attachmentRows.Close()
+
+	err = tx.QueryRowContext(ctx, "SELECT last_child FROM child_counters WHERE parent_id = ?", id).Scan(&rel.ChildCounter)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to read child counter of %s: %w", id, err)
+	}
+
+	snapRows, err := tx.QueryContext(ctx, `
+		SELECT id, snapshot_time, compaction_level, original_size, compressed_size, original_content, archived_events
+		FROM issue_snapshots WHERE issue_id = ? ORDER BY snapshot_time, id
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshots of %s: %w", id, err)
+	}
+	for snapRows.Next() {
+		var snap archivedIssueSnapshot
+		var archivedEvents sql.NullString
+		if err := snapRows.Scan(&snap.ID, &snap.SnapshotTime, &snap.CompactionLevel, &snap.OriginalSize,
+			&snap.CompressedSize, &snap.OriginalContent, &archivedEvents); err != nil {
+			_ = snapRows.Close()
+			return nil, fmt.Errorf("failed to scan snapshot of %s: %w", id, err)
+		}
+		if archivedEvents.Valid {
+			snap.ArchivedEvents = &archivedEvents.String
+		}
+		rel.IssueSnapshots = append(rel.IssueSnapshots, snap)
+	}
+	_ = snapRows.Close()
+
+	compRows, err := tx.QueryContext(ctx, `
+		SELECT id, compaction_level, snapshot_json, created_at
+		FROM compaction_snapshots WHERE issue_id = ? ORDER BY created_at, id
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compaction snapshots of %s: %w", id, err)
+	}
+	for compRows.Next() {
+		var snap archivedCompactionSnapshot
+		if err := compRows.Scan(&snap.ID, &snap.CompactionLevel, &snap.SnapshotJSON, &snap.CreatedAt); err != nil {
+			_ = compRows.Close()
+			return nil, fmt.Errorf("failed to scan compaction snapshot of %s: %w", id, err)
+		}
+		rel.CompactionSnapshots = append(rel.CompactionSnapshots, snap)
+	}
+	_ = compRows.Close()
+
+	return &rel, nil
+}
@@ -0,0 +1,263 @@
+package dolt
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestArchiveAndRestoreClosedIssue(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	for _, id := range []string{"arch-old", "arch-new", "arch-pin"} {
+		issue := &types.Issue{ID: id, Title: "Issue " + id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("failed to create %s: %v", id, err)
+		}
+		if err := store.CloseIssue(ctx, id, "done", "tester", "s1"); err != nil {
+			t.Fatalf("failed to close %s: %v", id, err)
+		}
+	}
+	if err := store.AddLabel(ctx, "arch-old", "keep-me", "tester"); err != nil {
+		t.Fatalf("failed to add label: %v", err)
+	}
+	if _, err := store.AddIssueComment(ctx, "arch-old", "tester", "note"); err != nil {
+		t.Fatalf("failed to add comment: %v", err)
+	}
+	if _, err := store.db.ExecContext(ctx,
+		"INSERT INTO child_counters (parent_id, last_child) VALUES ('arch-old', 3)"); err != nil {
+		t.Fatalf("failed to seed child counter: %v", err)
+	}
+	if _, err := store.db.ExecContext(ctx, `
+		INSERT INTO compaction_snapshots (id, issue_id, compaction_level, snapshot_json, created_at)
+		VALUES ('snap-1', 'arch-old', 1, '{"title":"before"}', ?)`, time.Now().UTC()); err != nil {
+		t.Fatalf("failed to seed compaction snapshot: %v", err)
+	}
+
+	oldDate := time.Now().UTC().AddDate(0, 0, -120)
+	if _, err := store.db.ExecContext(ctx,
+		"UPDATE issues SET closed_at = ? WHERE id IN ('arch-old', 'arch-pin')", oldDate); err != nil {
+		t.Fatalf("failed to backdate closed_at: %v", err)
+	}
+	if _, err := store.db.ExecContext(ctx, "UPDATE issues SET pinned = 1 WHERE id = 'arch-pin'"); err != nil {
+		t.Fatalf("failed to pin: %v", err)
+	}
+
+	cutoff := time.Now().UTC().AddDate(0, 0, -90)
+	ids, err := store.ArchiveClosedIssues(ctx, cutoff, true)
+	if err != nil {
+		t.Fatalf("dry run failed: %v", err)
+	}
+	if len(ids) != 1 || ids[0] != "arch-old" {
+		t.Fatalf("dry run = %v, want [arch-old]", ids)
+	}
+	if archived, _ := store.IsArchived(ctx, "arch-old"); archived {
+		t.Fatal("dry run must not archive")
+	}
+
+	if _, err := store.ArchiveClosedIssues(ctx, cutoff, false); err != nil {
+		t.Fatalf("archive failed: %v", err)
+	}
+	if _, err := store.GetIssue(ctx, "arch-old"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("GetIssue after archive: err = %v, want ErrNotFound", err)
+	}
+	archived, err := store.SearchArchivedIssues(ctx, "", types.IssueFilter{})
+	if err != nil {
+		t.Fatalf("SearchArchivedIssues failed: %v", err)
+	}
+	if len(archived) != 1 || archived[0].ID != "arch-old" {
+		t.Fatalf("archived = %v, want [arch-old]", archived)
+	}
+	if n, _ := store.CountArchivedIssues(ctx); n != 1 {
+		t.Errorf("CountArchivedIssues = %d, want 1", n)
+	}
+
+	if err := store.RestoreArchivedIssue(ctx, "arch-old"); err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	restored, err := store.GetIssue(ctx, "arch-old")
+	if err != nil {
+		t.Fatalf("GetIssue after restore: %v", err)
+	}
+	if restored.Status != types.StatusClosed {
+		t.Errorf("restored status = %s, want closed", restored.Status)
+	}
+	labels, _ := store.GetLabels(ctx, "arch-old")
+	if len(labels) != 1 || labels[0] != "keep-me" {
+		t.Errorf("restored labels = %v, want [keep-me]", labels)
+	}
+	comments, _ := store.GetIssueComments(ctx, "arch-old")
+	if len(comments) != 1 || comments[0].Text != "note" {
+		t.Errorf("restored comments = %v, want one 'note'", comments)
+	}
+
+	var lastChild int
+	if err := store.db.QueryRowContext(ctx,
+		"SELECT last_child FROM child_counters WHERE parent_id = 'arch-old'").Scan(&lastChild); err != nil || lastChild != 3 {
+		t.Errorf("restored child counter = %d (err %v), want 3", lastChild, err)
+	}
+	var snapshotJSON string
+	if err := store.db.QueryRowContext(ctx,
+		"SELECT snapshot_json FROM compaction_snapshots WHERE id = 'snap-1' AND issue_id = 'arch-old'").Scan(&snapshotJSON); err != nil || snapshotJSON != `{"title":"before"}` {
+		t.Errorf("restored compaction snapshot = %q (err %v)", snapshotJSON, err)
+	}
+
+	if err := store.RestoreArchivedIssue(ctx, "arch-old"); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("second restore: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestArchiveEligibleIssues(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("config.Initialize: %v", err)
+	}
+	config.Set("close.auto-archive-after", "30d")
+	defer config.Set("close.auto-archive-after", "")
+
+	for _, id := range []string{"due-past", "due-now", "due-later", "due-reopened", "due-pinned"} {
+		issue := &types.Issue{ID: id, Title: "Issue " + id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("failed to create %s: %v", id, err)
+		}
+		if err := store.CloseIssue(ctx, id, "done", "tester", "s1"); err != nil {
+			t.Fatalf("failed to close %s: %v", id, err)
+		}
+	}
+
+	// Closing schedules archiving close.auto-archive-after from now
+	var scheduled time.Time
+	if err := store.db.QueryRowContext(ctx, "SELECT archive_after FROM issues WHERE id = 'due-later'").Scan(&scheduled); err != nil {
+		t.Fatalf("failed to read archive_after: %v", err)
+	}
+	if want := time.Now().Add(30 * 24 * time.Hour); scheduled.Sub(want).Abs() > time.Minute {
+		t.Errorf("archive_after = %v, want about %v", scheduled, want)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for id, at := range map[string]time.Time{
+		"due-past":   now.Add(-time.Hour),
+		"due-now":    now,
+		"due-later":  now.Add(time.Second),
+		"due-pinned": now.Add(-time.Hour),
+	} {
+		if _, err := store.db.ExecContext(ctx, "UPDATE issues SET archive_after = ? WHERE id = ?", at, id); err != nil {
+			t.Fatalf("failed to set archive_after on %s: %v", id, err)
+		}
+	}
+	if _, err := store.db.ExecContext(ctx, "UPDATE issues SET pinned = 1 WHERE id = 'due-pinned'"); err != nil {
+		t.Fatalf("failed to pin: %v", err)
+	}
+	// Reopening clears the schedule
+	if err := store.UpdateIssue(ctx, "due-reopened", map[string]interface{}{"status": string(types.StatusOpen)}, "tester"); err != nil {
+		t.Fatalf("failed to reopen: %v", err)
+	}
+
+	ids, err := store.ArchiveEligibleIssues(ctx, now, true)
+	if err != nil {
+		t.Fatalf("dry run failed: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "due-now" || ids[1] != "due-past" {
+		t.Fatalf("dry run = %v, want [due-now due-past]", ids)
+	}
+
+	ids, err = store.ArchiveEligibleIssues(ctx, now, false)
+	if err != nil {
+		t.Fatalf("sweep failed: %v", err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("sweep archived %v, want 2 issues", ids)
+	}
+	for _, id := range []string{"due-later", "due-reopened", "due-pinned"} {
+		if archived, _ := store.IsArchived(ctx, id); archived {
+			t.Errorf("%s should not be archived yet", id)
+		}
+	}
+
+	// One second later the boundary issue comes due
+	ids, err = store.ArchiveEligibleIssues(ctx, now.Add(time.Second), false)
+	if err != nil {
+		t.Fatalf("second sweep failed: %v", err)
+	}
+	if len(ids) != 1 || ids[0] != "due-later" {
+		t.Errorf("second sweep = %v, want [due-later]", ids)
+	}
+}
+
+func TestCloseWithoutAutoArchive(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	issue := &types.Issue{ID: "no-auto", Title: "No auto archive", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("failed to create issue: %v", err)
+	}
+	if err := store.CloseIssue(ctx, "no-auto", "done", "tester", "s1"); err != nil {
+		t.Fatalf("failed to close issue: %v", err)
+	}
+	ids, err := store.ArchiveEligibleIssues(ctx, time.Now().AddDate(1, 0, 0), true)
+	if err != nil {
+		t.Fatalf("sweep failed: %v", err)
+	}
+	if len(ids) != 0 {
+		t.Errorf("sweep = %v, want none when close.auto-archive-after is unset", ids)
+	}
+}
+
+func TestGetHealthCounts_ArchivedParent(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	if err := config.Initialize(); err != nil {
+		t.Fatalf("config.Initialize: %v", err)
+	}
+
+	for _, id := range []string{"arch-parent", "arch-parent.1"} {
+		issue := &types.Issue{ID: id, Title: "Issue " + id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("failed to create %s: %v", id, err)
+		}
+	}
+	if err := store.CloseIssue(ctx, "arch-parent", "done", "tester", "s1"); err != nil {
+		t.Fatalf("failed to close parent: %v", err)
+	}
+	if _, err := store.ArchiveClosedIssues(ctx, time.Now().Add(time.Hour), false); err != nil {
+		t.Fatalf("archive failed: %v", err)
+	}
+
+	health, err := store.GetHealthCounts(ctx)
+	if err != nil {
+		t.Fatalf("GetHealthCounts: %v", err)
+	}
+	if health.Orphans != 0 {
+		t.Errorf("orphans = %d, want 0: the parent is archived, not missing", health.Orphans)
+	}
+
+	config.Set("archive.resolve-references", false)
+	defer config.Set("archive.resolve-references", true)
+	if health, err = store.GetHealthCounts(ctx); err != nil {
+		t.Fatalf("GetHealthCounts: %v", err)
+	}
+	if health.Orphans != 1 {
+		t.Errorf("orphans with archive.resolve-references off = %d, want 1", health.Orphans)
+	}
+}
@@ -0,0 +1,127 @@
+package dolt
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage/issueops"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// AddAttachment records a reference to a file or URL on an issue. Attaching
+// a name that already exists on the issue replaces its location, size and
+// hash, so re-attaching an updated file refreshes it.
+func (s *DoltStore) AddAttachment(ctx context.Context, a *types.Attachment) error {
+	if a.Name == "" {
+		return fmt.Errorf("attachment name is required")
+	}
+	_, err := s.execContext(ctx, `
+		INSERT INTO attachments (issue_id, name, url_or_path, size, sha256, created_by)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE url_or_path = ?, size = ?, sha256 = ?
+	`, a.IssueID, a.Name, a.Location, a.Size, a.SHA256, a.CreatedBy,
+		a.Location, a.Size, a.SHA256)
+	if err != nil {
+		return fmt.Errorf("failed to attach %s to %s: %w", a.Name, a.IssueID, err)
+	}
+	return nil
+}
+
+// GetAttachments returns the attachments of an issue, ordered by name.
+func (s *DoltStore) GetAttachments(ctx context.Context, issueID string) ([]*types.Attachment, error) {
+	byIssue, err := s.GetAttachmentsForIssues(ctx, []string{issueID})
+	if err != nil {
+		return nil, err
+	}
+	return byIssue[issueID], nil
+}
+
+// GetAttachmentsForIssues returns attachments for multiple issues, keyed by
+// issue ID. Issues without attachments are absent from the result.
+func (s *DoltStore) GetAttachmentsForIssues(ctx context.Context, issueIDs []string) (map[string][]*types.Attachment, error) {
+	result := make(map[string][]*types.Attachment)
+	for start := 0; start < len(issueIDs); start += queryBatchSize {
+		end := min(start+queryBatchSize, len(issueIDs))
+		placeholders, args := doltBuildSQLInClause(issueIDs[start:end])
+
+		//nolint:gosec // G201: placeholders contains only ? markers
+		rows, err := s.queryContext(ctx, fmt.Sprintf(`
+			SELECT issue_id, name, url_or_path, size, sha256, COALESCE(created_by, ''), created_at
+			FROM attachments WHERE issue_id IN (%s) ORDER BY issue_id, name
+		`, placeholders), args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get attachments: %w", err)
+		}
+		for rows.Next() {
+			a, err := scanAttachment(rows)
+			if err != nil {
+				_ = rows.Close()
+				return nil, err
+			}
+			result[a.IssueID] = append(result[a.IssueID], a)
+		}
+		err = rows.Err()
+		_ = rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get attachments: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// RemoveAttachment deletes the named attachment from an issue. The boolean
+// is false when the issue had no attachment by that name.
+func (s *DoltStore) RemoveAttachment(ctx context.Context, issueID, name string) (bool, error) {
+	res, err := s.execContext(ctx, "DELETE FROM attachments WHERE issue_id = ? AND name = ?", issueID, name)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove attachment %s from %s: %w", name, issueID, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to remove attachment %s from %s: %w", name, issueID, err)
+	}
+	return n > 0, nil
+}
+
+func scanAttachment(rows *sql.Rows) (*types.Attachment, error) {
+	var a types.Attachment
+	var size sql.NullInt64
+	if err := rows.Scan(&a.IssueID, &a.Name, &a.Location, &size, &a.SHA256, &a.CreatedBy, &a.CreatedAt); err != nil {
+		return nil, fmt.Errorf("failed to scan attachment: %w", err)
+	}
+	if size.Valid {
+		a.Size = &size.Int64
+	}
+	return &a, nil
+}
+
+// persistAttachmentsInTx writes the Attachments of created or imported
+// issues, keeping their original created_at. Wisps and skipped orphans are
+// handled as in persistMetaInTx.
+func persistAttachmentsInTx(ctx context.Context, tx *sql.Tx, issues []*types.Issue) error {
+	for _, issue := range issues {
+		if issueops.IsWisp(issue) {
+			continue
+		}
+		for _, a := range issue.Attachments {
+			if a.Name == "" {
+				return fmt.Errorf("%s: attachment name is required", issue.ID)
+			}
+			createdAt := a.CreatedAt.UTC()
+			if createdAt.IsZero() {
+				createdAt = time.Now().UTC()
+			}
+			if _, err := tx.ExecContext(ctx, `
+				INSERT INTO attachments (issue_id, name, url_or_path, size, sha256, created_by, created_at)
+				SELECT id, ?, ?, ?, ?, ?, ? FROM issues WHERE id = ?
+				ON DUPLICATE KEY UPDATE url_or_path = ?, size = ?, sha256 = ?
+			`, a.Name, a.Location, a.Size, a.SHA256, a.CreatedBy, createdAt, issue.ID,
+				a.Location, a.Size, a.SHA256); err != nil {
+				return fmt.Errorf("failed to attach %s to %s: %w", a.Name, issue.ID, err)
+			}
+		}
+	}
+	return nil
+}
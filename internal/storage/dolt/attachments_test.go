@@ -0,0 +1,83 @@
+package dolt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestAttachments(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	issue := &types.Issue{ID: "test-att1", Title: "Attached", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+
+	size := int64(12)
+	file := &types.Attachment{IssueID: issue.ID, Name: "crash.txt", Location: "logs/crash.txt", Size: &size, SHA256: "ab12", CreatedBy: "tester"}
+	link := &types.Attachment{IssueID: issue.ID, Name: "design", Location: "https://example.com/design.pdf"}
+	for _, a := range []*types.Attachment{file, link} {
+		if err := store.AddAttachment(ctx, a); err != nil {
+			t.Fatalf("AddAttachment(%s): %v", a.Name, err)
+		}
+	}
+
+	// Re-attaching a name refreshes it instead of adding a second row
+	newSize := int64(20)
+	if err := store.AddAttachment(ctx, &types.Attachment{IssueID: issue.ID, Name: "crash.txt", Location: "logs/crash.txt", Size: &newSize, SHA256: "cd34"}); err != nil {
+		t.Fatalf("AddAttachment(refresh): %v", err)
+	}
+
+	got, err := store.GetAttachments(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetAttachments: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("GetAttachments = %d attachments, want 2", len(got))
+	}
+	if got[0].Name != "crash.txt" || got[0].Size == nil || *got[0].Size != 20 || got[0].SHA256 != "cd34" {
+		t.Errorf("refreshed file attachment = %+v", got[0])
+	}
+	if got[1].Name != "design" || got[1].Size != nil {
+		t.Errorf("URL attachment = %+v, want no size", got[1])
+	}
+
+	removed, err := store.RemoveAttachment(ctx, issue.ID, "design")
+	if err != nil || !removed {
+		t.Fatalf("RemoveAttachment = %v, %v; want removed", removed, err)
+	}
+	if removed, err := store.RemoveAttachment(ctx, issue.ID, "design"); err != nil || removed {
+		t.Errorf("RemoveAttachment(again) = %v, %v; want not removed", removed, err)
+	}
+}
+
+func TestImportedAttachments(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	created := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+	issue := &types.Issue{ID: "test-att2", Title: "Imported", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask,
+		Attachments: []*types.Attachment{{Name: "spec", Location: "docs/spec.md", SHA256: "ef56", CreatedAt: created}}}
+	if err := store.CreateIssuesWithFullOptions(ctx, []*types.Issue{issue}, "tester", storage.BatchCreateOptions{}); err != nil {
+		t.Fatalf("CreateIssuesWithFullOptions: %v", err)
+	}
+
+	got, err := store.GetAttachmentsForIssues(ctx, []string{issue.ID})
+	if err != nil {
+		t.Fatalf("GetAttachmentsForIssues: %v", err)
+	}
+	if len(got[issue.ID]) != 1 || got[issue.ID][0].Location != "docs/spec.md" || !got[issue.ID][0].CreatedAt.Equal(created) {
+		t.Errorf("GetAttachmentsForIssues = %v, want the imported attachment", got[issue.ID])
+	}
+}
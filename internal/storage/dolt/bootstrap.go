@@ -163,7 +163,9 @@ func acquireBootstrapLock(lockPath string, timeout time.Duration) (*os.File, err
 	for {
 		err := lockfile.FlockExclusiveNonBlocking(f)
 		if err == nil {
-			// Lock acquired - update modification time for stale detection
+			// Lock acquired - record our PID (for bd doctor) and refresh
+			// the modification time for stale detection
+			_ = lockfile.WriteOwner(f) // Best effort: diagnostics fall back to age
 			return f, nil
 		}
 
@@ -0,0 +1,189 @@
+package dolt
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// sqlExecer is the subset of methods shared by *sql.Tx and *sql.Conn.
+type sqlExecer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// versionCommit creates the Dolt commit that ends a write. Callers stage the
+// tables they modified first. While a command transaction is active the
+// commit is deferred: the staged tables are recorded and CommitCommandTx
+// commits exactly those. "Nothing to commit" is not an error.
+func (s *DoltStore) versionCommit(ctx context.Context, ex sqlExecer, message string) error {
+	s.cmdTxMu.Lock()
+	active := s.cmdTxActive
+	s.cmdTxMu.Unlock()
+	if active {
+		tables, err := stagedTables(ctx, ex)
+		if err != nil {
+			return err
+		}
+		s.cmdTxMu.Lock()
+		s.cmdTxMessages = append(s.cmdTxMessages, message)
+		for _, table := range tables {
+			s.cmdTxTables[table] = struct{}{}
+		}
+		s.cmdTxMu.Unlock()
+		return nil
+	}
+
+	if _, err := ex.ExecContext(ctx, "CALL DOLT_COMMIT('-m', ?, '--author', ?)",
+		message, s.commitAuthorString()); err != nil && !isDoltNothingToCommit(err) {
+		return err
+	}
+	return nil
+}
+
+// BeginCommandTx starts a command transaction: until CommitCommandTx or
+// RollbackCommandTx, writes that would each create their own Dolt commit
+// leave their changes staged instead, so a bulk command produces a single
+// commit. Explicit Commit and CommitWithConfig calls are not deferred.
+//
+// It returns false, and defers nothing, when the store is read-only or the
+// working set already holds uncommitted changes (e.g. batch auto-commit
+// mode), since those could not be told apart from the command's own on
+// rollback.
+func (s *DoltStore) BeginCommandTx(ctx context.Context) (bool, error) {
+	if s.readOnly {
+		return false, nil
+	}
+	var dirty int
+	if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM dolt_status").Scan(&dirty); err != nil {
+		return false, fmt.Errorf("failed to query dolt_status: %w", err)
+	}
+	if dirty > 0 {
+		return false, nil
+	}
+
+	s.cmdTxMu.Lock()
+	defer s.cmdTxMu.Unlock()
+	s.cmdTxActive = true
+	s.cmdTxMessages = nil
+	s.cmdTxTables = make(map[string]struct{})
+	return true, nil
+}
+
+// CommitCommandTx ends the command transaction and creates one Dolt commit
+// for the writes it deferred. A single deferred write keeps its own commit
+// message; several are committed under message. It returns false when no
+// commit was deferred, leaving any other working-set changes to the caller.
+func (s *DoltStore) CommitCommandTx(ctx context.Context, message string) (bool, error) {
+	return s.commitCommandTx(ctx, message, false)
+}
+
+// CommitCommandTxWithMessage is CommitCommandTx, except message is used even
+// when only one write was deferred. It backs bd's --commit-message.
+func (s *DoltStore) CommitCommandTxWithMessage(ctx context.Context, message string) (bool, error) {
+	return s.commitCommandTx(ctx, message, true)
+}
+
+func (s *DoltStore) commitCommandTx(ctx context.Context, message string, override bool) (bool, error) {
+	messages, tables := s.endCommandTx()
+	if len(messages) == 0 {
+		return false, nil
+	}
+	if len(messages) == 1 && !override {
+		message = messages[0]
+	}
+	if len(tables) == 0 {
+		return false, nil // Only dolt-ignored tables (wisps) were written
+	}
+
+	// Stage and commit only the tables the deferred writes staged, on one
+	// connection and without -A, so unrelated working-set changes stay out
+	// of the command's commit (GH#2455).
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Close()
+	for _, table := range tables {
+		if _, err := conn.ExecContext(ctx, "CALL DOLT_ADD(?)", table); err != nil {
+			return false, fmt.Errorf("dolt add %s: %w", table, err)
+		}
+	}
+	if _, err := conn.ExecContext(ctx, "CALL DOLT_COMMIT('-m', ?, '--author', ?)",
+		message, s.commitAuthorString()); err != nil {
+		if isDoltNothingToCommit(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to commit: %w", err)
+	}
+	return true, nil
+}
+
+// RollbackCommandTx ends the command transaction and restores the tables its
+// deferred writes staged to their HEAD versions, so none of the command's
+// changes are kept. Other tables in the working set are left alone, and
+// writes to dolt-ignored tables (wisps) are not undone.
+func (s *DoltStore) RollbackCommandTx(ctx context.Context) error {
+	_, tables := s.endCommandTx()
+	if len(tables) == 0 {
+		return nil
+	}
+
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Close()
+
+	// Unstage the tables (staged root back to HEAD), then check them out so
+	// the working set matches the staged root again.
+	args := make([]any, len(tables))
+	for i, table := range tables {
+		args[i] = table
+	}
+	placeholders := "?" + strings.Repeat(", ?", len(tables)-1)
+	if _, err := conn.ExecContext(ctx, "CALL DOLT_RESET("+placeholders+")", args...); err != nil {
+		return fmt.Errorf("failed to unstage command changes: %w", err)
+	}
+	if _, err := conn.ExecContext(ctx, "CALL DOLT_CHECKOUT("+placeholders+")", args...); err != nil {
+		return fmt.Errorf("failed to discard command changes: %w", err)
+	}
+	return nil
+}
+
+// endCommandTx deactivates the command transaction and returns the commit
+// messages it deferred and the tables they staged, sorted.
+func (s *DoltStore) endCommandTx() ([]string, []string) {
+	s.cmdTxMu.Lock()
+	defer s.cmdTxMu.Unlock()
+	messages := s.cmdTxMessages
+	tables := make([]string, 0, len(s.cmdTxTables))
+	for table := range s.cmdTxTables {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	s.cmdTxActive = false
+	s.cmdTxMessages = nil
+	s.cmdTxTables = nil
+	return messages, tables
+}
+
+// stagedTables returns the tables with staged changes as seen by ex.
+func stagedTables(ctx context.Context, ex sqlExecer) ([]string, error) {
+	rows, err := ex.QueryContext(ctx, "SELECT table_name FROM dolt_status WHERE staged = true")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dolt_status: %w", err)
+	}
+	defer rows.Close()
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, fmt.Errorf("failed to scan dolt_status: %w", err)
+		}
+		tables = append(tables, table)
+	}
+	return tables, rows.Err()
+}
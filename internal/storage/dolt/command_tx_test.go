@@ -0,0 +1,199 @@
+package dolt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestCommandTxCoalescesCommits(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	countCommits := func() int {
+		t.Helper()
+		var n int
+		if err := store.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM dolt_log").Scan(&n); err != nil {
+			t.Fatalf("failed to count commits: %v", err)
+		}
+		return n
+	}
+
+	var ids []string
+	for i := 1; i <= 3; i++ {
+		issue := &types.Issue{ID: fmt.Sprintf("ctx-%d", i), Title: "Bulk", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("failed to create issue: %v", err)
+		}
+		ids = append(ids, issue.ID)
+	}
+	before := countCommits()
+
+	active, err := store.BeginCommandTx(ctx)
+	if err != nil {
+		t.Fatalf("BeginCommandTx: %v", err)
+	}
+	if !active {
+		t.Fatal("BeginCommandTx did not start on a clean working set")
+	}
+	for _, id := range ids {
+		if err := store.UpdateIssue(ctx, id, map[string]interface{}{"priority": 1}, "tester"); err != nil {
+			t.Fatalf("failed to update %s: %v", id, err)
+		}
+	}
+	if got := countCommits(); got != before {
+		t.Fatalf("commit count during command = %d, want %d (deferred)", got, before)
+	}
+
+	committed, err := store.CommitCommandTx(ctx, "bd: update 3 issues")
+	if err != nil {
+		t.Fatalf("CommitCommandTx: %v", err)
+	}
+	if !committed {
+		t.Fatal("CommitCommandTx reported nothing committed")
+	}
+	if got := countCommits(); got != before+1 {
+		t.Fatalf("commit count after command = %d, want %d", got, before+1)
+	}
+	var msg string
+	if err := store.db.QueryRowContext(ctx, "SELECT message FROM dolt_log LIMIT 1").Scan(&msg); err != nil {
+		t.Fatalf("failed to read dolt_log: %v", err)
+	}
+	if msg != "bd: update 3 issues" {
+		t.Errorf("commit message = %q, want %q", msg, "bd: update 3 issues")
+	}
+	for _, id := range ids {
+		issue, err := store.GetIssue(ctx, id)
+		if err != nil {
+			t.Fatalf("failed to get %s: %v", id, err)
+		}
+		if issue.Priority != 1 {
+			t.Errorf("%s priority = %d, want 1", id, issue.Priority)
+		}
+	}
+}
+
+func TestCommandTxRollback(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	issue := &types.Issue{ID: "ctx-rb", Title: "Original", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("failed to create issue: %v", err)
+	}
+
+	if active, err := store.BeginCommandTx(ctx); err != nil || !active {
+		t.Fatalf("BeginCommandTx = %v, %v; want true, nil", active, err)
+	}
+	if err := store.UpdateIssue(ctx, issue.ID, map[string]interface{}{"title": "Changed"}, "tester"); err != nil {
+		t.Fatalf("failed to update issue: %v", err)
+	}
+	if err := store.RollbackCommandTx(ctx); err != nil {
+		t.Fatalf("RollbackCommandTx: %v", err)
+	}
+
+	got, err := store.GetIssue(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("failed to get issue: %v", err)
+	}
+	if got.Title != "Original" {
+		t.Errorf("title after rollback = %q, want %q", got.Title, "Original")
+	}
+
+	// Writes after the command transaction ended commit on their own again.
+	if err := store.UpdateIssue(ctx, issue.ID, map[string]interface{}{"title": "Later"}, "tester"); err != nil {
+		t.Fatalf("failed to update issue: %v", err)
+	}
+	var msg string
+	if err := store.db.QueryRowContext(ctx, "SELECT message FROM dolt_log LIMIT 1").Scan(&msg); err != nil {
+		t.Fatalf("failed to read dolt_log: %v", err)
+	}
+	if msg != "bd: update ctx-rb" {
+		t.Errorf("latest commit = %q, want the update's own commit", msg)
+	}
+}
+
+func TestCommandTxWithMessage(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	if active, err := store.BeginCommandTx(ctx); err != nil || !active {
+		t.Fatalf("BeginCommandTx = %v, %v; want true, nil", active, err)
+	}
+	issue := &types.Issue{ID: "ctx-msg", Title: "Annotated", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("failed to create issue: %v", err)
+	}
+
+	// A single deferred write would normally keep its own message
+	const want = "Triage: file the login regression"
+	committed, err := store.CommitCommandTxWithMessage(ctx, want)
+	if err != nil || !committed {
+		t.Fatalf("CommitCommandTxWithMessage = %v, %v; want true, nil", committed, err)
+	}
+	var msg string
+	if err := store.db.QueryRowContext(ctx, "SELECT message FROM dolt_log LIMIT 1").Scan(&msg); err != nil {
+		t.Fatalf("failed to read dolt_log: %v", err)
+	}
+	if msg != want {
+		t.Errorf("commit message = %q, want %q", msg, want)
+	}
+}
+
+func TestCommandTxLeavesUnrelatedChanges(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	issue := &types.Issue{ID: "ctx-iso", Title: "Original", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("failed to create issue: %v", err)
+	}
+	configDirty := func() bool {
+		t.Helper()
+		var n int
+		if err := store.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM dolt_status WHERE table_name = 'config'").Scan(&n); err != nil {
+			t.Fatalf("failed to query dolt_status: %v", err)
+		}
+		return n > 0
+	}
+
+	for _, commit := range []bool{true, false} {
+		if active, err := store.BeginCommandTx(ctx); err != nil || !active {
+			t.Fatalf("BeginCommandTx = %v, %v; want true, nil", active, err)
+		}
+		if err := store.UpdateIssue(ctx, issue.ID, map[string]interface{}{"title": fmt.Sprintf("Changed (commit=%v)", commit)}, "tester"); err != nil {
+			t.Fatalf("failed to update issue: %v", err)
+		}
+		// Another writer's uncommitted change to a table the command never touched
+		if _, err := store.db.ExecContext(ctx, "REPLACE INTO config (`key`, value) VALUES ('ctx.unrelated', 'x')"); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+
+		if commit {
+			if _, err := store.CommitCommandTx(ctx, "bd: update"); err != nil {
+				t.Fatalf("CommitCommandTx: %v", err)
+			}
+		} else if err := store.RollbackCommandTx(ctx); err != nil {
+			t.Fatalf("RollbackCommandTx: %v", err)
+		}
+		if !configDirty() {
+			t.Errorf("commit=%v: unrelated config change was committed or reset", commit)
+		}
+		if _, err := store.db.ExecContext(ctx, "CALL DOLT_CHECKOUT('config')"); err != nil {
+			t.Fatalf("failed to discard config change: %v", err)
+		}
+	}
+}
@@ -765,3 +765,89 @@ func TestHighContentionStress(t *testing.T) {
 		}
 	}
 }
+
+// =============================================================================
+// Test: Concurrent Child ID Allocation
+// Many goroutines create children of one parent at once.
+// Verify: children get .1 through .N exactly once and none overwrites another
+// =============================================================================
+
+func TestConcurrentChildIDAllocation(t *testing.T) {
+	store, cleanup := setupConcurrentTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := concurrentTestContext(t)
+	defer cancel()
+
+	parent := &types.Issue{
+		ID:        "test-child-parent",
+		Title:     "Parent",
+		Status:    types.StatusOpen,
+		Priority:  2,
+		IssueType: types.TypeEpic,
+	}
+	if err := store.CreateIssue(ctx, parent, "tester"); err != nil {
+		t.Fatalf("failed to create parent: %v", err)
+	}
+
+	const numChildren = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, numChildren)
+	titles := make([]string, numChildren)
+	ids := make([]string, numChildren)
+	for i := 0; i < numChildren; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			child := &types.Issue{
+				ChildOf:   parent.ID,
+				Title:     fmt.Sprintf("Child %d", n),
+				Status:    types.StatusOpen,
+				Priority:  2,
+				IssueType: types.TypeTask,
+			}
+			if err := store.CreateIssue(ctx, child, fmt.Sprintf("worker-%d", n)); err != nil {
+				errs <- fmt.Errorf("child %d: %w", n, err)
+				return
+			}
+			ids[n], titles[n] = child.ID, child.Title
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("creation error: %v", err)
+	}
+	if t.Failed() {
+		t.FailNow()
+	}
+
+	seen := make(map[string]bool, numChildren)
+	for n, id := range ids {
+		if seen[id] {
+			t.Errorf("duplicate child ID: %s", id)
+		}
+		seen[id] = true
+		got, err := store.GetIssue(ctx, id)
+		if err != nil {
+			t.Errorf("failed to get %s: %v", id, err)
+			continue
+		}
+		if got.Title != titles[n] {
+			t.Errorf("%s has title %q, want %q (overwritten by a concurrent create)", id, got.Title, titles[n])
+		}
+	}
+	for i := 1; i <= numChildren; i++ {
+		if id := types.GenerateChildID(parent.ID, i); !seen[id] {
+			t.Errorf("child IDs are not contiguous: %s missing", id)
+		}
+	}
+
+	next, err := store.GetNextChildID(ctx, parent.ID)
+	if err != nil {
+		t.Fatalf("GetNextChildID: %v", err)
+	}
+	if want := types.GenerateChildID(parent.ID, numChildren+1); next != want {
+		t.Errorf("next child ID = %s, want %s", next, want)
+	}
+}
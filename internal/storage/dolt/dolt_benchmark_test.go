@@ -198,6 +198,69 @@ func BenchmarkWarmCache(b *testing.B) {
 	}
 }
 
+// benchRepeatedListIssues seeds issues for the repeated-list benchmarks.
+func benchRepeatedListIssues(b *testing.B, store *DoltStore) {
+	b.Helper()
+	issues := make([]*types.Issue, 50)
+	for i := range issues {
+		issues[i] = &types.Issue{
+			ID:        fmt.Sprintf("list-%d", i),
+			Title:     fmt.Sprintf("List Issue %d", i),
+			Status:    types.StatusOpen,
+			Priority:  i % 4,
+			IssueType: types.TypeTask,
+		}
+	}
+	if err := store.CreateIssues(context.Background(), issues, "bench"); err != nil {
+		b.Fatalf("failed to create issues: %v", err)
+	}
+}
+
+// BenchmarkRepeatedListPooled measures the list query a watch session runs on
+// every poll, reusing one store (and its connection pool) across iterations.
+// Compare with BenchmarkRepeatedListReopen for the per-invocation cost.
+func BenchmarkRepeatedListPooled(b *testing.B) {
+	store, cleanup := setupBenchStore(b)
+	defer cleanup()
+	benchRepeatedListIssues(b, store)
+
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := store.SearchIssues(ctx, "", types.IssueFilter{}); err != nil {
+			b.Fatalf("failed to list: %v", err)
+		}
+	}
+}
+
+// BenchmarkRepeatedListReopen measures the same list query when each
+// iteration opens and closes its own store, as separate bd invocations do.
+func BenchmarkRepeatedListReopen(b *testing.B) {
+	store, cleanup := setupBenchStore(b)
+	defer cleanup()
+	benchRepeatedListIssues(b, store)
+
+	ctx := context.Background()
+	cfg := &Config{
+		Path:           store.dbPath,
+		CommitterName:  "bench",
+		CommitterEmail: "bench@example.com",
+		Database:       "benchdb",
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s, err := New(ctx, cfg)
+		if err != nil {
+			b.Fatalf("failed to open store: %v", err)
+		}
+		if _, err := s.SearchIssues(ctx, "", types.IssueFilter{}); err != nil {
+			b.Fatalf("failed to list: %v", err)
+		}
+		s.Close()
+	}
+}
+
 // BenchmarkCLIWorkflow simulates a typical CLI workflow:
 // open -> list ready -> show issue -> close
 func BenchmarkCLIWorkflow(b *testing.B) {
@@ -466,6 +466,57 @@ func TestTransactionCreateIssueNoDoubleHyphen(t *testing.T) {
 	}
 }
 
+// TestRunInTransactionCanceledMidCommit simulates Ctrl-C during a long write:
+// the context is canceled while the transaction is still open. The write must
+// roll back cleanly, leaving no partial rows or dirty working set, and the
+// store must remain usable.
+func TestRunInTransactionCanceledMidCommit(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	txCtx, interrupt := context.WithCancel(ctx)
+	issue := &types.Issue{
+		ID:        "test-interrupted",
+		Title:     "interrupted write",
+		Status:    types.StatusOpen,
+		Priority:  2,
+		IssueType: types.TypeTask,
+	}
+	err := store.RunInTransaction(txCtx, "test: interrupted", func(tx storage.Transaction) error {
+		if err := tx.CreateIssue(txCtx, issue, "test-user"); err != nil {
+			return err
+		}
+		interrupt() // signal arrives before the transaction commits
+		<-txCtx.Done()
+		return txCtx.Err()
+	})
+	if err == nil {
+		t.Fatal("expected RunInTransaction to fail after cancellation")
+	}
+
+	if got, err := store.GetIssue(ctx, issue.ID); err == nil && got != nil {
+		t.Errorf("interrupted transaction left issue %s behind", issue.ID)
+	}
+
+	var dirty int
+	if err := store.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM dolt_status WHERE table_name = 'issues'").Scan(&dirty); err != nil {
+		t.Fatalf("query dolt_status: %v", err)
+	}
+	if dirty != 0 {
+		t.Errorf("interrupted transaction left the issues table dirty in the working set")
+	}
+
+	// The store is still usable after the interrupt
+	issue.ID = "test-after-interrupt"
+	if err := store.CreateIssue(ctx, issue, "test-user"); err != nil {
+		t.Fatalf("CreateIssue after interrupt failed: %v", err)
+	}
+}
+
 func TestGetCustomTypes(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
@@ -620,6 +671,51 @@ func TestDoltStoreIssueClose(t *testing.T) {
 	if retrieved.ClosedAt == nil {
 		t.Error("expected closed_at to be set")
 	}
+	if retrieved.CloseReason != "completed" {
+		t.Errorf("expected close_reason 'completed', got %q", retrieved.CloseReason)
+	}
+
+	// The close reason should be recorded in the Dolt commit message
+	var msg string
+	if err := store.db.QueryRowContext(ctx, "SELECT message FROM dolt_log LIMIT 1").Scan(&msg); err != nil {
+		t.Fatalf("failed to read dolt_log: %v", err)
+	}
+	if want := "bd: close " + issue.ID + ": completed"; msg != want {
+		t.Errorf("expected commit message %q, got %q", want, msg)
+	}
+
+	// Reopening should clear the close reason
+	if err := store.UpdateIssue(ctx, issue.ID, map[string]interface{}{"status": string(types.StatusOpen)}, "tester"); err != nil {
+		t.Fatalf("failed to reopen issue: %v", err)
+	}
+	reopened, err := store.GetIssue(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("failed to get issue: %v", err)
+	}
+	if reopened.CloseReason != "" {
+		t.Errorf("expected close_reason to be cleared on reopen, got %q", reopened.CloseReason)
+	}
+}
+
+func TestCloseCommitMessage(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason string
+		want   string
+	}{
+		{"empty reason", "", "bd: close bd-1"},
+		{"default reason", "Closed", "bd: close bd-1"},
+		{"custom reason", "fixed in #123", "bd: close bd-1: fixed in #123"},
+		{"multi-line reason", "fixed in #123\nsee PR for details", "bd: close bd-1: fixed in #123"},
+		{"whitespace trimmed", "  done  ", "bd: close bd-1: done"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := closeCommitMessage("bd-1", tt.reason); got != tt.want {
+				t.Errorf("closeCommitMessage(%q) = %q, want %q", tt.reason, got, tt.want)
+			}
+		})
+	}
 }
 
 // TestClosePromotedWisp verifies that bd close works for wisps that were
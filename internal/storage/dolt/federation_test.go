@@ -360,6 +360,52 @@ func TestFederationListRemotes(t *testing.T) {
 	}
 }
 
+// TestHasRemoteMultipleRemotes checks that ListRemotes returns every remote
+// and HasRemote finds a remote that isn't named "origin".
+func TestHasRemoteMultipleRemotes(t *testing.T) {
+	skipIfNoDolt(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	want := map[string]string{
+		"upstream": "file:///tmp/beads-upstream",
+		"mirror":   "file:///tmp/beads-mirror",
+	}
+	for name, url := range want {
+		if err := store.AddRemote(ctx, name, url); err != nil {
+			t.Fatalf("AddRemote(%s): %v", name, err)
+		}
+	}
+
+	remotes, err := store.ListRemotes(ctx)
+	if err != nil {
+		t.Fatalf("ListRemotes: %v", err)
+	}
+	got := make(map[string]string)
+	for _, r := range remotes {
+		got[r.Name] = r.URL
+	}
+	for name, url := range want {
+		if got[name] != url {
+			t.Errorf("remote %s = %q, want %q", name, got[name], url)
+		}
+	}
+
+	for _, name := range []string{"upstream", "mirror"} {
+		has, err := store.HasRemote(ctx, name)
+		if err != nil || !has {
+			t.Errorf("HasRemote(%s) = %v, %v; want true", name, has, err)
+		}
+	}
+	if has, err := store.HasRemote(ctx, "origin"); err != nil || has {
+		t.Errorf("HasRemote(origin) = %v, %v; want false", has, err)
+	}
+}
+
 // TestFederationSyncStatus tests the SyncStatus API
 func TestFederationSyncStatus(t *testing.T) {
 	skipIfNoDolt(t)
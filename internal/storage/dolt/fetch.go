@@ -0,0 +1,86 @@
+package dolt
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FetchedRef describes a remote-tracking branch changed by FetchRemote.
+type FetchedRef struct {
+	Ref string `json:"ref"`           // e.g. "remotes/origin/main"
+	Old string `json:"old,omitempty"` // Empty when the ref is new
+	New string `json:"new,omitempty"` // Empty when the ref no longer exists
+}
+
+// FetchRemote fetches from remote (the default remote when empty) without
+// merging, and reports the remote-tracking branches that moved. Inspect the
+// incoming changes with Diff("HEAD", "<remote>/<branch>") before pulling.
+func (s *DoltStore) FetchRemote(ctx context.Context, remote string) ([]FetchedRef, error) {
+	if remote == "" {
+		remote = s.remote
+	}
+	before, err := s.remoteTrackingRefs(ctx, remote)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Fetch(ctx, remote); err != nil {
+		return nil, err
+	}
+	after, err := s.remoteTrackingRefs(ctx, remote)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated []FetchedRef
+	for ref, hash := range after {
+		if before[ref] != hash {
+			updated = append(updated, FetchedRef{Ref: ref, Old: before[ref], New: hash})
+		}
+	}
+	for ref, hash := range before {
+		if _, ok := after[ref]; !ok {
+			updated = append(updated, FetchedRef{Ref: ref, Old: hash})
+		}
+	}
+	sort.Slice(updated, func(i, j int) bool { return updated[i].Ref < updated[j].Ref })
+	return updated, nil
+}
+
+// remoteTrackingRefs returns the remote-tracking branches of remote, by name.
+func (s *DoltStore) remoteTrackingRefs(ctx context.Context, remote string) (map[string]string, error) {
+	rows, err := s.queryContext(ctx, "SELECT name, hash FROM dolt_remote_branches WHERE name LIKE ?", "remotes/"+remote+"/%")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read remote branches: %w", err)
+	}
+	defer rows.Close()
+
+	refs := make(map[string]string)
+	for rows.Next() {
+		var name, hash string
+		if err := rows.Scan(&name, &hash); err != nil {
+			return nil, fmt.Errorf("failed to scan remote branch: %w", err)
+		}
+		refs[name] = hash
+	}
+	return refs, rows.Err()
+}
+
+// resolveRemoteTrackingRef maps "origin/main" to "remotes/origin/main" when
+// that remote-tracking branch exists, so fetched refs can be diffed by their
+// short name. Any other ref is returned unchanged.
+func (s *DoltStore) resolveRemoteTrackingRef(ctx context.Context, ref string) string {
+	if !strings.Contains(ref, "/") || strings.HasPrefix(ref, "remotes/") {
+		return ref
+	}
+	var n int
+	err := s.queryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&n)
+	}, "SELECT COUNT(*) FROM dolt_remote_branches WHERE name = ?", "remotes/"+ref)
+	if err != nil || n == 0 {
+		return ref
+	}
+	return "remotes/" + ref
+}
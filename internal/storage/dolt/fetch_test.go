@@ -0,0 +1,75 @@
+package dolt
+
+import (
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// TestFetchRemoteReportsUpdatedRefs pushes through one remote and fetches
+// the same file:// URL through another, so the fetch has refs to bring in.
+func TestFetchRemoteReportsUpdatedRefs(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	url := "file://" + t.TempDir()
+	for _, name := range []string{"fetch-src", "fetch-dst"} {
+		if err := store.AddRemote(ctx, name, url); err != nil {
+			t.Fatalf("AddRemote(%s): %v", name, err)
+		}
+	}
+	branch, err := store.CurrentBranch(ctx)
+	if err != nil {
+		t.Fatalf("CurrentBranch: %v", err)
+	}
+	push := func() string {
+		t.Helper()
+		if err := store.PushToRemote(ctx, "fetch-src", branch, false); err != nil {
+			t.Fatalf("PushToRemote: %v", err)
+		}
+		head, err := store.GetCurrentCommit(ctx)
+		if err != nil {
+			t.Fatalf("GetCurrentCommit: %v", err)
+		}
+		return head
+	}
+
+	first := push()
+	updated, err := store.FetchRemote(ctx, "fetch-dst")
+	if err != nil {
+		t.Fatalf("FetchRemote: %v", err)
+	}
+	ref := "remotes/fetch-dst/" + branch
+	if len(updated) != 1 || updated[0].Ref != ref || updated[0].Old != "" || updated[0].New != first {
+		t.Fatalf("first fetch = %+v, want new %s at %s", updated, ref, first)
+	}
+
+	// Nothing new upstream: fetch reports no changes.
+	if updated, err = store.FetchRemote(ctx, "fetch-dst"); err != nil || len(updated) != 0 {
+		t.Fatalf("repeat fetch = %+v, %v; want no updates", updated, err)
+	}
+
+	issue := &types.Issue{Title: "fetched later", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+	if err := store.Commit(ctx, "add issue"); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	second := push()
+
+	updated, err = store.FetchRemote(ctx, "fetch-dst")
+	if err != nil {
+		t.Fatalf("FetchRemote: %v", err)
+	}
+	if len(updated) != 1 || updated[0].Old != first || updated[0].New != second {
+		t.Fatalf("second fetch = %+v, want %s moved %s..%s", updated, ref, first, second)
+	}
+
+	// The short remote-tracking name resolves for diffs.
+	if _, err := store.Diff(ctx, "HEAD", "fetch-dst/"+branch); err != nil {
+		t.Errorf("Diff against fetch-dst/%s: %v", branch, err)
+	}
+}
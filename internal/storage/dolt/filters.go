@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/steveyegge/beads/internal/query"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -25,8 +26,9 @@ var (
 
 // buildIssueFilterClauses builds WHERE clause fragments and args from a query
 // string and IssueFilter. The tables parameter controls which table names are
-// referenced in subqueries (issues vs wisps).
-func buildIssueFilterClauses(query string, filter types.IssueFilter, tables filterTables) ([]string, []interface{}, error) {
+// referenced in subqueries (issues vs wisps); h gives the separator of
+// hierarchical child IDs for ParentID.
+func buildIssueFilterClauses(query string, filter types.IssueFilter, tables filterTables, h types.IDHierarchy) ([]string, []interface{}, error) {
 	var whereClauses []string
 	var args []interface{}
 
@@ -118,6 +120,14 @@ func buildIssueFilterClauses(query string, filter types.IssueFilter, tables filt
 		whereClauses = append(whereClauses, "assignee = ?")
 		args = append(args, *filter.Assignee)
 	}
+	if len(filter.AssigneeIn) > 0 {
+		placeholders := make([]string, len(filter.AssigneeIn))
+		for i, a := range filter.AssigneeIn {
+			placeholders[i] = "?"
+			args = append(args, a)
+		}
+		whereClauses = append(whereClauses, fmt.Sprintf("assignee IN (%s)", strings.Join(placeholders, ",")))
+	}
 
 	// Priority filters
 	if filter.Priority != nil {
@@ -154,8 +164,8 @@ func buildIssueFilterClauses(query string, filter types.IssueFilter, tables filt
 	// Parent/child dependency filters
 	if filter.ParentID != nil {
 		parentID := *filter.ParentID
-		whereClauses = append(whereClauses, fmt.Sprintf("(id IN (SELECT issue_id FROM %s WHERE type = 'parent-child' AND depends_on_id = ?) OR (id LIKE CONCAT(?, '.%%') AND id NOT IN (SELECT issue_id FROM %s WHERE type = 'parent-child')))", tables.dependencies, tables.dependencies))
-		args = append(args, parentID, parentID)
+		whereClauses = append(whereClauses, fmt.Sprintf("(id IN (SELECT issue_id FROM %s WHERE type = 'parent-child' AND depends_on_id = ?) OR (id LIKE CONCAT(?, ?, '%%') AND id NOT IN (SELECT issue_id FROM %s WHERE type = 'parent-child')))", tables.dependencies, tables.dependencies))
+		args = append(args, parentID, parentID, h.Separator())
 	}
 	if filter.NoParent {
 		whereClauses = append(whereClauses, fmt.Sprintf("id NOT IN (SELECT issue_id FROM %s WHERE type = 'parent-child')", tables.dependencies))
@@ -300,9 +310,29 @@ func buildIssueFilterClauses(query string, filter types.IssueFilter, tables filt
 		}
 	}
 
+	// Filter expression, compiled against the whitelist in internal/query
+	if filter.Expr != "" {
+		clause, exprArgs, err := compileFilterExpr(filter.Expr, tables)
+		if err != nil {
+			return nil, nil, err
+		}
+		whereClauses = append(whereClauses, clause)
+		args = append(args, exprArgs...)
+	}
+
 	return whereClauses, args, nil
 }
 
+// compileFilterExpr compiles an IssueFilter.Expr against the given tables.
+func compileFilterExpr(expr string, tables filterTables) (string, []interface{}, error) {
+	clause, args, err := query.CompileSQL(expr, time.Now(),
+		query.SQLTables{Main: tables.main, Labels: tables.labels, Dependencies: tables.dependencies})
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid filter expression: %w", err)
+	}
+	return clause, args, nil
+}
+
 // looksLikeIssueID returns true if the query string looks like a beads issue ID
 // (e.g., "bd-123", "hq-319", "bd-wisp-abc"). Issue IDs have the pattern:
 // prefix-suffix where prefix is 1+ alphanumeric/hyphen segments and suffix is
@@ -326,3 +356,50 @@ func looksLikeIssueID(query string) bool {
 	}
 	return true
 }
+
+// sortColumns maps --sort fields (types.SortFields) to SQL expressions.
+var sortColumns = map[string]string{
+	"priority": "priority",
+	"created":  "created_at",
+	"updated":  "updated_at",
+	"closed":   "closed_at",
+	"status":   "status",
+	"id":       "id",
+	"title":    "LOWER(title)",
+	"type":     "issue_type",
+	"assignee": "assignee",
+}
+
+// buildOrderBy returns the ORDER BY clause for filter.Sort, or defaultSQL
+// when no sort keys are given. Unset closed_at and assignee sort last in
+// either direction, and id breaks ties so pagination is deterministic.
+func buildOrderBy(keys []types.SortKey, defaultSQL string) string {
+	if len(keys) == 0 {
+		return defaultSQL
+	}
+	var parts []string
+	hasID := false
+	for _, key := range keys {
+		col, ok := sortColumns[key.Field]
+		if !ok {
+			continue
+		}
+		switch key.Field {
+		case "closed":
+			parts = append(parts, "closed_at IS NULL")
+		case "assignee":
+			parts = append(parts, "(assignee IS NULL OR assignee = '')")
+		case "id":
+			hasID = true
+		}
+		dir := "ASC"
+		if key.Desc {
+			dir = "DESC"
+		}
+		parts = append(parts, col+" "+dir)
+	}
+	if !hasID {
+		parts = append(parts, "id ASC")
+	}
+	return "ORDER BY " + strings.Join(parts, ", ")
+}
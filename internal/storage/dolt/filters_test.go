@@ -1,6 +1,10 @@
 package dolt
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
 
 func TestLooksLikeIssueID(t *testing.T) {
 	tests := []struct {
@@ -41,3 +45,22 @@ func TestLooksLikeIssueID(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildOrderBy(t *testing.T) {
+	const def = "ORDER BY priority ASC, created_at DESC, id ASC"
+	tests := []struct {
+		keys []types.SortKey
+		want string
+	}{
+		{nil, def},
+		{[]types.SortKey{{Field: "priority"}, {Field: "updated", Desc: true}}, "ORDER BY priority ASC, updated_at DESC, id ASC"},
+		{[]types.SortKey{{Field: "assignee"}}, "ORDER BY (assignee IS NULL OR assignee = ''), assignee ASC, id ASC"},
+		{[]types.SortKey{{Field: "closed", Desc: true}}, "ORDER BY closed_at IS NULL, closed_at DESC, id ASC"},
+		{[]types.SortKey{{Field: "id", Desc: true}, {Field: "title"}}, "ORDER BY id DESC, LOWER(title) ASC"},
+	}
+	for _, tt := range tests {
+		if got := buildOrderBy(tt.keys, def); got != tt.want {
+			t.Errorf("buildOrderBy(%v) = %q, want %q", tt.keys, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package dolt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// AddGroupMembers adds members to an assignee group, creating the group if
+// needed. Members already in the group are left alone.
+func (s *DoltStore) AddGroupMembers(ctx context.Context, group string, members []string) error {
+	for _, member := range members {
+		if _, err := s.execContext(ctx,
+			"INSERT IGNORE INTO assignee_groups (group_name, member) VALUES (?, ?)", group, member); err != nil {
+			return fmt.Errorf("failed to add %s to group %s: %w", member, group, err)
+		}
+	}
+	return nil
+}
+
+// RemoveGroupMembers removes members from an assignee group, or the whole
+// group when members is empty. Removing a missing member is not an error.
+func (s *DoltStore) RemoveGroupMembers(ctx context.Context, group string, members []string) error {
+	if len(members) == 0 {
+		if _, err := s.execContext(ctx, "DELETE FROM assignee_groups WHERE group_name = ?", group); err != nil {
+			return fmt.Errorf("failed to delete group %s: %w", group, err)
+		}
+		return nil
+	}
+	for _, member := range members {
+		if _, err := s.execContext(ctx,
+			"DELETE FROM assignee_groups WHERE group_name = ? AND member = ?", group, member); err != nil {
+			return fmt.Errorf("failed to remove %s from group %s: %w", member, group, err)
+		}
+	}
+	return nil
+}
+
+// GetGroupMembers returns the members of an assignee group sorted by name,
+// or nil if the group does not exist.
+func (s *DoltStore) GetGroupMembers(ctx context.Context, group string) ([]string, error) {
+	rows, err := s.queryContext(ctx,
+		"SELECT member FROM assignee_groups WHERE group_name = ? ORDER BY member", group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members of group %s: %w", group, err)
+	}
+	defer rows.Close()
+
+	var members []string
+	for rows.Next() {
+		var member string
+		if err := rows.Scan(&member); err != nil {
+			return nil, fmt.Errorf("failed to scan group member: %w", err)
+		}
+		members = append(members, member)
+	}
+	return members, rows.Err()
+}
+
+// ListGroups returns all assignee groups ordered by name, each with its
+// members sorted.
+func (s *DoltStore) ListGroups(ctx context.Context) ([]*types.AssigneeGroup, error) {
+	rows, err := s.queryContext(ctx,
+		"SELECT group_name, member FROM assignee_groups ORDER BY group_name, member")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+	defer rows.Close()
+
+	var groups []*types.AssigneeGroup
+	for rows.Next() {
+		var name, member string
+		if err := rows.Scan(&name, &member); err != nil {
+			return nil, fmt.Errorf("failed to scan group member: %w", err)
+		}
+		if len(groups) == 0 || groups[len(groups)-1].Name != name {
+			groups = append(groups, &types.AssigneeGroup{Name: name})
+		}
+		g := groups[len(groups)-1]
+		g.Members = append(g.Members, member)
+	}
+	return groups, rows.Err()
+}
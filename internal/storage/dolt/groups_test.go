@@ -0,0 +1,61 @@
+package dolt
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAssigneeGroups(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := store.AddGroupMembers(ctx, "backend", []string{"carol", "alice"}); err != nil {
+		t.Fatalf("AddGroupMembers: %v", err)
+	}
+	// Adding an existing member again is a no-op
+	if err := store.AddGroupMembers(ctx, "backend", []string{"alice", "bob"}); err != nil {
+		t.Fatalf("AddGroupMembers (again): %v", err)
+	}
+	if err := store.AddGroupMembers(ctx, "docs", []string{"dave"}); err != nil {
+		t.Fatalf("AddGroupMembers (docs): %v", err)
+	}
+
+	members, err := store.GetGroupMembers(ctx, "backend")
+	if err != nil {
+		t.Fatalf("GetGroupMembers: %v", err)
+	}
+	if want := []string{"alice", "bob", "carol"}; !reflect.DeepEqual(members, want) {
+		t.Fatalf("GetGroupMembers = %v, want %v", members, want)
+	}
+
+	if err := store.RemoveGroupMembers(ctx, "backend", []string{"bob"}); err != nil {
+		t.Fatalf("RemoveGroupMembers: %v", err)
+	}
+	groups, err := store.ListGroups(ctx)
+	if err != nil {
+		t.Fatalf("ListGroups: %v", err)
+	}
+	if len(groups) != 2 || groups[0].Name != "backend" || groups[1].Name != "docs" {
+		t.Fatalf("ListGroups = %+v, want backend and docs", groups)
+	}
+	if want := []string{"alice", "carol"}; !reflect.DeepEqual(groups[0].Members, want) {
+		t.Errorf("backend members = %v, want %v", groups[0].Members, want)
+	}
+
+	// No members removes the whole group
+	if err := store.RemoveGroupMembers(ctx, "docs", nil); err != nil {
+		t.Fatalf("RemoveGroupMembers (group): %v", err)
+	}
+	members, err = store.GetGroupMembers(ctx, "docs")
+	if err != nil {
+		t.Fatalf("GetGroupMembers after delete: %v", err)
+	}
+	if len(members) != 0 {
+		t.Errorf("docs still has members after delete: %v", members)
+	}
+}
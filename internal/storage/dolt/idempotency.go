@@ -0,0 +1,57 @@
+package dolt
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage"
+)
+
+// LookupIdempotencyKey returns the issue an earlier create recorded under key
+// (see BatchCreateOptions.IdempotencyKey), or "" when the key is unused or
+// has expired. Expired keys are purged first, as are reservations with no
+// issue left by older bd versions.
+func (s *DoltStore) LookupIdempotencyKey(ctx context.Context, key string) (string, error) {
+	if key == "" {
+		return "", fmt.Errorf("idempotency key must not be empty")
+	}
+	if _, err := s.execContext(ctx, "DELETE FROM idempotency_keys WHERE expires_at <= ? OR issue_id = ''", time.Now().UTC()); err != nil {
+		return "", fmt.Errorf("failed to purge expired idempotency keys: %w", err)
+	}
+
+	var issueID string
+	err := s.queryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&issueID)
+	}, "SELECT issue_id FROM idempotency_keys WHERE idem_key = ?", key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read idempotency key %q: %w", key, err)
+	}
+	return issueID, nil
+}
+
+// recordIdempotencyKeyInTx records key for issueID inside the create's
+// transaction, so the key exists exactly when the issue does. The primary key
+// makes concurrent creates with the same key race safely: the loser gets
+// storage.ErrIdempotencyKeyUsed and its transaction rolls back.
+func recordIdempotencyKeyInTx(ctx context.Context, tx *sql.Tx, key, issueID string, ttl time.Duration) error {
+	now := time.Now().UTC()
+	if _, err := tx.ExecContext(ctx, "DELETE FROM idempotency_keys WHERE idem_key = ? AND expires_at <= ?", key, now); err != nil {
+		return fmt.Errorf("failed to purge expired idempotency key %q: %w", key, err)
+	}
+	res, err := tx.ExecContext(ctx,
+		"INSERT IGNORE INTO idempotency_keys (idem_key, issue_id, created_at, expires_at) VALUES (?, ?, ?, ?)",
+		key, issueID, now, now.Add(ttl))
+	if err != nil {
+		return fmt.Errorf("failed to record idempotency key %q: %w", key, err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("%w: %q", storage.ErrIdempotencyKeyUsed, key)
+	}
+	return nil
+}
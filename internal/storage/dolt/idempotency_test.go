@@ -0,0 +1,92 @@
+package dolt
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestIdempotencyKeyReturnsFirstIssue(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	// create runs the create path bd create follows under --idempotency-key.
+	create := func(title string) string {
+		t.Helper()
+		existingID, err := store.LookupIdempotencyKey(ctx, "webhook-42")
+		if err != nil {
+			t.Fatalf("LookupIdempotencyKey: %v", err)
+		}
+		if existingID != "" {
+			return existingID
+		}
+		issue := &types.Issue{Title: title, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssueWithOptions(ctx, issue, "tester", storage.BatchCreateOptions{
+			SkipPrefixValidation: true,
+			IdempotencyKey:       "webhook-42",
+			IdempotencyTTL:       time.Hour,
+		}); err != nil {
+			t.Fatalf("CreateIssueWithOptions: %v", err)
+		}
+		return issue.ID
+	}
+
+	first := create("Delivered once")
+	second := create("Delivered twice")
+	if second != first {
+		t.Fatalf("second create with the same key returned %s, want %s", second, first)
+	}
+	var n int
+	if err := store.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM issues WHERE title LIKE 'Delivered%'").Scan(&n); err != nil {
+		t.Fatalf("failed to count issues: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("%d issues created, want 1", n)
+	}
+
+	// Once the key expires it can be used again.
+	if _, err := store.db.ExecContext(ctx, "UPDATE idempotency_keys SET expires_at = ? WHERE idem_key = 'webhook-42'",
+		time.Now().UTC().Add(-time.Minute)); err != nil {
+		t.Fatalf("failed to expire key: %v", err)
+	}
+	if third := create("Delivered after expiry"); third == first {
+		t.Errorf("create after expiry returned the expired key's issue %s", first)
+	}
+}
+
+func TestIdempotencyKeyRecordedWithIssue(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	opts := storage.BatchCreateOptions{SkipPrefixValidation: true, IdempotencyKey: "race", IdempotencyTTL: time.Hour}
+	first := &types.Issue{Title: "Winner", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssueWithOptions(ctx, first, "tester", opts); err != nil {
+		t.Fatalf("CreateIssueWithOptions: %v", err)
+	}
+
+	// A create that skipped the lookup (a concurrent duplicate) must fail
+	// without leaving a second issue behind.
+	second := &types.Issue{Title: "Loser", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssueWithOptions(ctx, second, "tester", opts); !errors.Is(err, storage.ErrIdempotencyKeyUsed) {
+		t.Fatalf("duplicate create error = %v, want ErrIdempotencyKeyUsed", err)
+	}
+	var n int
+	if err := store.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM issues WHERE title = 'Loser'").Scan(&n); err != nil {
+		t.Fatalf("failed to count issues: %v", err)
+	}
+	if n != 0 {
+		t.Errorf("duplicate create left %d issue(s) behind", n)
+	}
+	if got, err := store.LookupIdempotencyKey(ctx, "race"); err != nil || got != first.ID {
+		t.Errorf("LookupIdempotencyKey = %q, %v; want %q", got, err, first.ID)
+	}
+}
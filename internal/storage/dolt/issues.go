@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -19,44 +20,104 @@ import (
 // CreateIssue creates a new issue.
 // Delegates SQL work to issueops; handles Dolt versioning for non-ephemeral issues.
 func (s *DoltStore) CreateIssue(ctx context.Context, issue *types.Issue, actor string) error {
+	// SkipPrefixValidation matches legacy behavior: single-issue path does
+	// not validate prefixes for explicit IDs.
+	return s.CreateIssueWithOptions(ctx, issue, actor, storage.BatchCreateOptions{
+		SkipPrefixValidation: true,
+	})
+}
+
+// CreateIssueWithOptions is CreateIssue with batch options, e.g. an
+// idempotency key recorded in the same transaction as the issue.
+func (s *DoltStore) CreateIssueWithOptions(ctx context.Context, issue *types.Issue, actor string, opts storage.BatchCreateOptions) error {
+	return s.createIssue(ctx, issue, nil, actor, opts)
+}
+
+// CreateIssueWithDependencies is CreateIssueWithOptions that also adds deps,
+// validated as AddDependency validates them, in the same transaction and
+// Dolt commit: the issue is created with all of its dependencies or not at
+// all. A dependency's IssueID may be empty, meaning the new issue. Used by
+// the beads library's Store.Create.
+func (s *DoltStore) CreateIssueWithDependencies(ctx context.Context, issue *types.Issue, deps []*types.Dependency, actor string, opts storage.BatchCreateOptions) error {
+	return s.createIssue(ctx, issue, deps, actor, opts)
+}
+
+func (s *DoltStore) createIssue(ctx context.Context, issue *types.Issue, deps []*types.Dependency, actor string, opts storage.BatchCreateOptions) error {
 	if issue == nil {
 		return fmt.Errorf("issue must not be nil")
 	}
+	opts.Hierarchy = s.hierarchy
 	// Route ephemeral issues and infra types to wisps table.
 	if issue.Ephemeral || s.IsInfraTypeCtx(ctx, issue.IssueType) {
 		issue.Ephemeral = true
 	}
 
+	// A child ID or sequence number allocated inside the transaction can
+	// lose a race with a concurrent create, which surfaces as a
+	// serialization failure at commit. The transaction has rolled back, so
+	// retrying re-allocates from the winner's committed counter.
+	id, seq := issue.ID, issue.Seq
+	for attempt := 1; ; attempt++ {
+		err := s.createIssueOnce(ctx, issue, deps, actor, opts)
+		if err == nil && len(deps) > 0 {
+			s.invalidateBlockedIDsCache()
+		}
+		if err == nil || attempt == createRetryAttempts || !isSerializationError(err) {
+			return err
+		}
+		issue.ID, issue.Seq = id, seq
+		time.Sleep(time.Duration(attempt) * 20 * time.Millisecond)
+	}
+}
+
+// createRetryAttempts bounds CreateIssue's retries of a create whose
+// allocated ID lost a race.
+const createRetryAttempts = 5
+
+func (s *DoltStore) createIssueOnce(ctx context.Context, issue *types.Issue, deps []*types.Dependency, actor string, opts storage.BatchCreateOptions) error {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer func() { _ = tx.Rollback() }()
 
-	// SkipPrefixValidation matches legacy behavior: single-issue path does
-	// not validate prefixes for explicit IDs.
-	bc, err := issueops.NewBatchContext(ctx, tx, storage.BatchCreateOptions{
-		SkipPrefixValidation: true,
-	})
+	bc, err := issueops.NewBatchContext(ctx, tx, opts)
 	if err != nil {
 		return err
 	}
 	if err := issueops.CreateIssueInTx(ctx, tx, bc, issue, actor); err != nil {
 		return err
 	}
+	for _, dep := range deps {
+		if dep.IssueID != "" && dep.IssueID != issue.ID {
+			return fmt.Errorf("dependency %s -> %s does not start at new issue %s", dep.IssueID, dep.DependsOnID, issue.ID)
+		}
+		// Copy, so a retry after a lost ID race sees the caller's dependency
+		d := *dep
+		d.IssueID = issue.ID
+		if err := issueops.AddDependencyInTx(ctx, tx, &d, actor, issueops.AddDependencyOpts{
+			IsCrossPrefix: isCrossPrefixDep(d.IssueID, d.DependsOnID),
+		}); err != nil {
+			return fmt.Errorf("failed to add dependency on %s: %w", d.DependsOnID, err)
+		}
+	}
+	if opts.IdempotencyKey != "" {
+		if err := recordIdempotencyKeyInTx(ctx, tx, opts.IdempotencyKey, issue.ID, opts.IdempotencyTTL); err != nil {
+			return err
+		}
+	}
 
 	// Dolt versioning — wisps are transient and skip DOLT_COMMIT.
 	if !issue.Ephemeral {
 		// GH#2455: Stage only the tables we modified, then commit without -A
 		// to avoid sweeping up stale config changes from concurrent operations.
-		for _, table := range []string{"issues", "events"} {
+		for _, table := range []string{"issues", "events", "dependencies", "child_counters", "issue_seq_counter", "idempotency_keys"} {
 			if _, err := tx.ExecContext(ctx, "CALL DOLT_ADD(?)", table); err != nil {
 				return fmt.Errorf("dolt add %s: %w", table, err)
 			}
 		}
 		commitMsg := fmt.Sprintf("bd: create %s", issue.ID)
-		if _, err := tx.ExecContext(ctx, "CALL DOLT_COMMIT('-m', ?, '--author', ?)",
-			commitMsg, s.commitAuthorString()); err != nil && !isDoltNothingToCommit(err) {
+		if err := s.versionCommit(ctx, tx, commitMsg); err != nil {
 			return fmt.Errorf("dolt commit: %w", err)
 		}
 	}
@@ -78,9 +139,11 @@ func (s *DoltStore) CreateIssuesWithFullOptions(ctx context.Context, issues []*t
 	if len(issues) == 0 {
 		return nil
 	}
+	opts.Hierarchy = s.hierarchy
 
 	// All-ephemeral fast path: individual transactions, no Dolt versioning.
-	if issueops.AllEphemeral(issues) {
+	// An idempotency key needs the whole batch in one transaction.
+	if issueops.AllEphemeral(issues) && opts.IdempotencyKey == "" {
 		for _, issue := range issues {
 			issue.Ephemeral = true
 			tx, err := s.db.BeginTx(ctx, nil)
@@ -109,17 +172,79 @@ func (s *DoltStore) CreateIssuesWithFullOptions(ctx context.Context, issues []*t
 	}
 	defer func() { _ = tx.Rollback() }()
 
+	return s.createIssuesInTx(ctx, tx, issues, actor, opts, fmt.Sprintf("bd: create %d issue(s)", len(issues)))
+}
+
+// CreateIssuesUnder creates issues as descendants of parentID, like
+// CreateIssuesWithFullOptions, assigning their IDs in the same transaction:
+// assign receives the IDs already under parentID and nextChildID, which
+// takes parentID's next child slot (see GetNextChildID), and must give
+// every issue its final ID. Slots are therefore taken exactly when the
+// issues are created. Used by 'bd import --under'.
+func (s *DoltStore) CreateIssuesUnder(ctx context.Context, parentID string, issues []*types.Issue, actor string, opts storage.BatchCreateOptions, commitMsg string, assign func(taken map[string]bool, nextChildID func() (string, error)) error) error {
+	if len(issues) == 0 {
+		return nil
+	}
+	opts.Hierarchy = s.hierarchy
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, "SELECT id FROM issues WHERE id LIKE CONCAT(?, ?, '%')", parentID, s.hierarchy.Separator())
+	if err != nil {
+		return fmt.Errorf("failed to list children of %s: %w", parentID, err)
+	}
+	taken := make(map[string]bool)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("failed to scan child of %s: %w", parentID, err)
+		}
+		taken[id] = true
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to list children of %s: %w", parentID, err)
+	}
+
+	nextChildID := func() (string, error) {
+		return issueops.GetNextChildIDTx(ctx, tx, parentID, s.hierarchy)
+	}
+	if err := assign(taken, nextChildID); err != nil {
+		return err
+	}
+
+	return s.createIssuesInTx(ctx, tx, issues, actor, opts, commitMsg)
+}
+
+// createIssuesInTx is the batch create shared by CreateIssuesWithFullOptions
+// and CreateIssuesUnder: it writes issues and their related rows, stages the
+// tables and commits tx under commitMsg.
+func (s *DoltStore) createIssuesInTx(ctx context.Context, tx *sql.Tx, issues []*types.Issue, actor string, opts storage.BatchCreateOptions, commitMsg string) error {
 	if err := issueops.CreateIssuesInTx(ctx, tx, issues, actor, opts); err != nil {
 		return err
 	}
+	if err := persistMetaInTx(ctx, tx, issues); err != nil {
+		return err
+	}
+	if err := persistAttachmentsInTx(ctx, tx, issues); err != nil {
+		return err
+	}
+	if opts.IdempotencyKey != "" {
+		if err := recordIdempotencyKeyInTx(ctx, tx, opts.IdempotencyKey, issues[len(issues)-1].ID, opts.IdempotencyTTL); err != nil {
+			return err
+		}
+	}
 
 	// GH#2455: Stage only the tables we modified, then commit without -A.
-	for _, table := range []string{"issues", "events", "labels", "comments", "dependencies", "child_counters"} {
+	for _, table := range []string{"issues", "events", "labels", "comments", "dependencies", "child_counters", "issue_meta", "attachments", "issue_seq_counter", "idempotency_keys"} {
 		_, _ = tx.ExecContext(ctx, "CALL DOLT_ADD(?)", table)
 	}
-	commitMsg := fmt.Sprintf("bd: create %d issue(s)", len(issues))
-	if _, err := tx.ExecContext(ctx, "CALL DOLT_COMMIT('-m', ?, '--author', ?)",
-		commitMsg, s.commitAuthorString()); err != nil && !isDoltNothingToCommit(err) {
+	if err := s.versionCommit(ctx, tx, commitMsg); err != nil {
 		return fmt.Errorf("dolt commit: %w", err)
 	}
 
@@ -150,6 +275,33 @@ func (s *DoltStore) GetIssue(ctx context.Context, id string) (*types.Issue, erro
 	return issue, nil
 }
 
+// IssueExists reports whether an issue or wisp with exactly this ID exists,
+// without loading it. closed narrows the check: nil matches any status, true
+// only closed issues, false only issues that are not closed.
+func (s *DoltStore) IssueExists(ctx context.Context, id string, closed *bool) (bool, error) {
+	cond := ""
+	if closed != nil {
+		if *closed {
+			cond = " AND status = 'closed'"
+		} else {
+			cond = " AND status <> 'closed'"
+		}
+	}
+
+	var exists int
+	//nolint:gosec // G202: cond is one of two fixed clauses
+	err := s.queryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&exists)
+	}, "SELECT 1 FROM issues WHERE id = ?"+cond+" UNION ALL SELECT 1 FROM wisps WHERE id = ?"+cond+" LIMIT 1", id, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check issue %s: %w", id, err)
+	}
+	return true, nil
+}
+
 // GetIssueByExternalRef retrieves an issue by external reference.
 // Returns storage.ErrNotFound (wrapped) if no issue with the given external reference exists.
 func (s *DoltStore) GetIssueByExternalRef(ctx context.Context, externalRef string) (*types.Issue, error) {
@@ -168,8 +320,30 @@ func (s *DoltStore) GetIssueByExternalRef(ctx context.Context, externalRef strin
 	return s.GetIssue(ctx, id)
 }
 
+// GetIssueIDBySeq returns the ID of the issue with the given per-repo
+// sequence number (see issue_seq).
+func (s *DoltStore) GetIssueIDBySeq(ctx context.Context, seq int) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var id string
+	err := s.db.QueryRowContext(ctx, "SELECT id FROM issues WHERE seq = ?", seq).Scan(&id)
+	if err == sql.ErrNoRows {
+		return "", fmt.Errorf("%w: #%d", storage.ErrNotFound, seq)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get issue by seq: %w", err)
+	}
+	return id, nil
+}
+
 // UpdateIssue updates fields on an issue
 func (s *DoltStore) UpdateIssue(ctx context.Context, id string, updates map[string]interface{}, actor string) error {
+	if title, ok := updates["title"].(string); ok {
+		if err := types.ValidateTitle(title); err != nil {
+			return err
+		}
+	}
 	// Validate metadata against schema before wisp routing (GH#1416 Phase 2)
 	if rawMeta, ok := updates["metadata"]; ok {
 		metadataStr, err := storage.NormalizeMetadataValue(rawMeta)
@@ -243,8 +417,9 @@ func (s *DoltStore) UpdateIssue(ctx context.Context, id string, updates map[stri
 		}
 	}
 
-	// Auto-manage closed_at
+	// Auto-manage closed_at and archive_after
 	setClauses, args = manageClosedAt(oldIssue, updates, setClauses, args)
+	setClauses, args = manageArchiveAfter(oldIssue, updates, setClauses, args)
 
 	args = append(args, id)
 
@@ -268,8 +443,7 @@ func (s *DoltStore) UpdateIssue(ctx context.Context, id string, updates map[stri
 		_, _ = tx.ExecContext(ctx, "CALL DOLT_ADD(?)", table)
 	}
 	commitMsg := fmt.Sprintf("bd: update %s", id)
-	if _, err := tx.ExecContext(ctx, "CALL DOLT_COMMIT('-m', ?, '--author', ?)",
-		commitMsg, s.commitAuthorString()); err != nil && !isDoltNothingToCommit(err) {
+	if err := s.versionCommit(ctx, tx, commitMsg); err != nil {
 		return fmt.Errorf("dolt commit: %w", err)
 	}
 
@@ -349,8 +523,7 @@ func (s *DoltStore) ClaimIssue(ctx context.Context, id string, actor string) err
 		_, _ = tx.ExecContext(ctx, "CALL DOLT_ADD(?)", table)
 	}
 	commitMsg := fmt.Sprintf("bd: claim %s", id)
-	if _, err := tx.ExecContext(ctx, "CALL DOLT_COMMIT('-m', ?, '--author', ?)",
-		commitMsg, s.commitAuthorString()); err != nil && !isDoltNothingToCommit(err) {
+	if err := s.versionCommit(ctx, tx, commitMsg); err != nil {
 		return fmt.Errorf("dolt commit: %w", err)
 	}
 
@@ -378,9 +551,9 @@ func (s *DoltStore) CloseIssue(ctx context.Context, id string, reason string, ac
 	defer func() { _ = tx.Rollback() }() // No-op after successful commit
 
 	result, err := tx.ExecContext(ctx, `
-		UPDATE issues SET status = ?, closed_at = ?, updated_at = ?, close_reason = ?, closed_by_session = ?
+		UPDATE issues SET status = ?, closed_at = ?, updated_at = ?, close_reason = ?, closed_by_session = ?, archive_after = ?
 		WHERE id = ?
-	`, types.StatusClosed, now, now, reason, session, id)
+	`, types.StatusClosed, now, now, reason, session, archiveAfter(now), id)
 	if err != nil {
 		return fmt.Errorf("failed to close issue: %w", err)
 	}
@@ -401,9 +574,8 @@ func (s *DoltStore) CloseIssue(ctx context.Context, id string, reason string, ac
 	for _, table := range []string{"issues", "events"} {
 		_, _ = tx.ExecContext(ctx, "CALL DOLT_ADD(?)", table)
 	}
-	commitMsg := fmt.Sprintf("bd: close %s", id)
-	if _, err := tx.ExecContext(ctx, "CALL DOLT_COMMIT('-m', ?, '--author', ?)",
-		commitMsg, s.commitAuthorString()); err != nil && !isDoltNothingToCommit(err) {
+	commitMsg := closeCommitMessage(id, reason)
+	if err := s.versionCommit(ctx, tx, commitMsg); err != nil {
 		return fmt.Errorf("dolt commit: %w", err)
 	}
 
@@ -415,6 +587,21 @@ func (s *DoltStore) CloseIssue(ctx context.Context, id string, reason string, ac
 	return nil
 }
 
+// closeCommitMessage builds the Dolt commit message for closing an issue.
+// A user-supplied reason is appended so the resolution shows up in dolt_log;
+// the CLI's generic "Closed" default is omitted to keep history terse.
+func closeCommitMessage(id, reason string) string {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || reason == "Closed" {
+		return fmt.Sprintf("bd: close %s", id)
+	}
+	// Commit subjects are single-line; keep only the first line of the reason.
+	if i := strings.IndexByte(reason, '\n'); i >= 0 {
+		reason = strings.TrimSpace(reason[:i])
+	}
+	return fmt.Sprintf("bd: close %s: %s", id, reason)
+}
+
 // DeleteIssue permanently removes an issue
 func (s *DoltStore) DeleteIssue(ctx context.Context, id string) error {
 	// Route ephemeral IDs to wisps table (falls through for promoted wisps)
@@ -464,8 +651,7 @@ func (s *DoltStore) DeleteIssue(ctx context.Context, id string) error {
 		_, _ = tx.ExecContext(ctx, "CALL DOLT_ADD(?)", table)
 	}
 	commitMsg := fmt.Sprintf("bd: delete %s", id)
-	if _, err := tx.ExecContext(ctx, "CALL DOLT_COMMIT('-m', ?, '--author', ?)",
-		commitMsg, s.commitAuthorString()); err != nil && !isDoltNothingToCommit(err) {
+	if err := s.versionCommit(ctx, tx, commitMsg); err != nil {
 		return fmt.Errorf("dolt commit: %w", err)
 	}
 
@@ -687,39 +873,10 @@ func (s *DoltStore) DeleteIssues(ctx context.Context, ids []string, cascade bool
 		return result, nil
 	}
 
-	// Delete in batches. The schema uses ON DELETE CASCADE for labels, comments,
-	// events, child_counters, issue_snapshots, and compaction_snapshots — as well
-	// as dependencies.issue_id — so only the inbound dependency edge
-	// (depends_on_id, which has no FK) needs explicit cleanup before issuing the
-	// DELETE FROM issues.
-	totalDeleted := 0
-	for i := 0; i < len(expandedIDs); i += deleteBatchSize {
-		end := i + deleteBatchSize
-		if end > len(expandedIDs) {
-			end = len(expandedIDs)
-		}
-		batch := expandedIDs[i:end]
-		batchInClause, batchArgs := doltBuildSQLInClause(batch)
-
-		// 1. Delete inbound dependency edges (depends_on_id has no FK CASCADE)
-		_, err = tx.ExecContext(ctx,
-			fmt.Sprintf(`DELETE FROM dependencies WHERE depends_on_id IN (%s)`, batchInClause),
-			batchArgs...)
-		if err != nil {
-			return nil, fmt.Errorf("failed to delete inbound dependencies: %w", err)
-		}
-
-		// 2. Delete the issues — CASCADE handles labels, comments, events,
-		//    child_counters, issue_snapshots, compaction_snapshots, and
-		//    dependencies (issue_id side via fk_dep_issue).
-		deleteResult, err := tx.ExecContext(ctx,
-			fmt.Sprintf(`DELETE FROM issues WHERE id IN (%s)`, batchInClause),
-			batchArgs...)
-		if err != nil {
-			return nil, fmt.Errorf("failed to delete issues: %w", err)
-		}
-		rowsAffected, _ := deleteResult.RowsAffected()
-		totalDeleted += int(rowsAffected)
+	// Delete in batches (see deleteIssueRowsInTx for the cascade details).
+	totalDeleted, err := deleteIssueRowsInTx(ctx, tx, expandedIDs)
+	if err != nil {
+		return nil, err
 	}
 	result.DeletedCount = totalDeleted + wispDeleteCount
 
@@ -730,8 +887,7 @@ func (s *DoltStore) DeleteIssues(ctx context.Context, ids []string, cascade bool
 		_, _ = tx.ExecContext(ctx, "CALL DOLT_ADD(?)", table)
 	}
 	commitMsg := fmt.Sprintf("bd: delete %d issue(s)", totalDeleted)
-	if _, err := tx.ExecContext(ctx, "CALL DOLT_COMMIT('-m', ?, '--author', ?)",
-		commitMsg, s.commitAuthorString()); err != nil && !isDoltNothingToCommit(err) {
+	if err := s.versionCommit(ctx, tx, commitMsg); err != nil {
 		return nil, fmt.Errorf("dolt commit: %w", err)
 	}
 
@@ -743,6 +899,40 @@ func (s *DoltStore) DeleteIssues(ctx context.Context, ids []string, cascade bool
 	return result, nil
 }
 
+// deleteIssueRowsInTx deletes the given issues in batches and returns how many
+// rows were removed. The schema uses ON DELETE CASCADE for labels, comments,
+// events, child_counters, issue_snapshots, and compaction_snapshots — as well
+// as dependencies.issue_id — so only the inbound dependency edge
+// (depends_on_id, which has no FK) needs explicit cleanup first.
+func deleteIssueRowsInTx(ctx context.Context, tx *sql.Tx, ids []string) (int, error) {
+	totalDeleted := 0
+	for i := 0; i < len(ids); i += deleteBatchSize {
+		end := i + deleteBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batchInClause, batchArgs := doltBuildSQLInClause(ids[i:end])
+
+		// 1. Delete inbound dependency edges (depends_on_id has no FK CASCADE)
+		if _, err := tx.ExecContext(ctx,
+			fmt.Sprintf(`DELETE FROM dependencies WHERE depends_on_id IN (%s)`, batchInClause),
+			batchArgs...); err != nil {
+			return 0, fmt.Errorf("failed to delete inbound dependencies: %w", err)
+		}
+
+		// 2. Delete the issues — CASCADE handles the rest.
+		deleteResult, err := tx.ExecContext(ctx,
+			fmt.Sprintf(`DELETE FROM issues WHERE id IN (%s)`, batchInClause),
+			batchArgs...)
+		if err != nil {
+			return 0, fmt.Errorf("failed to delete issues: %w", err)
+		}
+		rowsAffected, _ := deleteResult.RowsAffected()
+		totalDeleted += int(rowsAffected)
+	}
+	return totalDeleted, nil
+}
+
 // maxRecursiveResults is the safety limit for the total number of issues discovered
 // during recursive dependent traversal. Prevents pathological dependency graphs
 // from causing unbounded memory/time consumption.
@@ -1032,19 +1222,8 @@ func isAllowedUpdateField(key string) bool {
 }
 
 func manageClosedAt(oldIssue *types.Issue, updates map[string]interface{}, setClauses []string, args []interface{}) ([]string, []interface{}) {
-	statusVal, hasStatus := updates["status"]
-	_, hasExplicitClosedAt := updates["closed_at"]
-	if hasExplicitClosedAt || !hasStatus {
-		return setClauses, args
-	}
-
-	var newStatus string
-	switch v := statusVal.(type) {
-	case string:
-		newStatus = v
-	case types.Status:
-		newStatus = string(v)
-	default:
+	newStatus, ok := closedAtTransition(updates)
+	if !ok {
 		return setClauses, args
 	}
 
@@ -1060,6 +1239,24 @@ func manageClosedAt(oldIssue *types.Issue, updates map[string]interface{}, setCl
 	return setClauses, args
 }
 
+// closedAtTransition returns the new status of an update that should manage
+// closed_at automatically: one that changes status without setting closed_at.
+func closedAtTransition(updates map[string]interface{}) (string, bool) {
+	statusVal, hasStatus := updates["status"]
+	_, hasExplicitClosedAt := updates["closed_at"]
+	if hasExplicitClosedAt || !hasStatus {
+		return "", false
+	}
+	switch v := statusVal.(type) {
+	case string:
+		return v, true
+	case types.Status:
+		return string(v), true
+	default:
+		return "", false
+	}
+}
+
 func determineEventType(oldIssue *types.Issue, updates map[string]interface{}) types.EventType {
 	statusVal, hasStatus := updates["status"]
 	if !hasStatus {
@@ -0,0 +1,69 @@
+package dolt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// AddIssueLink records a link from an issue to a commit or URL. It returns
+// false without error when the same link already exists, so scanning the
+// same history twice is harmless.
+func (s *DoltStore) AddIssueLink(ctx context.Context, link *types.IssueLink) (bool, error) {
+	if !link.Type.IsValid() {
+		return false, fmt.Errorf("invalid link type %q (want %s or %s)", link.Type, types.LinkFixes, types.LinkMentions)
+	}
+	res, err := s.execContext(ctx, `
+		INSERT INTO issue_links (issue_id, kind, target, link_type, created_by)
+		SELECT ?, ?, ?, ?, ? FROM DUAL
+		WHERE NOT EXISTS (
+			SELECT 1 FROM issue_links WHERE issue_id = ? AND kind = ? AND target = ? AND link_type = ?
+		)
+	`, link.IssueID, link.Kind, link.Target, link.Type, link.CreatedBy,
+		link.IssueID, link.Kind, link.Target, link.Type)
+	if err != nil {
+		return false, fmt.Errorf("failed to link %s to %s: %w", link.IssueID, link.Target, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to link %s to %s: %w", link.IssueID, link.Target, err)
+	}
+	return n > 0, nil
+}
+
+// GetIssueLinks returns the links of an issue, oldest first.
+func (s *DoltStore) GetIssueLinks(ctx context.Context, issueID string) ([]*types.IssueLink, error) {
+	rows, err := s.queryContext(ctx, `
+		SELECT id, issue_id, kind, target, link_type, COALESCE(created_by, ''), created_at
+		FROM issue_links WHERE issue_id = ? ORDER BY created_at, id
+	`, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get links of %s: %w", issueID, err)
+	}
+	defer rows.Close()
+
+	var links []*types.IssueLink
+	for rows.Next() {
+		var link types.IssueLink
+		if err := rows.Scan(&link.ID, &link.IssueID, &link.Kind, &link.Target, &link.Type, &link.CreatedBy, &link.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan link: %w", err)
+		}
+		links = append(links, &link)
+	}
+	return links, rows.Err()
+}
+
+// RemoveIssueLink deletes every link from an issue to target and returns
+// how many were removed.
+func (s *DoltStore) RemoveIssueLink(ctx context.Context, issueID, target string) (int, error) {
+	res, err := s.execContext(ctx, "DELETE FROM issue_links WHERE issue_id = ? AND target = ?", issueID, target)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unlink %s from %s: %w", issueID, target, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to unlink %s from %s: %w", issueID, target, err)
+	}
+	return int(n), nil
+}
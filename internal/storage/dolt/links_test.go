@@ -0,0 +1,57 @@
+package dolt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestIssueLinks(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	issue := &types.Issue{ID: "test-link1", Title: "Linked", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+
+	commit := &types.IssueLink{IssueID: issue.ID, Kind: types.LinkKindCommit, Target: "0123456789abcdef0123456789abcdef01234567", Type: types.LinkFixes, CreatedBy: "tester"}
+	pr := &types.IssueLink{IssueID: issue.ID, Kind: types.LinkKindURL, Target: "https://github.com/org/repo/pull/7", Type: types.LinkMentions}
+	for _, link := range []*types.IssueLink{commit, pr} {
+		added, err := store.AddIssueLink(ctx, link)
+		if err != nil || !added {
+			t.Fatalf("AddIssueLink(%s) = %v, %v; want added", link.Target, added, err)
+		}
+	}
+	if added, err := store.AddIssueLink(ctx, commit); err != nil || added {
+		t.Errorf("AddIssueLink(duplicate) = %v, %v; want not added", added, err)
+	}
+	if _, err := store.AddIssueLink(ctx, &types.IssueLink{IssueID: issue.ID, Kind: types.LinkKindURL, Target: "https://x", Type: "blocks"}); err == nil {
+		t.Error("AddIssueLink accepted an invalid link type")
+	}
+
+	links, err := store.GetIssueLinks(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetIssueLinks: %v", err)
+	}
+	if len(links) != 2 {
+		t.Fatalf("GetIssueLinks = %d links, want 2", len(links))
+	}
+
+	n, err := store.RemoveIssueLink(ctx, issue.ID, pr.Target)
+	if err != nil || n != 1 {
+		t.Fatalf("RemoveIssueLink = %d, %v; want 1", n, err)
+	}
+	links, err = store.GetIssueLinks(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetIssueLinks after remove: %v", err)
+	}
+	if len(links) != 1 || links[0].Target != commit.Target || links[0].Type != types.LinkFixes {
+		t.Errorf("GetIssueLinks after remove = %+v, want only the commit link", links)
+	}
+}
@@ -0,0 +1,138 @@
+package dolt
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/steveyegge/beads/internal/storage/issueops"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// metaKeyPattern is the character set allowed in issue metadata keys. Dots,
+// colons and slashes let integrators namespace keys, e.g. "jira.key" or
+// "ci:run/url".
+var metaKeyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._:/-]*$`)
+
+// maxMetaKeyLength matches the issue_meta.key column.
+const maxMetaKeyLength = 255
+
+// ValidateMetaKey reports whether key may be used as an issue metadata key.
+func ValidateMetaKey(key string) error {
+	if len(key) > maxMetaKeyLength {
+		return fmt.Errorf("meta key is longer than %d characters", maxMetaKeyLength)
+	}
+	if !metaKeyPattern.MatchString(key) {
+		return fmt.Errorf("invalid meta key %q: use letters, digits and . _ : / - (starting with a letter or digit)", key)
+	}
+	return nil
+}
+
+// SetMeta creates or replaces the value of key on an issue.
+func (s *DoltStore) SetMeta(ctx context.Context, issueID, key, value string) error {
+	if err := ValidateMetaKey(key); err != nil {
+		return err
+	}
+	_, err := s.execContext(ctx, "INSERT INTO issue_meta (issue_id, `key`, value) VALUES (?, ?, ?) "+
+		"ON DUPLICATE KEY UPDATE value = VALUES(value)", issueID, key, value)
+	if err != nil {
+		return fmt.Errorf("failed to set meta %s on %s: %w", key, issueID, err)
+	}
+	return nil
+}
+
+// GetMeta returns the value of key on an issue. The boolean is false when
+// the key is not set.
+func (s *DoltStore) GetMeta(ctx context.Context, issueID, key string) (string, bool, error) {
+	var value string
+	err := s.withRetry(ctx, func() error {
+		return s.db.QueryRowContext(ctx,
+			"SELECT value FROM issue_meta WHERE issue_id = ? AND `key` = ?", issueID, key,
+		).Scan(&value)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get meta %s on %s: %w", key, issueID, err)
+	}
+	return value, true, nil
+}
+
+// ListMeta returns all metadata on an issue.
+func (s *DoltStore) ListMeta(ctx context.Context, issueID string) (map[string]string, error) {
+	meta, err := s.GetMetaForIssues(ctx, []string{issueID})
+	if err != nil {
+		return nil, err
+	}
+	if meta[issueID] == nil {
+		return map[string]string{}, nil
+	}
+	return meta[issueID], nil
+}
+
+// GetMetaForIssues returns metadata for multiple issues, keyed by issue ID.
+// Issues without metadata are absent from the result.
+func (s *DoltStore) GetMetaForIssues(ctx context.Context, issueIDs []string) (map[string]map[string]string, error) {
+	result := make(map[string]map[string]string)
+	for start := 0; start < len(issueIDs); start += queryBatchSize {
+		end := min(start+queryBatchSize, len(issueIDs))
+		placeholders, args := doltBuildSQLInClause(issueIDs[start:end])
+
+		//nolint:gosec // G201: placeholders contains only ? markers
+		rows, err := s.queryContext(ctx, fmt.Sprintf(
+			"SELECT issue_id, `key`, value FROM issue_meta WHERE issue_id IN (%s)", placeholders), args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get meta: %w", err)
+		}
+		for rows.Next() {
+			var issueID, key, value string
+			if err := rows.Scan(&issueID, &key, &value); err != nil {
+				_ = rows.Close()
+				return nil, fmt.Errorf("failed to scan meta: %w", err)
+			}
+			if result[issueID] == nil {
+				result[issueID] = make(map[string]string)
+			}
+			result[issueID][key] = value
+		}
+		err = rows.Err()
+		_ = rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get meta: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// DeleteMeta removes key from an issue. Deleting a missing key is not an error.
+func (s *DoltStore) DeleteMeta(ctx context.Context, issueID, key string) error {
+	if _, err := s.execContext(ctx, "DELETE FROM issue_meta WHERE issue_id = ? AND `key` = ?", issueID, key); err != nil {
+		return fmt.Errorf("failed to delete meta %s on %s: %w", key, issueID, err)
+	}
+	return nil
+}
+
+// persistMetaInTx writes the Meta of created or imported issues. Wisps have
+// no metadata table, and issues skipped as orphans were never inserted, so
+// their Meta is ignored.
+func persistMetaInTx(ctx context.Context, tx *sql.Tx, issues []*types.Issue) error {
+	for _, issue := range issues {
+		if issueops.IsWisp(issue) {
+			continue
+		}
+		for key, value := range issue.Meta {
+			if err := ValidateMetaKey(key); err != nil {
+				return fmt.Errorf("%s: %w", issue.ID, err)
+			}
+			if _, err := tx.ExecContext(ctx, "INSERT INTO issue_meta (issue_id, `key`, value) "+
+				"SELECT id, ?, ? FROM issues WHERE id = ? "+
+				"ON DUPLICATE KEY UPDATE value = ?", key, value, issue.ID, value); err != nil {
+				return fmt.Errorf("failed to set meta %s on %s: %w", key, issue.ID, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package dolt
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestValidateMetaKey(t *testing.T) {
+	for _, key := range []string{"jira.key", "ci:run/url", "GH_ID", "x-1"} {
+		if err := ValidateMetaKey(key); err != nil {
+			t.Errorf("ValidateMetaKey(%q) = %v, want nil", key, err)
+		}
+	}
+	for _, key := range []string{"", ".hidden", "-flag", "has space", "semi;colon", "quote'", strings.Repeat("k", 256)} {
+		if err := ValidateMetaKey(key); err == nil {
+			t.Errorf("ValidateMetaKey(%q) = nil, want error", key)
+		}
+	}
+}
+
+func TestIssueMeta(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	issue := &types.Issue{ID: "test-meta1", Title: "Meta", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("CreateIssue: %v", err)
+	}
+
+	if err := store.SetMeta(ctx, issue.ID, "jira.key", "PROJ-1"); err != nil {
+		t.Fatalf("SetMeta: %v", err)
+	}
+	if err := store.SetMeta(ctx, issue.ID, "jira.key", "PROJ-2"); err != nil {
+		t.Fatalf("SetMeta (replace): %v", err)
+	}
+	if err := store.SetMeta(ctx, issue.ID, "bad key", "x"); err == nil {
+		t.Error("SetMeta accepted an invalid key")
+	}
+
+	value, ok, err := store.GetMeta(ctx, issue.ID, "jira.key")
+	if err != nil || !ok || value != "PROJ-2" {
+		t.Fatalf("GetMeta = %q, %v, %v; want PROJ-2", value, ok, err)
+	}
+	if _, ok, err := store.GetMeta(ctx, issue.ID, "missing"); err != nil || ok {
+		t.Fatalf("GetMeta(missing) = %v, %v; want not found", ok, err)
+	}
+
+	meta, err := store.ListMeta(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("ListMeta: %v", err)
+	}
+	if len(meta) != 1 || meta["jira.key"] != "PROJ-2" {
+		t.Fatalf("ListMeta = %v, want jira.key=PROJ-2", meta)
+	}
+
+	if err := store.DeleteMeta(ctx, issue.ID, "jira.key"); err != nil {
+		t.Fatalf("DeleteMeta: %v", err)
+	}
+	meta, err = store.ListMeta(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("ListMeta after delete: %v", err)
+	}
+	if len(meta) != 0 {
+		t.Errorf("ListMeta after delete = %v, want empty", meta)
+	}
+}
+
+func TestImportedIssueMeta(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	issue := &types.Issue{ID: "test-meta2", Title: "Imported", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask,
+		Meta: map[string]string{"ci:run/url": "https://ci.example/1"}}
+	if err := store.CreateIssuesWithFullOptions(ctx, []*types.Issue{issue}, "tester", storage.BatchCreateOptions{}); err != nil {
+		t.Fatalf("CreateIssuesWithFullOptions: %v", err)
+	}
+
+	meta, err := store.GetMetaForIssues(ctx, []string{issue.ID})
+	if err != nil {
+		t.Fatalf("GetMetaForIssues: %v", err)
+	}
+	if meta[issue.ID]["ci:run/url"] != "https://ci.example/1" {
+		t.Errorf("GetMetaForIssues = %v, want the imported meta", meta)
+	}
+}
@@ -29,6 +29,15 @@ var migrationsList = []Migration{
 	{"wisp_dep_type_index", migrations.MigrateWispDepTypeIndex},
 	{"cleanup_autopush_metadata", migrations.MigrateCleanupAutopushMetadata},
 	{"uuid_primary_keys", migrations.MigrateUUIDPrimaryKeys},
+	{"views_table", migrations.MigrateViewsTable},
+	{"issues_archive_table", migrations.MigrateIssuesArchiveTable},
+	{"issue_meta_table", migrations.MigrateIssueMetaTable},
+	{"issue_links_table", migrations.MigrateIssueLinksTable},
+	{"attachments_table", migrations.MigrateAttachmentsTable},
+	{"idempotency_keys_table", migrations.MigrateIdempotencyKeysTable},
+	{"issue_seq", migrations.MigrateIssueSeq},
+	{"archive_after_column", migrations.MigrateArchiveAfterColumn},
+	{"assignee_groups_table", migrations.MigrateAssigneeGroupsTable},
 }
 
 // RunMigrations executes all registered Dolt migrations in order.
@@ -49,7 +58,8 @@ func RunMigrations(db *sql.DB) error {
 		"wisp_dependencies", "labels", "wisp_labels", "comments",
 		"wisp_comments", "metadata", "child_counters", "issue_counter",
 		"issue_snapshots", "compaction_snapshots", "federation_peers",
-		"dolt_ignore",
+		"views", "issues_archive", "issue_meta", "issue_links", "attachments", "idempotency_keys",
+		"issue_seq_counter", "assignee_groups", "dolt_ignore",
 	}
 	for _, table := range migrationTables {
 		_, _ = db.Exec("CALL DOLT_ADD(?)", table)
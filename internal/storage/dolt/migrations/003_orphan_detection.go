@@ -10,7 +10,8 @@ import (
 // DetectOrphanedChildren finds child issues whose parent no longer exists.
 // A child issue has a dotted ID (e.g., "bd-abc.1") where the parent is the
 // part before the last dot ("bd-abc"). An orphan is a child whose parent ID
-// is not present in the issues table.
+// is not present in the issues table. Parents moved to issues_archive by
+// 'bd archive' still count as present.
 //
 // This migration is non-destructive: it only logs orphans for the user to
 // review. Users can then decide to delete orphans or convert them to
@@ -26,7 +27,13 @@ func DetectOrphanedChildren(db *sql.DB) error {
 		LEFT JOIN issues parent
 			ON parent.id = SUBSTRING(child.id, 1, LENGTH(child.id) - LENGTH(SUBSTRING_INDEX(child.id, '.', -1)) - 1)
 		WHERE child.id LIKE '%.%'
-			AND parent.id IS NULL
+			AND parent.id IS NULL`
+	if archived, err := tableExists(db, "issues_archive"); err == nil && archived {
+		query += `
+			AND SUBSTRING(child.id, 1, LENGTH(child.id) - LENGTH(SUBSTRING_INDEX(child.id, '.', -1)) - 1)
+				NOT IN (SELECT id FROM issues_archive)`
+	}
+	query += `
 		ORDER BY child.id`
 
 	rows, err := db.Query(query)
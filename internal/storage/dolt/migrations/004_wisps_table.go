@@ -3,7 +3,10 @@ package migrations
 import (
 	"database/sql"
 	"fmt"
+	"strconv"
 	"strings"
+
+	"github.com/steveyegge/beads/internal/types"
 )
 
 // MigrateWispsTable adds dolt_ignore patterns for wisps tables and creates the
@@ -51,12 +54,13 @@ func MigrateWispsTable(db *sql.DB) error {
 	return nil
 }
 
-// wispsTableSchema mirrors the issues table schema exactly.
+// wispsTableSchema mirrors the issues table schema exactly, including the
+// title column sized by types.MaxTitleLength.
 // This table is ignored by dolt_ignore and will not appear in Dolt commits.
-const wispsTableSchema = `CREATE TABLE IF NOT EXISTS wisps (
+var wispsTableSchema = `CREATE TABLE IF NOT EXISTS wisps (
     id VARCHAR(255) PRIMARY KEY,
     content_hash VARCHAR(64),
-    title VARCHAR(500) NOT NULL,
+    title VARCHAR(` + strconv.Itoa(types.MaxTitleLength) + `) NOT NULL,
     description TEXT NOT NULL DEFAULT '',
     design TEXT NOT NULL DEFAULT '',
     acceptance_criteria TEXT NOT NULL DEFAULT '',
@@ -107,6 +111,7 @@ const wispsTableSchema = `CREATE TABLE IF NOT EXISTS wisps (
     rig VARCHAR(255) DEFAULT '',
     due_at DATETIME,
     defer_until DATETIME,
+    seq INT,
     INDEX idx_wisps_status (status),
     INDEX idx_wisps_priority (priority),
     INDEX idx_wisps_issue_type (issue_type),
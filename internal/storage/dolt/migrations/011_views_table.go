@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// MigrateViewsTable creates the views table used by 'bd view' to store
+// named list filters. Views are versioned like issues so the team shares them.
+func MigrateViewsTable(db *sql.DB) error {
+	exists, err := tableExists(db, "views")
+	if err != nil {
+		return fmt.Errorf("failed to check views existence: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	_, err = db.Exec(`CREATE TABLE views (
+    name VARCHAR(255) PRIMARY KEY,
+    args TEXT NOT NULL,
+    created_by VARCHAR(255) DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+)`)
+	if err != nil {
+		return fmt.Errorf("failed to create views table: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// MigrateIssuesArchiveTable creates the issues_archive table used by
+// 'bd archive' to move old closed issues out of the active issues table.
+// It mirrors the issues schema, plus archived_at and archived_relations
+// (labels, dependencies, comments and events captured at archive time,
+// since deleting from issues cascades to those tables).
+func MigrateIssuesArchiveTable(db *sql.DB) error {
+	exists, err := tableExists(db, "issues_archive")
+	if err != nil {
+		return fmt.Errorf("failed to check issues_archive existence: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	if _, err := db.Exec(issuesArchiveTableSchema); err != nil {
+		return fmt.Errorf("failed to create issues_archive table: %w", err)
+	}
+	return nil
+}
+
+// issuesArchiveTableSchema mirrors the issues table schema. Columns added to
+// issues must be added here too, or archiving will fail on the column list.
+// As in the issues table, the title column is sized by types.MaxTitleLength.
+var issuesArchiveTableSchema = `CREATE TABLE IF NOT EXISTS issues_archive (
+    id VARCHAR(255) PRIMARY KEY,
+    content_hash VARCHAR(64),
+    title VARCHAR(` + strconv.Itoa(types.MaxTitleLength) + `) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    design TEXT NOT NULL DEFAULT '',
+    acceptance_criteria TEXT NOT NULL DEFAULT '',
+    notes TEXT NOT NULL DEFAULT '',
+    status VARCHAR(32) NOT NULL DEFAULT 'open',
+    priority INT NOT NULL DEFAULT 2,
+    issue_type VARCHAR(32) NOT NULL DEFAULT 'task',
+    assignee VARCHAR(255),
+    estimated_minutes INT,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by VARCHAR(255) DEFAULT '',
+    owner VARCHAR(255) DEFAULT '',
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    closed_at DATETIME,
+    closed_by_session VARCHAR(255) DEFAULT '',
+    external_ref VARCHAR(255),
+    spec_id VARCHAR(1024),
+    compaction_level INT DEFAULT 0,
+    compacted_at DATETIME,
+    compacted_at_commit VARCHAR(64),
+    original_size INT,
+    sender VARCHAR(255) DEFAULT '',
+    ephemeral TINYINT(1) DEFAULT 0,
+    wisp_type VARCHAR(32) DEFAULT '',
+    pinned TINYINT(1) DEFAULT 0,
+    is_template TINYINT(1) DEFAULT 0,
+    crystallizes TINYINT(1) DEFAULT 0,
+    mol_type VARCHAR(32) DEFAULT '',
+    work_type VARCHAR(32) DEFAULT 'mutex',
+    quality_score DOUBLE,
+    source_system VARCHAR(255) DEFAULT '',
+    metadata JSON DEFAULT (JSON_OBJECT()),
+    source_repo VARCHAR(512) DEFAULT '',
+    close_reason TEXT DEFAULT '',
+    event_kind VARCHAR(32) DEFAULT '',
+    actor VARCHAR(255) DEFAULT '',
+    target VARCHAR(255) DEFAULT '',
+    payload TEXT DEFAULT '',
+    await_type VARCHAR(32) DEFAULT '',
+    await_id VARCHAR(255) DEFAULT '',
+    timeout_ns BIGINT DEFAULT 0,
+    waiters TEXT DEFAULT '',
+    hook_bead VARCHAR(255) DEFAULT '',
+    role_bead VARCHAR(255) DEFAULT '',
+    agent_state VARCHAR(32) DEFAULT '',
+    last_activity DATETIME,
+    role_type VARCHAR(32) DEFAULT '',
+    rig VARCHAR(255) DEFAULT '',
+    due_at DATETIME,
+    defer_until DATETIME,
+    seq INT,
+    -- Archive bookkeeping
+    archived_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    archived_relations JSON,
+    INDEX idx_issues_archive_status (status),
+    INDEX idx_issues_archive_priority (priority),
+    INDEX idx_issues_archive_issue_type (issue_type),
+    INDEX idx_issues_archive_assignee (assignee),
+    INDEX idx_issues_archive_created_at (created_at),
+    INDEX idx_issues_archive_spec_id (spec_id),
+    INDEX idx_issues_archive_external_ref (external_ref),
+    INDEX idx_issues_archive_closed_at (closed_at)
+)`
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// MigrateIssueMetaTable creates the issue_meta table used by 'bd meta' to
+// store free-form key/value data on issues (external tool IDs, URLs, ...),
+// so integrations don't need a schema migration for every new field.
+func MigrateIssueMetaTable(db *sql.DB) error {
+	exists, err := tableExists(db, "issue_meta")
+	if err != nil {
+		return fmt.Errorf("failed to check issue_meta existence: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	_, err = db.Exec("CREATE TABLE issue_meta (" +
+		"issue_id VARCHAR(255) NOT NULL, " +
+		"`key` VARCHAR(255) NOT NULL, " +
+		"value TEXT NOT NULL, " +
+		"PRIMARY KEY (issue_id, `key`), " +
+		"CONSTRAINT fk_issue_meta_issue FOREIGN KEY (issue_id) REFERENCES issues(id) ON DELETE CASCADE)")
+	if err != nil {
+		return fmt.Errorf("failed to create issue_meta table: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// MigrateIssueLinksTable creates the issue_links table used by 'bd link' to
+// associate issues with git commits and URLs (pull requests, CI runs, ...).
+func MigrateIssueLinksTable(db *sql.DB) error {
+	exists, err := tableExists(db, "issue_links")
+	if err != nil {
+		return fmt.Errorf("failed to check issue_links existence: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	_, err = db.Exec(`CREATE TABLE issue_links (
+    id CHAR(36) NOT NULL PRIMARY KEY DEFAULT (UUID()),
+    issue_id VARCHAR(255) NOT NULL,
+    kind VARCHAR(16) NOT NULL,
+    target TEXT NOT NULL,
+    link_type VARCHAR(32) NOT NULL DEFAULT 'mentions',
+    created_by VARCHAR(255) DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_issue_links_issue (issue_id),
+    CONSTRAINT fk_issue_links_issue FOREIGN KEY (issue_id) REFERENCES issues(id) ON DELETE CASCADE
+)`)
+	if err != nil {
+		return fmt.Errorf("failed to create issue_links table: %w", err)
+	}
+
+	return nil
+}
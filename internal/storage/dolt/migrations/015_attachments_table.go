@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// MigrateAttachmentsTable creates the attachments table used by 'bd attach'
+// to reference files and URLs related to an issue. Only the location, size
+// and SHA-256 are stored; the contents stay out of Dolt.
+func MigrateAttachmentsTable(db *sql.DB) error {
+	exists, err := tableExists(db, "attachments")
+	if err != nil {
+		return fmt.Errorf("failed to check attachments existence: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	_, err = db.Exec(`CREATE TABLE attachments (
+    id CHAR(36) NOT NULL PRIMARY KEY DEFAULT (UUID()),
+    issue_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    url_or_path TEXT NOT NULL,
+    size BIGINT,
+    sha256 CHAR(64) NOT NULL DEFAULT '',
+    created_by VARCHAR(255) DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY idx_attachments_issue_name (issue_id, name),
+    CONSTRAINT fk_attachments_issue FOREIGN KEY (issue_id) REFERENCES issues(id) ON DELETE CASCADE
+)`)
+	if err != nil {
+		return fmt.Errorf("failed to create attachments table: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// MigrateIdempotencyKeysTable creates the idempotency_keys table used by
+// 'bd create --idempotency-key' to return the issue an earlier create with
+// the same key made, instead of creating a duplicate.
+func MigrateIdempotencyKeysTable(db *sql.DB) error {
+	exists, err := tableExists(db, "idempotency_keys")
+	if err != nil {
+		return fmt.Errorf("failed to check idempotency_keys existence: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	_, err = db.Exec(`CREATE TABLE idempotency_keys (
+    idem_key VARCHAR(255) PRIMARY KEY,
+    issue_id VARCHAR(255) NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NOT NULL,
+    INDEX idx_idempotency_keys_expires (expires_at)
+)`)
+	if err != nil {
+		return fmt.Errorf("failed to create idempotency_keys table: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// MigrateIssueSeq adds the seq column holding each issue's friendly per-repo
+// number (#42) and the issue_seq_counter table that allocates them. The
+// column is added to wisps and issues_archive as well, which mirror the
+// issues table. Existing issues stay unnumbered.
+func MigrateIssueSeq(db *sql.DB) error {
+	for _, table := range []string{"issues", "wisps", "issues_archive"} {
+		exists, err := tableExists(db, table)
+		if err != nil {
+			return fmt.Errorf("failed to check %s existence: %w", table, err)
+		}
+		if !exists {
+			continue
+		}
+		hasSeq, err := columnExists(db, table, "seq")
+		if err != nil {
+			return fmt.Errorf("failed to check %s.seq column: %w", table, err)
+		}
+		if hasSeq {
+			continue
+		}
+		//nolint:gosec // G202: table comes from the fixed list above
+		if _, err := db.Exec("ALTER TABLE `" + table + "` ADD COLUMN seq INT"); err != nil {
+			return fmt.Errorf("failed to add %s.seq column: %w", table, err)
+		}
+	}
+
+	if !indexExists(db, "issues", "idx_issues_seq") {
+		if _, err := db.Exec(`CREATE UNIQUE INDEX idx_issues_seq ON issues(seq)`); err != nil {
+			return fmt.Errorf("failed to create seq index: %w", err)
+		}
+	}
+
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS issue_seq_counter (
+    name VARCHAR(32) PRIMARY KEY,
+    last_seq INT NOT NULL DEFAULT 0
+)`); err != nil {
+		return fmt.Errorf("failed to create issue_seq_counter table: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// MigrateArchiveAfterColumn adds issues.archive_after, the time from which
+// 'bd archive --run' may move a closed issue to issues_archive. It is set on
+// close when close.auto-archive-after is configured. Existing closed issues
+// stay NULL and are only archived by 'bd archive --closed-before'. The
+// column is added to wisps and issues_archive as well, which mirror the
+// issues table.
+func MigrateArchiveAfterColumn(db *sql.DB) error {
+	for _, table := range []string{"issues", "wisps", "issues_archive"} {
+		exists, err := tableExists(db, table)
+		if err != nil {
+			return fmt.Errorf("failed to check %s existence: %w", table, err)
+		}
+		if !exists {
+			continue
+		}
+		hasColumn, err := columnExists(db, table, "archive_after")
+		if err != nil {
+			return fmt.Errorf("failed to check %s.archive_after column: %w", table, err)
+		}
+		if hasColumn {
+			continue
+		}
+		//nolint:gosec // G202: table comes from the fixed list above
+		if _, err := db.Exec("ALTER TABLE `" + table + "` ADD COLUMN archive_after DATETIME"); err != nil {
+			return fmt.Errorf("failed to add %s.archive_after column: %w", table, err)
+		}
+	}
+	return nil
+}
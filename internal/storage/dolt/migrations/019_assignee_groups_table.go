@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// MigrateAssigneeGroupsTable creates the assignee_groups table used by
+// 'bd group': '@name' in 'bd assign' and 'bd list --assignee' expands to the
+// group's members. Groups are versioned like issues so the team shares them.
+func MigrateAssigneeGroupsTable(db *sql.DB) error {
+	exists, err := tableExists(db, "assignee_groups")
+	if err != nil {
+		return fmt.Errorf("failed to check assignee_groups existence: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	_, err = db.Exec(`CREATE TABLE assignee_groups (
+    group_name VARCHAR(255) NOT NULL,
+    member VARCHAR(255) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_name, member)
+)`)
+	if err != nil {
+		return fmt.Errorf("failed to create assignee_groups table: %w", err)
+	}
+
+	return nil
+}
@@ -55,6 +55,14 @@ func NewFromConfigWithOptions(ctx context.Context, beadsDir string, cfg *Config)
 	explicitPort := fileCfg.DoltServerPort > 0
 	cfg.AutoStart = resolveAutoStart(cfg.AutoStart, autoStartCfg, explicitPort)
 
+	// Same lookup order for the child ID separator.
+	if cfg.HierarchySeparator == "" {
+		cfg.HierarchySeparator = config.GetString("hierarchy.separator")
+	}
+	if cfg.HierarchySeparator == "" {
+		cfg.HierarchySeparator = config.GetStringFromDir(beadsDir, "hierarchy.separator")
+	}
+
 	return New(ctx, cfg)
 }
 
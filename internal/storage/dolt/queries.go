@@ -8,7 +8,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/issueops"
 	"github.com/steveyegge/beads/internal/types"
 )
 
@@ -44,7 +46,7 @@ func (s *DoltStore) SearchIssues(ctx context.Context, query string, filter types
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	whereClauses, args, err := buildIssueFilterClauses(query, filter, issuesFilterTables)
+	whereClauses, args, err := buildIssueFilterClauses(query, filter, issuesFilterTables, s.hierarchy)
 	if err != nil {
 		return nil, err
 	}
@@ -59,13 +61,15 @@ func (s *DoltStore) SearchIssues(ctx context.Context, query string, filter types
 		limitSQL = fmt.Sprintf(" LIMIT %d", filter.Limit)
 	}
 
-	// nolint:gosec // G201: whereSQL contains column comparisons with ?, limitSQL is a safe integer
+	orderBySQL := buildOrderBy(filter.Sort, "ORDER BY priority ASC, created_at DESC, id ASC")
+
+	// nolint:gosec // G201: whereSQL contains column comparisons with ?, orderBySQL is built from a fixed column map, limitSQL is a safe integer
 	querySQL := fmt.Sprintf(`
 		SELECT id FROM issues
 		%s
-		ORDER BY priority ASC, created_at DESC, id ASC
 		%s
-	`, whereSQL, limitSQL)
+		%s
+	`, whereSQL, orderBySQL, limitSQL)
 
 	rows, err := s.queryContext(ctx, querySQL, args...)
 	if err != nil {
@@ -102,6 +106,65 @@ func (s *DoltStore) SearchIssues(ctx context.Context, query string, filter types
 	return doltResults, nil
 }
 
+// CountIssues returns how many issues SearchIssues would return for the same
+// query and filter, using COUNT(*) instead of loading rows. filter.Limit is ignored.
+func (s *DoltStore) CountIssues(ctx context.Context, query string, filter types.IssueFilter) (int, error) {
+	filter.Limit = 0
+
+	// Ephemeral-only queries count wisps, falling through to issues when the
+	// wisps table is missing or empty (pre-migration databases).
+	if filter.Ephemeral != nil && *filter.Ephemeral {
+		n, err := s.countMatching(ctx, query, filter, wispsFilterTables, "")
+		if err != nil && !isTableNotExistError(err) {
+			return 0, fmt.Errorf("count wisps (ephemeral filter): %w", err)
+		}
+		if n > 0 {
+			return n, nil
+		}
+	}
+
+	n, err := s.countMatching(ctx, query, filter, issuesFilterTables, "")
+	if err != nil {
+		return 0, fmt.Errorf("failed to count issues: %w", err)
+	}
+
+	// Searching everything merges wisps, deduplicated by ID (see SearchIssues).
+	if filter.Ephemeral == nil {
+		wisps, err := s.countMatching(ctx, query, filter, wispsFilterTables, "id NOT IN (SELECT id FROM issues)")
+		if err != nil && !isTableNotExistError(err) {
+			return 0, fmt.Errorf("count wisps (merge): %w", err)
+		}
+		n += wisps
+	}
+	return n, nil
+}
+
+// countMatching runs COUNT(*) over tables.main with the filter's WHERE
+// clauses plus an optional extra clause.
+func (s *DoltStore) countMatching(ctx context.Context, query string, filter types.IssueFilter, tables filterTables, extra string) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	whereClauses, args, err := buildIssueFilterClauses(query, filter, tables, s.hierarchy)
+	if err != nil {
+		return 0, err
+	}
+	if extra != "" {
+		whereClauses = append(whereClauses, extra)
+	}
+	whereSQL := ""
+	if len(whereClauses) > 0 {
+		whereSQL = "WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	var n int
+	// nolint:gosec // G201: whereSQL contains column comparisons with ?, table names are constants
+	err = s.queryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&n)
+	}, fmt.Sprintf("SELECT COUNT(*) FROM %s %s", tables.main, whereSQL), args...)
+	return n, err
+}
+
 // GetReadyWork returns issues that are ready to work on (not blocked).
 //
 // Blocking semantics are unified through computeBlockedIDs, which is the
@@ -196,8 +259,8 @@ func (s *DoltStore) GetReadyWork(ctx context.Context, filter types.WorkFilter) (
 	// Explicit parent-child dependency takes precedence over dotted-ID prefix.
 	if filter.ParentID != nil {
 		parentID := *filter.ParentID
-		whereClauses = append(whereClauses, "(id IN (SELECT issue_id FROM dependencies WHERE type = 'parent-child' AND depends_on_id = ?) OR (id LIKE CONCAT(?, '.%') AND id NOT IN (SELECT issue_id FROM dependencies WHERE type = 'parent-child')))")
-		args = append(args, parentID, parentID)
+		whereClauses = append(whereClauses, "(id IN (SELECT issue_id FROM dependencies WHERE type = 'parent-child' AND depends_on_id = ?) OR (id LIKE CONCAT(?, ?, '%') AND id NOT IN (SELECT issue_id FROM dependencies WHERE type = 'parent-child')))")
+		args = append(args, parentID, parentID, s.hierarchy.Separator())
 	}
 
 	// Metadata existence check (GH#1406)
@@ -446,7 +509,7 @@ func (s *DoltStore) GetBlockedIssues(ctx context.Context, filter types.WorkFilte
 		}
 		// Also include dotted-ID children (e.g., "parent.1.2")
 		for id := range blockerMap {
-			if strings.HasPrefix(id, parentID+".") {
+			if s.hierarchy.IsChildOf(id, parentID) {
 				parentChildSet[id] = true
 			}
 		}
@@ -707,6 +770,52 @@ func (s *DoltStore) GetStatistics(ctx context.Context) (*types.Statistics, error
 	return stats, nil
 }
 
+// GetHealthCounts returns orphan, overdue and oldest-open figures for the
+// issues table. Orphans use the same parent derivation as the
+// orphan_detection migration: the ID with its last separator and segment
+// removed. Children of archived parents are not orphans.
+func (s *DoltStore) GetHealthCounts(ctx context.Context) (*types.HealthCounts, error) {
+	health := &types.HealthCounts{}
+	var oldest sql.NullTime
+	err := s.withRetry(ctx, func() error {
+		return s.db.QueryRowContext(ctx, `
+			SELECT
+				COALESCE(SUM(CASE WHEN due_at IS NOT NULL AND due_at < ? AND status != 'closed' THEN 1 ELSE 0 END), 0),
+				MIN(CASE WHEN status NOT IN ('closed', 'pinned') THEN created_at END)
+			FROM issues
+		`, time.Now().UTC().Format(time.RFC3339)).Scan(&health.Overdue, &oldest)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get health counts: %w", err)
+	}
+	if oldest.Valid {
+		health.OldestOpen = &oldest.Time
+	}
+
+	sep := s.hierarchy.Separator()
+	args := []any{sep, sep}
+	query := `
+		SELECT COUNT(*)
+		FROM issues child
+		LEFT JOIN issues parent
+			ON parent.id = SUBSTRING(child.id, 1, LENGTH(child.id) - LENGTH(SUBSTRING_INDEX(child.id, ?, -1)) - 1)
+		WHERE child.id LIKE CONCAT('%', ?, '%') AND parent.id IS NULL`
+	// A parent moved by 'bd archive' still exists
+	if config.GetBool("archive.resolve-references") {
+		query += `
+			AND SUBSTRING(child.id, 1, LENGTH(child.id) - LENGTH(SUBSTRING_INDEX(child.id, ?, -1)) - 1)
+				NOT IN (SELECT id FROM issues_archive)`
+		args = append(args, sep)
+	}
+	err = s.withRetry(ctx, func() error {
+		return s.db.QueryRowContext(ctx, query, args...).Scan(&health.Orphans)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count orphaned children: %w", err)
+	}
+	return health, nil
+}
+
 // computeBlockedIDs returns the set of issue IDs that are blocked by active issues.
 // Uses separate single-table queries with Go-level filtering to avoid Dolt's
 // joinIter panic (slice bounds out of range at join_iters.go:192).
@@ -1325,7 +1434,10 @@ func (s *DoltStore) GetMoleculeLastActivity(ctx context.Context, moleculeID stri
 	return result, nil
 }
 
-// GetNextChildID returns the next available child ID for a parent
+// GetNextChildID reserves the next available child ID for a parent in its
+// own transaction. Callers that go on to create the child should set
+// Issue.ChildOf instead, so the slot is taken in the create transaction and
+// concurrent creates cannot both be handed the same ID.
 func (s *DoltStore) GetNextChildID(ctx context.Context, parentID string) (string, error) {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -1333,43 +1445,13 @@ func (s *DoltStore) GetNextChildID(ctx context.Context, parentID string) (string
 	}
 	defer tx.Rollback()
 
-	var lastChild int
-	err = tx.QueryRowContext(ctx, "SELECT last_child FROM child_counters WHERE parent_id = ?", parentID).Scan(&lastChild)
-	if err == sql.ErrNoRows {
-		lastChild = 0
-	} else if err != nil {
-		return "", wrapQueryError("get next child ID: read counter", err)
-	}
-
-	// Check existing children to prevent overwrites after JSONL import (GH#2166).
-	// The counter may be stale if issues were imported without reconciling child_counters.
-	var maxExisting sql.NullInt64
-	err = tx.QueryRowContext(ctx, `
-		SELECT MAX(CAST(SUBSTRING_INDEX(id, '.', -1) AS UNSIGNED))
-		FROM issues
-		WHERE id LIKE CONCAT(?, '.%')
-		  AND id NOT LIKE CONCAT(?, '.%.%')
-	`, parentID, parentID).Scan(&maxExisting)
-	if err != nil {
-		return "", wrapQueryError("get next child ID: scan existing children", err)
-	}
-	if maxExisting.Valid && int(maxExisting.Int64) > lastChild {
-		lastChild = int(maxExisting.Int64)
-	}
-
-	nextChild := lastChild + 1
-
-	_, err = tx.ExecContext(ctx, `
-		INSERT INTO child_counters (parent_id, last_child) VALUES (?, ?)
-		ON DUPLICATE KEY UPDATE last_child = ?
-	`, parentID, nextChild, nextChild)
+	childID, err := issueops.GetNextChildIDTx(ctx, tx, parentID, s.hierarchy)
 	if err != nil {
-		return "", wrapExecError("get next child ID: update counter", err)
+		return "", err
 	}
 
 	if err := tx.Commit(); err != nil {
 		return "", wrapTransactionError("get next child ID: commit", err)
 	}
-
-	return fmt.Sprintf("%s.%d", parentID, nextChild), nil
+	return childID, nil
 }
@@ -2,10 +2,12 @@ package dolt
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/steveyegge/beads/internal/idgen"
 	"github.com/steveyegge/beads/internal/types"
 )
 
@@ -1761,6 +1763,56 @@ func TestCreateIssue_ExplicitIDOverridesCounter(t *testing.T) {
 	}
 }
 
+func TestCreateIssue_ULIDScheme(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	if err := store.SetConfig(ctx, "id.scheme", "ulid"); err != nil {
+		t.Fatalf("failed to set id.scheme: %v", err)
+	}
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		issue := &types.Issue{
+			Title:     fmt.Sprintf("ULID issue %d", i),
+			Status:    types.StatusOpen,
+			Priority:  2,
+			IssueType: types.TypeTask,
+		}
+		if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("failed to create issue %d: %v", i, err)
+		}
+		suffix, ok := strings.CutPrefix(issue.ID, "test-")
+		if !ok || !idgen.IsULID(suffix) {
+			t.Fatalf("expected test-<ulid>, got %q", issue.ID)
+		}
+		ids = append(ids, issue.ID)
+	}
+	if !sort.StringsAreSorted(ids) {
+		t.Errorf("ULID IDs should sort in creation order, got %v", ids)
+	}
+
+	// Children keep the usual numeric suffix behind the ULID parent
+	childID, err := store.GetNextChildID(ctx, ids[0])
+	if err != nil {
+		t.Fatalf("GetNextChildID failed: %v", err)
+	}
+	if childID != ids[0]+".1" {
+		t.Errorf("child ID = %q, want %q", childID, ids[0]+".1")
+	}
+
+	if err := store.SetConfig(ctx, "id.scheme", "uuid"); err != nil {
+		t.Fatalf("failed to set id.scheme: %v", err)
+	}
+	bad := &types.Issue{Title: "Bad scheme", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, bad, "tester"); err == nil {
+		t.Error("expected an error for an unknown id.scheme")
+	}
+}
+
 func TestCreateIssue_HashModeDefault(t *testing.T) {
 	store, cleanup := setupTestStore(t)
 	defer cleanup()
@@ -2019,3 +2071,90 @@ func TestSearchIssues_StableOrdering(t *testing.T) {
 		}
 	}
 }
+
+// =============================================================================
+// CountIssues tests
+// =============================================================================
+
+func TestCountIssues_MatchesSearchIssues(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	for i := 0; i < 4; i++ {
+		issue := &types.Issue{ID: fmt.Sprintf("cnt-%d", i), Title: "Count me", Status: types.StatusOpen, Priority: i % 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("failed to create issue: %v", err)
+		}
+	}
+	if err := store.CloseIssue(ctx, "cnt-0", "done", "tester", "s1"); err != nil {
+		t.Fatalf("failed to close issue: %v", err)
+	}
+	wisp := &types.Issue{ID: "cnt-wisp-1", Title: "Ephemeral", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask, Ephemeral: true}
+	if err := store.CreateIssue(ctx, wisp, "tester"); err != nil {
+		t.Fatalf("failed to create wisp: %v", err)
+	}
+
+	open := types.StatusOpen
+	priority := 1
+	filters := map[string]types.IssueFilter{
+		"all":      {},
+		"open":     {Status: &open},
+		"priority": {Priority: &priority},
+		"limited":  {Limit: 1},
+	}
+	for name, filter := range filters {
+		t.Run(name, func(t *testing.T) {
+			n, err := store.CountIssues(ctx, "", filter)
+			if err != nil {
+				t.Fatalf("CountIssues failed: %v", err)
+			}
+			filter.Limit = 0
+			issues, err := store.SearchIssues(ctx, "", filter)
+			if err != nil {
+				t.Fatalf("SearchIssues failed: %v", err)
+			}
+			if n != len(issues) {
+				t.Errorf("CountIssues = %d, SearchIssues returned %d", n, len(issues))
+			}
+		})
+	}
+}
+
+// TestGetHealthCounts_MultiDotOrphans checks that a child's parent is the ID
+// before its last separator: bd-a.1.2.3 belongs to bd-a.1.2, not bd-a.
+func TestGetHealthCounts_MultiDotOrphans(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	for _, tc := range []struct {
+		ids  []string
+		want int
+	}{
+		{ids: []string{"test-a", "test-a.1"}, want: 0},
+		{ids: []string{"test-a.1.2"}, want: 0},   // parent test-a.1 exists
+		{ids: []string{"test-b.1.2"}, want: 1},   // test-b.1 is missing
+		{ids: []string{"test-a.1.2.3"}, want: 1}, // parent test-a.1.2 exists; test-b.1.2 still orphaned
+		{ids: []string{"test-a.1.9.3"}, want: 2}, // test-a.1.9 is missing even though test-a.1 exists
+	} {
+		for _, id := range tc.ids {
+			if _, err := store.db.ExecContext(ctx,
+				`INSERT INTO issues (id, title, description, design, acceptance_criteria, notes, status, priority, issue_type, created_at, updated_at)
+				 VALUES (?, 'Orphan test', '', '', '', '', 'open', 2, 'task', NOW(), NOW())`, id); err != nil {
+				t.Fatalf("failed to insert %s: %v", id, err)
+			}
+		}
+		health, err := store.GetHealthCounts(ctx)
+		if err != nil {
+			t.Fatalf("GetHealthCounts: %v", err)
+		}
+		if health.Orphans != tc.want {
+			t.Errorf("after adding %v: orphans = %d, want %d", tc.ids, health.Orphans, tc.want)
+		}
+	}
+}
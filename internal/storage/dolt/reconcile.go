@@ -0,0 +1,56 @@
+package dolt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/issueops"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// ReconcileIssues upserts issues and deletes deleteIDs in one transaction with
+// one Dolt commit, so a failure part-way leaves the database unchanged.
+// Deletion orphans dependents (like DeleteIssues with force). Used by
+// 'bd verify --fix --prefer jsonl' to make the database match a JSONL file.
+func (s *DoltStore) ReconcileIssues(ctx context.Context, issues []*types.Issue, deleteIDs []string, actor string, opts storage.BatchCreateOptions, commitMsg string) error {
+	if len(issues) == 0 && len(deleteIDs) == 0 {
+		return nil
+	}
+	opts.Hierarchy = s.hierarchy
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // No-op after successful commit
+
+	if len(issues) > 0 {
+		if err := issueops.CreateIssuesInTx(ctx, tx, issues, actor, opts); err != nil {
+			return err
+		}
+		if err := persistMetaInTx(ctx, tx, issues); err != nil {
+			return err
+		}
+		if err := persistAttachmentsInTx(ctx, tx, issues); err != nil {
+			return err
+		}
+	}
+	if _, err := deleteIssueRowsInTx(ctx, tx, deleteIDs); err != nil {
+		return err
+	}
+
+	// GH#2455: Stage only the tables we modified, then commit without -A.
+	for _, table := range []string{"issues", "events", "labels", "comments", "dependencies", "child_counters", "issue_snapshots", "compaction_snapshots", "issue_meta", "attachments", "issue_seq_counter"} {
+		_, _ = tx.ExecContext(ctx, "CALL DOLT_ADD(?)", table)
+	}
+	if err := s.versionCommit(ctx, tx, commitMsg); err != nil {
+		return fmt.Errorf("dolt commit: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.invalidateBlockedIDsCache()
+	return nil
+}
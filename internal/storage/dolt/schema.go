@@ -1,17 +1,24 @@
 package dolt
 
+import (
+	"strconv"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
 // currentSchemaVersion is bumped whenever the schema or migrations change.
 // initSchemaOnDB checks this against the stored version and skips re-initialization
 // when they match, avoiding ~20 DDL statements per bd invocation.
-const currentSchemaVersion = 7
+const currentSchemaVersion = 16
 
-// schema defines the MySQL-compatible database schema for Dolt.
-const schema = `
+// schema defines the MySQL-compatible database schema for Dolt. The title
+// column is sized by types.MaxTitleLength so validation and storage agree.
+var schema = `
 -- Issues table
 CREATE TABLE IF NOT EXISTS issues (
     id VARCHAR(255) PRIMARY KEY,
     content_hash VARCHAR(64),
-    title VARCHAR(500) NOT NULL,
+    title VARCHAR(` + strconv.Itoa(types.MaxTitleLength) + `) NOT NULL,
     description TEXT NOT NULL,
     design TEXT NOT NULL,
     acceptance_criteria TEXT NOT NULL,
@@ -78,13 +85,18 @@ CREATE TABLE IF NOT EXISTS issues (
     -- Time-based scheduling fields
     due_at DATETIME,
     defer_until DATETIME,
+    -- Friendly per-repo number (issue_seq=true); NULL when not numbered
+    seq INT,
+    -- When bd archive --run may move a closed issue (close.auto-archive-after)
+    archive_after DATETIME,
     INDEX idx_issues_status (status),
     INDEX idx_issues_priority (priority),
     INDEX idx_issues_issue_type (issue_type),
     INDEX idx_issues_assignee (assignee),
     INDEX idx_issues_created_at (created_at),
     INDEX idx_issues_spec_id (spec_id),
-    INDEX idx_issues_external_ref (external_ref)
+    INDEX idx_issues_external_ref (external_ref),
+    UNIQUE INDEX idx_issues_seq (seq)
 );
 
 -- Dependencies table (edge schema)
@@ -114,6 +126,51 @@ CREATE TABLE IF NOT EXISTS labels (
     CONSTRAINT fk_labels_issue FOREIGN KEY (issue_id) REFERENCES issues(id) ON DELETE CASCADE
 );
 
+-- Issue metadata table (free-form key/value data for integrations)
+CREATE TABLE IF NOT EXISTS issue_meta (
+    issue_id VARCHAR(255) NOT NULL,
+    ` + "`key`" + ` VARCHAR(255) NOT NULL,
+    value TEXT NOT NULL,
+    PRIMARY KEY (issue_id, ` + "`key`" + `),
+    CONSTRAINT fk_issue_meta_issue FOREIGN KEY (issue_id) REFERENCES issues(id) ON DELETE CASCADE
+);
+
+-- Issue links table (git commits and URLs associated with an issue)
+CREATE TABLE IF NOT EXISTS issue_links (
+    id CHAR(36) NOT NULL PRIMARY KEY DEFAULT (UUID()),
+    issue_id VARCHAR(255) NOT NULL,
+    kind VARCHAR(16) NOT NULL,
+    target TEXT NOT NULL,
+    link_type VARCHAR(32) NOT NULL DEFAULT 'mentions',
+    created_by VARCHAR(255) DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_issue_links_issue (issue_id),
+    CONSTRAINT fk_issue_links_issue FOREIGN KEY (issue_id) REFERENCES issues(id) ON DELETE CASCADE
+);
+
+-- Attachments table (references to files and URLs; contents are not stored)
+CREATE TABLE IF NOT EXISTS attachments (
+    id CHAR(36) NOT NULL PRIMARY KEY DEFAULT (UUID()),
+    issue_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    url_or_path TEXT NOT NULL,
+    size BIGINT,
+    sha256 CHAR(64) NOT NULL DEFAULT '',
+    created_by VARCHAR(255) DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY idx_attachments_issue_name (issue_id, name),
+    CONSTRAINT fk_attachments_issue FOREIGN KEY (issue_id) REFERENCES issues(id) ON DELETE CASCADE
+);
+
+-- Idempotency keys table (bd create --idempotency-key; written in the create's transaction)
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    idem_key VARCHAR(255) PRIMARY KEY,
+    issue_id VARCHAR(255) NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NOT NULL,
+    INDEX idx_idempotency_keys_expires (expires_at)
+);
+
 -- Comments table
 CREATE TABLE IF NOT EXISTS comments (
     id CHAR(36) NOT NULL PRIMARY KEY DEFAULT (UUID()),
@@ -208,6 +265,12 @@ CREATE TABLE IF NOT EXISTS issue_counter (
     last_id INT NOT NULL DEFAULT 0
 );
 
+-- Issue sequence counter (for issue_seq=true friendly numbers)
+CREATE TABLE IF NOT EXISTS issue_seq_counter (
+    name VARCHAR(32) PRIMARY KEY,
+    last_seq INT NOT NULL DEFAULT 0
+);
+
 -- Interactions table (agent audit log)
 CREATE TABLE IF NOT EXISTS interactions (
     id VARCHAR(32) PRIMARY KEY,
@@ -231,6 +294,23 @@ CREATE TABLE IF NOT EXISTS interactions (
     INDEX idx_interactions_parent_id (parent_id)
 );
 
+-- Saved views table (named list filters, shared with the repo)
+CREATE TABLE IF NOT EXISTS views (
+    name VARCHAR(255) PRIMARY KEY,
+    args TEXT NOT NULL,
+    created_by VARCHAR(255) DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
+
+-- Assignee groups table ('@name' expands to the members; shared with the repo)
+CREATE TABLE IF NOT EXISTS assignee_groups (
+    group_name VARCHAR(255) NOT NULL,
+    member VARCHAR(255) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_name, member)
+);
+
 -- Federation peers table (for SQL user authentication)
 -- Stores credentials for peer-to-peer Dolt remotes between Gas Towns
 CREATE TABLE IF NOT EXISTS federation_peers (
@@ -0,0 +1,110 @@
+package dolt
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// schemaInfoTables are the tables DescribeSchema reports: issues first,
+// then the tables that hang issue data off issues.id.
+var schemaInfoTables = []string{
+	"issues", "labels", "dependencies", "comments", "events",
+	"issue_meta", "issue_links", "attachments",
+}
+
+// SchemaColumn is one column as reported by SHOW COLUMNS.
+type SchemaColumn struct {
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	Nullable bool    `json:"nullable"`
+	Key      string  `json:"key,omitempty"` // PRI, UNI or MUL
+	Default  *string `json:"default,omitempty"`
+	Extra    string  `json:"extra,omitempty"`
+}
+
+// SchemaTable is one table of the live schema. Columns is empty when the
+// table does not exist, e.g. before the migration that creates it ran.
+type SchemaTable struct {
+	Name    string         `json:"name"`
+	Exists  bool           `json:"exists"`
+	Columns []SchemaColumn `json:"columns"`
+}
+
+// SchemaMigration is a registered migration. Migrations are idempotent and
+// run on every open until the schema version is current, so they are not
+// recorded individually; Applied is true when the database is at the version
+// this binary expects.
+type SchemaMigration struct {
+	Name    string `json:"name"`
+	Applied bool   `json:"applied"`
+}
+
+// SchemaInfo describes the live database schema.
+type SchemaInfo struct {
+	SchemaVersion   int               `json:"schema_version"` // From config; 0 when never recorded
+	ExpectedVersion int               `json:"expected_version"`
+	Tables          []SchemaTable     `json:"tables"`
+	Migrations      []SchemaMigration `json:"migrations"`
+}
+
+// DescribeSchema reads the live schema of the issue tables, using the same
+// SHOW COLUMNS introspection the migrations use to test for columns.
+func (s *DoltStore) DescribeSchema(ctx context.Context) (*SchemaInfo, error) {
+	info := &SchemaInfo{ExpectedVersion: currentSchemaVersion}
+
+	var version string
+	err := s.queryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&version)
+	}, "SELECT `value` FROM config WHERE `key` = 'schema_version'")
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	info.SchemaVersion, _ = strconv.Atoi(version) // Unset or malformed reads as 0
+
+	for _, name := range schemaInfoTables {
+		table, err := s.describeTable(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		info.Tables = append(info.Tables, table)
+	}
+
+	applied := info.SchemaVersion >= currentSchemaVersion
+	for _, m := range migrationsList {
+		info.Migrations = append(info.Migrations, SchemaMigration{Name: m.Name, Applied: applied})
+	}
+	return info, nil
+}
+
+func (s *DoltStore) describeTable(ctx context.Context, name string) (SchemaTable, error) {
+	table := SchemaTable{Name: name, Columns: []SchemaColumn{}}
+	// Dolt doesn't support prepared-statement parameters for SHOW commands.
+	//nolint:gosec // G202: name comes from schemaInfoTables
+	rows, err := s.queryContext(ctx, "SHOW COLUMNS FROM `"+name+"`")
+	if err != nil {
+		if isTableNotExistError(err) {
+			return table, nil
+		}
+		return table, fmt.Errorf("failed to describe %s: %w", name, err)
+	}
+	defer rows.Close()
+
+	table.Exists = true
+	for rows.Next() {
+		var c SchemaColumn
+		var null string
+		var def sql.NullString
+		if err := rows.Scan(&c.Name, &c.Type, &null, &c.Key, &def, &c.Extra); err != nil {
+			return table, fmt.Errorf("failed to scan %s column: %w", name, err)
+		}
+		c.Nullable = null == "YES"
+		if def.Valid {
+			c.Default = &def.String
+		}
+		table.Columns = append(table.Columns, c)
+	}
+	return table, rows.Err()
+}
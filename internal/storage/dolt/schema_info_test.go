@@ -0,0 +1,51 @@
+package dolt
+
+import (
+	"testing"
+)
+
+func TestDescribeSchema(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	info, err := store.DescribeSchema(ctx)
+	if err != nil {
+		t.Fatalf("DescribeSchema: %v", err)
+	}
+	if info.SchemaVersion != currentSchemaVersion || info.ExpectedVersion != currentSchemaVersion {
+		t.Errorf("versions = %d/%d, want %d", info.SchemaVersion, info.ExpectedVersion, currentSchemaVersion)
+	}
+	if len(info.Migrations) != len(migrationsList) {
+		t.Errorf("%d migrations, want %d", len(info.Migrations), len(migrationsList))
+	}
+	for _, m := range info.Migrations {
+		if !m.Applied {
+			t.Errorf("migration %s not applied on a current schema", m.Name)
+		}
+	}
+
+	tables := make(map[string]SchemaTable)
+	for _, table := range info.Tables {
+		tables[table.Name] = table
+	}
+	for _, name := range []string{"issues", "labels", "dependencies", "comments"} {
+		if !tables[name].Exists {
+			t.Errorf("table %s missing", name)
+		}
+	}
+	columns := make(map[string]SchemaColumn)
+	for _, c := range tables["issues"].Columns {
+		columns[c.Name] = c
+	}
+	for _, name := range []string{"id", "title", "status", "spec_id", "wisp_type"} {
+		if _, ok := columns[name]; !ok {
+			t.Errorf("issues.%s missing from %v", name, tables["issues"].Columns)
+		}
+	}
+	if id := columns["id"]; id.Key != "PRI" || id.Nullable {
+		t.Errorf("issues.id = %+v, want a NOT NULL primary key", id)
+	}
+}
@@ -113,6 +113,39 @@ func TestSchemaParityIssuesVsWisps(t *testing.T) {
 	}
 }
 
+// TestSchemaParityIssuesVsArchive verifies that issues_archive has every
+// issues column with the same type, plus only its own archive bookkeeping.
+// Archiving copies rows column by column, so drift breaks bd archive.
+func TestSchemaParityIssuesVsArchive(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	archiveMap := make(map[string]string)
+	for _, c := range queryColumns(t, store, "issues_archive") {
+		archiveMap[c.Name] = c.ColumnType
+	}
+	if len(archiveMap) == 0 {
+		t.Fatal("issues_archive table has no columns — migration 012 not run?")
+	}
+
+	for _, c := range queryColumns(t, store, "issues") {
+		archiveType, ok := archiveMap[c.Name]
+		if !ok {
+			t.Errorf("column %q in issues but missing from issues_archive", c.Name)
+			continue
+		}
+		if archiveType != c.ColumnType {
+			t.Errorf("column %q type mismatch: issues=%q, issues_archive=%q", c.Name, c.ColumnType, archiveType)
+		}
+		delete(archiveMap, c.Name)
+	}
+	delete(archiveMap, "archived_at")
+	delete(archiveMap, "archived_relations")
+	for name := range archiveMap {
+		t.Errorf("column %q in issues_archive but missing from issues", name)
+	}
+}
+
 // TestSchemaParityAuxiliaryTables verifies that wisp auxiliary tables have the
 // same column names as their issues counterparts. Type/nullability differences
 // are allowed (wisps are more permissive), but column names must match.
@@ -169,6 +202,30 @@ func TestSchemaParityAuxiliaryTables(t *testing.T) {
 	}
 }
 
+// TestTitleColumnMatchesMaxTitleLength verifies every table holding issue
+// rows sizes its title column to types.MaxTitleLength, so a title that
+// passes validation is never truncated or rejected by the database.
+func TestTitleColumnMatchesMaxTitleLength(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	want := fmt.Sprintf("varchar(%d)", types.MaxTitleLength)
+	for _, table := range []string{"issues", "wisps", "issues_archive"} {
+		found := false
+		for _, c := range queryColumns(t, store, table) {
+			if c.Name == "title" {
+				found = true
+				if c.ColumnType != want {
+					t.Errorf("%s.title is %s, want %s", table, c.ColumnType, want)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("%s has no title column", table)
+		}
+	}
+}
+
 // TestMigrations004And005Together verifies that migrations 004 (wisps table)
 // and 005 (wisp auxiliary tables) run correctly in sequence. Migration 005
 // depends on 004's "wisp_%" dolt_ignore pattern to keep auxiliary tables
@@ -0,0 +1,176 @@
+package dolt
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SquashResult describes the local commits folded together by SquashUnpushed.
+type SquashResult struct {
+	Base     string   `json:"base"`             // Last pushed commit; left untouched
+	Squashed int      `json:"squashed"`         // Number of commits replaced
+	Commit   string   `json:"commit,omitempty"` // New commit (empty on dry run or no-op)
+	IssueIDs []string `json:"issue_ids"`        // Issues changed by the squashed commits
+	Message  string   `json:"message,omitempty"`
+}
+
+// SquashUnpushed replaces the commits made since the last push to
+// remote/branch with a single commit whose message lists the affected issue
+// IDs. Commits already on the remote are never rewritten: the squash base is
+// the merge base of HEAD and the remote-tracking branch. Empty remote and
+// branch default to the store's remote and the current branch.
+func (s *DoltStore) SquashUnpushed(ctx context.Context, remote, branch string, dryRun bool) (*SquashResult, error) {
+	if remote == "" {
+		remote = s.remote
+	}
+	if branch == "" {
+		current, err := s.CurrentBranch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		branch = current
+	}
+
+	upstream := fmt.Sprintf("remotes/%s/%s", remote, branch)
+	var tracked int
+	if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM dolt_remote_branches WHERE name = ?", upstream).Scan(&tracked); err != nil {
+		return nil, fmt.Errorf("failed to read remote branches: %w", err)
+	}
+	if tracked == 0 {
+		return nil, fmt.Errorf("no remote-tracking branch %s; push once before squashing so already-shared commits can be protected", upstream)
+	}
+
+	var base string
+	if err := s.db.QueryRowContext(ctx, "SELECT DOLT_MERGE_BASE('HEAD', ?)", upstream).Scan(&base); err != nil {
+		return nil, fmt.Errorf("failed to find merge base with %s: %w", upstream, err)
+	}
+	return s.squashSince(ctx, base, dryRun)
+}
+
+// squashSince squashes every commit after base into one. base must already
+// be known to be safe to keep (see SquashUnpushed).
+func (s *DoltStore) squashSince(ctx context.Context, base string, dryRun bool) (*SquashResult, error) {
+	if err := validateRef(base); err != nil {
+		return nil, fmt.Errorf("invalid base: %w", err)
+	}
+
+	// Pin a single connection so the reset and commit run on the same Dolt session.
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Close()
+
+	// nolint:gosec // G201: base is validated by validateRef() above
+	rows, err := conn.QueryContext(ctx, fmt.Sprintf("SELECT message FROM DOLT_LOG('%s..HEAD')", base))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits since %s: %w", base, err)
+	}
+	var subjects []string
+	for rows.Next() {
+		var message string
+		if err := rows.Scan(&message); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed to scan commit: %w", err)
+		}
+		subject, _, _ := strings.Cut(message, "\n")
+		subjects = append(subjects, subject)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list commits since %s: %w", base, err)
+	}
+
+	result := &SquashResult{Base: base, Squashed: len(subjects)}
+	if len(subjects) < 2 {
+		result.Squashed = 0
+		return result, nil
+	}
+
+	ids, err := changedIssueIDs(ctx, conn, base)
+	if err != nil {
+		return nil, err
+	}
+	result.IssueIDs = ids
+	result.Message = squashMessage(subjects, ids)
+	if dryRun {
+		return result, nil
+	}
+
+	var dirty int
+	if err := conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM dolt_status").Scan(&dirty); err != nil {
+		return nil, fmt.Errorf("failed to query dolt_status: %w", err)
+	}
+	if dirty > 0 {
+		return nil, fmt.Errorf("working set has uncommitted changes; commit them with 'bd dolt commit' before squashing")
+	}
+
+	if _, err := conn.ExecContext(ctx, "CALL DOLT_RESET('--soft', ?)", base); err != nil {
+		return nil, fmt.Errorf("failed to reset to %s: %w", base, err)
+	}
+	// The working set was clean, so -A stages exactly the squashed commits'
+	// changes (including config rows they committed), nothing stray (GH#2455).
+	if _, err := conn.ExecContext(ctx, "CALL DOLT_COMMIT('-Am', ?, '--author', ?)",
+		result.Message, s.commitAuthorString()); err != nil {
+		return nil, fmt.Errorf("failed to commit squash (HEAD is now %s; changes are staged): %w", base, err)
+	}
+	if err := conn.QueryRowContext(ctx, "SELECT DOLT_HASHOF('HEAD')").Scan(&result.Commit); err != nil {
+		return nil, fmt.Errorf("failed to read new HEAD: %w", err)
+	}
+	return result, nil
+}
+
+// changedIssueIDs returns the sorted IDs of issues and wisps that differ
+// between base and HEAD.
+func changedIssueIDs(ctx context.Context, conn *sql.Conn, base string) ([]string, error) {
+	seen := make(map[string]bool)
+	for _, table := range []string{"issues", "wisps"} {
+		// nolint:gosec // G201: base is validated by the caller, table is hardcoded
+		rows, err := conn.QueryContext(ctx, fmt.Sprintf(
+			"SELECT COALESCE(to_id, from_id) FROM dolt_diff('%s', 'HEAD', '%s')", base, table))
+		if err != nil {
+			if table == "wisps" {
+				continue // wisps may not exist in the base commit
+			}
+			return nil, fmt.Errorf("failed to diff %s since %s: %w", table, base, err)
+		}
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				_ = rows.Close()
+				return nil, fmt.Errorf("failed to scan diff: %w", err)
+			}
+			seen[id] = true
+		}
+		_ = rows.Close()
+	}
+	ids := make([]string, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// squashMessage builds the aggregated commit message. subjects are newest first.
+func squashMessage(subjects, ids []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "bd: squash %d commits", len(subjects))
+	if len(ids) > 0 {
+		const maxInSubject = 5
+		if len(ids) <= maxInSubject {
+			fmt.Fprintf(&b, " (%s)", strings.Join(ids, ", "))
+		} else {
+			fmt.Fprintf(&b, " (%s, +%d more)", strings.Join(ids[:maxInSubject], ", "), len(ids)-maxInSubject)
+		}
+		fmt.Fprintf(&b, "\n\nIssues: %s", strings.Join(ids, ", "))
+	}
+	b.WriteString("\n")
+	for i := len(subjects) - 1; i >= 0; i-- {
+		fmt.Fprintf(&b, "\n- %s", subjects[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,79 @@
+package dolt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestSquashMessage(t *testing.T) {
+	msg := squashMessage([]string{"bd: close bd-2", "bd: create bd-1"}, []string{"bd-1", "bd-2"})
+	subject, body, _ := strings.Cut(msg, "\n")
+	if subject != "bd: squash 2 commits (bd-1, bd-2)" {
+		t.Errorf("subject = %q", subject)
+	}
+	// Oldest commit first in the body
+	if strings.Index(body, "create bd-1") > strings.Index(body, "close bd-2") {
+		t.Errorf("body not in chronological order:\n%s", body)
+	}
+
+	many := squashMessage([]string{"a", "b"}, []string{"x-1", "x-2", "x-3", "x-4", "x-5", "x-6", "x-7"})
+	if !strings.HasPrefix(many, "bd: squash 2 commits (x-1, x-2, x-3, x-4, x-5, +2 more)") {
+		t.Errorf("long subject = %q", many)
+	}
+}
+
+func TestSquashSinceReducesCommitCount(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	base, err := store.GetCurrentCommit(ctx)
+	if err != nil {
+		t.Fatalf("failed to get current commit: %v", err)
+	}
+	countCommits := func() int {
+		var n int
+		if err := store.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM dolt_log").Scan(&n); err != nil {
+			t.Fatalf("failed to count commits: %v", err)
+		}
+		return n
+	}
+	before := countCommits()
+
+	for i := 1; i <= 3; i++ {
+		issue := &types.Issue{ID: fmt.Sprintf("sq-%d", i), Title: "Squash me", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+			t.Fatalf("failed to create issue: %v", err)
+		}
+		if err := store.Commit(ctx, fmt.Sprintf("bd: create sq-%d", i)); err != nil {
+			t.Fatalf("failed to commit: %v", err)
+		}
+	}
+	if got := countCommits(); got != before+3 {
+		t.Fatalf("commit count after creates = %d, want %d", got, before+3)
+	}
+
+	result, err := store.squashSince(ctx, base, false)
+	if err != nil {
+		t.Fatalf("squashSince failed: %v", err)
+	}
+	if result.Squashed != 3 {
+		t.Errorf("Squashed = %d, want 3", result.Squashed)
+	}
+	if strings.Join(result.IssueIDs, ",") != "sq-1,sq-2,sq-3" {
+		t.Errorf("IssueIDs = %v, want sq-1..3", result.IssueIDs)
+	}
+	if got := countCommits(); got != before+1 {
+		t.Errorf("commit count after squash = %d, want %d", got, before+1)
+	}
+	for i := 1; i <= 3; i++ {
+		if _, err := store.GetIssue(ctx, fmt.Sprintf("sq-%d", i)); err != nil {
+			t.Errorf("sq-%d lost by squash: %v", i, err)
+		}
+	}
+}
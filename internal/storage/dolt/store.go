@@ -40,6 +40,7 @@ import (
 	"github.com/steveyegge/beads/internal/doltserver"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/storage/doltutil"
+	"github.com/steveyegge/beads/internal/types"
 )
 
 // DefaultSQLPort is the default port for dolt sql-server.
@@ -145,10 +146,19 @@ type DoltStore struct {
 	remotePassword string // Remote auth password for Hosted Dolt push/pull (optional)
 	serverMode     bool   // true when connected to external dolt sql-server (not embedded)
 
+	// hierarchy generates and parses child IDs (hierarchy.separator)
+	hierarchy types.IDHierarchy
+
 	// autoStartedServerDir is set when this store triggered a dolt sql-server
 	// auto-start. Close() uses it to stop the server when the last store
 	// referencing it is closed (tracked via autoStartRefs).
 	autoStartedServerDir string
+
+	// Command transaction state (see BeginCommandTx)
+	cmdTxMu       sync.Mutex
+	cmdTxActive   bool
+	cmdTxMessages []string            // version commits deferred while cmdTxActive
+	cmdTxTables   map[string]struct{} // tables those deferred writes staged
 }
 
 // Config holds Dolt database configuration
@@ -157,7 +167,7 @@ type Config struct {
 	BeadsDir       string // Path to .beads directory (for server auto-start when Path is custom)
 	CommitterName  string // Git-style committer name
 	CommitterEmail string // Git-style committer email
-	Remote         string // Default remote name (e.g., "origin")
+	Remote         string // Default remote name (default.remote config; "origin" if empty)
 	Database       string // Database name within Dolt (default: "beads")
 	ReadOnly       bool   // Open in read-only mode (skip schema init)
 
@@ -192,6 +202,10 @@ type Config struct {
 	// MaxOpenConns overrides the connection pool size (0 = default 10).
 	// Set to 1 for branch isolation in tests (DOLT_CHECKOUT is session-level).
 	MaxOpenConns int
+
+	// HierarchySeparator joins a parent ID and a child number in
+	// hierarchical IDs (hierarchy.separator; "." if empty).
+	HierarchySeparator string
 }
 
 // cliExecTimeout is the maximum time to wait for dolt CLI push/pull operations.
@@ -612,6 +626,11 @@ func New(ctx context.Context, cfg *Config) (*DoltStore, error) {
 // newServerMode creates a DoltStore connected to a running dolt sql-server.
 // This path is pure Go and does not require CGO.
 func newServerMode(ctx context.Context, cfg *Config) (*DoltStore, error) {
+	hierarchy, err := types.NewIDHierarchy(cfg.HierarchySeparator)
+	if err != nil {
+		return nil, fmt.Errorf("hierarchy.separator: %w", err)
+	}
+
 	breaker := newCircuitBreaker(cfg.ServerPort)
 
 	// Circuit breaker: fail-fast if the server is known to be down.
@@ -705,6 +724,7 @@ func newServerMode(ctx context.Context, cfg *Config) (*DoltStore, error) {
 		serverMode:           true,
 		readOnly:             cfg.ReadOnly,
 		autoStartedServerDir: autoStartedDir,
+		hierarchy:            hierarchy,
 	}
 
 	// Schema initialization for server mode (idempotent).
@@ -1243,6 +1263,23 @@ func (s *DoltStore) Path() string {
 	return s.dbPath
 }
 
+// IDHierarchy returns how this store generates and parses hierarchical child
+// IDs (Config.HierarchySeparator).
+func (s *DoltStore) IDHierarchy() types.IDHierarchy {
+	return s.hierarchy
+}
+
+// DefaultRemote returns the remote Push, Pull and ForcePush use: Config.Remote
+// (the default.remote setting), or "origin".
+func (s *DoltStore) DefaultRemote() string {
+	return s.remote
+}
+
+// DefaultBranch returns the branch Push, Pull and ForcePush use.
+func (s *DoltStore) DefaultBranch() string {
+	return s.branch
+}
+
 // CLIDir returns the directory for dolt CLI operations (push/pull/remote/fetch).
 // The actual database lives in a subdirectory of Path() named after the database.
 // Use this instead of Path() when running dolt CLI commands that target the
@@ -1373,8 +1410,7 @@ func (s *DoltStore) doltAddAndCommit(ctx context.Context, tables []string, commi
 			return fmt.Errorf("dolt add %s: %w", table, err)
 		}
 	}
-	if _, err := conn.ExecContext(ctx, "CALL DOLT_COMMIT('-m', ?, '--author', ?)",
-		commitMsg, s.commitAuthorString()); err != nil && !isDoltNothingToCommit(err) {
+	if err := s.versionCommit(ctx, conn, commitMsg); err != nil {
 		return fmt.Errorf("dolt commit: %w", err)
 	}
 	return nil
@@ -2128,14 +2164,16 @@ type HistoryEntry struct {
 
 // HasRemote checks if a Dolt remote with the given name exists.
 func (s *DoltStore) HasRemote(ctx context.Context, name string) (bool, error) {
-	var count int
-	err := s.queryRowContext(ctx, func(row *sql.Row) error {
-		return row.Scan(&count)
-	}, "SELECT COUNT(*) FROM dolt_remotes WHERE name = ?", name)
+	remotes, err := s.ListRemotes(ctx)
 	if err != nil {
 		return false, fmt.Errorf("failed to check remote %s: %w", name, err)
 	}
-	return count > 0, nil
+	for _, r := range remotes {
+		if r.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 // AddRemote adds a Dolt remote
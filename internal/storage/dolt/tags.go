@@ -0,0 +1,183 @@
+package dolt
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// validTagPattern matches tag names: letters, digits, '.', '_', '-' and '/',
+// starting with a letter or digit (e.g. "sprint-12-start", "release/v1.2").
+var validTagPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._/-]*$`)
+
+// TagInfo describes a Dolt tag.
+type TagInfo struct {
+	Name    string    `json:"name"`
+	Hash    string    `json:"hash"`
+	Tagger  string    `json:"tagger"`
+	Email   string    `json:"email"`
+	Date    time.Time `json:"date"`
+	Message string    `json:"message,omitempty"`
+}
+
+// ValidateTagName checks that name is usable as a Dolt tag and as an AS OF ref.
+func ValidateTagName(name string) error {
+	if name == "" {
+		return fmt.Errorf("tag name cannot be empty")
+	}
+	if len(name) > 128 {
+		return fmt.Errorf("tag name too long (max 128 characters)")
+	}
+	if !validTagPattern.MatchString(name) {
+		return fmt.Errorf("invalid tag name %q (use letters, digits, '.', '_', '-' and '/')", name)
+	}
+	if strings.Contains(name, "..") || strings.HasSuffix(name, ".") || strings.HasSuffix(name, "/") || strings.HasSuffix(name, ".lock") {
+		return fmt.Errorf("invalid tag name %q", name)
+	}
+	if strings.EqualFold(name, "HEAD") || strings.EqualFold(name, "WORKING") || strings.EqualFold(name, "STAGED") {
+		return fmt.Errorf("tag name %q is reserved", name)
+	}
+	return nil
+}
+
+// GetTag returns the named tag, or storage.ErrNotFound.
+func (s *DoltStore) GetTag(ctx context.Context, name string) (*TagInfo, error) {
+	var t TagInfo
+	var message sql.NullString
+	err := s.queryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&t.Name, &t.Hash, &t.Tagger, &t.Email, &t.Date, &message)
+	}, "SELECT tag_name, tag_hash, tagger, email, date, message FROM dolt_tags WHERE tag_name = ?", name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: tag %s", storage.ErrNotFound, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag %s: %w", name, err)
+	}
+	t.Message = message.String
+	return &t, nil
+}
+
+// CreateTag tags the commit ref resolves to (HEAD when ref is empty).
+// Existing tags are never moved: a name collision is an error.
+func (s *DoltStore) CreateTag(ctx context.Context, name, ref, message string) (*TagInfo, error) {
+	if err := ValidateTagName(name); err != nil {
+		return nil, err
+	}
+	if existing, err := s.GetTag(ctx, name); err == nil {
+		return nil, fmt.Errorf("tag %s already exists at %s", name, existing.Hash)
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+	var branches int
+	if err := s.queryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&branches)
+	}, "SELECT COUNT(*) FROM dolt_branches WHERE name = ?", name); err != nil {
+		return nil, fmt.Errorf("failed to check branches: %w", err)
+	}
+	if branches > 0 {
+		return nil, fmt.Errorf("tag %s would shadow the branch of the same name", name)
+	}
+
+	if ref == "" {
+		ref = "HEAD"
+	}
+	hash, err := s.ResolveCommit(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []interface{}{name, hash}
+	query := "CALL DOLT_TAG(?, ?)"
+	if message != "" {
+		query = "CALL DOLT_TAG(?, ?, '-m', ?)"
+		args = append(args, message)
+	}
+	if _, err := s.execContext(ctx, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to create tag %s: %w", name, err)
+	}
+	return s.GetTag(ctx, name)
+}
+
+// ListTags returns all tags, oldest first.
+func (s *DoltStore) ListTags(ctx context.Context) ([]TagInfo, error) {
+	rows, err := s.queryContext(ctx, "SELECT tag_name, tag_hash, tagger, email, date, message FROM dolt_tags ORDER BY date, tag_name")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []TagInfo
+	for rows.Next() {
+		var t TagInfo
+		var message sql.NullString
+		if err := rows.Scan(&t.Name, &t.Hash, &t.Tagger, &t.Email, &t.Date, &message); err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		t.Message = message.String
+		tags = append(tags, t)
+	}
+	return tags, rows.Err()
+}
+
+// DeleteTag removes the named tag, or returns storage.ErrNotFound.
+func (s *DoltStore) DeleteTag(ctx context.Context, name string) error {
+	if _, err := s.GetTag(ctx, name); err != nil {
+		return err
+	}
+	if _, err := s.execContext(ctx, "CALL DOLT_TAG('-d', ?)", name); err != nil {
+		return fmt.Errorf("failed to delete tag %s: %w", name, err)
+	}
+	return nil
+}
+
+// SearchIssuesAsOf runs an issue search against the issues, labels and
+// dependencies tables as they were at ref (a commit, branch or tag).
+func (s *DoltStore) SearchIssuesAsOf(ctx context.Context, ref, query string, filter types.IssueFilter) ([]*types.Issue, error) {
+	if err := validateRef(ref); err != nil {
+		return nil, fmt.Errorf("invalid ref: %w", err)
+	}
+	asOf := fmt.Sprintf(" AS OF '%s'", ref)
+	tables := filterTables{main: "issues" + asOf, labels: "labels" + asOf, dependencies: "dependencies" + asOf}
+
+	whereClauses, args, err := buildIssueFilterClauses(query, filter, tables, s.hierarchy)
+	if err != nil {
+		return nil, err
+	}
+	whereSQL := ""
+	if len(whereClauses) > 0 {
+		whereSQL = "WHERE " + strings.Join(whereClauses, " AND ")
+	}
+	limitSQL := ""
+	if filter.Limit > 0 {
+		limitSQL = fmt.Sprintf(" LIMIT %d", filter.Limit)
+	}
+
+	// nolint:gosec // G201: ref is validated by validateRef() above - AS OF requires literal
+	rows, err := s.queryContext(ctx, fmt.Sprintf(`
+		SELECT %s FROM %s
+		%s
+		%s
+		%s
+	`, issueSelectColumns, tables.main, whereSQL, buildOrderBy(filter.Sort, "ORDER BY priority ASC, created_at DESC, id ASC"), limitSQL), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search issues as of %s: %w", ref, err)
+	}
+	defer rows.Close()
+
+	var issues []*types.Issue
+	for rows.Next() {
+		issue, err := scanIssueFrom(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan issue as of %s: %w", ref, err)
+		}
+		issues = append(issues, issue)
+	}
+	return issues, rows.Err()
+}
@@ -0,0 +1,83 @@
+package dolt
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestValidateTagName(t *testing.T) {
+	valid := []string{"sprint-12-start", "v1.2", "release/2026-q3", "a_b"}
+	for _, name := range valid {
+		if err := ValidateTagName(name); err != nil {
+			t.Errorf("ValidateTagName(%q) = %v, want nil", name, err)
+		}
+	}
+	invalid := []string{"", "-lead", "has space", "a..b", "trailing.", "x.lock", "HEAD", "it's", strings.Repeat("a", 129)}
+	for _, name := range invalid {
+		if err := ValidateTagName(name); err == nil {
+			t.Errorf("ValidateTagName(%q) = nil, want error", name)
+		}
+	}
+}
+
+func TestCreateListDeleteTag(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	issue := &types.Issue{ID: "tag-1", Title: "Before tag", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("failed to create issue: %v", err)
+	}
+	if err := store.Commit(ctx, "add tag-1"); err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+
+	tag, err := store.CreateTag(ctx, "sprint-1", "", "start of sprint")
+	if err != nil {
+		t.Fatalf("CreateTag failed: %v", err)
+	}
+	if tag.Hash == "" || tag.Message != "start of sprint" {
+		t.Errorf("tag = %+v, want hash and message", tag)
+	}
+	if _, err := store.CreateTag(ctx, "sprint-1", "", ""); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("duplicate CreateTag err = %v, want collision error", err)
+	}
+
+	later := &types.Issue{ID: "tag-2", Title: "After tag", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, later, "tester"); err != nil {
+		t.Fatalf("failed to create issue: %v", err)
+	}
+	if err := store.Commit(ctx, "add tag-2"); err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+
+	asOf, err := store.SearchIssuesAsOf(ctx, "sprint-1", "", types.IssueFilter{IDPrefix: "tag-"})
+	if err != nil {
+		t.Fatalf("SearchIssuesAsOf failed: %v", err)
+	}
+	if len(asOf) != 1 || asOf[0].ID != "tag-1" {
+		t.Errorf("issues as of sprint-1 = %v, want [tag-1]", asOf)
+	}
+
+	tags, err := store.ListTags(ctx)
+	if err != nil {
+		t.Fatalf("ListTags failed: %v", err)
+	}
+	if len(tags) != 1 || tags[0].Name != "sprint-1" {
+		t.Errorf("ListTags = %+v, want [sprint-1]", tags)
+	}
+
+	if err := store.DeleteTag(ctx, "sprint-1"); err != nil {
+		t.Fatalf("DeleteTag failed: %v", err)
+	}
+	if err := store.DeleteTag(ctx, "sprint-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("second DeleteTag err = %v, want ErrNotFound", err)
+	}
+}
@@ -11,9 +11,14 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/issueops"
 	"github.com/steveyegge/beads/internal/types"
 )
 
+// versionCommitTimeout bounds the DOLT_ADD/DOLT_COMMIT step that follows a
+// successful SQL commit, which runs detached from the caller's cancellation.
+const versionCommitTimeout = 30 * time.Second
+
 // doltTransaction implements storage.Transaction for Dolt
 type doltTransaction struct {
 	tx          *sql.Tx
@@ -110,16 +115,20 @@ func (s *DoltStore) runDoltTransaction(ctx context.Context, commitMsg string, fn
 	// stale changes from concurrent operations (e.g., corrupting
 	// issue_prefix in the config table).
 	if commitMsg != "" {
+		// The SQL transaction is durable at this point. Finish the version
+		// commit even if ctx is canceled (e.g. Ctrl-C), so an interrupt can't
+		// strand committed rows as uncommitted working-set changes.
+		commitCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), versionCommitTimeout)
+		defer cancel()
+
 		// Stage only the tables this transaction actually modified.
 		for table := range tx.dirtyTables {
-			_, addErr := conn.ExecContext(ctx, "CALL DOLT_ADD(?)", table)
+			_, addErr := conn.ExecContext(commitCtx, "CALL DOLT_ADD(?)", table)
 			if addErr != nil {
 				return fmt.Errorf("dolt add %s: %w", table, addErr)
 			}
 		}
-		_, err = conn.ExecContext(ctx, "CALL DOLT_COMMIT('-m', ?, '--author', ?)",
-			commitMsg, s.commitAuthorString())
-		if err != nil && !isDoltNothingToCommit(err) {
+		if err := s.versionCommit(commitCtx, conn, commitMsg); err != nil {
 			return fmt.Errorf("dolt commit: %w", err)
 		}
 	}
@@ -158,6 +167,14 @@ func (t *doltTransaction) CreateIssue(ctx context.Context, issue *types.Issue, a
 	}
 
 	// Generate ID if not provided
+	if issue.ID == "" && issue.ChildOf != "" {
+		childID, err := issueops.GetNextChildIDTx(ctx, t.tx, issue.ChildOf, t.store.hierarchy)
+		if err != nil {
+			return err
+		}
+		issue.ID = childID
+		t.markDirty("child_counters")
+	}
 	if issue.ID == "" {
 		var configPrefix string
 		err := t.tx.QueryRowContext(ctx, "SELECT value FROM config WHERE `key` = ?", "issue_prefix").Scan(&configPrefix)
@@ -194,6 +211,19 @@ func (t *doltTransaction) CreateIssue(ctx context.Context, issue *types.Issue, a
 		return err
 	}
 
+	if table == "issues" {
+		issueSeq, err := issueops.IsIssueSeqEnabledTx(ctx, t.tx)
+		if err != nil {
+			return err
+		}
+		if issueSeq {
+			if err := issueops.AssignIssueSeqTx(ctx, t.tx, issue); err != nil {
+				return err
+			}
+			t.markDirty("issue_seq_counter")
+		}
+	}
+
 	t.markDirty(table)
 	return insertIssueTxIntoTable(ctx, t.tx, table, issue)
 }
@@ -326,6 +356,14 @@ func (t *doltTransaction) SearchIssues(ctx context.Context, query string, filter
 		whereClauses = append(whereClauses, "assignee = ?")
 		args = append(args, *filter.Assignee)
 	}
+	if len(filter.AssigneeIn) > 0 {
+		placeholders := make([]string, len(filter.AssigneeIn))
+		for i, a := range filter.AssigneeIn {
+			placeholders[i] = "?"
+			args = append(args, a)
+		}
+		whereClauses = append(whereClauses, fmt.Sprintf("assignee IN (%s)", strings.Join(placeholders, ",")))
+	}
 
 	// Date ranges
 	if filter.CreatedAfter != nil {
@@ -457,8 +495,8 @@ func (t *doltTransaction) SearchIssues(ctx context.Context, query string, filter
 	if filter.ParentID != nil {
 		parentID := *filter.ParentID
 		//nolint:gosec // G201: depTable is hardcoded to "dependencies" or "wisp_dependencies"
-		whereClauses = append(whereClauses, fmt.Sprintf("(id IN (SELECT issue_id FROM %s WHERE type = 'parent-child' AND depends_on_id = ?) OR (id LIKE CONCAT(?, '.%%') AND id NOT IN (SELECT issue_id FROM %s WHERE type = 'parent-child')))", depTable, depTable))
-		args = append(args, parentID, parentID)
+		whereClauses = append(whereClauses, fmt.Sprintf("(id IN (SELECT issue_id FROM %s WHERE type = 'parent-child' AND depends_on_id = ?) OR (id LIKE CONCAT(?, ?, '%%') AND id NOT IN (SELECT issue_id FROM %s WHERE type = 'parent-child')))", depTable, depTable))
+		args = append(args, parentID, parentID, t.store.hierarchy.Separator())
 	}
 
 	// No-parent filtering
@@ -513,6 +551,16 @@ func (t *doltTransaction) SearchIssues(ctx context.Context, query string, filter
 		}
 	}
 
+	// Filter expression
+	if filter.Expr != "" {
+		clause, exprArgs, err := compileFilterExpr(filter.Expr, filterTables{main: table, labels: labelTable, dependencies: depTable})
+		if err != nil {
+			return nil, err
+		}
+		whereClauses = append(whereClauses, clause)
+		args = append(args, exprArgs...)
+	}
+
 	whereSQL := ""
 	if len(whereClauses) > 0 {
 		whereSQL = "WHERE " + strings.Join(whereClauses, " AND ")
@@ -523,10 +571,10 @@ func (t *doltTransaction) SearchIssues(ctx context.Context, query string, filter
 		limitSQL = fmt.Sprintf(" LIMIT %d", filter.Limit)
 	}
 
-	//nolint:gosec // G201: table is hardcoded, whereSQL is parameterized
+	//nolint:gosec // G201: table is hardcoded, whereSQL is parameterized, ORDER BY uses a fixed column map
 	rows, err := t.tx.QueryContext(ctx, fmt.Sprintf(`
-		SELECT id FROM %s %s ORDER BY priority ASC, created_at DESC %s
-	`, table, whereSQL, limitSQL), args...)
+		SELECT id FROM %s %s %s %s
+	`, table, whereSQL, buildOrderBy(filter.Sort, "ORDER BY priority ASC, created_at DESC"), limitSQL), args...)
 	if err != nil {
 		return nil, wrapQueryError("search issues in tx", err)
 	}
@@ -559,6 +607,11 @@ func (t *doltTransaction) SearchIssues(ctx context.Context, query string, filter
 
 // UpdateIssue updates an issue within the transaction
 func (t *doltTransaction) UpdateIssue(ctx context.Context, id string, updates map[string]interface{}, actor string) error {
+	if title, ok := updates["title"].(string); ok {
+		if err := types.ValidateTitle(title); err != nil {
+			return err
+		}
+	}
 	table := "issues"
 	if t.isActiveWisp(ctx, id) {
 		table = "wisps"
@@ -618,11 +671,16 @@ func (t *doltTransaction) CloseIssue(ctx context.Context, id string, reason stri
 	}
 
 	now := time.Now().UTC()
-	//nolint:gosec // G201: table is hardcoded
-	_, err := t.tx.ExecContext(ctx, fmt.Sprintf(`
-		UPDATE %s SET status = ?, closed_at = ?, updated_at = ?, close_reason = ?, closed_by_session = ?
-		WHERE id = ?
-	`, table), types.StatusClosed, now, now, reason, session, id)
+	setClause := "status = ?, closed_at = ?, updated_at = ?, close_reason = ?, closed_by_session = ?"
+	args := []interface{}{types.StatusClosed, now, now, reason, session}
+	if table == "issues" {
+		// Wisps are never archived
+		setClause += ", archive_after = ?"
+		args = append(args, archiveAfter(now))
+	}
+	args = append(args, id)
+	//nolint:gosec // G201: table and setClause are hardcoded
+	_, err := t.tx.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET %s WHERE id = ?", table, setClause), args...)
 	if err == nil {
 		t.markDirty(table)
 	}
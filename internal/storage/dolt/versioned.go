@@ -2,7 +2,9 @@ package dolt
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"regexp"
 
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
@@ -44,6 +46,8 @@ func (s *DoltStore) Diff(ctx context.Context, fromRef, toRef string) ([]*storage
 	if err := validateRef(toRef); err != nil {
 		return nil, fmt.Errorf("invalid toRef: %w", err)
 	}
+	fromRef = s.resolveRemoteTrackingRef(ctx, fromRef)
+	toRef = s.resolveRemoteTrackingRef(ctx, toRef)
 
 	// Query issue-level diffs using dolt_diff table function
 	// Syntax: dolt_diff(from_ref, to_ref, 'table_name')
@@ -67,6 +71,12 @@ func (s *DoltStore) Diff(ctx context.Context, fromRef, toRef string) ([]*storage
 	}
 	defer rows.Close()
 
+	return scanDiffEntries(rows)
+}
+
+// scanDiffEntries scans rows of from_/to_ issue columns (as selected by Diff
+// and IssuesInCommit) into diff entries.
+func scanDiffEntries(rows *sql.Rows) ([]*storage.DiffEntry, error) {
 	var entries []*storage.DiffEntry
 	for rows.Next() {
 		var fromID, toID, diffType string
@@ -211,3 +221,97 @@ func (s *DoltStore) CommitExists(ctx context.Context, commitHash string) (bool,
 
 	return count > 0, nil
 }
+
+// LogMatching returns commits whose message matches re, newest first.
+// A limit of 0 or less returns every match.
+func (s *DoltStore) LogMatching(ctx context.Context, re *regexp.Regexp, limit int) ([]CommitInfo, error) {
+	rows, err := s.queryContext(ctx, `
+		SELECT commit_hash, committer, email, date, message
+		FROM dolt_log
+		ORDER BY date DESC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get log: %w", err)
+	}
+	defer rows.Close()
+
+	var commits []CommitInfo
+	for rows.Next() {
+		var c CommitInfo
+		if err := rows.Scan(&c.Hash, &c.Author, &c.Email, &c.Date, &c.Message); err != nil {
+			return nil, fmt.Errorf("failed to scan commit: %w", err)
+		}
+		if re != nil && !re.MatchString(c.Message) {
+			continue
+		}
+		commits = append(commits, c)
+		if limit > 0 && len(commits) >= limit {
+			break
+		}
+	}
+	return commits, rows.Err()
+}
+
+// ResolveCommit expands a ref (HEAD, a branch, or a full or abbreviated
+// commit hash) to a full commit hash. Abbreviated hashes must be unambiguous.
+func (s *DoltStore) ResolveCommit(ctx context.Context, ref string) (string, error) {
+	if err := validateRef(ref); err != nil {
+		return "", err
+	}
+
+	rows, err := s.queryContext(ctx, "SELECT commit_hash FROM dolt_log WHERE commit_hash LIKE ? LIMIT 2", ref+"%")
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve commit %s: %w", ref, err)
+	}
+	var matches []string
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			_ = rows.Close()
+			return "", fmt.Errorf("failed to scan commit: %w", err)
+		}
+		matches = append(matches, hash)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("failed to resolve commit %s: %w", ref, err)
+	}
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 2:
+		return "", fmt.Errorf("commit prefix %s is ambiguous", ref)
+	}
+
+	// Not a hash prefix: let Dolt resolve HEAD, HEAD~N, branches and tags.
+	var hash string
+	if err := s.db.QueryRowContext(ctx, "SELECT DOLT_HASHOF(?)", ref).Scan(&hash); err != nil {
+		return "", fmt.Errorf("unknown commit or ref %s: %w", ref, err)
+	}
+	return hash, nil
+}
+
+// IssuesInCommit lists the issues changed by a single commit, using the
+// dolt_diff_issues system table. commitHash must be a full hash; see
+// ResolveCommit.
+func (s *DoltStore) IssuesInCommit(ctx context.Context, commitHash string) ([]*storage.DiffEntry, error) {
+	rows, err := s.queryContext(ctx, `
+		SELECT
+			COALESCE(from_id, '') as from_id,
+			COALESCE(to_id, '') as to_id,
+			diff_type,
+			from_title, to_title,
+			from_description, to_description,
+			from_status, to_status,
+			from_priority, to_priority
+		FROM dolt_diff_issues
+		WHERE to_commit = ?
+		ORDER BY COALESCE(to_id, from_id)
+	`, commitHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issues in commit %s: %w", commitHash, err)
+	}
+	defer rows.Close()
+
+	return scanDiffEntries(rows)
+}
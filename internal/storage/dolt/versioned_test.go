@@ -1,8 +1,11 @@
 package dolt
 
 import (
+	"regexp"
 	"strings"
 	"testing"
+
+	"github.com/steveyegge/beads/internal/types"
 )
 
 // TestCommitExists tests the CommitExists method.
@@ -167,3 +170,45 @@ func TestCommitPending(t *testing.T) {
 		}
 	})
 }
+
+// TestLogMatchingAndIssuesInCommit tests message filtering and the
+// commit → changed issues lookup.
+func TestLogMatchingAndIssuesInCommit(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := testContext(t)
+	defer cancel()
+
+	issue := &types.Issue{ID: "log-1", Title: "Logged", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, issue, "tester"); err != nil {
+		t.Fatalf("failed to create issue: %v", err)
+	}
+	if err := store.Commit(ctx, "sync: marker-7731"); err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+
+	commits, err := store.LogMatching(ctx, regexp.MustCompile(`marker-\d+`), 0)
+	if err != nil {
+		t.Fatalf("LogMatching failed: %v", err)
+	}
+	if len(commits) != 1 || !strings.Contains(commits[0].Message, "marker-7731") {
+		t.Fatalf("LogMatching = %+v, want the marker commit", commits)
+	}
+
+	hash, err := store.ResolveCommit(ctx, commits[0].Hash[:8])
+	if err != nil {
+		t.Fatalf("ResolveCommit failed: %v", err)
+	}
+	if hash != commits[0].Hash {
+		t.Errorf("ResolveCommit = %s, want %s", hash, commits[0].Hash)
+	}
+
+	entries, err := store.IssuesInCommit(ctx, hash)
+	if err != nil {
+		t.Fatalf("IssuesInCommit failed: %v", err)
+	}
+	if len(entries) != 1 || entries[0].IssueID != "log-1" || entries[0].DiffType != "added" {
+		t.Errorf("IssuesInCommit = %+v, want log-1 added", entries)
+	}
+}
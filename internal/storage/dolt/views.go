@@ -0,0 +1,80 @@
+package dolt
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// SaveView creates or replaces a saved view.
+func (s *DoltStore) SaveView(ctx context.Context, view *types.SavedView) error {
+	args, err := json.Marshal(view.Args)
+	if err != nil {
+		return fmt.Errorf("failed to encode view args: %w", err)
+	}
+	_, err = s.execContext(ctx, `
+		INSERT INTO views (name, args, created_by) VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE args = VALUES(args)
+	`, view.Name, string(args), view.CreatedBy)
+	if err != nil {
+		return fmt.Errorf("failed to save view %s: %w", view.Name, err)
+	}
+	return nil
+}
+
+// GetView returns the named view, or nil if it does not exist.
+func (s *DoltStore) GetView(ctx context.Context, name string) (*types.SavedView, error) {
+	var view types.SavedView
+	var args string
+	err := s.withRetry(ctx, func() error {
+		return s.db.QueryRowContext(ctx,
+			"SELECT name, args, COALESCE(created_by, ''), created_at, updated_at FROM views WHERE name = ?", name,
+		).Scan(&view.Name, &args, &view.CreatedBy, &view.CreatedAt, &view.UpdatedAt)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get view %s: %w", name, err)
+	}
+	if err := json.Unmarshal([]byte(args), &view.Args); err != nil {
+		return nil, fmt.Errorf("failed to decode view %s: %w", name, err)
+	}
+	return &view, nil
+}
+
+// ListViews returns all saved views ordered by name.
+func (s *DoltStore) ListViews(ctx context.Context) ([]*types.SavedView, error) {
+	rows, err := s.queryContext(ctx,
+		"SELECT name, args, COALESCE(created_by, ''), created_at, updated_at FROM views ORDER BY name")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list views: %w", err)
+	}
+	defer rows.Close()
+
+	var views []*types.SavedView
+	for rows.Next() {
+		var view types.SavedView
+		var args string
+		if err := rows.Scan(&view.Name, &args, &view.CreatedBy, &view.CreatedAt, &view.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan view: %w", err)
+		}
+		if err := json.Unmarshal([]byte(args), &view.Args); err != nil {
+			return nil, fmt.Errorf("failed to decode view %s: %w", view.Name, err)
+		}
+		views = append(views, &view)
+	}
+	return views, rows.Err()
+}
+
+// DeleteView removes a saved view. Deleting a missing view is not an error.
+func (s *DoltStore) DeleteView(ctx context.Context, name string) error {
+	if _, err := s.execContext(ctx, "DELETE FROM views WHERE name = ?", name); err != nil {
+		return fmt.Errorf("failed to delete view %s: %w", name, err)
+	}
+	return nil
+}
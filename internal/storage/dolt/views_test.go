@@ -0,0 +1,55 @@
+package dolt
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+func TestSavedViews(t *testing.T) {
+	store, cleanup := setupTestStore(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	view := &types.SavedView{Name: "triage", Args: []string{"--status", "open", "--assignee", "me"}, CreatedBy: "tester"}
+	if err := store.SaveView(ctx, view); err != nil {
+		t.Fatalf("SaveView: %v", err)
+	}
+
+	got, err := store.GetView(ctx, "triage")
+	if err != nil {
+		t.Fatalf("GetView: %v", err)
+	}
+	if got == nil || !reflect.DeepEqual(got.Args, view.Args) || got.CreatedBy != "tester" {
+		t.Fatalf("GetView = %+v, want args %v", got, view.Args)
+	}
+
+	// Saving again replaces the args
+	view.Args = []string{"--priority", "0"}
+	if err := store.SaveView(ctx, view); err != nil {
+		t.Fatalf("SaveView (replace): %v", err)
+	}
+	views, err := store.ListViews(ctx)
+	if err != nil {
+		t.Fatalf("ListViews: %v", err)
+	}
+	if len(views) != 1 || !reflect.DeepEqual(views[0].Args, view.Args) {
+		t.Fatalf("ListViews = %+v, want one view with args %v", views, view.Args)
+	}
+
+	if err := store.DeleteView(ctx, "triage"); err != nil {
+		t.Fatalf("DeleteView: %v", err)
+	}
+	got, err = store.GetView(ctx, "triage")
+	if err != nil {
+		t.Fatalf("GetView after delete: %v", err)
+	}
+	if got != nil {
+		t.Errorf("view still present after delete: %+v", got)
+	}
+}
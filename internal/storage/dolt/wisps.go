@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/issueops"
 	"github.com/steveyegge/beads/internal/types"
 )
 
@@ -35,7 +36,7 @@ func insertIssueIntoTable(ctx context.Context, tx *sql.Tx, table string, issue *
 			event_kind, actor, target, payload,
 			await_type, await_id, timeout_ns, waiters,
 			hook_bead, role_bead, agent_state, last_activity, role_type, rig,
-			due_at, defer_until, metadata
+			due_at, defer_until, metadata, seq
 		) VALUES (
 			?, ?, ?, ?, ?, ?, ?,
 			?, ?, ?, ?, ?,
@@ -46,7 +47,7 @@ func insertIssueIntoTable(ctx context.Context, tx *sql.Tx, table string, issue *
 			?, ?, ?, ?,
 			?, ?, ?, ?,
 			?, ?, ?, ?, ?, ?,
-			?, ?, ?
+			?, ?, ?, ?
 		)
 		ON DUPLICATE KEY UPDATE
 			content_hash = VALUES(content_hash),
@@ -76,7 +77,7 @@ func insertIssueIntoTable(ctx context.Context, tx *sql.Tx, table string, issue *
 		issue.EventKind, issue.Actor, issue.Target, issue.Payload,
 		issue.AwaitType, issue.AwaitID, issue.Timeout.Nanoseconds(), formatJSONStringArray(issue.Waiters),
 		issue.HookBead, issue.RoleBead, issue.AgentState, issue.LastActivity, issue.RoleType, issue.Rig,
-		issue.DueAt, issue.DeferUntil, jsonMetadata(issue.Metadata),
+		issue.DueAt, issue.DeferUntil, jsonMetadata(issue.Metadata), nullIntVal(issue.Seq),
 	)
 	return wrapExecError("insert issue into table", err)
 }
@@ -128,9 +129,16 @@ func generateIssueIDInTable(ctx context.Context, tx *sql.Tx, table, prefix strin
 		}
 	}
 
+	scheme, err := issueops.IDSchemeTx(ctx, tx)
+	if err != nil {
+		return "", err
+	}
+	if scheme == issueops.IDSchemeULID {
+		return issueops.GenerateULIDIDInTable(ctx, tx, table, prefix, issue)
+	}
+
 	baseLength := getAdaptiveIDLengthFromTable(ctx, tx, table, prefix)
 
-	var err error
 	maxLength := 8
 	if baseLength > maxLength {
 		baseLength = maxLength
@@ -508,7 +516,7 @@ func (s *DoltStore) searchWisps(ctx context.Context, query string, filter types.
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	whereClauses, args, err := buildIssueFilterClauses(query, filter, wispsFilterTables)
+	whereClauses, args, err := buildIssueFilterClauses(query, filter, wispsFilterTables, s.hierarchy)
 	if err != nil {
 		return nil, err
 	}
@@ -523,13 +531,15 @@ func (s *DoltStore) searchWisps(ctx context.Context, query string, filter types.
 		limitSQL = fmt.Sprintf(" LIMIT %d", filter.Limit)
 	}
 
-	//nolint:gosec // G201: whereSQL contains column comparisons with ?, limitSQL is a safe integer
+	orderBySQL := buildOrderBy(filter.Sort, "ORDER BY priority ASC, created_at DESC")
+
+	//nolint:gosec // G201: whereSQL contains column comparisons with ?, orderBySQL is built from a fixed column map, limitSQL is a safe integer
 	querySQL := fmt.Sprintf(`
 		SELECT id FROM wisps
 		%s
-		ORDER BY priority ASC, created_at DESC
 		%s
-	`, whereSQL, limitSQL)
+		%s
+	`, whereSQL, orderBySQL, limitSQL)
 
 	rows, err := s.queryContext(ctx, querySQL, args...)
 	if err != nil {
@@ -4,6 +4,8 @@ package doltutil
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"time"
 )
 
@@ -11,19 +13,39 @@ import (
 // Dolt can hang indefinitely on close; this prevents commands from hanging.
 const CloseTimeout = 5 * time.Second
 
+// PanicOnCloseTimeoutEnv makes a close timeout panic instead of warning.
+// Set it in tests to turn leaked/hung resources into hard failures.
+const PanicOnCloseTimeoutEnv = "BD_PANIC_ON_CLOSE_TIMEOUT"
+
+// closeWarnOutput is where close-timeout warnings go (overridable in tests).
+var closeWarnOutput io.Writer = os.Stderr
+
 // CloseWithTimeout runs a close function with a timeout to prevent indefinite hangs.
 // Returns an error if the close times out or if the close function returns an error.
+// A timeout also prints a warning naming the resource, since callers usually
+// discard close errors during shutdown.
 func CloseWithTimeout(name string, closeFn func() error) error {
+	return closeWithTimeout(name, closeFn, CloseTimeout)
+}
+
+func closeWithTimeout(name string, closeFn func() error, timeout time.Duration) error {
 	done := make(chan error, 1)
 	go func() {
 		done <- closeFn()
 	}()
 
+	start := time.Now()
 	select {
 	case err := <-done:
 		return err
-	case <-time.After(CloseTimeout):
-		// Close is hanging - log and continue rather than blocking forever
-		return fmt.Errorf("%s close timed out after %v", name, CloseTimeout)
+	case <-time.After(timeout):
+		// Close is hanging - report and continue rather than blocking forever
+		waited := time.Since(start).Round(time.Millisecond)
+		if os.Getenv(PanicOnCloseTimeoutEnv) != "" {
+			panic(fmt.Sprintf("%s close hung for %v (%s is set)", name, waited, PanicOnCloseTimeoutEnv))
+		}
+		_, _ = fmt.Fprintf(closeWarnOutput, "Warning: %s close hung for %v; abandoning it (set %s=1 to panic instead)\n",
+			name, waited, PanicOnCloseTimeoutEnv)
+		return fmt.Errorf("%s close timed out after %v", name, timeout)
 	}
 }
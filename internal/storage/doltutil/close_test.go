@@ -1,6 +1,7 @@
 package doltutil
 
 import (
+	"bytes"
 	"errors"
 	"strings"
 	"testing"
@@ -42,6 +43,49 @@ func TestCloseWithTimeout_Timeout(t *testing.T) {
 	}
 }
 
+func TestCloseWithTimeout_WarnsWithResourceName(t *testing.T) {
+	var buf bytes.Buffer
+	orig := closeWarnOutput
+	closeWarnOutput = &buf
+	defer func() { closeWarnOutput = orig }()
+	t.Setenv(PanicOnCloseTimeoutEnv, "")
+
+	release := make(chan struct{})
+	defer close(release)
+
+	err := closeWithTimeout("hung-conn", func() error {
+		<-release
+		return nil
+	}, 50*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "hung-conn") {
+		t.Fatalf("expected timeout error naming hung-conn, got: %v", err)
+	}
+	if !strings.Contains(buf.String(), "hung-conn close hung") {
+		t.Errorf("expected warning naming the resource, got: %q", buf.String())
+	}
+}
+
+func TestCloseWithTimeout_PanicsUnderDebugEnv(t *testing.T) {
+	t.Setenv(PanicOnCloseTimeoutEnv, "1")
+
+	release := make(chan struct{})
+	defer close(release)
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected panic when close hangs with debug env set")
+		}
+		if msg, _ := r.(string); !strings.Contains(msg, "hung-conn") {
+			t.Errorf("panic should name the resource, got: %v", r)
+		}
+	}()
+	_ = closeWithTimeout("hung-conn", func() error {
+		<-release
+		return nil
+	}, 50*time.Millisecond)
+}
+
 func TestCloseTimeout_Value(t *testing.T) {
 	if CloseTimeout != 5*time.Second {
 		t.Errorf("CloseTimeout = %v, want 5s", CloseTimeout)
@@ -13,7 +13,7 @@ func (s *EmbeddedDoltStore) GetNextChildID(ctx context.Context, parentID string)
 	var childID string
 	err := s.withConn(ctx, true, func(tx *sql.Tx) error {
 		var err error
-		childID, err = issueops.GetNextChildIDTx(ctx, tx, parentID)
+		childID, err = issueops.GetNextChildIDTx(ctx, tx, parentID, s.hierarchy)
 		return err
 	})
 	return childID, err
@@ -26,6 +26,7 @@ func (s *EmbeddedDoltStore) CreateIssue(ctx context.Context, issue *types.Issue,
 		// validate prefixes for explicit IDs on the single-issue path.
 		bc, err := issueops.NewBatchContext(ctx, tx, storage.BatchCreateOptions{
 			SkipPrefixValidation: true,
+			Hierarchy:            s.hierarchy,
 		})
 		if err != nil {
 			return err
@@ -45,6 +46,7 @@ func (s *EmbeddedDoltStore) CreateIssuesWithFullOptions(ctx context.Context, iss
 	if len(issues) == 0 {
 		return nil
 	}
+	opts.Hierarchy = s.hierarchy
 
 	// All-ephemeral fast path: create each wisp individually within
 	// its own transaction, threading opts through so that callers'
@@ -34,12 +34,17 @@ func TryLock(dataDir string) (*Lock, error) {
 	if err := lockfile.FlockExclusiveNonBlocking(f); err != nil {
 		f.Close()
 		if lockfile.IsLocked(err) {
-			return nil, fmt.Errorf("embeddeddolt: another process holds the exclusive lock on %s; "+
+			holder := "another process"
+			if pid, ok := lockfile.ReadOwnerPID(lockPath); ok {
+				holder = fmt.Sprintf("another process (PID %d)", pid)
+			}
+			return nil, fmt.Errorf("embeddeddolt: %s holds the exclusive lock on %s; "+
 				"the embedded backend supports only one writer at a time — "+
-				"use the dolt server backend for concurrent access", dataDir)
+				"use the dolt server backend for concurrent access", holder, dataDir)
 		}
 		return nil, fmt.Errorf("embeddeddolt: acquiring lock: %w", err)
 	}
+	_ = lockfile.WriteOwner(f) // Best effort: names the holder for the next contender
 
 	return &Lock{f: f}, nil
 }
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS issue_seq_counter;
+ALTER TABLE wisps DROP COLUMN seq;
+DROP INDEX idx_issues_seq ON issues;
+ALTER TABLE issues DROP COLUMN seq;
@@ -0,0 +1,7 @@
+ALTER TABLE issues ADD COLUMN seq INT;
+CREATE UNIQUE INDEX idx_issues_seq ON issues (seq);
+ALTER TABLE wisps ADD COLUMN seq INT;
+CREATE TABLE IF NOT EXISTS issue_seq_counter (
+    name VARCHAR(32) PRIMARY KEY,
+    last_seq INT NOT NULL DEFAULT 0
+);
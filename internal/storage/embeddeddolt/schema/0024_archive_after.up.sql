@@ -0,0 +1 @@
+ALTER TABLE issues ADD COLUMN archive_after DATETIME;
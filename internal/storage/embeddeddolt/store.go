@@ -12,6 +12,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/storage"
 	"github.com/steveyegge/beads/internal/types"
 )
@@ -25,10 +26,11 @@ var _ storage.DoltStorage = (*EmbeddedDoltStore)(nil)
 // time the embedded engine's write lock is held, reducing contention when
 // multiple processes access the same database concurrently.
 type EmbeddedDoltStore struct {
-	dataDir  string
-	database string
-	branch   string
-	closed   atomic.Bool
+	dataDir   string
+	database  string
+	branch    string
+	hierarchy types.IDHierarchy // child IDs, per hierarchy.separator
+	closed    atomic.Bool
 }
 
 // errClosed is returned when a method is called after Close.
@@ -49,10 +51,16 @@ func New(ctx context.Context, beadsDir, database, branch string) (*EmbeddedDoltS
 		return nil, fmt.Errorf("embeddeddolt: creating data directory: %w", err)
 	}
 
+	hierarchy, err := types.NewIDHierarchy(config.GetStringFromDir(absBeadsDir, "hierarchy.separator"))
+	if err != nil {
+		return nil, fmt.Errorf("embeddeddolt: hierarchy.separator: %w", err)
+	}
+
 	s := &EmbeddedDoltStore{
-		dataDir:  dataDir,
-		database: database,
-		branch:   branch,
+		dataDir:   dataDir,
+		database:  database,
+		branch:    branch,
+		hierarchy: hierarchy,
 	}
 
 	if err := s.initSchema(ctx); err != nil {
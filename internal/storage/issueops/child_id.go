@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+
+	"github.com/steveyegge/beads/internal/types"
 )
 
 // GetNextChildIDTx atomically generates the next child ID for a parent issue
@@ -11,8 +13,9 @@ import (
 // with any existing children in the issues table (to handle imports that bypass
 // the counter), increments, and upserts the counter.
 //
-// Returns the full child ID string (e.g., "parent-id.3").
-func GetNextChildIDTx(ctx context.Context, tx *sql.Tx, parentID string) (string, error) {
+// Returns the full child ID string (e.g., "parent-id.3"), joined with h's
+// separator.
+func GetNextChildIDTx(ctx context.Context, tx *sql.Tx, parentID string, h types.IDHierarchy) (string, error) {
 	var lastChild int
 	err := tx.QueryRowContext(ctx, "SELECT last_child FROM child_counters WHERE parent_id = ?", parentID).Scan(&lastChild)
 	if err == sql.ErrNoRows {
@@ -23,13 +26,14 @@ func GetNextChildIDTx(ctx context.Context, tx *sql.Tx, parentID string) (string,
 
 	// Check existing children to prevent overwrites after JSONL import (GH#2166).
 	// The counter may be stale if issues were imported without reconciling child_counters.
+	sep := h.Separator()
 	var maxExisting sql.NullInt64
 	err = tx.QueryRowContext(ctx, `
-		SELECT MAX(CAST(SUBSTRING_INDEX(id, '.', -1) AS UNSIGNED))
+		SELECT MAX(CAST(SUBSTRING_INDEX(id, ?, -1) AS UNSIGNED))
 		FROM issues
-		WHERE id LIKE CONCAT(?, '.%')
-		  AND id NOT LIKE CONCAT(?, '.%.%')
-	`, parentID, parentID).Scan(&maxExisting)
+		WHERE id LIKE CONCAT(?, ?, '%')
+		  AND id NOT LIKE CONCAT(?, ?, '%', ?, '%')
+	`, sep, parentID, sep, parentID, sep, sep).Scan(&maxExisting)
 	if err != nil {
 		return "", fmt.Errorf("get next child ID: scan existing children: %w", err)
 	}
@@ -46,5 +50,5 @@ func GetNextChildIDTx(ctx context.Context, tx *sql.Tx, parentID string) (string,
 		return "", fmt.Errorf("get next child ID: update counter: %w", err)
 	}
 
-	return fmt.Sprintf("%s.%d", parentID, nextChild), nil
+	return h.ChildID(parentID, nextChild), nil
 }
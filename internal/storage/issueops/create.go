@@ -17,6 +17,7 @@ type BatchContext struct {
 	CustomTypes     []string
 	ConfigPrefix    string
 	AllowedPrefixes string
+	IssueSeq        bool // Number new issues (issue_seq=true)
 	Opts            storage.BatchCreateOptions
 }
 
@@ -36,12 +37,17 @@ func NewBatchContext(ctx context.Context, tx *sql.Tx, opts storage.BatchCreateOp
 	}
 	var allowedPrefixes string
 	_ = tx.QueryRowContext(ctx, "SELECT value FROM config WHERE `key` = ?", "allowed_prefixes").Scan(&allowedPrefixes)
+	issueSeq, err := IsIssueSeqEnabledTx(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
 
 	return &BatchContext{
 		CustomStatuses:  customStatuses,
 		CustomTypes:     customTypes,
 		ConfigPrefix:    configPrefix,
 		AllowedPrefixes: allowedPrefixes,
+		IssueSeq:        issueSeq,
 		Opts:            opts,
 	}, nil
 }
@@ -57,6 +63,17 @@ func CreateIssueInTx(ctx context.Context, tx *sql.Tx, bc *BatchContext, issue *t
 
 	issueTable, eventTable := TableRouting(issue)
 
+	// A child ID is allocated in the same transaction as the insert, so
+	// concurrent creates under one parent conflict at commit (and retry)
+	// instead of both taking the same slot.
+	if issue.ID == "" && issue.ChildOf != "" {
+		var err error
+		issue.ID, err = GetNextChildIDTx(ctx, tx, issue.ChildOf, bc.Opts.Hierarchy)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Resolve prefix and generate ID if needed.
 	if issue.ID == "" {
 		prefix := bc.ConfigPrefix
@@ -68,7 +85,7 @@ func CreateIssueInTx(ctx context.Context, tx *sql.Tx, bc *BatchContext, issue *t
 			prefix = bc.ConfigPrefix + "-wisp"
 		}
 		var err error
-		issue.ID, err = GenerateIssueIDInTable(ctx, tx, issueTable, prefix, issue, actor)
+		issue.ID, err = GenerateIssueIDInTable(ctx, tx, issueTable, prefix, issue, actor, bc.Opts.Hierarchy)
 		if err != nil {
 			return fmt.Errorf("failed to generate issue ID: %w", err)
 		}
@@ -78,12 +95,20 @@ func CreateIssueInTx(ctx context.Context, tx *sql.Tx, bc *BatchContext, issue *t
 		}
 	}
 
-	if skip, err := CheckOrphan(ctx, tx, issue, issueTable, bc.Opts.OrphanHandling); err != nil {
+	if skip, err := CheckOrphan(ctx, tx, issue, issueTable, bc.Opts); err != nil {
 		return err
 	} else if skip {
 		return nil
 	}
 
+	// The sequence number is allocated in this transaction, so a failed
+	// create does not use one up.
+	if bc.IssueSeq && issueTable == "issues" {
+		if err := AssignIssueSeqTx(ctx, tx, issue); err != nil {
+			return err
+		}
+	}
+
 	isNew, err := InsertIssueIfNew(ctx, tx, issueTable, issue)
 	if err != nil {
 		return err
@@ -120,7 +145,7 @@ func CreateIssuesInTx(ctx context.Context, tx *sql.Tx, issues []*types.Issue, ac
 		return err
 	}
 
-	return ReconcileChildCounters(ctx, tx, issues)
+	return ReconcileChildCounters(ctx, tx, issues, opts.Hierarchy)
 }
 
 // PrepareIssueForInsert normalizes timestamps, validates, and computes the content hash.
@@ -178,16 +203,16 @@ func ValidateIssueIDPrefix(id, prefix, allowedPrefixes string) error {
 	return fmt.Errorf("%w: issue ID %s does not match configured prefix %s", storage.ErrPrefixMismatch, id, prefix)
 }
 
-// ParseHierarchicalID checks if an ID is hierarchical (e.g., "bd-abc.1")
-// and returns the parent ID and child number.
-func ParseHierarchicalID(id string) (parentID string, childNum int, ok bool) {
-	lastDot := strings.LastIndex(id, ".")
-	if lastDot == -1 {
+// ParseHierarchicalID checks if an ID is hierarchical under h (e.g.,
+// "bd-abc.1") and returns the parent ID and child number.
+func ParseHierarchicalID(id string, h types.IDHierarchy) (parentID string, childNum int, ok bool) {
+	lastSep := strings.LastIndex(id, h.Separator())
+	if lastSep == -1 {
 		return "", 0, false
 	}
-	parentID = id[:lastDot]
+	parentID = id[:lastSep]
 	var num int
-	if _, err := fmt.Sscanf(id[lastDot+1:], "%d", &num); err != nil {
+	if _, err := fmt.Sscanf(id[lastSep+1:], "%d", &num); err != nil {
 		return "", 0, false
 	}
 	return parentID, num, true
@@ -207,11 +232,11 @@ func AllEphemeral(issues []*types.Issue) bool {
 // Returns (skip=true, nil) if the issue should be skipped.
 //
 //nolint:gosec // G201: table is a hardcoded constant
-func CheckOrphan(ctx context.Context, tx *sql.Tx, issue *types.Issue, issueTable string, handling storage.OrphanHandling) (skip bool, err error) {
+func CheckOrphan(ctx context.Context, tx *sql.Tx, issue *types.Issue, issueTable string, opts storage.BatchCreateOptions) (skip bool, err error) {
 	if issue.ID == "" {
 		return false, nil
 	}
-	parentID, _, ok := ParseHierarchicalID(issue.ID)
+	parentID, _, ok := ParseHierarchicalID(issue.ID, opts.Hierarchy)
 	if !ok {
 		return false, nil
 	}
@@ -224,7 +249,7 @@ func CheckOrphan(ctx context.Context, tx *sql.Tx, issue *types.Issue, issueTable
 		return false, nil
 	}
 
-	switch handling {
+	switch opts.OrphanHandling {
 	case storage.OrphanStrict:
 		return false, fmt.Errorf("parent issue %s does not exist (strict mode)", parentID)
 	case storage.OrphanSkip:
@@ -356,10 +381,10 @@ func PersistDependencies(ctx context.Context, tx *sql.Tx, issues []*types.Issue,
 
 // ReconcileChildCounters updates child_counters so that subsequent
 // bd create --parent doesn't collide with imported hierarchical IDs.
-func ReconcileChildCounters(ctx context.Context, tx *sql.Tx, issues []*types.Issue) error {
+func ReconcileChildCounters(ctx context.Context, tx *sql.Tx, issues []*types.Issue, h types.IDHierarchy) error {
 	childMaxMap := make(map[string]int)
 	for _, issue := range issues {
-		if parentID, childNum, ok := ParseHierarchicalID(issue.ID); ok {
+		if parentID, childNum, ok := ParseHierarchicalID(issue.ID, h); ok {
 			if childNum > childMaxMap[parentID] {
 				childMaxMap[parentID] = childNum
 			}
@@ -13,6 +13,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/steveyegge/beads/internal/config"
 	"github.com/steveyegge/beads/internal/idgen"
@@ -51,7 +52,7 @@ func InsertIssueIntoTable(ctx context.Context, tx *sql.Tx, table string, issue *
 			event_kind, actor, target, payload,
 			await_type, await_id, timeout_ns, waiters,
 			hook_bead, role_bead, agent_state, last_activity, role_type, rig,
-			due_at, defer_until, metadata
+			due_at, defer_until, metadata, seq
 		) VALUES (
 			?, ?, ?, ?, ?, ?, ?,
 			?, ?, ?, ?, ?,
@@ -62,7 +63,7 @@ func InsertIssueIntoTable(ctx context.Context, tx *sql.Tx, table string, issue *
 			?, ?, ?, ?,
 			?, ?, ?, ?,
 			?, ?, ?, ?, ?, ?,
-			?, ?, ?
+			?, ?, ?, ?
 		)
 		ON DUPLICATE KEY UPDATE
 			content_hash = VALUES(content_hash),
@@ -92,7 +93,7 @@ func InsertIssueIntoTable(ctx context.Context, tx *sql.Tx, table string, issue *
 		issue.EventKind, issue.Actor, issue.Target, issue.Payload,
 		issue.AwaitType, issue.AwaitID, issue.Timeout.Nanoseconds(), FormatJSONStringArray(issue.Waiters),
 		issue.HookBead, issue.RoleBead, issue.AgentState, issue.LastActivity, issue.RoleType, issue.Rig,
-		issue.DueAt, issue.DeferUntil, JSONMetadata(issue.Metadata),
+		issue.DueAt, issue.DeferUntil, JSONMetadata(issue.Metadata), NullIntVal(issue.Seq),
 	)
 	if err != nil {
 		return fmt.Errorf("insert issue into %s: %w", table, err)
@@ -118,7 +119,7 @@ func RecordEventInTable(ctx context.Context, tx *sql.Tx, table, issueID string,
 // in the specified table. Supports counter mode for non-ephemeral issues.
 //
 //nolint:gosec // G201: table is a hardcoded constant
-func GenerateIssueIDInTable(ctx context.Context, tx *sql.Tx, table, prefix string, issue *types.Issue, actor string) (string, error) {
+func GenerateIssueIDInTable(ctx context.Context, tx *sql.Tx, table, prefix string, issue *types.Issue, actor string, h types.IDHierarchy) (string, error) {
 	// Counter mode only applies to the issues table (not wisps).
 	if table == "issues" {
 		counterMode, err := IsCounterModeTx(ctx, tx)
@@ -130,8 +131,16 @@ func GenerateIssueIDInTable(ctx context.Context, tx *sql.Tx, table, prefix strin
 		}
 	}
 
+	scheme, err := IDSchemeTx(ctx, tx)
+	if err != nil {
+		return "", err
+	}
+	if scheme == IDSchemeULID {
+		return GenerateULIDIDInTable(ctx, tx, table, prefix, issue)
+	}
+
 	// Default hash-based ID generation
-	baseLength, err := GetAdaptiveIDLengthTx(ctx, tx, table, prefix)
+	baseLength, err := GetAdaptiveIDLengthTx(ctx, tx, table, prefix, h)
 	if err != nil {
 		baseLength = 6
 	}
@@ -160,6 +169,59 @@ func GenerateIssueIDInTable(ctx context.Context, tx *sql.Tx, table, prefix strin
 	return "", fmt.Errorf("failed to generate unique ID after trying lengths %d-%d with 10 nonces each", baseLength, maxLength)
 }
 
+// IDSchemeConfigKey is the database config key choosing the suffix of
+// generated IDs: IDSchemeHash (the default) or IDSchemeULID. It only affects
+// new IDs; issue_id_mode=counter takes precedence for the issues table.
+const IDSchemeConfigKey = "id.scheme"
+
+const (
+	IDSchemeHash = "hash"
+	IDSchemeULID = "ulid"
+)
+
+// IDSchemeTx returns the configured id.scheme, IDSchemeHash when unset.
+func IDSchemeTx(ctx context.Context, tx *sql.Tx) (string, error) {
+	var scheme string
+	err := tx.QueryRowContext(ctx, "SELECT value FROM config WHERE `key` = ?", IDSchemeConfigKey).Scan(&scheme)
+	if err != nil && err != sql.ErrNoRows {
+		return "", fmt.Errorf("failed to read %s config: %w", IDSchemeConfigKey, err)
+	}
+	switch scheme = strings.ToLower(strings.TrimSpace(scheme)); scheme {
+	case "", IDSchemeHash:
+		return IDSchemeHash, nil
+	case IDSchemeULID:
+		return IDSchemeULID, nil
+	default:
+		return "", fmt.Errorf("invalid %s %q (expected %q or %q)", IDSchemeConfigKey, scheme, IDSchemeHash, IDSchemeULID)
+	}
+}
+
+// GenerateULIDIDInTable generates a time-sortable ID from the issue's
+// creation time, so ORDER BY id approximates creation order. A collision
+// would need the same millisecond and 80 random bits, but is checked anyway.
+//
+//nolint:gosec // G201: table is a hardcoded constant
+func GenerateULIDIDInTable(ctx context.Context, tx *sql.Tx, table, prefix string, issue *types.Issue) (string, error) {
+	createdAt := issue.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	for attempt := 0; attempt < 3; attempt++ {
+		candidate, err := idgen.GenerateULIDID(prefix, createdAt)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate ULID: %w", err)
+		}
+		var count int
+		if err := tx.QueryRowContext(ctx, fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE id = ?`, table), candidate).Scan(&count); err != nil {
+			return "", fmt.Errorf("failed to check for ID collision: %w", err)
+		}
+		if count == 0 {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("failed to generate a unique ULID for prefix %q", prefix)
+}
+
 // IsCounterModeTx checks whether issue_id_mode=counter is configured.
 func IsCounterModeTx(ctx context.Context, tx *sql.Tx) (bool, error) {
 	var idMode string
@@ -257,17 +319,18 @@ func SeedCounterFromExistingIssuesTx(ctx context.Context, tx *sql.Tx, prefix str
 	return nil
 }
 
-// GetAdaptiveIDLengthTx returns the appropriate hash length based on database size.
+// GetAdaptiveIDLengthTx returns the appropriate hash length based on database
+// size. Child IDs under h do not count.
 //
 //nolint:gosec // G201: table is a hardcoded constant
-func GetAdaptiveIDLengthTx(ctx context.Context, tx *sql.Tx, table, prefix string) (int, error) {
+func GetAdaptiveIDLengthTx(ctx context.Context, tx *sql.Tx, table, prefix string, h types.IDHierarchy) (int, error) {
 	var count int
 	err := tx.QueryRowContext(ctx, fmt.Sprintf(`
 		SELECT COUNT(*)
 		FROM %s
 		WHERE id LIKE CONCAT(?, '-%%')
-		  AND INSTR(SUBSTRING(id, LENGTH(?) + 2), '.') = 0
-	`, table), prefix, prefix).Scan(&count)
+		  AND INSTR(SUBSTRING(id, LENGTH(?) + 2), ?) = 0
+	`, table), prefix, prefix, h.Separator()).Scan(&count)
 	if err != nil {
 		return 6, err
 	}
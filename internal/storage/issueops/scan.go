@@ -20,7 +20,7 @@ const IssueSelectColumns = `id, content_hash, title, description, design, accept
 	       hook_bead, role_bead, agent_state, last_activity, role_type, rig, mol_type,
 	       event_kind, actor, target, payload,
 	       due_at, defer_until,
-	       quality_score, work_type, source_system, metadata`
+	       quality_score, work_type, source_system, metadata, seq`
 
 // IssueScanner is the common interface between *sql.Row and *sql.Rows,
 // allowing a single scan function to work with both single-row and
@@ -35,7 +35,7 @@ func ScanIssueFrom(s IssueScanner) (*types.Issue, error) {
 	var issue types.Issue
 	var createdAtStr, updatedAtStr sql.NullString // TEXT columns - must parse manually
 	var closedAt, compactedAt, lastActivity, dueAt, deferUntil sql.NullTime
-	var estimatedMinutes, originalSize, timeoutNs sql.NullInt64
+	var estimatedMinutes, originalSize, timeoutNs, seq sql.NullInt64
 	var createdBy sql.NullString
 	var assignee, externalRef, specID, compactedAtCommit, owner sql.NullString
 	var contentHash, sourceRepo, closeReason sql.NullString
@@ -58,7 +58,7 @@ func ScanIssueFrom(s IssueScanner) (*types.Issue, error) {
 		&hookBead, &roleBead, &agentState, &lastActivity, &roleType, &rig, &molType,
 		&eventKind, &actor, &target, &payload,
 		&dueAt, &deferUntil,
-		&qualityScore, &workType, &sourceSystem, &metadata,
+		&qualityScore, &workType, &sourceSystem, &metadata, &seq,
 	); err != nil {
 		return nil, err
 	}
@@ -97,6 +97,9 @@ func ScanIssueFrom(s IssueScanner) (*types.Issue, error) {
 	if specID.Valid {
 		issue.SpecID = specID.String
 	}
+	if seq.Valid {
+		issue.Seq = int(seq.Int64)
+	}
 	if compactedAt.Valid {
 		issue.CompactedAt = &compactedAt.Time
 	}
@@ -0,0 +1,85 @@
+package issueops
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/steveyegge/beads/internal/types"
+)
+
+// IssueSeqConfigKey is the database config key that turns on per-repo
+// sequence numbers ("true"). Hashed IDs stay the primary key either way.
+const IssueSeqConfigKey = "issue_seq"
+
+// issueSeqCounter names the issue_seq_counter row used for the issues table.
+const issueSeqCounter = "issues"
+
+// IsIssueSeqEnabledTx checks whether issue_seq=true is configured.
+func IsIssueSeqEnabledTx(ctx context.Context, tx *sql.Tx) (bool, error) {
+	var value string
+	err := tx.QueryRowContext(ctx, "SELECT value FROM config WHERE `key` = ?", IssueSeqConfigKey).Scan(&value)
+	if err != nil && err != sql.ErrNoRows {
+		return false, fmt.Errorf("failed to read %s config: %w", IssueSeqConfigKey, err)
+	}
+	return value == "true", nil
+}
+
+// AssignIssueSeqTx gives an issue about to be inserted into the issues table
+// the next sequence number. Issues that already carry a number, or that
+// already exist (re-imports and upserts), keep the one they have, so the
+// counter only advances for genuinely new issues.
+func AssignIssueSeqTx(ctx context.Context, tx *sql.Tx, issue *types.Issue) error {
+	if issue.Seq != 0 {
+		return nil
+	}
+	if issue.ID != "" {
+		var existing sql.NullInt64
+		err := tx.QueryRowContext(ctx, "SELECT seq FROM issues WHERE id = ?", issue.ID).Scan(&existing)
+		if err == nil {
+			issue.Seq = int(existing.Int64)
+			return nil
+		}
+		if err != sql.ErrNoRows {
+			return fmt.Errorf("failed to read sequence number of %s: %w", issue.ID, err)
+		}
+	}
+	seq, err := NextIssueSeqTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+	issue.Seq = seq
+	return nil
+}
+
+// NextIssueSeqTx atomically increments and returns the next sequence number.
+// The counter row is seeded from the highest number already in use, so
+// numbers continue after imports. Because it is updated inside the caller's
+// transaction, a rolled-back create leaves no gap, and the unique index on
+// issues.seq rejects duplicates if concurrent creates race.
+func NextIssueSeqTx(ctx context.Context, tx *sql.Tx) (int, error) {
+	res, err := tx.ExecContext(ctx, "UPDATE issue_seq_counter SET last_seq = last_seq + 1 WHERE name = ?", issueSeqCounter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment issue sequence: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check rows affected for issue sequence: %w", err)
+	}
+	if rowsAffected == 0 {
+		var maxSeq sql.NullInt64
+		if err := tx.QueryRowContext(ctx, "SELECT MAX(seq) FROM issues").Scan(&maxSeq); err != nil {
+			return 0, fmt.Errorf("failed to seed issue sequence: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO issue_seq_counter (name, last_seq) VALUES (?, ?)",
+			issueSeqCounter, maxSeq.Int64+1); err != nil {
+			return 0, fmt.Errorf("failed to insert initial issue sequence: %w", err)
+		}
+	}
+
+	var seq int
+	if err := tx.QueryRowContext(ctx, "SELECT last_seq FROM issue_seq_counter WHERE name = ?", issueSeqCounter).Scan(&seq); err != nil {
+		return 0, fmt.Errorf("failed to read issue sequence: %w", err)
+	}
+	return seq, nil
+}
@@ -27,6 +27,10 @@ var ErrNotInitialized = errors.New("database not initialized")
 // ErrPrefixMismatch is returned when an issue ID does not match the configured prefix.
 var ErrPrefixMismatch = errors.New("prefix mismatch")
 
+// ErrIdempotencyKeyUsed is returned when a create names an idempotency key
+// that an earlier create already recorded. Nothing was created.
+var ErrIdempotencyKeyUsed = errors.New("idempotency key already used")
+
 // Storage is the interface satisfied by *dolt.DoltStore.
 // Consumers depend on this interface rather than on the concrete type so that
 // alternative implementations (mocks, proxies, etc.) can be substituted.
@@ -40,13 +40,106 @@ func GenerateHashID(prefix, title, description string, created time.Time, worksp
 	return hash
 }
 
+// DefaultHierarchySeparator joins a parent ID and a child number in
+// hierarchical IDs unless hierarchy.separator says otherwise.
+const DefaultHierarchySeparator = "."
+
+// HierarchySafeSeparators are the characters hierarchy.separator may be.
+// They cannot occur in a prefix or hash, are not SQL LIKE wildcards, and
+// need no quoting in a shell word.
+const HierarchySafeSeparators = ".:~+^=@"
+
+// ValidateHierarchySeparator checks that sep is a single safe character.
+func ValidateHierarchySeparator(sep string) error {
+	if len(sep) != 1 || !strings.Contains(HierarchySafeSeparators, sep) {
+		return fmt.Errorf("invalid hierarchy separator %q (expected one of %s)", sep,
+			strings.Join(strings.Split(HierarchySafeSeparators, ""), " "))
+	}
+	return nil
+}
+
+// IDHierarchy generates and parses hierarchical child IDs for one
+// workspace's hierarchy.separator. The zero value uses
+// DefaultHierarchySeparator.
+type IDHierarchy struct {
+	sep string
+}
+
+// NewIDHierarchy returns the IDHierarchy for sep. An empty sep means the
+// default separator.
+func NewIDHierarchy(sep string) (IDHierarchy, error) {
+	if sep == "" {
+		return IDHierarchy{}, nil
+	}
+	if err := ValidateHierarchySeparator(sep); err != nil {
+		return IDHierarchy{}, err
+	}
+	return IDHierarchy{sep: sep}, nil
+}
+
+// Separator returns the separator between a parent ID and a child number.
+func (h IDHierarchy) Separator() string {
+	if h.sep == "" {
+		return DefaultHierarchySeparator
+	}
+	return h.sep
+}
+
+// ChildID creates a hierarchical child ID: parentID, the separator, and
+// childNumber (e.g., "bd-af78e9a2.1", "bd-af78e9a2.1.2").
+func (h IDHierarchy) ChildID(parentID string, childNumber int) string {
+	return fmt.Sprintf("%s%s%d", parentID, h.Separator(), childNumber)
+}
+
+// Parse extracts the root ID, parent ID and depth from a hierarchical ID.
+// See ParseHierarchicalID.
+func (h IDHierarchy) Parse(id string) (rootID, parentID string, depth int) {
+	sep := h.Separator()
+
+	// Count separators to determine depth
+	depth = strings.Count(id, sep)
+
+	// Root ID (no parent)
+	if depth == 0 {
+		return id, "", 0
+	}
+
+	// Root ID is everything before the first separator, parent ID
+	// everything before the last.
+	rootID = id[:strings.Index(id, sep)]
+	parentID = id[:strings.LastIndex(id, sep)]
+
+	return rootID, parentID, depth
+}
+
+// IsChildOf reports whether id is a descendant of parentID at any depth.
+func (h IDHierarchy) IsChildOf(id, parentID string) bool {
+	return strings.HasPrefix(id, parentID+h.Separator())
+}
+
+// CheckDepth validates that adding a child to parentID won't exceed
+// maxDepth. See CheckHierarchyDepth.
+func (h IDHierarchy) CheckDepth(parentID string, maxDepth int) error {
+	if maxDepth < 1 {
+		maxDepth = MaxHierarchyDepth
+	}
+
+	// Count separators to determine current depth
+	depth := strings.Count(parentID, h.Separator())
+
+	if depth >= maxDepth {
+		return fmt.Errorf("maximum hierarchy depth (%d) exceeded for parent %s", maxDepth, parentID)
+	}
+	return nil
+}
+
 // GenerateChildID creates a hierarchical child ID.
 // Format: parent.N (e.g., "bd-af78e9a2.1", "bd-af78e9a2.1.2")
 //
 // Max depth: 3 levels (prevents over-decomposition)
 // Max breadth: Unlimited (tested up to 347 children)
 func GenerateChildID(parentID string, childNumber int) string {
-	return fmt.Sprintf("%s.%d", parentID, childNumber)
+	return IDHierarchy{}.ChildID(parentID, childNumber)
 }
 
 // ParseHierarchicalID extracts the parent ID and depth from a hierarchical ID.
@@ -58,35 +151,12 @@ func GenerateChildID(parentID string, childNumber int) string {
 //	"bd-af78e9a2.1" → ("bd-af78e9a2", "bd-af78e9a2", 1)
 //	"bd-af78e9a2.1.2" → ("bd-af78e9a2", "bd-af78e9a2.1", 2)
 func ParseHierarchicalID(id string) (rootID, parentID string, depth int) {
-	// Count dots to determine depth
-	depth = 0
-	lastDot := -1
-	for i, ch := range id {
-		if ch == '.' {
-			depth++
-			lastDot = i
-		}
-	}
-
-	// Root ID (no parent)
-	if depth == 0 {
-		return id, "", 0
-	}
-
-	// Find root ID (everything before first dot)
-	firstDot := -1
-	for i, ch := range id {
-		if ch == '.' {
-			firstDot = i
-			break
-		}
-	}
-	rootID = id[:firstDot]
-
-	// Parent ID (everything before last dot)
-	parentID = id[:lastDot]
+	return IDHierarchy{}.Parse(id)
+}
 
-	return rootID, parentID, depth
+// IsChildOf reports whether id is a descendant of parentID at any depth.
+func IsChildOf(id, parentID string) bool {
+	return IDHierarchy{}.IsChildOf(id, parentID)
 }
 
 // ExtractPrefix returns the prefix portion of a bead ID (everything before
@@ -108,20 +178,5 @@ const MaxHierarchyDepth = 3
 // Returns an error if the depth would be exceeded.
 // If maxDepth < 1, it defaults to MaxHierarchyDepth.
 func CheckHierarchyDepth(parentID string, maxDepth int) error {
-	if maxDepth < 1 {
-		maxDepth = MaxHierarchyDepth
-	}
-
-	// Count dots to determine current depth
-	depth := 0
-	for _, ch := range parentID {
-		if ch == '.' {
-			depth++
-		}
-	}
-
-	if depth >= maxDepth {
-		return fmt.Errorf("maximum hierarchy depth (%d) exceeded for parent %s", maxDepth, parentID)
-	}
-	return nil
+	return IDHierarchy{}.CheckDepth(parentID, maxDepth)
 }
@@ -210,6 +210,47 @@ func BenchmarkGenerateChildID(b *testing.B) {
 	}
 }
 
+func TestIDHierarchy(t *testing.T) {
+	for _, sep := range []string{"/", "-", "_", "%", "::", " "} {
+		if _, err := NewIDHierarchy(sep); err == nil {
+			t.Errorf("NewIDHierarchy(%q) succeeded, want error", sep)
+		}
+	}
+	def, err := NewIDHierarchy("")
+	if err != nil {
+		t.Fatalf("NewIDHierarchy(\"\"): %v", err)
+	}
+	if got := def.Separator(); got != DefaultHierarchySeparator {
+		t.Fatalf("default separator = %q, want %q", got, DefaultHierarchySeparator)
+	}
+
+	h, err := NewIDHierarchy(":")
+	if err != nil {
+		t.Fatalf("NewIDHierarchy(\":\"): %v", err)
+	}
+	if got := h.ChildID("bd-v1.2", 3); got != "bd-v1.2:3" {
+		t.Errorf("ChildID = %q, want bd-v1.2:3", got)
+	}
+	root, parent, depth := h.Parse("bd-v1.2:3:4")
+	if root != "bd-v1.2" || parent != "bd-v1.2:3" || depth != 2 {
+		t.Errorf("Parse = (%q, %q, %d), want (bd-v1.2, bd-v1.2:3, 2)", root, parent, depth)
+	}
+	if !h.IsChildOf("bd-abc:1:2", "bd-abc") || h.IsChildOf("bd-abc.1", "bd-abc") {
+		t.Errorf("IsChildOf should only follow the hierarchy's separator")
+	}
+	if err := h.CheckDepth("bd-abc:1:2", 3); err != nil {
+		t.Errorf("CheckDepth(depth 2, max 3) = %v, want nil", err)
+	}
+	if err := h.CheckDepth("bd-abc:1:2:3", 3); err == nil {
+		t.Errorf("CheckDepth(depth 3, max 3) = nil, want error")
+	}
+
+	// The package functions keep the default separator.
+	if got := GenerateChildID("bd-v1", 3); got != "bd-v1.3" {
+		t.Errorf("GenerateChildID = %q, want bd-v1.3", got)
+	}
+}
+
 func TestCheckHierarchyDepth(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -8,6 +8,7 @@ import (
 	"hash"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // Issue represents a trackable work item.
@@ -15,7 +16,8 @@ import (
 type Issue struct {
 	// ===== Core Identification =====
 	ID          string `json:"id"`
-	ContentHash string `json:"-"` // Internal: SHA256 of canonical content
+	ContentHash string `json:"-"`             // Internal: SHA256 of canonical content
+	Seq         int    `json:"seq,omitempty"` // Friendly per-repo number (#42) when issue_seq is enabled; not part of the content hash
 
 	// ===== Issue Content =====
 	Title              string `json:"title"`
@@ -66,11 +68,14 @@ type Issue struct {
 	SourceRepo     string `json:"-"` // Which repo owns this issue (multi-repo support)
 	IDPrefix       string `json:"-"` // Override prefix for ID generation (appends to config prefix)
 	PrefixOverride string `json:"-"` // Completely replace config prefix (for cross-rig creation)
+	ChildOf        string `json:"-"` // Allocate the ID as this parent's next child, in the create transaction
 
 	// ===== Relational Data (populated for export/import) =====
-	Labels       []string      `json:"labels,omitempty"`
-	Dependencies []*Dependency `json:"dependencies,omitempty"`
-	Comments     []*Comment    `json:"comments,omitempty"`
+	Labels       []string          `json:"labels,omitempty"`
+	Dependencies []*Dependency     `json:"dependencies,omitempty"`
+	Comments     []*Comment        `json:"comments,omitempty"`
+	Meta         map[string]string `json:"meta,omitempty"` // Key/value data from 'bd meta'
+	Attachments  []*Attachment     `json:"attachments,omitempty"`
 
 	// ===== Messaging Fields (inter-agent communication) =====
 	Sender    string   `json:"sender,omitempty"`    // Who sent this (for messages)
@@ -260,6 +265,28 @@ func (w hashFieldWriter) entityRef(e *EntityRef) {
 	}
 }
 
+// MaxTitleLength is the longest title, in characters, that fits the
+// issues.title VARCHAR column. The Dolt schema is built from it.
+const MaxTitleLength = 500
+
+// ValidateTitleLength rejects titles longer than MaxTitleLength. Length is
+// counted in characters, as the VARCHAR column counts it, not bytes.
+func ValidateTitleLength(title string) error {
+	if n := utf8.RuneCountInString(title); n > MaxTitleLength {
+		return fmt.Errorf("title must be %d characters or less (got %d)", MaxTitleLength, n)
+	}
+	return nil
+}
+
+// ValidateTitle rejects titles that are empty or only whitespace, and titles
+// longer than MaxTitleLength.
+func ValidateTitle(title string) error {
+	if strings.TrimSpace(title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	return ValidateTitleLength(title)
+}
+
 // Validate checks if the issue has valid field values (built-in statuses only)
 func (i *Issue) Validate() error {
 	return i.ValidateWithCustomStatuses(nil)
@@ -274,11 +301,8 @@ func (i *Issue) ValidateWithCustomStatuses(customStatuses []string) error {
 // ValidateWithCustom checks if the issue has valid field values,
 // allowing custom statuses and types in addition to built-in ones.
 func (i *Issue) ValidateWithCustom(customStatuses, customTypes []string) error {
-	if len(i.Title) == 0 {
-		return fmt.Errorf("title is required")
-	}
-	if len(i.Title) > 500 {
-		return fmt.Errorf("title must be 500 characters or less (got %d)", len(i.Title))
+	if err := ValidateTitle(i.Title); err != nil {
+		return err
 	}
 	if i.Priority < 0 || i.Priority > 4 {
 		return fmt.Errorf("priority must be between 0 and 4 (got %d)", i.Priority)
@@ -317,11 +341,8 @@ func (i *Issue) ValidateWithCustom(customStatuses, customTypes []string) error {
 // since the source repo already validated them when the issue was created.
 // This implements "trust the chain below you" from the HOP federation model.
 func (i *Issue) ValidateForImport(customStatuses []string) error {
-	if len(i.Title) == 0 {
-		return fmt.Errorf("title is required")
-	}
-	if len(i.Title) > 500 {
-		return fmt.Errorf("title must be 500 characters or less (got %d)", len(i.Title))
+	if err := ValidateTitle(i.Title); err != nil {
+		return err
 	}
 	if i.Priority < 0 || i.Priority > 4 {
 		return fmt.Errorf("priority must be between 0 and 4 (got %d)", i.Priority)
@@ -664,6 +685,7 @@ type IssueDetails struct {
 	Dependencies []*IssueWithDependencyMetadata `json:"dependencies,omitempty"`
 	Dependents   []*IssueWithDependencyMetadata `json:"dependents,omitempty"`
 	Comments     []*Comment                     `json:"comments,omitempty"`
+	Links        []*IssueLink                   `json:"links,omitempty"`
 	Parent       *string                        `json:"parent,omitempty"`
 
 	// Epic progress fields (populated only for issue_type=epic with children)
@@ -835,6 +857,70 @@ type Comment struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// IssueLink associates an issue with a git commit or a URL (see 'bd link').
+type IssueLink struct {
+	ID        string    `json:"id"`
+	IssueID   string    `json:"issue_id"`
+	Kind      LinkKind  `json:"kind"`
+	Target    string    `json:"target"` // Full commit SHA or URL
+	Type      LinkType  `json:"type"`
+	CreatedBy string    `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// LinkKind is what an IssueLink points at.
+type LinkKind string
+
+// Link kinds
+const (
+	LinkKindCommit LinkKind = "commit"
+	LinkKindURL    LinkKind = "url"
+)
+
+// LinkType describes how the linked commit or URL relates to the issue.
+type LinkType string
+
+// Link types
+const (
+	LinkFixes    LinkType = "fixes"
+	LinkMentions LinkType = "mentions"
+)
+
+// IsValid reports whether t is a known link type.
+func (t LinkType) IsValid() bool {
+	return t == LinkFixes || t == LinkMentions
+}
+
+// Attachment references a file or URL related to an issue (see 'bd attach').
+// Only the reference is stored, never the contents. Size and SHA256 are
+// recorded for local files when they are attached.
+type Attachment struct {
+	IssueID   string    `json:"issue_id"`
+	Name      string    `json:"name"`
+	Location  string    `json:"url_or_path"` // URL, or path relative to the repository root
+	Size      *int64    `json:"size,omitempty"`
+	SHA256    string    `json:"sha256,omitempty"`
+	CreatedBy string    `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SavedView is a named set of 'bd list' filter arguments (see 'bd view').
+// Args are stored verbatim; values such as "me" are resolved at run time.
+type SavedView struct {
+	Name      string    `json:"name"`
+	Args      []string  `json:"args"`
+	CreatedBy string    `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AssigneeGroup is a named set of assignees that '@name' expands to in
+// 'bd assign' and 'bd list --assignee' (see 'bd group').
+type AssigneeGroup struct {
+	Name    string   `json:"name"`
+	Members []string `json:"members"`
+}
+
 // Event represents an audit trail entry
 type Event struct {
 	ID        string    `json:"id"`
@@ -910,17 +996,26 @@ type Statistics struct {
 	BlockedIssues           int     `json:"blocked_issues"`
 	DeferredIssues          int     `json:"deferred_issues"` // Issues on ice
 	ReadyIssues             int     `json:"ready_issues"`
-	PinnedIssues            int     `json:"pinned_issues"` // Persistent issues
+	PinnedIssues            int     `json:"pinned_issues"`             // Persistent issues
+	ArchivedIssues          int     `json:"archived_issues,omitempty"` // Moved to issues_archive; only set when requested
 	EpicsEligibleForClosure int     `json:"epics_eligible_for_closure"`
 	AverageLeadTime         float64 `json:"average_lead_time_hours"`
 }
 
+// HealthCounts holds tracker-health numbers that complement Statistics.
+type HealthCounts struct {
+	Orphans    int        `json:"orphans"`               // Dotted child IDs whose parent issue is missing
+	Overdue    int        `json:"overdue"`               // Not closed and past due_at
+	OldestOpen *time.Time `json:"oldest_open,omitempty"` // created_at of the oldest issue not closed or pinned
+}
+
 // IssueFilter is used to filter issue queries
 type IssueFilter struct {
 	Status       *Status
 	Priority     *int
 	IssueType    *IssueType
 	Assignee     *string
+	AssigneeIn   []string // Any of these assignees, e.g. the members of an @group
 	Labels       []string // AND semantics: issue must have ALL these labels
 	LabelsAny    []string // OR semantics: issue must have AT LEAST ONE of these labels
 	LabelPattern string   // Glob pattern for label matching (e.g., "tech-*")
@@ -993,6 +1088,72 @@ type IssueFilter struct {
 	// Metadata field filtering (GH#1406)
 	MetadataFields map[string]string // Top-level key=value equality; AND semantics (all must match)
 	HasMetadataKey string            // Existence check: issue has this top-level key set (non-null)
+
+	// Filter expression, e.g. "status=open AND priority<=1" (see internal/query).
+	// The store compiles it to a parameterized WHERE clause; AND-ed with the rest.
+	Expr string
+
+	// Ordering: nil keeps the store's default order (priority, then newest)
+	Sort []SortKey
+}
+
+// SortKey is one key of a multi-key sort, as given to 'bd list --sort'.
+type SortKey struct {
+	Field string // One of SortFields
+	Desc  bool
+}
+
+// SortFields lists the fields accepted by --sort, each mapped to whether it
+// sorts descending when no direction is given (timestamps: newest first).
+var SortFields = map[string]bool{
+	"priority": false,
+	"created":  true,
+	"updated":  true,
+	"closed":   true,
+	"status":   false,
+	"id":       false,
+	"title":    false,
+	"type":     false,
+	"assignee": false,
+}
+
+// sortFieldNames is SortFields in the order shown in error messages.
+const sortFieldNames = "priority, created, updated, closed, status, id, title, type, assignee"
+
+// ParseSortKeys parses a comma-separated --sort value such as
+// "priority:asc,updated:desc". A key without a direction uses the field's
+// default from SortFields.
+func ParseSortKeys(spec string) ([]SortKey, error) {
+	var keys []SortKey
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		field, dir, hasDir := strings.Cut(part, ":")
+		field = strings.ToLower(strings.TrimSpace(field))
+		desc, ok := SortFields[field]
+		if !ok {
+			return nil, fmt.Errorf("invalid sort field %q (valid: %s)", field, sortFieldNames)
+		}
+		if seen[field] {
+			return nil, fmt.Errorf("sort field %q given more than once", field)
+		}
+		seen[field] = true
+		if hasDir {
+			switch strings.ToLower(strings.TrimSpace(dir)) {
+			case "asc":
+				desc = false
+			case "desc":
+				desc = true
+			default:
+				return nil, fmt.Errorf("invalid sort direction %q for %s (want asc or desc)", dir, field)
+			}
+		}
+		keys = append(keys, SortKey{Field: field, Desc: desc})
+	}
+	return keys, nil
 }
 
 // SortPolicy determines how ready work is ordered
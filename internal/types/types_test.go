@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 	"time"
 )
@@ -193,6 +194,49 @@ func TestIssueValidation(t *testing.T) {
 	}
 }
 
+func TestValidateTitleLength(t *testing.T) {
+	tests := []struct {
+		name    string
+		title   string
+		wantErr bool
+	}{
+		{"at the limit", strings.Repeat("a", MaxTitleLength), false},
+		{"one over", strings.Repeat("a", MaxTitleLength+1), true},
+		// Characters, not bytes: 500 two-byte runes still fit the column
+		{"multibyte at the limit", strings.Repeat("é", MaxTitleLength), false},
+		{"multibyte one over", strings.Repeat("é", MaxTitleLength+1), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTitleLength(tt.title)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateTitleLength() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), "(got 501)") {
+				t.Errorf("error %q should report the current length", err)
+			}
+		})
+	}
+}
+
+func TestValidateTitle(t *testing.T) {
+	for _, title := range []string{"", " ", "\t\n "} {
+		if err := ValidateTitle(title); err == nil || err.Error() != "title is required" {
+			t.Errorf("ValidateTitle(%q) = %v, want title is required", title, err)
+		}
+		issue := &Issue{Title: title, Status: StatusOpen, Priority: 2, IssueType: TypeTask}
+		if err := issue.Validate(); err == nil {
+			t.Errorf("Validate() accepted title %q", title)
+		}
+	}
+	if err := ValidateTitle(" Fix login "); err != nil {
+		t.Errorf("ValidateTitle rejected a real title: %v", err)
+	}
+	if err := ValidateTitle(strings.Repeat("a", MaxTitleLength+1)); err == nil {
+		t.Error("ValidateTitle should still enforce MaxTitleLength")
+	}
+}
+
 func TestStatusIsValid(t *testing.T) {
 	tests := []struct {
 		status Status
@@ -1386,3 +1430,28 @@ func TestComputeContentHashWithValidations(t *testing.T) {
 		t.Error("Expected different hash when Score is added")
 	}
 }
+
+func TestParseSortKeys(t *testing.T) {
+	keys, err := ParseSortKeys("priority:asc, updated:DESC,created")
+	if err != nil {
+		t.Fatalf("ParseSortKeys: %v", err)
+	}
+	want := []SortKey{{Field: "priority"}, {Field: "updated", Desc: true}, {Field: "created", Desc: true}}
+	if len(keys) != len(want) {
+		t.Fatalf("ParseSortKeys = %v, want %v", keys, want)
+	}
+	for i := range want {
+		if keys[i] != want[i] {
+			t.Errorf("key %d = %+v, want %+v", i, keys[i], want[i])
+		}
+	}
+
+	if keys, err := ParseSortKeys(""); err != nil || len(keys) != 0 {
+		t.Errorf("ParseSortKeys(\"\") = %v, %v; want no keys", keys, err)
+	}
+	for _, bad := range []string{"size", "priority:up", "priority,priority:desc", "id; DROP TABLE issues"} {
+		if _, err := ParseSortKeys(bad); err == nil {
+			t.Errorf("ParseSortKeys(%q) = nil error, want error", bad)
+		}
+	}
+}
@@ -58,3 +58,12 @@ func ShouldUseEmoji() bool {
 	// Default: use emoji only if stdout is a TTY
 	return IsTerminal()
 }
+
+// TerminalWidth returns the width of stdout in columns, or 0 if stdout is not
+// a terminal or its size can't be determined.
+func TerminalWidth() int {
+	if w, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && w > 0 {
+		return w
+	}
+	return 0
+}
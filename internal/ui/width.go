@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// DefaultWidth is the output width assumed when it can't be detected, e.g.
+// when stdout is piped and COLUMNS is unset.
+const DefaultWidth = 80
+
+// DetectWidth returns the width tables should fit: COLUMNS when set to a
+// positive number, else the width of the stdout terminal, else
+// DefaultWidth. Commands with a --width flag use that instead.
+func DetectWidth() int {
+	if n, err := strconv.Atoi(strings.TrimSpace(os.Getenv("COLUMNS"))); err == nil && n > 0 {
+		return n
+	}
+	if w := TerminalWidth(); w > 0 {
+		return w
+	}
+	return DefaultWidth
+}
+
+// DisplayWidth returns the number of terminal cells s occupies; CJK
+// characters and most emoji take two.
+func DisplayWidth(s string) int {
+	return runewidth.StringWidth(s)
+}
+
+// Truncate shortens s to at most width cells, ending in "…" when anything
+// was cut. It never splits a multibyte character.
+func Truncate(s string, width int) string {
+	if DisplayWidth(s) <= width {
+		return s
+	}
+	if width <= 0 {
+		return ""
+	}
+	return runewidth.Truncate(s, width, "…")
+}
+
+// PadRight pads s with spaces to width cells. Strings already that wide
+// are returned unchanged.
+func PadRight(s string, width int) string {
+	if pad := width - DisplayWidth(s); pad > 0 {
+		return s + strings.Repeat(" ", pad)
+	}
+	return s
+}
@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		width int
+		want  string
+	}{
+		{"fits", "Fix login", 20, "Fix login"},
+		{"ascii", "Fix the login redirect", 10, "Fix the l…"},
+		{"cjk fits exactly", "修复登录", 8, "修复登录"},
+		// Each CJK character is two cells; the ellipsis takes one, which
+		// leaves room for three characters but not a half of a fourth.
+		{"cjk", "修复登录重定向问题", 8, "修复登…"},
+		{"mixed", "bug: 修复登录", 8, "bug: 修…"},
+		{"emoji", "🚀🚀🚀🚀 launch", 6, "🚀🚀…"},
+		{"zero", "anything", 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Truncate(tt.in, tt.width)
+			if got != tt.want {
+				t.Errorf("Truncate(%q, %d) = %q, want %q", tt.in, tt.width, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("Truncate(%q, %d) split a character: %q", tt.in, tt.width, got)
+			}
+			if w := DisplayWidth(got); w > tt.width {
+				t.Errorf("Truncate(%q, %d) is %d cells wide", tt.in, tt.width, w)
+			}
+		})
+	}
+}
+
+func TestPadRight(t *testing.T) {
+	if got := PadRight("修复", 6); got != "修复  " {
+		t.Errorf("PadRight(修复, 6) = %q, want two spaces of padding", got)
+	}
+	if got := PadRight("toolong", 3); got != "toolong" {
+		t.Errorf("PadRight(toolong, 3) = %q, want it unchanged", got)
+	}
+}
+
+func TestDetectWidth(t *testing.T) {
+	t.Setenv("COLUMNS", "132")
+	if got := DetectWidth(); got != 132 {
+		t.Errorf("DetectWidth() with COLUMNS=132 = %d", got)
+	}
+	if TerminalWidth() > 0 {
+		t.Skip("stdout is a terminal")
+	}
+	t.Setenv("COLUMNS", "")
+	if got := DetectWidth(); got != DefaultWidth {
+		t.Errorf("DetectWidth() without COLUMNS = %d, want %d", got, DefaultWidth)
+	}
+}
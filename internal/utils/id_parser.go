@@ -4,6 +4,7 @@ package utils
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/steveyegge/beads/internal/storage"
@@ -26,12 +27,32 @@ func parseIssueID(input string, prefix string) string {
 	return prefix + input
 }
 
+// seqResolver is implemented by stores that can look issues up by their
+// per-repo sequence number.
+type seqResolver interface {
+	GetIssueIDBySeq(ctx context.Context, seq int) (string, error)
+}
+
+// parseIssueSeq parses a "#42"-style sequence reference.
+func parseIssueSeq(input string) (int, bool) {
+	digits, ok := strings.CutPrefix(input, "#")
+	if !ok || digits == "" {
+		return 0, false
+	}
+	seq, err := strconv.Atoi(digits)
+	if err != nil || seq <= 0 || strconv.Itoa(seq) != digits {
+		return 0, false
+	}
+	return seq, true
+}
+
 // ResolvePartialID resolves a potentially partial issue ID to a full ID.
 // Supports:
 // - Full IDs: "bd-a3f8e9" or "a3f8e9" → "bd-a3f8e9"
 // - Without hyphen: "bda3f8e9" or "wya3f8e9" → "bd-a3f8e9"
 // - Partial IDs: "a3f8" → "bd-a3f8e9" (if unique match)
 // - Hierarchical: "a3f8e9.1" → "bd-a3f8e9.1"
+// - Sequence numbers: "#42" → the issue numbered 42 (when issue_seq is on)
 //
 // Returns an error if:
 // - No issue found matching the ID
@@ -41,6 +62,14 @@ func ResolvePartialID(ctx context.Context, store storage.Storage, input string)
 		return "", fmt.Errorf("cannot resolve issue ID %q: storage is nil", input)
 	}
 
+	if seq, ok := parseIssueSeq(input); ok {
+		r, ok := store.(seqResolver)
+		if !ok {
+			return "", fmt.Errorf("cannot resolve %q: storage does not support sequence numbers", input)
+		}
+		return r.GetIssueIDBySeq(ctx, seq)
+	}
+
 	// Fast path: Use SearchIssues with exact ID filter (GH#942).
 	// This uses the same query path as "bd list --id", ensuring consistency.
 	// Previously we used GetIssue which could fail in cases where SearchIssues
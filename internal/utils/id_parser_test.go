@@ -424,6 +424,16 @@ func TestExtractIssuePrefix(t *testing.T) {
 			issueID:  "beads-vscode-1",
 			expected: "beads-vscode", // Last hyphen before numeric suffix
 		},
+		{
+			name:     "multi-part prefix with ULID",
+			issueID:  "web-app-01j9z3k4m5n6p7q8r9s0t1v2w3",
+			expected: "web-app", // id.scheme=ulid suffix is longer than any hash
+		},
+		{
+			name:     "hierarchical ULID",
+			issueID:  "my-app-01j9z3k4m5n6p7q8r9s0t1v2w3.2",
+			expected: "my-app",
+		},
 		{
 			name:     "web-app style prefix",
 			issueID:  "web-app-123",
@@ -924,6 +934,51 @@ func TestResolvePartialID_Wisp(t *testing.T) {
 	}
 }
 
+func TestResolvePartialID_Seq(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+
+	// Issues created before numbering is turned on stay unnumbered.
+	before := &types.Issue{ID: "bd-b4f0", Title: "Before", Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+	if err := store.CreateIssue(ctx, before, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetConfig(ctx, "issue_seq", "true"); err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, id := range []string{"bd-s1a1", "bd-s2b2"} {
+		issue := &types.Issue{ID: id, Title: "Numbered " + id, Status: types.StatusOpen, Priority: 2, IssueType: types.TypeTask}
+		if err := store.CreateIssue(ctx, issue, "test"); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+
+	for i, id := range ids {
+		issue, err := store.GetIssue(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if issue.Seq != i+1 {
+			t.Errorf("%s seq = %d; want %d", id, issue.Seq, i+1)
+		}
+		result, err := ResolvePartialID(ctx, store, fmt.Sprintf("#%d", i+1))
+		if err != nil {
+			t.Errorf("ResolvePartialID(#%d) unexpected error: %v", i+1, err)
+		}
+		if result != id {
+			t.Errorf("ResolvePartialID(#%d) = %q; want %q", i+1, result, id)
+		}
+	}
+	if issue, err := store.GetIssue(ctx, "bd-b4f0"); err != nil || issue.Seq != 0 {
+		t.Errorf("pre-existing issue seq = %v (err %v); want unnumbered", issue, err)
+	}
+	if _, err := ResolvePartialID(ctx, store, "#99"); err == nil {
+		t.Error("ResolvePartialID(#99) should fail for an unused number")
+	}
+}
+
 // TestResolvePartialID_TitleFalsePositive verifies that when the search query
 // matches an issue's title but NOT its ID, the in-memory filter correctly
 // rejects it. This is important because the optimization passes hashPart as
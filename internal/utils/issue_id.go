@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+
+	"github.com/steveyegge/beads/internal/idgen"
+	"github.com/steveyegge/beads/internal/types"
 )
 
 // ExtractIssuePrefix extracts the prefix from an issue ID like "bd-123" -> "bd"
@@ -12,6 +15,7 @@ import (
 //   - "web-app-a3f8e9" -> "web-app" (hash suffix with digits)
 //   - "my-cool-app-123" -> "my-cool-app" (numeric suffix)
 //   - "bd-a3f" -> "bd" (3-char hash)
+//   - "web-app-01j9z3k4m5n6p7q8r9s0t1v2w3" -> "web-app" (ULID, id.scheme=ulid)
 //
 // Falls back to first hyphen when suffix looks like an English word (4+ chars, no digits):
 //   - "vc-baseline-test" -> "vc" (word-like suffix: "test" is not a hash)
@@ -32,16 +36,18 @@ func ExtractIssuePrefix(issueID string) string {
 		return issueID[:lastIdx]
 	}
 
-	// Extract the base part before any dot (handle "123.1.2" -> check "123")
+	// Extract the base part before any hierarchy separator (handle "123.1.2"
+	// -> check "123"). None can occur in a hash, so any workspace's
+	// hierarchy.separator is handled without knowing which it is.
 	basePart := suffix
-	if dotIdx := strings.Index(suffix, "."); dotIdx > 0 {
-		basePart = suffix[:dotIdx]
+	if sepIdx := strings.IndexAny(suffix, types.HierarchySafeSeparators); sepIdx > 0 {
+		basePart = suffix[:sepIdx]
 	}
 
 	// Check if this looks like a valid issue ID suffix (numeric or hash-like)
 	// Use isLikelyHash which requires digits for 4+ char suffixes to avoid
 	// treating English words like "test", "gate", "part" as hash IDs
-	if isNumeric(basePart) || isLikelyHash(basePart) {
+	if isNumeric(basePart) || isLikelyHash(basePart) || idgen.IsULID(basePart) {
 		return issueID[:lastIdx]
 	}
 
@@ -0,0 +1,370 @@
+package beads
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/beads/internal/beads"
+	"github.com/steveyegge/beads/internal/config"
+	"github.com/steveyegge/beads/internal/export"
+	"github.com/steveyegge/beads/internal/hooks"
+	"github.com/steveyegge/beads/internal/storage"
+	"github.com/steveyegge/beads/internal/storage/dolt"
+	"github.com/steveyegge/beads/internal/utils"
+)
+
+// Store is a beads workspace opened for embedding. It performs the issue
+// operations behind bd create, update, close, list, show and export on the
+// same storage layer the CLI uses, so other Go programs need not shell out
+// to bd. Hooks registered with OnEvent run after each change.
+//
+// A Store is safe for concurrent use. Storage returns the lower-level
+// interface for everything else (dependencies, labels, ready work).
+type Store struct {
+	store    *dolt.DoltStore
+	beadsDir string
+
+	holdsWorkspace bool // Counted in openWorkspace until Close
+	releaseOnce    sync.Once
+
+	mu      sync.RWMutex
+	actor   string
+	session string
+	hooks   map[HookEvent][]HookFunc
+}
+
+// HookEvent names the change a hook runs after. The values match the
+// events of .beads/hooks scripts (on_create, on_update, on_close).
+type HookEvent string
+
+// HookEvent constants
+const (
+	HookCreate HookEvent = hooks.EventCreate
+	HookUpdate HookEvent = hooks.EventUpdate
+	HookClose  HookEvent = hooks.EventClose
+)
+
+// HookFunc is called after a change is committed, with the issue as stored.
+type HookFunc func(ctx context.Context, event HookEvent, issue *Issue)
+
+// Export formats accepted by Store.Export, as for 'bd export --format'.
+const (
+	ExportJSONL    = export.FormatJSONL
+	ExportCSV      = export.FormatCSV
+	ExportMarkdown = export.FormatMarkdown
+)
+
+// OpenStore opens the beads workspace at path: a directory containing
+// .beads/, or the .beads directory itself. Redirects and metadata.json
+// (including Dolt server mode) are honored as they are by bd. The workspace
+// must already exist; create one with 'bd init'.
+//
+// The workspace's config.yaml is loaded as bd loads it, so settings such as
+// hierarchy.separator and close.auto-archive-after apply. Configuration is
+// process-wide, so a process can use only one workspace at a time: while a
+// Store is open, OpenStore refuses other workspaces. The same workspace may
+// be opened more than once.
+func OpenStore(ctx context.Context, path string) (*Store, error) {
+	beadsDir := path
+	if filepath.Base(filepath.Clean(path)) != ".beads" {
+		beadsDir = filepath.Join(path, ".beads")
+	}
+	if info, err := os.Stat(beadsDir); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("no .beads directory in %s (run 'bd init' first)", path)
+	}
+	beadsDir = beads.FollowRedirect(beadsDir)
+
+	openWorkspace.Lock()
+	defer openWorkspace.Unlock()
+	if openWorkspace.stores > 0 && !sameDir(openWorkspace.beadsDir, beadsDir) {
+		return nil, fmt.Errorf("cannot open %s: workspace %s is already open in this process, and configuration is process-wide (close its Stores first)", beadsDir, openWorkspace.beadsDir)
+	}
+	if openWorkspace.stores == 0 {
+		if err := config.InitializeFromDir(beadsDir); err != nil {
+			return nil, fmt.Errorf("failed to load config for %s: %w", beadsDir, err)
+		}
+	}
+	store, err := dolt.NewFromConfig(ctx, beadsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open beads database in %s: %w", beadsDir, err)
+	}
+	openWorkspace.beadsDir = beadsDir
+	openWorkspace.stores++
+	return &Store{store: store, beadsDir: beadsDir, actor: defaultActor(), holdsWorkspace: true}, nil
+}
+
+// openWorkspace is the workspace whose configuration OpenStore loaded and
+// how many Stores hold it open.
+var openWorkspace struct {
+	sync.Mutex
+	beadsDir string
+	stores   int
+}
+
+func sameDir(a, b string) bool {
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	if errA != nil || errB != nil {
+		return filepath.Clean(a) == filepath.Clean(b)
+	}
+	return absA == absB
+}
+
+// NewStore wraps st, which must come from Open, OpenFromConfig or
+// Store.Storage, in a Store. Unlike OpenStore it loads no configuration and
+// does not hold the workspace open; bd uses it to run its commands on the
+// store it already has. BeadsDir returns "" and Close closes st.
+func NewStore(st Storage) (*Store, error) {
+	store, ok := st.(*dolt.DoltStore)
+	if !ok {
+		return nil, fmt.Errorf("unsupported storage type %T", st)
+	}
+	return &Store{store: store, actor: defaultActor()}, nil
+}
+
+// defaultActor follows bd's actor resolution without running git.
+func defaultActor() string {
+	for _, key := range []string{"BD_ACTOR", "BEADS_ACTOR", "USER"} {
+		if v := os.Getenv(key); v != "" {
+			return v
+		}
+	}
+	return "unknown"
+}
+
+// Close releases the database connection. Use CloseIssue to close an issue.
+func (s *Store) Close() error {
+	s.releaseOnce.Do(func() {
+		if !s.holdsWorkspace {
+			return
+		}
+		openWorkspace.Lock()
+		openWorkspace.stores--
+		openWorkspace.Unlock()
+	})
+	return s.store.Close()
+}
+
+// Storage returns the underlying storage for operations Store does not wrap.
+// Changes made through it do not run hooks.
+func (s *Store) Storage() Storage {
+	return s.store
+}
+
+// BeadsDir returns the resolved .beads directory opened by OpenStore.
+func (s *Store) BeadsDir() string {
+	return s.beadsDir
+}
+
+// SetActor sets the name recorded in the audit trail for changes made
+// through s. It defaults to $BD_ACTOR, $BEADS_ACTOR or $USER.
+func (s *Store) SetActor(actor string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.actor = actor
+}
+
+func (s *Store) currentActor() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.actor
+}
+
+// SetSession sets the session recorded on issues closed through s, as bd
+// close --session does.
+func (s *Store) SetSession(session string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.session = session
+}
+
+func (s *Store) currentSession() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.session
+}
+
+// OnEvent registers fn to run after every change of the given kind. Hooks
+// run synchronously, in registration order, on the goroutine that made the
+// change.
+func (s *Store) OnEvent(event HookEvent, fn HookFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.hooks == nil {
+		s.hooks = make(map[HookEvent][]HookFunc)
+	}
+	s.hooks[event] = append(s.hooks[event], fn)
+}
+
+func (s *Store) fire(ctx context.Context, event HookEvent, issue *Issue) {
+	s.mu.RLock()
+	fns := append([]HookFunc(nil), s.hooks[event]...)
+	s.mu.RUnlock()
+	for _, fn := range fns {
+		fn(ctx, event, issue)
+	}
+}
+
+// NewIssue returns an unsaved issue with title and the priority bd create
+// would give it: create.default-priority from config.yaml, else P2. Pass it
+// to Create after setting the other fields.
+func (s *Store) NewIssue(title string) (*Issue, error) {
+	priority, ok, err := config.CreateDefaultPriority()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		priority = 2
+	}
+	return &Issue{Title: title, Priority: priority, IssueType: TypeTask}, nil
+}
+
+// Create creates issue, as bd create does. An empty ID is generated from the
+// workspace prefix. An empty Status gets create.default-status (else open),
+// nil Labels get create.default-labels and an empty IssueType is task.
+// Priority is stored as given, so a zero Priority is P0; start from NewIssue
+// for bd create's default priority. Labels and Dependencies (with IssueID
+// empty or issue's ID) on issue are saved with it in one transaction: if any
+// dependency is rejected, nothing is created and no hook runs. issue is
+// updated in place with its ID and timestamps.
+func (s *Store) Create(ctx context.Context, issue *Issue) error {
+	return s.CreateWithOptions(ctx, issue, CreateOptions{})
+}
+
+// CreateOptions are the options of bd create that Store.Create does not take.
+type CreateOptions struct {
+	// IdempotencyKey, when set, is recorded with the new issue. If an
+	// unexpired entry for the key exists, nothing is created and
+	// ErrIdempotencyKeyUsed is returned (bd create --idempotency-key).
+	IdempotencyKey string
+	// IdempotencyTTL is how long IdempotencyKey is remembered; bd create
+	// uses create.idempotency-ttl.
+	IdempotencyTTL time.Duration
+}
+
+// ErrIdempotencyKeyUsed is returned by CreateWithOptions when an earlier
+// create already used the idempotency key.
+var ErrIdempotencyKeyUsed = storage.ErrIdempotencyKeyUsed
+
+// CreateWithOptions is Create with the options in opts.
+func (s *Store) CreateWithOptions(ctx context.Context, issue *Issue, opts CreateOptions) error {
+	if issue == nil {
+		return fmt.Errorf("issue must not be nil")
+	}
+	if issue.Status == "" {
+		status, err := config.CreateDefaultStatus()
+		if err != nil {
+			return err
+		}
+		issue.Status = status
+	}
+	if issue.Labels == nil {
+		issue.Labels = config.CreateDefaultLabels()
+	}
+	deps := issue.Dependencies
+	issue.Dependencies = nil
+	err := s.store.CreateIssueWithDependencies(ctx, issue, deps, s.currentActor(), storage.BatchCreateOptions{
+		SkipPrefixValidation: true,
+		IdempotencyKey:       opts.IdempotencyKey,
+		IdempotencyTTL:       opts.IdempotencyTTL,
+	})
+	issue.Dependencies = deps
+	if err != nil {
+		return err
+	}
+	for _, dep := range deps {
+		dep.IssueID = issue.ID
+	}
+	s.fire(ctx, HookCreate, issue)
+	return nil
+}
+
+// Update applies updates (column name to value, as accepted by
+// Storage.UpdateIssue) to the issue with the given ID or unique ID prefix,
+// and returns the updated issue. With no updates nothing is written, but
+// hooks still run.
+func (s *Store) Update(ctx context.Context, id string, updates map[string]interface{}) (*Issue, error) {
+	fullID, err := utils.ResolvePartialID(ctx, s.store, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(updates) > 0 {
+		if err := s.store.UpdateIssue(ctx, fullID, updates, s.currentActor()); err != nil {
+			return nil, err
+		}
+	}
+	issue, err := s.store.GetIssue(ctx, fullID)
+	if err != nil {
+		return nil, fmt.Errorf("updated %s but failed to reload it: %w", fullID, err)
+	}
+	s.fire(ctx, HookUpdate, issue)
+	return issue, nil
+}
+
+// CloseIssue closes the issue with the given ID or unique ID prefix, as bd
+// close does, and returns it.
+func (s *Store) CloseIssue(ctx context.Context, id, reason string) (*Issue, error) {
+	fullID, err := utils.ResolvePartialID(ctx, s.store, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.CloseIssue(ctx, fullID, reason, s.currentActor(), s.currentSession()); err != nil {
+		return nil, err
+	}
+	issue, err := s.store.GetIssue(ctx, fullID)
+	if err != nil {
+		return nil, fmt.Errorf("closed %s but failed to reload it: %w", fullID, err)
+	}
+	s.fire(ctx, HookClose, issue)
+	return issue, nil
+}
+
+// List returns the issues matching filter. Unlike bd list, nothing is
+// filtered by default: set filter.ExcludeStatus to leave out closed issues.
+func (s *Store) List(ctx context.Context, filter IssueFilter) ([]*Issue, error) {
+	return s.store.SearchIssues(ctx, "", filter)
+}
+
+// Show returns the issue with the given ID or unique ID prefix, with its
+// labels, dependencies and comments filled in, as bd show displays it.
+func (s *Store) Show(ctx context.Context, id string) (*Issue, error) {
+	fullID, err := utils.ResolvePartialID(ctx, s.store, id)
+	if err != nil {
+		return nil, err
+	}
+	issue, err := s.store.GetIssue(ctx, fullID)
+	if err != nil {
+		return nil, err
+	}
+	if issue.Labels, err = s.store.GetLabels(ctx, fullID); err != nil {
+		return nil, fmt.Errorf("failed to load labels of %s: %w", fullID, err)
+	}
+	if issue.Dependencies, err = s.store.GetDependencyRecords(ctx, fullID); err != nil {
+		return nil, fmt.Errorf("failed to load dependencies of %s: %w", fullID, err)
+	}
+	if issue.Comments, err = s.store.GetIssueComments(ctx, fullID); err != nil {
+		return nil, fmt.Errorf("failed to load comments of %s: %w", fullID, err)
+	}
+	return issue, nil
+}
+
+// Export writes the issues bd export writes (templates and infra types
+// excluded) to w in format (ExportJSONL, ExportCSV or ExportMarkdown) and
+// returns how many it wrote. JSONL output can be loaded with 'bd import'.
+func (s *Store) Export(ctx context.Context, w io.Writer, format string) (int, error) {
+	switch format {
+	case ExportJSONL, ExportCSV, ExportMarkdown:
+	default:
+		return 0, fmt.Errorf("invalid export format %q (valid: %s, %s, %s)", format, ExportJSONL, ExportCSV, ExportMarkdown)
+	}
+	snap, err := export.LoadIssues(ctx, s.store, export.Options{})
+	if err != nil {
+		return 0, err
+	}
+	return snap.Write(w, format)
+}
@@ -0,0 +1,299 @@
+package beads_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/beads"
+)
+
+// newTestWorkspace creates a workspace with an initialized database and
+// returns its root.
+func newTestWorkspace(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	beadsDir := filepath.Join(root, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatalf("failed to create .beads dir: %v", err)
+	}
+
+	ctx := context.Background()
+	storage, err := beads.OpenFromConfig(ctx, beadsDir)
+	if err != nil {
+		t.Fatalf("OpenFromConfig failed: %v", err)
+	}
+	defer storage.Close()
+	if err := storage.SetConfig(ctx, "issue_prefix", "lib"); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+	return root
+}
+
+func TestOpenStore_NoWorkspace(t *testing.T) {
+	_, err := beads.OpenStore(context.Background(), t.TempDir())
+	if err == nil || !strings.Contains(err.Error(), "bd init") {
+		t.Errorf("expected an error suggesting 'bd init', got %v", err)
+	}
+}
+
+func TestStore_Lifecycle(t *testing.T) {
+	skipIfNoDoltServer(t)
+
+	ctx := context.Background()
+	store, err := beads.OpenStore(ctx, newTestWorkspace(t))
+	if err != nil {
+		t.Fatalf("OpenStore failed: %v", err)
+	}
+	defer store.Close()
+	store.SetActor("library-test")
+
+	var events []string
+	record := func(_ context.Context, event beads.HookEvent, issue *beads.Issue) {
+		events = append(events, string(event)+":"+issue.ID)
+	}
+	store.OnEvent(beads.HookCreate, record)
+	store.OnEvent(beads.HookUpdate, record)
+	store.OnEvent(beads.HookClose, record)
+
+	parent := &beads.Issue{Title: "Embed beads", Priority: 1, IssueType: beads.TypeFeature, Labels: []string{"api"}}
+	if err := store.Create(ctx, parent); err != nil {
+		t.Fatalf("Create parent failed: %v", err)
+	}
+	if !strings.HasPrefix(parent.ID, "lib-") {
+		t.Errorf("generated ID %q does not use the workspace prefix", parent.ID)
+	}
+	child := &beads.Issue{
+		Title:        "Write the docs",
+		Priority:     2,
+		IssueType:    beads.TypeTask,
+		Dependencies: []*beads.Dependency{{DependsOnID: parent.ID, Type: beads.DepBlocks}},
+	}
+	if err := store.Create(ctx, child); err != nil {
+		t.Fatalf("Create child failed: %v", err)
+	}
+
+	shown, err := store.Show(ctx, child.ID)
+	if err != nil {
+		t.Fatalf("Show failed: %v", err)
+	}
+	if len(shown.Dependencies) != 1 || shown.Dependencies[0].DependsOnID != parent.ID {
+		t.Errorf("Show dependencies = %v, want one on %s", shown.Dependencies, parent.ID)
+	}
+	if shown, err = store.Show(ctx, parent.ID); err != nil || len(shown.Labels) != 1 || shown.Labels[0] != "api" {
+		t.Errorf("Show(%s) labels = %v (err %v), want [api]", parent.ID, shown.Labels, err)
+	}
+
+	updated, err := store.Update(ctx, child.ID, map[string]interface{}{"assignee": "alice"})
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if updated.Assignee != "alice" {
+		t.Errorf("Update assignee = %q, want alice", updated.Assignee)
+	}
+
+	closed, err := store.CloseIssue(ctx, parent.ID, "shipped")
+	if err != nil {
+		t.Fatalf("CloseIssue failed: %v", err)
+	}
+	if closed.Status != beads.StatusClosed {
+		t.Errorf("CloseIssue status = %q, want closed", closed.Status)
+	}
+
+	open, err := store.List(ctx, beads.IssueFilter{ExcludeStatus: []beads.Status{beads.StatusClosed}})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(open) != 1 || open[0].ID != child.ID {
+		t.Errorf("List open = %d issues, want only %s", len(open), child.ID)
+	}
+
+	var buf bytes.Buffer
+	n, err := store.Export(ctx, &buf, beads.ExportJSONL)
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if n != 2 || strings.Count(buf.String(), "\n") != 2 {
+		t.Errorf("Export wrote %d issues:\n%s", n, buf.String())
+	}
+	if _, err := store.Export(ctx, &buf, "yaml"); err == nil {
+		t.Error("Export accepted an unknown format")
+	}
+
+	want := []string{"create:" + parent.ID, "create:" + child.ID, "update:" + child.ID, "close:" + parent.ID}
+	if strings.Join(events, " ") != strings.Join(want, " ") {
+		t.Errorf("hooks fired %v, want %v", events, want)
+	}
+}
+
+func TestStore_CreateIsAtomic(t *testing.T) {
+	skipIfNoDoltServer(t)
+
+	ctx := context.Background()
+	root := newTestWorkspace(t)
+	if err := os.WriteFile(filepath.Join(root, ".beads", "config.yaml"), []byte("hierarchy:\n  separator: \":\"\n"), 0600); err != nil {
+		t.Fatalf("failed to write config.yaml: %v", err)
+	}
+	store, err := beads.OpenStore(ctx, root)
+	if err != nil {
+		t.Fatalf("OpenStore failed: %v", err)
+	}
+	defer store.Close()
+
+	var events []string
+	store.OnEvent(beads.HookCreate, func(_ context.Context, _ beads.HookEvent, issue *beads.Issue) {
+		events = append(events, issue.ID)
+	})
+
+	parent := &beads.Issue{Title: "Parent", Priority: 2, IssueType: beads.TypeEpic}
+	if err := store.Create(ctx, parent); err != nil {
+		t.Fatalf("Create parent failed: %v", err)
+	}
+	child := &beads.Issue{Title: "Child", Priority: 2, IssueType: beads.TypeTask, ChildOf: parent.ID}
+	if err := store.Create(ctx, child); err != nil {
+		t.Fatalf("Create child failed: %v", err)
+	}
+	if want := parent.ID + ":1"; child.ID != want {
+		t.Errorf("child ID = %q, want %q (config.yaml separator)", child.ID, want)
+	}
+
+	// A rejected dependency leaves no issue behind and runs no hook
+	orphan := &beads.Issue{
+		Title:        "Blocked by nothing",
+		Priority:     2,
+		IssueType:    beads.TypeTask,
+		Dependencies: []*beads.Dependency{{DependsOnID: "lib-missing", Type: beads.DepBlocks}},
+	}
+	if err := store.Create(ctx, orphan); err == nil {
+		t.Fatal("Create accepted a dependency on a missing issue")
+	}
+	all, err := store.List(ctx, beads.IssueFilter{})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(all) != 2 {
+		t.Errorf("List = %d issues after the failed create, want 2", len(all))
+	}
+	if want := []string{parent.ID, child.ID}; strings.Join(events, " ") != strings.Join(want, " ") {
+		t.Errorf("hooks fired for %v, want %v", events, want)
+	}
+}
+
+func TestOpenStore_OneWorkspacePerProcess(t *testing.T) {
+	skipIfNoDoltServer(t)
+
+	ctx := context.Background()
+	first, second := newTestWorkspace(t), newTestWorkspace(t)
+
+	store, err := beads.OpenStore(ctx, first)
+	if err != nil {
+		t.Fatalf("OpenStore failed: %v", err)
+	}
+	again, err := beads.OpenStore(ctx, filepath.Join(first, ".beads"))
+	if err != nil {
+		t.Fatalf("reopening the same workspace failed: %v", err)
+	}
+	if _, err := beads.OpenStore(ctx, second); err == nil || !strings.Contains(err.Error(), "already open") {
+		t.Fatalf("expected a second workspace to be refused, got %v", err)
+	}
+
+	// The workspace stays held until every Store on it is closed
+	_ = store.Close()
+	_ = store.Close()
+	if _, err := beads.OpenStore(ctx, second); err == nil {
+		t.Fatal("second workspace opened while a Store on the first was still open")
+	}
+	_ = again.Close()
+
+	other, err := beads.OpenStore(ctx, second)
+	if err != nil {
+		t.Fatalf("OpenStore after closing the first workspace failed: %v", err)
+	}
+	_ = other.Close()
+}
+
+func TestStore_CreateDefaults(t *testing.T) {
+	skipIfNoDoltServer(t)
+
+	ctx := context.Background()
+	root := newTestWorkspace(t)
+	cfg := "create:\n  default-priority: P3\n  default-status: deferred\n  default-labels: [triage]\n"
+	if err := os.WriteFile(filepath.Join(root, ".beads", "config.yaml"), []byte(cfg), 0600); err != nil {
+		t.Fatalf("failed to write config.yaml: %v", err)
+	}
+	store, err := beads.OpenStore(ctx, root)
+	if err != nil {
+		t.Fatalf("OpenStore failed: %v", err)
+	}
+	defer store.Close()
+
+	issue, err := store.NewIssue("Defaults from config.yaml")
+	if err != nil {
+		t.Fatalf("NewIssue failed: %v", err)
+	}
+	if err := store.Create(ctx, issue); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	shown, err := store.Show(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("Show failed: %v", err)
+	}
+	if shown.Priority != 3 || shown.Status != beads.StatusDeferred || shown.IssueType != beads.TypeTask {
+		t.Errorf("got priority %d, status %q, type %q; want 3, deferred, task", shown.Priority, shown.Status, shown.IssueType)
+	}
+	if strings.Join(shown.Labels, ",") != "triage" {
+		t.Errorf("labels = %v, want [triage]", shown.Labels)
+	}
+
+	// Explicit values win, including an empty label list
+	explicit := &beads.Issue{Title: "Explicit", Priority: 1, Status: beads.StatusOpen, Labels: []string{}}
+	if err := store.Create(ctx, explicit); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if shown, err = store.Show(ctx, explicit.ID); err != nil {
+		t.Fatalf("Show failed: %v", err)
+	}
+	if shown.Priority != 1 || shown.Status != beads.StatusOpen || len(shown.Labels) != 0 {
+		t.Errorf("got priority %d, status %q, labels %v; want 1, open, none", shown.Priority, shown.Status, shown.Labels)
+	}
+}
+
+func TestNewStore_WrapsOpenStorage(t *testing.T) {
+	skipIfNoDoltServer(t)
+
+	ctx := context.Background()
+	root := newTestWorkspace(t)
+	storage, err := beads.OpenFromConfig(ctx, filepath.Join(root, ".beads"))
+	if err != nil {
+		t.Fatalf("OpenFromConfig failed: %v", err)
+	}
+	store, err := beads.NewStore(storage)
+	if err != nil {
+		t.Fatalf("NewStore failed: %v", err)
+	}
+	defer store.Close()
+	store.SetActor("lib-test")
+	store.SetSession("session-1")
+
+	issue := &beads.Issue{Title: "Wrapped", Priority: 2, IssueType: beads.TypeTask}
+	if err := store.CreateWithOptions(ctx, issue, beads.CreateOptions{IdempotencyKey: "once", IdempotencyTTL: time.Hour}); err != nil {
+		t.Fatalf("CreateWithOptions failed: %v", err)
+	}
+	again := &beads.Issue{Title: "Wrapped", Priority: 2, IssueType: beads.TypeTask}
+	if err := store.CreateWithOptions(ctx, again, beads.CreateOptions{IdempotencyKey: "once", IdempotencyTTL: time.Hour}); !errors.Is(err, beads.ErrIdempotencyKeyUsed) {
+		t.Errorf("reused idempotency key: got %v, want ErrIdempotencyKeyUsed", err)
+	}
+
+	closed, err := store.CloseIssue(ctx, issue.ID, "done")
+	if err != nil {
+		t.Fatalf("CloseIssue failed: %v", err)
+	}
+	if closed.ClosedBySession != "session-1" {
+		t.Errorf("ClosedBySession = %q, want session-1", closed.ClosedBySession)
+	}
+}